@@ -87,9 +87,18 @@ func (acc *Account) Copy() *Account {
 	accCopy := *acc
 	accCopy.Permissions.Roles = make([]string, len(acc.Permissions.Roles))
 	copy(accCopy.Permissions.Roles, acc.Permissions.Roles)
+	if acc.EvictedStorageHash != nil {
+		accCopy.EvictedStorageHash = make([]byte, len(acc.EvictedStorageHash))
+		copy(accCopy.EvictedStorageHash, acc.EvictedStorageHash)
+	}
 	return &accCopy
 }
 
+// Dormant accounts have had their storage evicted for non-payment of storage rent
+func (acc *Account) Dormant() bool {
+	return len(acc.EvictedStorageHash) > 0
+}
+
 func (acc *Account) Equal(accOther *Account) bool {
 	accEnc, err := acc.Encode()
 	if err != nil {
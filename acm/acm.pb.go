@@ -1,19 +1,19 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: acm.proto
 
-package acm // import "github.com/hyperledger/burrow/acm"
+package acm
 
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import crypto "github.com/hyperledger/burrow/crypto"
-import permission "github.com/hyperledger/burrow/permission"
-
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-
-import io "io"
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	crypto "github.com/hyperledger/burrow/crypto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	permission "github.com/hyperledger/burrow/permission"
+	io "io"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -28,21 +28,27 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Account struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	PublicKey            crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey" json:"PublicKey"`
-	Sequence             uint64                                       `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
-	Balance              uint64                                       `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
-	Code                 Bytecode                                     `protobuf:"bytes,5,opt,name=Code,proto3,customtype=Bytecode" json:"Code"`
-	Permissions          permission.AccountPermissions                `protobuf:"bytes,6,opt,name=Permissions" json:"Permissions"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address     github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	PublicKey   crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey"`
+	Sequence    uint64                                       `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Balance     uint64                                       `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Code        Bytecode                                     `protobuf:"bytes,5,opt,name=Code,proto3,customtype=Bytecode" json:"Code"`
+	Permissions permission.AccountPermissions                `protobuf:"bytes,6,opt,name=Permissions,proto3" json:"Permissions"`
+	// The number of words of storage held by the account as of the last commit (only maintained when storage rent is
+	// enabled)
+	StorageWords uint64 `protobuf:"varint,7,opt,name=StorageWords,proto3" json:"StorageWords,omitempty"`
+	// When non-empty the account is dormant: its storage has been evicted for non-payment of rent and can only be
+	// restored from storage matching this hash
+	EvictedStorageHash   []byte   `protobuf:"bytes,8,opt,name=EvictedStorageHash,proto3" json:"EvictedStorageHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Account) Reset()      { *m = Account{} }
 func (*Account) ProtoMessage() {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acm_f7c82c29a83feed4, []int{0}
+	return fileDescriptor_49ed775bc0a6adf6, []int{0}
 }
 func (m *Account) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -59,8 +65,8 @@ func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Account) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account.Merge(dst, src)
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
 }
 func (m *Account) XXX_Size() int {
 	return m.Size()
@@ -99,6 +105,20 @@ func (m *Account) GetPermissions() permission.AccountPermissions {
 	return permission.AccountPermissions{}
 }
 
+func (m *Account) GetStorageWords() uint64 {
+	if m != nil {
+		return m.StorageWords
+	}
+	return 0
+}
+
+func (m *Account) GetEvictedStorageHash() []byte {
+	if m != nil {
+		return m.EvictedStorageHash
+	}
+	return nil
+}
+
 func (*Account) XXX_MessageName() string {
 	return "acm.Account"
 }
@@ -106,6 +126,37 @@ func init() {
 	proto.RegisterType((*Account)(nil), "acm.Account")
 	golang_proto.RegisterType((*Account)(nil), "acm.Account")
 }
+
+func init() { proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
+func init() { golang_proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
+
+var fileDescriptor_49ed775bc0a6adf6 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x3b, 0xb7, 0xb9, 0x4d, 0x3b, 0xed, 0xa2, 0x77, 0x56, 0x43, 0x17, 0x69, 0x6f, 0x71,
+	0xd1, 0x85, 0x26, 0xe0, 0x1f, 0x04, 0x77, 0x8d, 0x28, 0x82, 0x20, 0x25, 0x5d, 0x08, 0xee, 0x92,
+	0xc9, 0x31, 0x0d, 0x34, 0x9d, 0x38, 0x99, 0x28, 0x79, 0x09, 0xd7, 0x2e, 0x7d, 0x14, 0x97, 0x5d,
+	0xba, 0x76, 0x51, 0xa4, 0x7d, 0x11, 0xe9, 0x38, 0xad, 0x11, 0xc4, 0xdd, 0x7c, 0xe7, 0x77, 0xce,
+	0x9c, 0x8f, 0xef, 0xe0, 0x86, 0xcf, 0x12, 0x3b, 0x15, 0x5c, 0x72, 0x52, 0xf5, 0x59, 0xd2, 0xd9,
+	0x8b, 0x62, 0x39, 0xc9, 0x03, 0x9b, 0xf1, 0xc4, 0x89, 0x78, 0xc4, 0x1d, 0xc5, 0x82, 0xfc, 0x56,
+	0x29, 0x25, 0xd4, 0xeb, 0x73, 0xa6, 0xd3, 0x4e, 0x41, 0x24, 0x71, 0x96, 0xc5, 0x7c, 0xa6, 0x2b,
+	0x2d, 0x26, 0x8a, 0x54, 0x6a, 0xde, 0x7f, 0xac, 0x62, 0x73, 0xc8, 0x18, 0xcf, 0x67, 0x92, 0x5c,
+	0x61, 0x73, 0x18, 0x86, 0x02, 0xb2, 0x8c, 0xa2, 0x1e, 0x1a, 0xb4, 0xdc, 0xc3, 0xf9, 0xa2, 0x5b,
+	0x79, 0x5b, 0x74, 0x77, 0x4b, 0x3b, 0x27, 0x45, 0x0a, 0x62, 0x0a, 0x61, 0x04, 0xc2, 0x09, 0x72,
+	0x21, 0xf8, 0x83, 0xa3, 0x3f, 0xd4, 0xb3, 0xde, 0xe6, 0x13, 0x72, 0x84, 0x1b, 0xa3, 0x3c, 0x98,
+	0xc6, 0xec, 0x12, 0x0a, 0xfa, 0xa7, 0x87, 0x06, 0xcd, 0xfd, 0x7f, 0xb6, 0x6e, 0xde, 0x02, 0xd7,
+	0x58, 0x2f, 0xf1, 0xbe, 0x3a, 0x49, 0x07, 0xd7, 0xc7, 0x70, 0x97, 0xc3, 0x8c, 0x01, 0xad, 0xf6,
+	0xd0, 0xc0, 0xf0, 0xb6, 0x9a, 0x50, 0x6c, 0xba, 0xfe, 0xd4, 0x5f, 0x23, 0x43, 0xa1, 0x8d, 0x24,
+	0x3b, 0xd8, 0x38, 0xe5, 0x21, 0xd0, 0xbf, 0xca, 0x79, 0x5b, 0x3b, 0xaf, 0xbb, 0x85, 0x04, 0xc6,
+	0x43, 0xf0, 0x14, 0x25, 0xe7, 0xb8, 0x39, 0xda, 0x06, 0x92, 0xd1, 0x9a, 0x32, 0x65, 0xd9, 0xa5,
+	0x90, 0x74, 0x18, 0xa5, 0x2e, 0xed, 0xb0, 0x3c, 0x48, 0xfa, 0xb8, 0x35, 0x96, 0x5c, 0xf8, 0x11,
+	0x5c, 0x73, 0x11, 0x66, 0xd4, 0x54, 0x66, 0xbe, 0xd5, 0x88, 0x8d, 0xc9, 0xd9, 0x7d, 0xcc, 0x24,
+	0x84, 0xba, 0x7c, 0xe1, 0x67, 0x13, 0x5a, 0x5f, 0xfb, 0xf3, 0x7e, 0x20, 0x27, 0xc6, 0xd3, 0x73,
+	0xb7, 0xe2, 0x1e, 0xcf, 0x97, 0x16, 0x7a, 0x5d, 0x5a, 0xe8, 0x7d, 0x69, 0xa1, 0x97, 0x95, 0x85,
+	0xe6, 0x2b, 0x0b, 0xdd, 0xfc, 0xff, 0xfd, 0x02, 0x3e, 0x4b, 0x82, 0x9a, 0x3a, 0xe8, 0xc1, 0xc7,
+	0x00, 0x5d, 0x84, 0xa8, 0x30, 0x31, 0x02, 0x00, 0x00,
+}
+
 func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -163,6 +214,17 @@ func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if m.StorageWords != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(m.StorageWords))
+	}
+	if len(m.EvictedStorageHash) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(len(m.EvictedStorageHash)))
+		i += copy(dAtA[i:], m.EvictedStorageHash)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -198,6 +260,13 @@ func (m *Account) Size() (n int) {
 	n += 1 + l + sovAcm(uint64(l))
 	l = m.Permissions.Size()
 	n += 1 + l + sovAcm(uint64(l))
+	if m.StorageWords != 0 {
+		n += 1 + sovAcm(uint64(m.StorageWords))
+	}
+	l = len(m.EvictedStorageHash)
+	if l > 0 {
+		n += 1 + l + sovAcm(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -232,7 +301,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -260,7 +329,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -269,6 +338,9 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthAcm
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -290,7 +362,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -299,6 +371,9 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthAcm
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -320,7 +395,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= (uint64(b) & 0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -339,7 +414,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= (uint64(b) & 0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -358,7 +433,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -367,6 +442,9 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthAcm
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -388,7 +466,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -397,6 +475,9 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthAcm
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -404,6 +485,59 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageWords", wireType)
+			}
+			m.StorageWords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageWords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedStorageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvictedStorageHash = append(m.EvictedStorageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvictedStorageHash == nil {
+				m.EvictedStorageHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
@@ -413,6 +547,9 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthAcm
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAcm
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -480,10 +617,13 @@ func skipAcm(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthAcm
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthAcm
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -512,6 +652,9 @@ func skipAcm(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthAcm
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -530,31 +673,3 @@ var (
 	ErrInvalidLengthAcm = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowAcm   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("acm.proto", fileDescriptor_acm_f7c82c29a83feed4) }
-func init() { golang_proto.RegisterFile("acm.proto", fileDescriptor_acm_f7c82c29a83feed4) }
-
-var fileDescriptor_acm_f7c82c29a83feed4 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbd, 0x4e, 0xfb, 0x30,
-	0x14, 0xc5, 0xeb, 0x36, 0xff, 0x7e, 0xb8, 0x1d, 0xfa, 0xf7, 0x64, 0x75, 0x70, 0x0b, 0x62, 0xe8,
-	0x00, 0x89, 0xc4, 0x87, 0x90, 0xd8, 0x1a, 0x24, 0x16, 0x24, 0x54, 0x85, 0x8d, 0x2d, 0x71, 0x2e,
-	0x69, 0xa4, 0xa6, 0x0e, 0x4e, 0x2c, 0x94, 0x37, 0x61, 0xe4, 0x39, 0x98, 0x18, 0x3b, 0x32, 0x33,
-	0x54, 0x28, 0x7d, 0x11, 0x54, 0xe3, 0x86, 0x4c, 0x6c, 0x3e, 0xfe, 0xdd, 0x73, 0xef, 0xd1, 0xc1,
-	0x3d, 0x9f, 0x27, 0x76, 0x2a, 0x45, 0x2e, 0x48, 0xcb, 0xe7, 0xc9, 0xe8, 0x24, 0x8a, 0xf3, 0x85,
-	0x0a, 0x6c, 0x2e, 0x12, 0x27, 0x12, 0x91, 0x70, 0x34, 0x0b, 0xd4, 0xa3, 0x56, 0x5a, 0xe8, 0xd7,
-	0x8f, 0x67, 0x34, 0x4c, 0x41, 0x26, 0x71, 0x96, 0xc5, 0x62, 0x65, 0x7e, 0x06, 0x5c, 0x16, 0x69,
-	0x6e, 0xf8, 0xe1, 0x5b, 0x13, 0x77, 0x66, 0x9c, 0x0b, 0xb5, 0xca, 0xc9, 0x1d, 0xee, 0xcc, 0xc2,
-	0x50, 0x42, 0x96, 0x51, 0x34, 0x41, 0xd3, 0x81, 0x7b, 0xbe, 0xde, 0x8c, 0x1b, 0x9f, 0x9b, 0xf1,
-	0x71, 0xed, 0xe6, 0xa2, 0x48, 0x41, 0x2e, 0x21, 0x8c, 0x40, 0x3a, 0x81, 0x92, 0x52, 0x3c, 0x3b,
-	0x66, 0xa1, 0xf1, 0x7a, 0xfb, 0x25, 0xe4, 0x02, 0xf7, 0xe6, 0x2a, 0x58, 0xc6, 0xfc, 0x16, 0x0a,
-	0xda, 0x9c, 0xa0, 0x69, 0xff, 0xf4, 0xbf, 0x6d, 0x86, 0x2b, 0xe0, 0x5a, 0xbb, 0x23, 0xde, 0xef,
-	0x24, 0x19, 0xe1, 0xee, 0x3d, 0x3c, 0x29, 0x58, 0x71, 0xa0, 0xad, 0x09, 0x9a, 0x5a, 0x5e, 0xa5,
-	0x09, 0xc5, 0x1d, 0xd7, 0x5f, 0xfa, 0x3b, 0x64, 0x69, 0xb4, 0x97, 0xe4, 0x08, 0x5b, 0xd7, 0x22,
-	0x04, 0xfa, 0x4f, 0x27, 0x1f, 0x9a, 0xe4, 0x5d, 0xb7, 0xc8, 0x81, 0x8b, 0x10, 0x3c, 0x4d, 0xc9,
-	0x0d, 0xee, 0xcf, 0xab, 0x42, 0x32, 0xda, 0xd6, 0xa1, 0x98, 0x5d, 0x2b, 0xc9, 0x94, 0x51, 0x9b,
-	0x32, 0x09, 0xeb, 0xc6, 0x2b, 0xeb, 0xe5, 0x75, 0xdc, 0x70, 0x2f, 0xd7, 0x25, 0x43, 0x1f, 0x25,
-	0x43, 0x5f, 0x25, 0x43, 0xef, 0x5b, 0x86, 0xd6, 0x5b, 0x86, 0x1e, 0x0e, 0xfe, 0x6e, 0xcb, 0xe7,
-	0x49, 0xd0, 0xd6, 0xe5, 0x9f, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x10, 0xec, 0x45, 0x82, 0xdd,
-	0x01, 0x00, 0x00,
-}
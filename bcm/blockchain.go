@@ -131,6 +131,10 @@ func (bc *Blockchain) CommitBlockAtHeight(blockTime time.Time, blockHash, appHas
 	if err != nil {
 		return err
 	}
+	if !bc.lastBlockTime.IsZero() && bc.lastBlockHeight+1 == height {
+		blockInterval.Observe(blockTime.Sub(bc.lastBlockTime).Seconds())
+	}
+	blockHeight.Set(float64(height))
 	bc.lastBlockHeight = height
 	bc.lastBlockTime = blockTime
 	bc.lastBlockHash = blockHash
@@ -139,6 +143,13 @@ func (bc *Blockchain) CommitBlockAtHeight(blockTime time.Time, blockHash, appHas
 	return nil
 }
 
+// Persist the current blockchain state immediately rather than waiting for the next block to be committed
+func (bc *Blockchain) Checkpoint() error {
+	bc.Lock()
+	defer bc.Unlock()
+	return bc.save()
+}
+
 func (bc *Blockchain) save() error {
 	if bc.db != nil {
 		encodedState, err := bc.Encode()
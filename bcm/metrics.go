@@ -0,0 +1,25 @@
+package bcm
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	blockHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "burrow",
+		Subsystem: "chain",
+		Name:      "block_height",
+		Help:      "Height of the last committed block",
+	})
+	blockInterval = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "chain",
+		Name:      "block_interval_seconds",
+		Help:      "Time between the block times of consecutive blocks",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30, 60, 300},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(blockHeight, blockInterval)
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/util/abigen"
+	cli "github.com/jawher/mow.cli"
+)
+
+func Abi(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("gen", "generate typed Go bindings for a contract from its ABI or the bin file output by "+
+			"burrow deploy", func(cmd *cli.Cmd) {
+			fileArg := cmd.StringArg("FILE", "", "ABI (.abi) or deploy output (.bin) file")
+			nameOpt := cmd.StringOpt("n name", "", "Go type name for the contract, defaults to the file name "+
+				"without its extension")
+			packageOpt := cmd.StringOpt("p package", "", "Go package name for the bindings, defaults to the "+
+				"lower-cased contract name")
+			outputOpt := cmd.StringOpt("o output", "", "File to write the bindings to, defaults to STDOUT")
+
+			cmd.Spec = "[--name=<contract name>] [--package=<package name>] [--output=<file>] FILE"
+
+			cmd.Action = func() {
+				abiOrBin, err := ioutil.ReadFile(*fileArg)
+				if err != nil {
+					output.Fatalf("Could not read %s: %v", *fileArg, err)
+				}
+				name := *nameOpt
+				if name == "" {
+					name = strings.TrimSuffix(filepath.Base(*fileArg), filepath.Ext(*fileArg))
+				}
+				pkg := *packageOpt
+				if pkg == "" {
+					pkg = strings.ToLower(name)
+				}
+				src, err := abigen.Generate(pkg, name, abiOrBin)
+				if err != nil {
+					output.Fatalf("Could not generate bindings for %s: %v", *fileArg, err)
+				}
+				if *outputOpt == "" {
+					output.Printf("%s", src)
+					return
+				}
+				err = ioutil.WriteFile(*outputOpt, src, 0644)
+				if err != nil {
+					output.Fatalf("Could not write bindings to %s: %v", *outputOpt, err)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"strings"
 
 	"github.com/hyperledger/burrow/config"
@@ -70,11 +71,13 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		restoreDumpOpt := cmd.StringOpt("restore-dump", "", "Including AppHash for restored file")
 
+		restoreDiffOpt := cmd.StringsOpt("restore-diff", nil, "Diff dumps to apply in order on top of --restore-dump")
+
 		cmd.Spec = "[--keys-url=<keys URL> | --keysdir=<keys directory>] " +
 			"[--config-template-in=<text template> --config-out=<output file>]... " +
 			"[--genesis-spec=<GenesisSpec file> | --genesis-doc=<GenesisDoc file>] " +
 			"[--separate-genesis-doc=<genesis JSON file>] [--chain-name=<chain name>] [--json] " +
-			"[--generate-node-keys] [--restore-dump=<dump file>] " +
+			"[--generate-node-keys] [--restore-dump=<dump file> [--restore-diff=<diff dump file>]...] " +
 			"[--logging=<logging program>] [--describe-logging] [--debug]"
 
 		configOpts := addConfigOptions(cmd)
@@ -215,21 +218,23 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("no GenesisDoc provided, cannot restore dump")
 				}
 
-				if len(conf.GenesisDoc.Validators) == 0 {
-					output.Fatalf("On restore, validators must be provided in GenesisDoc or GenesisSpec")
-				}
-
-				reader, err := state.NewFileDumpReader(*restoreDumpOpt)
+				// The chain params affect what is stored in state so must be taken from the dump before we build it
+				header, err := state.ReadDumpHeader(*restoreDumpOpt)
 				if err != nil {
-					output.Fatalf("Failed to read restore dump: %v", err)
+					output.Fatalf("could not read dump header from %s: %v", *restoreDumpOpt, err)
 				}
+				state.SetDumpGenesis(header, conf.GenesisDoc)
 
 				st, err := state.MakeGenesisState(db.NewMemDB(), conf.GenesisDoc)
 				if err != nil {
 					output.Fatalf("could not generate state from genesis: %v", err)
 				}
 
-				err = st.LoadDump(reader)
+				header, err = st.LoadDumpFiles(*restoreDumpOpt, *restoreDiffOpt...)
+				if err != nil {
+					output.Fatalf("could not restore dump %s: %v", *restoreDumpOpt, err)
+				}
+				err = state.CheckDumpGenesis(header, conf.GenesisDoc)
 				if err != nil {
 					output.Fatalf("could not restore dump %s: %v", *restoreDumpOpt, err)
 				}
@@ -239,6 +244,16 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("could not commit: %v", err)
 				}
 
+				// Tendermint takes its validators from the GenesisDoc so they must agree with the restored state
+				conf.GenesisDoc.Validators, err = restoredValidators(conf.GenesisDoc.Validators, st)
+				if err != nil {
+					output.Fatalf("could not read restored validators: %v", err)
+				}
+
+				if len(conf.GenesisDoc.Validators) == 0 {
+					output.Fatalf("On restore, validators must be provided in GenesisDoc, GenesisSpec, or the dump")
+				}
+
 				conf.GenesisDoc.AppHash = st.Hash()
 			}
 
@@ -325,3 +340,42 @@ func processTemplate(pkg *deployment.Config, config *genesis.GenesisDoc, templat
 	}
 	return ioutil.WriteFile(templateOut, []byte(output), 0644)
 }
+
+// Get the validators with their power as in the restored state st, keeping the details of those also in the genesis
+// validators. Genesis validators absent from the restored state have no power so are dropped since Tendermint does not
+// accept validators without power in its genesis.
+func restoredValidators(genesisValidators []genesis.Validator, st *state.State) ([]genesis.Validator, error) {
+	var restored []genesis.Validator
+	indices := make(map[crypto.Address]int)
+	err := st.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		indices[id.GetAddress()] = len(restored)
+		restored = append(restored, genesis.Validator{
+			BasicAccount: genesis.BasicAccount{
+				Address:   id.GetAddress(),
+				PublicKey: id.GetPublicKey(),
+				Amount:    power.Uint64(),
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]genesis.Validator, 0, len(restored))
+	for _, gv := range genesisValidators {
+		i, ok := indices[gv.PublicKey.GetAddress()]
+		if !ok {
+			continue
+		}
+		gv.Amount = restored[i].Amount
+		validators = append(validators, gv)
+		delete(indices, gv.PublicKey.GetAddress())
+	}
+	// Then any validators that only appear in the dump in the order of the restored state
+	for _, rv := range restored {
+		if _, ok := indices[rv.Address]; ok {
+			validators = append(validators, rv)
+		}
+	}
+	return validators, nil
+}
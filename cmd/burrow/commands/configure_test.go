@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestRestoredValidators(t *testing.T) {
+	kept := acm.GeneratePrivateAccountFromSecret("kept").GetPublicKey()
+	dropped := acm.GeneratePrivateAccountFromSecret("dropped").GetPublicKey()
+	added := acm.GeneratePrivateAccountFromSecret("added").GetPublicKey()
+
+	genesisDoc := &genesis.GenesisDoc{
+		ChainName: "Restored",
+		Validators: []genesis.Validator{
+			{BasicAccount: genesis.BasicAccount{Address: kept.GetAddress(), PublicKey: kept, Amount: 10}, Name: "kept"},
+			{BasicAccount: genesis.BasicAccount{Address: dropped.GetAddress(), PublicKey: dropped, Amount: 20}},
+		},
+	}
+	// As burrow configure --restore-dump does
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	require.NoError(t, err)
+	_, err = st.LoadDump(newDumpReader(t,
+		&dump.Dump{Header: &dump.Header{Version: dump.Version, ChainID: "Original"}},
+		&dump.Dump{Validator: validator.New(kept, big.NewInt(5))},
+		&dump.Dump{Validator: validator.New(added, big.NewInt(7))},
+	))
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+
+	validators, err := restoredValidators(genesisDoc.Validators, st)
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+	assert.Equal(t, "kept", validators[0].Name)
+	assert.Equal(t, uint64(5), validators[0].Amount)
+	assert.Equal(t, added, validators[1].PublicKey)
+	assert.Equal(t, uint64(7), validators[1].Amount)
+}
+
+// Reads rows followed by a valid trailer
+type dumpReader struct {
+	rows []*dump.Dump
+}
+
+func newDumpReader(t *testing.T, rows ...*dump.Dump) *dumpReader {
+	digest := dump.NewDigest()
+	for _, row := range rows {
+		require.NoError(t, digest.Write(row))
+	}
+	return &dumpReader{rows: append(rows, &dump.Dump{Trailer: &dump.Trailer{Checksum: digest.Sum()}})}
+}
+
+func (dr *dumpReader) Next() (*dump.Dump, error) {
+	if len(dr.rows) == 0 {
+		return nil, nil
+	}
+	row := dr.rows[0]
+	dr.rows = dr.rows[1:]
+	return row, nil
+}
@@ -39,8 +39,8 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		binPathOpt := cmd.StringOpt("b bin-path", "[dir]/bin",
 			"path to the bin directory jobs should use when saving binaries after the compile process defaults to --dir + /bin")
 
-		defaultGasOpt := cmd.StringOpt("g gas", "1111111111",
-			"default gas to use; can be overridden for any single job")
+		defaultGasOpt := cmd.StringOpt("g gas", "",
+			"default gas to use for jobs that do not specify their own, without it the gas such jobs need is estimated")
 
 		jobsOpt := cmd.IntOpt("j jobs", 2,
 			"default number of concurrent solidity compilers to run")
@@ -23,6 +23,8 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		chainURLOpt := cmd.StringOpt("u chain-url", "127.0.0.1:10997", "chain-url to be used in IP:PORT format")
 		heightOpt := cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height")
+		fromHeightOpt := cmd.IntOpt("f from-height", 0, "Only dump the changes since this block height, "+
+			"producing a diff dump to restore on top of a dump taken at that height")
 		filename := cmd.StringArg("FILE", "", "Save dump here")
 		useJSON := cmd.BoolOpt("j json", false, "Output in json")
 
@@ -41,7 +43,10 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 			}
 			dc := rpcdump.NewDumpClient(conn)
 
-			dump, err := dc.GetDump(ctx, &rpcdump.GetDumpParam{Height: uint64(*heightOpt)})
+			dump, err := dc.GetDump(ctx, &rpcdump.GetDumpParam{
+				Height:     uint64(*heightOpt),
+				FromHeight: uint64(*fromHeightOpt),
+			})
 			if err != nil {
 				output.Fatalf("failed to retrieve dump: %v", err)
 				return
@@ -4,7 +4,12 @@ import (
 	"encoding/json"
 
 	"github.com/hyperledger/burrow/bcm"
-
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/export"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	cli "github.com/jawher/mow.cli"
 	"github.com/tendermint/tendermint/libs/db"
@@ -15,6 +20,7 @@ func Examine(output Output) func(cmd *cli.Cmd) {
 		configOpt := dump.StringOpt("c config", "", "Use the a specified burrow config file")
 
 		var explorer *bcm.BlockStore
+		var dbDir string
 
 		dump.Before = func() {
 			conf, err := obtainBurrowConfig(*configOpt, "")
@@ -23,7 +29,8 @@ func Examine(output Output) func(cmd *cli.Cmd) {
 			}
 			tmConf := conf.Tendermint.TendermintConfig()
 
-			explorer = bcm.NewBlockExplorer(db.DBBackendType(tmConf.DBBackend), tmConf.DBDir())
+			dbDir = tmConf.DBDir()
+			explorer = bcm.NewBlockExplorer(db.DBBackendType(tmConf.DBBackend), dbDir)
 		}
 
 		dump.Command("blocks", "dump blocks to stdout", func(cmd *cli.Cmd) {
@@ -87,5 +94,61 @@ func Examine(output Output) func(cmd *cli.Cmd) {
 				}
 			}
 		})
+
+		dump.Command("export", "export blocks, transactions, events, and accounts to files partitioned by "+
+			"height for offline analytics", func(cmd *cli.Cmd) {
+			rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT where omitting "+
+				"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
+			outOpt := cmd.StringOpt("o out", "export", "Directory beneath which to write a directory per table")
+			formatOpt := cmd.StringOpt("f format", "csv", "Format in which to write tables, either csv "+
+				"or parquet")
+			partitionOpt := cmd.IntOpt("p partition-size", export.DefaultPartitionSize,
+				"Number of heights in each partition of each table")
+			abiOpt := cmd.StringsOpt("a abi", nil, "ABI file or directory of ABI files with which to decode "+
+				"log events, may be given multiple times")
+
+			cmd.Spec = "[--out=<directory>] [--format=<format>] [--partition-size=<heights>] [--abi=<path>...] " +
+				"[RANGE]"
+
+			cmd.Action = func() {
+				format, ok := export.Formats[*formatOpt]
+				if !ok {
+					output.Fatalf("Unknown export format '%s'", *formatOpt)
+				}
+				if *partitionOpt <= 0 {
+					output.Fatalf("Partition size must be positive")
+				}
+				start, end, err := replayRange(*rangeArg, uint64(explorer.Height())+1)
+				if err != nil {
+					output.Fatalf("Could not parse range: %v", err)
+				}
+				if start == 0 {
+					start = 1
+				}
+				if end <= start {
+					output.Fatalf("Range %s contains no blocks", *rangeArg)
+				}
+				// Load the state as of the last block we export, any writes stay in the cache so the node's
+				// database is untouched
+				st, err := state.LoadState(storage.NewCacheDB(core.NewBurrowDB(dbDir)), state.VersionAtHeight(end-1))
+				if err != nil {
+					output.Fatalf("Could not load state: %v", err)
+				}
+				var abiSpec *abi.AbiSpec
+				if len(*abiOpt) > 0 {
+					abiSpec, err = abi.LoadPath(*abiOpt...)
+					if err != nil {
+						output.Fatalf("Could not load ABI: %v", err)
+					}
+				}
+				exporter := export.NewExporter(explorer, st, format, *outOpt, uint64(*partitionOpt), abiSpec,
+					logging.NewNoopLogger())
+				err = exporter.Export(start, end-1)
+				if err != nil {
+					output.Fatalf("Could not export: %v", err)
+				}
+				output.Logf("Exported heights %d to %d beneath %s", start, end-1, *outOpt)
+			}
+		})
 	}
 }
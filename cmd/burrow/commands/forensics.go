@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/forensics"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+)
+
+func Forensics(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpt := cmd.StringOpt("c config", "", "Use the a specified burrow config file")
+
+		var replay *forensics.Replay
+
+		cmd.Before = func() {
+			conf, err := obtainBurrowConfig(*configOpt, "")
+			if err != nil {
+				output.Fatalf("Could not obtain config: %v", err)
+			}
+			if conf.GenesisDoc == nil {
+				output.Fatalf("No GenesisDoc in config, cannot replay blocks")
+			}
+			replay = forensics.NewReplay(conf.Tendermint.TendermintConfig().DBDir(), conf.GenesisDoc,
+				logging.NewNoopLogger())
+		}
+
+		printJSON := func(v interface{}) {
+			bs, err := json.Marshal(v)
+			if err != nil {
+				output.Fatalf("Could not serialise output: %v", err)
+			}
+			output.Printf(string(bs))
+		}
+
+		cmd.Command("replay", "replay blocks against stored state printing the app hashes and TxExecutions "+
+			"of each", func(cmd *cli.Cmd) {
+			rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT where omitting "+
+				"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
+
+			cmd.Spec = "[RANGE]"
+
+			cmd.Action = func() {
+				start, end, err := replayRange(*rangeArg, replay.Height()+1)
+				if err != nil {
+					output.Fatalf("Could not parse range: %v", err)
+				}
+				recaps, err := replay.Blocks(start, end)
+				if err != nil {
+					output.Fatalf("Could not replay blocks: %v", err)
+				}
+				for _, recap := range recaps {
+					printJSON(recap)
+				}
+			}
+		})
+
+		cmd.Command("bisect", "find the first block whose replayed app hash differs from the one recorded "+
+			"by consensus and report how its TxExecutions differ from those stored", func(cmd *cli.Cmd) {
+			rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT to search where omitting "+
+				"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
+
+			cmd.Spec = "[RANGE]"
+
+			cmd.Action = func() {
+				// The app hash of the last block is recorded in the next block so we cannot bisect the last block
+				start, end, err := replayRange(*rangeArg, replay.Height())
+				if err != nil {
+					output.Fatalf("Could not parse range: %v", err)
+				}
+				recap, err := replay.Bisect(start, end)
+				if err != nil {
+					output.Fatalf("Could not bisect blocks: %v", err)
+				}
+				if recap == nil {
+					output.Logf("No divergent block found between heights %d and %d", start, end)
+					return
+				}
+				output.Logf("First divergent block is at height %d", recap.Height)
+				div, err := forensics.CompareReplay(replay, recap)
+				if err != nil {
+					output.Fatalf("Could not compare replay with stored state: %v", err)
+				}
+				printJSON(div)
+			}
+		})
+
+		cmd.Command("report", "report the differences between the TxExecutions and state stored by this "+
+			"node and another node at a height", func(cmd *cli.Cmd) {
+			otherOpt := cmd.StringOpt("o other-config", "", "Burrow config file of the other node")
+			heightArg := cmd.IntArg("HEIGHT", 0, "Height of the block after which to compare")
+
+			cmd.Spec = "--other-config=<burrow config file> HEIGHT"
+
+			cmd.Action = func() {
+				otherConf, err := obtainBurrowConfig(*otherOpt, "")
+				if err != nil {
+					output.Fatalf("Could not obtain config of other node: %v", err)
+				}
+				if otherConf.GenesisDoc == nil {
+					output.Fatalf("No GenesisDoc in config of other node")
+				}
+				other := forensics.NewReplay(otherConf.Tendermint.TendermintConfig().DBDir(), otherConf.GenesisDoc,
+					logging.NewNoopLogger())
+				div, err := forensics.Compare(replay, other, uint64(*heightArg))
+				if err != nil {
+					output.Fatalf("Could not compare nodes: %v", err)
+				}
+				printJSON(div)
+			}
+		})
+	}
+}
+
+// Resolve a range argument to a start (inclusive) and end (exclusive) height where negative heights count back from
+// limit, which is the exclusive end height of the full range
+func replayRange(rangeString string, limit uint64) (uint64, uint64, error) {
+	start, end, err := parseRange(rangeString)
+	if err != nil {
+		return 0, 0, err
+	}
+	if start < 0 {
+		start += int64(limit)
+	}
+	if end < 0 {
+		end += int64(limit) + 1
+	}
+	if start < 0 || end < 0 {
+		return 0, 0, fmt.Errorf("range %s extends before the first block", rangeString)
+	}
+	return uint64(start), uint64(end), nil
+}
@@ -23,12 +23,12 @@ import (
 	"github.com/hyperledger/burrow/util/snatives/templates"
 )
 
-// Dump SNative contracts
-func Snatives(output Output) func(cmd *cli.Cmd) {
+// Dump SNative contracts registered with natives
+func Snatives(output Output, natives *evm.Natives) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		contractsOpt := cmd.StringsOpt("c contracts", nil, "Contracts to generate")
 		cmd.Action = func() {
-			contracts := evm.SNativeContracts()
+			contracts := natives.SNativeContracts()
 			// Index of next contract
 			i := 1
 			for _, contract := range contracts {
@@ -25,6 +25,14 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		evmVersionOpt := cmd.StringOpt("param-evmversion", "", "EVM hard fork whose opcodes are enabled, "+
+			"one of: byzantium (default), istanbul")
+		blockGasLimitOpt := cmd.IntOpt("param-blockgaslimit", 0, "Maximum total gas the CallTxs in a block "+
+			"may use, 0 for unlimited")
+		storageRentPerWordOpt := cmd.IntOpt("param-storagerentperword", 0, "Rent charged per word of contract "+
+			"storage each storage rent period, 0 to disable storage rent")
+		storageRentPeriodOpt := cmd.IntOpt("param-storagerentperiod", 0, "Number of blocks between collections "+
+			"of storage rent, 0 for the default")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
 			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
@@ -59,6 +67,18 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			if *evmVersionOpt != "" {
+				genesisSpec.Params.EVMVersion = *evmVersionOpt
+			}
+			if *blockGasLimitOpt > 0 {
+				genesisSpec.Params.BlockGasLimit = uint64(*blockGasLimitOpt)
+			}
+			if *storageRentPerWordOpt > 0 {
+				genesisSpec.Params.StorageRentPerWord = uint64(*storageRentPerWordOpt)
+			}
+			if *storageRentPeriodOpt > 0 {
+				genesisSpec.Params.StorageRentPeriod = uint64(*storageRentPeriodOpt)
+			}
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
@@ -2,8 +2,14 @@ package commands
 
 import (
 	"context"
+	"os"
 
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcsnapshot"
+	"github.com/hyperledger/burrow/snapshot"
 	cli "github.com/jawher/mow.cli"
+	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
 )
 
 func Start(output Output) func(cmd *cli.Cmd) {
@@ -15,7 +21,35 @@ func Start(output Output) func(cmd *cli.Cmd) {
 
 		restoreDumpOpt := cmd.StringOpt("restore-dump", "", "Restore new chain from backup")
 
-		cmd.Spec = "[--config=<config file>] [--genesis=<genesis json file>] [--restore-dump=<burrow dump file>]"
+		restoreDiffOpt := cmd.StringsOpt("restore-diff", nil, "Diff dumps (see burrow dump --from-height) to "+
+			"apply in order on top of --restore-dump")
+
+		fromSnapshotOpt := cmd.StringOpt("from-snapshot", "", "Restore state of a new node from a snapshot "+
+			"before starting, either a local snapshot directory or the GRPC address of a peer serving snapshots")
+
+		snapshotAppHashOpt := cmd.StringOpt("snapshot-app-hash", "", "The trusted app hash (hex) of the "+
+			"snapshot to restore - the snapshot is rejected unless it reproduces exactly this state")
+
+		snapshotCAOpt := cmd.StringOpt("snapshot-ca", "", "PEM file of the CAs to verify the snapshot peer's "+
+			"certificate against, the system roots are used if not given")
+
+		snapshotCertOpt := cmd.StringOpt("snapshot-cert", "", "Certificate to present to a snapshot peer that "+
+			"requires mutual TLS")
+
+		snapshotKeyOpt := cmd.StringOpt("snapshot-key", "", "Key of --snapshot-cert")
+
+		snapshotTokenOpt := cmd.String(cli.StringOpt{
+			Name:   "snapshot-token",
+			Desc:   "Bearer token to call a snapshot peer that requires authorization",
+			EnvVar: "BURROW_SNAPSHOT_TOKEN",
+		})
+
+		snapshotInsecureOpt := cmd.BoolOpt("snapshot-insecure", false, "Connect to the snapshot peer without TLS")
+
+		cmd.Spec = "[--config=<config file>] [--genesis=<genesis json file>] " +
+			"[--restore-dump=<burrow dump file> [--restore-diff=<burrow diff dump file>]... | " +
+			"--from-snapshot=<directory or address> --snapshot-app-hash=<hash> [--snapshot-ca=<PEM file>] " +
+			"[--snapshot-cert=<PEM file> --snapshot-key=<PEM file>] [--snapshot-token=<token>] [--snapshot-insecure]]"
 
 		configOpts := addConfigOptions(cmd)
 
@@ -39,7 +73,41 @@ func Start(output Output) func(cmd *cli.Cmd) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			kern, err := conf.Kernel(ctx, *restoreDumpOpt)
+			if *fromSnapshotOpt != "" {
+				appHash, err := hex.DecodeString(*snapshotAppHashOpt)
+				if err != nil || len(appHash) == 0 {
+					output.Fatalf("a valid --snapshot-app-hash is required to restore from a snapshot: %v", err)
+				}
+				var source snapshot.Source
+				if info, err := os.Stat(*fromSnapshotOpt); err == nil && info.IsDir() {
+					source = snapshot.NewStore(*fromSnapshotOpt)
+				} else {
+					clientConfig := &rpc.ClientConfig{
+						CAFile:   *snapshotCAOpt,
+						CertFile: *snapshotCertOpt,
+						KeyFile:  *snapshotKeyOpt,
+						Token:    *snapshotTokenOpt,
+						Insecure: *snapshotInsecureOpt,
+					}
+					dialOptions, err := clientConfig.DialOptions()
+					if err != nil {
+						output.Fatalf("could not configure connection to snapshot peer: %v", err)
+					}
+					conn, err := grpc.Dial(*fromSnapshotOpt, dialOptions...)
+					if err != nil {
+						output.Fatalf("could not connect to snapshot peer: %v", err)
+					}
+					defer conn.Close()
+					source = rpcsnapshot.NewSource(ctx, rpcsnapshot.NewSnapshotClient(conn))
+				}
+				manifest, err := conf.RestoreSnapshot(source, appHash)
+				if err != nil {
+					output.Fatalf("could not restore from snapshot: %v", err)
+				}
+				output.Logf("Restored state from snapshot at height %d", manifest.Height)
+			}
+
+			kern, err := conf.Kernel(ctx, *restoreDumpOpt, *restoreDiffOpt...)
 			if err != nil {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
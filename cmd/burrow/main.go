@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/hyperledger/burrow/cmd/burrow/commands"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/project"
 	cli "github.com/jawher/mow.cli"
 )
@@ -50,11 +51,23 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("examine", "Dump objects from an offline Burrow .burrow directory",
 		commands.Examine(output))
 
+	app.Command("forensics", "Replay blocks from an offline Burrow .burrow directory to diagnose consensus failures",
+		commands.Forensics(output))
+
 	app.Command("deploy", "Deploy and test contracts",
 		commands.Deploy(output))
 
+	// The StorageRent interface does not depend on the rent charged so include it whether or not a chain enables rent
+	natives := evm.DefaultNatives()
+	err := natives.RegisterSNative(evm.StorageRentContract(0))
+	if err != nil {
+		panic(err)
+	}
 	app.Command("snatives", "Dump Solidity interface contracts for SNatives",
-		commands.Snatives(output))
+		commands.Snatives(output, natives))
+
+	app.Command("abi", "Generate typed Go bindings from contract ABIs",
+		commands.Abi(output))
 
 	app.Command("vent", "Start the Vent EVM event and blocks consumer service to populated databases from smart contracts",
 		commands.Vent(output))
@@ -14,6 +14,8 @@ import (
 	"github.com/hyperledger/burrow/logging/lifecycle"
 	logging_config "github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/snapshot"
+	"github.com/hyperledger/burrow/tracing"
 )
 
 const DefaultBurrowConfigTOMLFileName = "burrow.toml"
@@ -31,6 +33,8 @@ type BurrowConfig struct {
 	Keys       *keys.KeysConfig                   `json:",omitempty" toml:",omitempty"`
 	RPC        *rpc.RPCConfig                     `json:",omitempty" toml:",omitempty"`
 	Logging    *logging_config.LoggingConfig      `json:",omitempty" toml:",omitempty"`
+	Snapshot   *snapshot.SnapshotConfig           `json:",omitempty" toml:",omitempty"`
+	Tracing    *tracing.TracingConfig             `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowConfig() *BurrowConfig {
@@ -40,10 +44,12 @@ func DefaultBurrowConfig() *BurrowConfig {
 		RPC:        rpc.DefaultRPCConfig(),
 		Execution:  execution.DefaultExecutionConfig(),
 		Logging:    logging_config.DefaultNodeLoggingConfig(),
+		Snapshot:   snapshot.DefaultSnapshotConfig(),
+		Tracing:    tracing.DefaultTracingConfig(),
 	}
 }
 
-func (conf *BurrowConfig) Kernel(ctx context.Context, restoreDump string) (*core.Kernel, error) {
+func (conf *BurrowConfig) Kernel(ctx context.Context, restoreDump string, restoreDiffs ...string) (*core.Kernel, error) {
 	if conf.GenesisDoc == nil {
 		return nil, fmt.Errorf("no GenesisDoc defined in config, cannot make Kernel")
 	}
@@ -76,6 +82,13 @@ func (conf *BurrowConfig) Kernel(ctx context.Context, restoreDump string) (*core
 	}
 	privValidator := tendermint.NewPrivValidatorMemory(val, signer)
 
+	var restore []string
+	if restoreDump != "" {
+		restore = append([]string{restoreDump}, restoreDiffs...)
+	} else if len(restoreDiffs) > 0 {
+		return nil, fmt.Errorf("diff dumps can only be restored on top of a full dump")
+	}
+
 	var exeOptions []execution.ExecutionOption
 	if conf.Execution != nil {
 		exeOptions, err = conf.Execution.ExecutionOptions()
@@ -84,8 +97,27 @@ func (conf *BurrowConfig) Kernel(ctx context.Context, restoreDump string) (*core
 		}
 	}
 
+	// Spans are recorded throughout the node so the tracer is installed globally
+	tracer, err := conf.Tracing.Tracer(logger)
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetTracer(tracer)
+
 	return core.NewKernel(ctx, keyClient, privValidator, conf.GenesisDoc, conf.Tendermint.TendermintConfig(), conf.RPC,
-		conf.Keys, keyStore, exeOptions, conf.Tendermint.DefaultAuthorizedPeersProvider(), restoreDump, logger)
+		conf.Keys, conf.Snapshot, conf.Tendermint.MempoolPriority, keyStore, exeOptions, conf.Tendermint.DefaultAuthorizedPeersProvider(), restore, logger)
+}
+
+// Restore state from the snapshot in source matching the trusted appHash ready for a Kernel to be started
+func (conf *BurrowConfig) RestoreSnapshot(source snapshot.Source, appHash []byte) (*snapshot.Manifest, error) {
+	if conf.GenesisDoc == nil {
+		return nil, fmt.Errorf("no GenesisDoc defined in config, cannot restore snapshot")
+	}
+	logger, err := lifecycle.NewLoggerFromLoggingConfig(conf.Logging)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate logger from logging config: %v", err)
+	}
+	return core.RestoreSnapshot(conf.Tendermint.TendermintConfig(), conf.GenesisDoc, source, appHash, logger)
 }
 
 func (conf *BurrowConfig) JSONString() string {
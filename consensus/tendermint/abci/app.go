@@ -1,6 +1,7 @@
 package abci
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"runtime/debug"
@@ -15,7 +16,9 @@ import (
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/tracing"
 	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -219,15 +222,21 @@ func txExecutor(name string, executor execution.BatchExecutor, txDecoder txs.Dec
 		if err != nil {
 			logger.InfoMsg("Decoding error",
 				structure.ErrorKey, err)
+			txRejections.WithLabelValues(name, "encoding error").Inc()
 			return abciTypes.ResponseCheckTx{
 				Code: codes.EncodingErrorCode,
 				Log:  logf("Encoding error: %s", err),
 			}
 		}
 
+		_, span := tracing.StartTx(context.Background(), txEnv.Tx.Hash(), "abci."+name)
+		defer span.End()
+
 		txe, err := executor.Execute(txEnv)
 		if err != nil {
+			span.SetError(err)
 			ex := errors.AsException(err)
+			txRejections.WithLabelValues(name, ex.ErrorCode().String()).Inc()
 			logger.InfoMsg("Execution error",
 				structure.ErrorKey, err,
 				"tx_hash", txEnv.Tx.Hash())
@@ -252,10 +261,21 @@ func txExecutor(name string, executor execution.BatchExecutor, txDecoder txs.Dec
 			Code: codes.TxExecutionSuccessCode,
 			Log:  logf("Execution success - TxExecution in data"),
 			Data: bs,
+			// Tendermint reaps txs from the mempool up to the consensus MaxGas by GasWanted
+			GasWanted: int64(gasLimit(txEnv)),
+			GasUsed:   int64(txe.Result.GetGasUsed()),
 		}
 	}
 }
 
+// The gas a transaction may consume - only CallTxs consume gas
+func gasLimit(txEnv *txs.Envelope) uint64 {
+	if callTx, ok := txEnv.Tx.Payload.(*payload.CallTx); ok {
+		return callTx.GasLimit
+	}
+	return 0
+}
+
 func (app *App) EndBlock(reqEndBlock abciTypes.RequestEndBlock) abciTypes.ResponseEndBlock {
 	var validatorUpdates []abciTypes.ValidatorUpdate
 	defer func() {
@@ -288,6 +308,10 @@ func (app *App) Commit() abciTypes.ResponseCommit {
 		}
 	}()
 	blockTime := app.block.Header.Time
+	_, span := tracing.Start(context.Background(), "abci.Commit")
+	span.SetAttribute("height", app.block.Header.Height)
+	span.SetAttribute("txs", app.block.Header.NumTxs)
+	defer span.End()
 	app.logger.InfoMsg("Committing block",
 		"tag", "Commit",
 		structure.ScopeKey, "Commit()",
@@ -0,0 +1,307 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/tendermint/tendermint/mempool"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// Reasons given for the removal of a transaction from the mempool other than an error from CheckTx
+const (
+	MempoolReasonCommitted   = "committed in a block"
+	MempoolReasonNotAdmitted = "not admitted by Tendermint after passing CheckTx"
+)
+
+var mempoolEventTag = query.TagMap{event.MessageTypeKey: reflect.TypeOf(&MempoolEvent{}).String()}
+
+// The parts of Tendermint's mempool needed to list and recheck its transactions
+type TendermintMempool interface {
+	sync.Locker
+	ReapMaxTxs(max int) tmTypes.Txs
+	Update(height int64, txs tmTypes.Txs, preCheck mempool.PreCheckFunc, postCheck mempool.PostCheckFunc) error
+}
+
+// A transaction admitted to the mempool
+type MempoolTx struct {
+	Envelope *txs.Envelope
+	// The receipt from the most recent CheckTx of the transaction, nil if it was admitted before we were tracking
+	Receipt  *txs.Receipt
+	Admitted time.Time
+}
+
+// Published when a transaction is admitted to or removed from the mempool
+type MempoolEvent struct {
+	Tx      *MempoolTx
+	Removed bool
+	// Why the transaction was removed
+	Reason string
+}
+
+func (ev *MempoolEvent) Tagged() query.Tagged {
+	return query.MergeTags(mempoolEventTag, query.TagMap{event.TxHashKey: ev.Tx.Envelope.Tx.Hash()})
+}
+
+func QueryForMempoolEvents() *query.Builder {
+	return query.NewBuilder().AndEquals(event.MessageTypeKey, reflect.TypeOf(&MempoolEvent{}).String())
+}
+
+// Evicted transactions are rejected by CheckTx for this long, after which they may be resubmitted
+const EvictedTxTTL = 10 * time.Minute
+
+// Mempool tracks the transactions Tendermint's mempool admits through CheckTx along with the receipt each got,
+// publishes MempoolEvents as they are admitted and removed, and can evict them
+type Mempool struct {
+	tip        bcm.BlockchainInfo
+	checker    execution.BatchExecutor
+	tendermint TendermintMempool
+	txDecoder  txs.Decoder
+	publisher  event.Publisher
+	// Priority of transactions and whether they are admitted given the number we hold out of capacity
+	prioritiser *Prioritiser
+	capacity    int
+	logger      *logging.Logger
+	now         func() time.Time
+	mtx         sync.Mutex
+	// Keyed by tx hash
+	txs map[string]*MempoolTx
+	// Keyed by tx hash, CheckTx rejects these transactions until they expire
+	evicted map[string]evictedTx
+}
+
+type evictedTx struct {
+	reason  string
+	expires time.Time
+}
+
+// NewMempool assigns transactions priorities with prioritiser, which may be nil, and applies its load limits against
+// the capacity of Tendermint's mempool
+func NewMempool(tip bcm.BlockchainInfo, txDecoder txs.Decoder, publisher event.Publisher, prioritiser *Prioritiser,
+	capacity int, logger *logging.Logger) *Mempool {
+
+	return &Mempool{
+		tip:         tip,
+		txDecoder:   txDecoder,
+		publisher:   publisher,
+		prioritiser: prioritiser,
+		capacity:    capacity,
+		logger:      logger.With(structure.ComponentKey, "Mempool"),
+		now:         time.Now,
+		txs:         make(map[string]*MempoolTx),
+		evicted:     make(map[string]evictedTx),
+	}
+}
+
+// Checker wraps the executor used by CheckTx so that we see the transactions it admits and, on recheck, removes
+func (mp *Mempool) Checker(checker execution.BatchExecutor) execution.BatchExecutor {
+	mp.checker = checker
+	return &mempoolChecker{BatchExecutor: checker, mempool: mp}
+}
+
+// Committer wraps the executor used by DeliverTx so that we see the transactions removed from the mempool by commits
+func (mp *Mempool) Committer(committer execution.BatchCommitter) execution.BatchCommitter {
+	return &mempoolCommitter{BatchCommitter: committer, mempool: mp}
+}
+
+// Provide Tendermint's mempool, which must be done before transactions can be listed or evicted
+func (mp *Mempool) SetTendermintMempool(tendermint TendermintMempool) {
+	mp.tendermint = tendermint
+}
+
+func (mp *Mempool) Get(txHash []byte) (*MempoolTx, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mtx, ok := mp.txs[string(txHash)]
+	return mtx, ok
+}
+
+// List the transactions in Tendermint's mempool in the order they will be proposed
+func (mp *Mempool) List() ([]*MempoolTx, error) {
+	if mp.tendermint == nil {
+		return nil, fmt.Errorf("Tendermint's mempool has not been provided")
+	}
+	// Anything we are tracking before reaping has finished CheckTx so must have been added to Tendermint's mempool
+	// by the time we can reap
+	mp.mtx.Lock()
+	tracked := make(map[string]*MempoolTx, len(mp.txs))
+	for key, mtx := range mp.txs {
+		tracked[key] = mtx
+	}
+	mp.mtx.Unlock()
+
+	reaped := mp.tendermint.ReapMaxTxs(-1)
+	mtxs := make([]*MempoolTx, 0, len(reaped))
+	for _, txBytes := range reaped {
+		txEnv, err := mp.txDecoder.DecodeTx(txBytes)
+		if err != nil {
+			return nil, err
+		}
+		txHash := txEnv.Tx.Hash()
+		mtx, ok := tracked[string(txHash)]
+		if ok {
+			delete(tracked, string(txHash))
+		} else if mtx, ok = mp.Get(txHash); !ok {
+			mtx = &MempoolTx{Envelope: txEnv}
+		}
+		mtxs = append(mtxs, mtx)
+	}
+	// Tendermint can reject a transaction after CheckTx, for example if it wants more gas than a block allows
+	for key := range tracked {
+		mp.remove([]byte(key), MempoolReasonNotAdmitted)
+	}
+	return mtxs, nil
+}
+
+// Evict a transaction from the mempool by having CheckTx reject it and then making Tendermint recheck its mempool.
+// Since the check cache is reset for the recheck any transactions depending on the evicted transaction will also be
+// removed. Eviction requires Tendermint's recheck to be enabled, which it is by default. The transaction is rejected if
+// resubmitted within EvictedTxTTL.
+func (mp *Mempool) Evict(txHash []byte, reason string) (*MempoolTx, error) {
+	if mp.tendermint == nil {
+		return nil, fmt.Errorf("Tendermint's mempool has not been provided")
+	}
+	mp.mtx.Lock()
+	mtx, ok := mp.txs[string(txHash)]
+	if ok {
+		now := mp.now()
+		// Entries are only added here so sweeping here bounds them by the evictions made within EvictedTxTTL
+		for key, etx := range mp.evicted {
+			if !now.Before(etx.expires) {
+				delete(mp.evicted, key)
+			}
+		}
+		mp.evicted[string(txHash)] = evictedTx{reason: reason, expires: now.Add(EvictedTxTTL)}
+	}
+	mp.mtx.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no transaction with hash %X in the mempool", txHash)
+	}
+	mp.logger.InfoMsg("Evicting transaction from mempool", "tx_hash", mtx.Envelope.Tx.Hash(), "reason", reason)
+	return mtx, mp.recheck()
+}
+
+// Reset the check cache and have Tendermint recheck the transactions in its mempool. Unlike during a commit we cannot
+// hold the checker's lock here without risking deadlock with the commit so reads of the check cache, for example by
+// mempool signing, may briefly see state from before the pending transactions.
+func (mp *Mempool) recheck() error {
+	mp.tendermint.Lock()
+	defer mp.tendermint.Unlock()
+	err := mp.checker.Reset()
+	if err != nil {
+		return err
+	}
+	return mp.tendermint.Update(int64(mp.tip.LastBlockHeight()), nil, nil, nil)
+}
+
+func (mp *Mempool) admit(txEnv *txs.Envelope, receipt *txs.Receipt) {
+	mp.mtx.Lock()
+	mtx, ok := mp.txs[string(txEnv.Tx.Hash())]
+	if ok {
+		// Rechecked so replace rather than update since callers may hold the previous MempoolTx
+		mp.txs[string(txEnv.Tx.Hash())] = &MempoolTx{
+			Envelope: mtx.Envelope,
+			Receipt:  receipt,
+			Admitted: mtx.Admitted,
+		}
+		mp.mtx.Unlock()
+		return
+	}
+	mtx = &MempoolTx{
+		Envelope: txEnv,
+		Receipt:  receipt,
+		Admitted: time.Now(),
+	}
+	mp.txs[string(txEnv.Tx.Hash())] = mtx
+	mp.mtx.Unlock()
+	mp.publish(&MempoolEvent{Tx: mtx})
+}
+
+func (mp *Mempool) remove(txHash []byte, reason string) {
+	mp.mtx.Lock()
+	mtx, ok := mp.txs[string(txHash)]
+	delete(mp.txs, string(txHash))
+	if reason == MempoolReasonCommitted {
+		delete(mp.evicted, string(txHash))
+	}
+	mp.mtx.Unlock()
+	if ok {
+		mp.publish(&MempoolEvent{Tx: mtx, Removed: true, Reason: reason})
+	}
+}
+
+// Whether a new transaction with priority may be admitted given how full the mempool is
+func (mp *Mempool) admits(priority uint64) bool {
+	mp.mtx.Lock()
+	size := len(mp.txs)
+	mp.mtx.Unlock()
+	return mp.prioritiser.Admits(priority, size, mp.capacity)
+}
+
+func (mp *Mempool) evictedReason(txHash []byte) (string, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	etx, ok := mp.evicted[string(txHash)]
+	if !ok || !mp.now().Before(etx.expires) {
+		return "", false
+	}
+	return etx.reason, true
+}
+
+func (mp *Mempool) publish(ev *MempoolEvent) {
+	err := mp.publisher.Publish(context.Background(), ev, ev.Tagged())
+	if err != nil {
+		mp.logger.InfoMsg("Could not publish MempoolEvent", structure.ErrorKey, err)
+	}
+}
+
+type mempoolChecker struct {
+	execution.BatchExecutor
+	mempool *Mempool
+}
+
+func (mc *mempoolChecker) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	txHash := txEnv.Tx.Hash()
+	if reason, ok := mc.mempool.evictedReason(txHash); ok {
+		mc.mempool.remove(txHash, reason)
+		return nil, errors.ErrorCodef(errors.ErrorCodeTxEvicted, "transaction %v was evicted: %s", txHash, reason)
+	}
+	priority := mc.mempool.prioritiser.Priority(txEnv.Tx)
+	// Transactions already admitted are being rechecked so are not subject to load limits
+	if _, ok := mc.mempool.Get(txHash); !ok && !mc.mempool.admits(priority) {
+		return nil, errors.ErrorCodef(errors.ErrorCodeInsufficientPriority,
+			"mempool is under load and transaction %v has priority %d below the minimum of %d", txHash, priority,
+			mc.mempool.prioritiser.MinPriority())
+	}
+	txe, err := mc.BatchExecutor.Execute(txEnv)
+	if err != nil {
+		// Only removes the transaction if this is a recheck
+		mc.mempool.remove(txHash, err.Error())
+		return nil, err
+	}
+	txe.Receipt.Priority = priority
+	mc.mempool.admit(txEnv, txe.Receipt)
+	return txe, nil
+}
+
+type mempoolCommitter struct {
+	execution.BatchCommitter
+	mempool *Mempool
+}
+
+func (mc *mempoolCommitter) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	mc.mempool.remove(txEnv.Tx.Hash(), MempoolReasonCommitted)
+	return mc.BatchCommitter.Execute(txEnv)
+}
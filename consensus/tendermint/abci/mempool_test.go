@@ -0,0 +1,196 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/mempool"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestMempool(t *testing.T) {
+	emitter := event.NewEmitter(logging.NewNoopLogger())
+	txCodec := txs.NewAminoCodec()
+	mp := NewMempool(testTip{}, txCodec, emitter, nil, 0, logging.NewNoopLogger())
+	checker := mp.Checker(newTestChecker())
+	committer := mp.Committer(&testCommitter{checker: newTestChecker()})
+	tm := &testTendermintMempool{checker: checker, txCodec: txCodec}
+	mp.SetTendermintMempool(tm)
+
+	events, err := emitter.Subscribe(context.Background(), "test", QueryForMempoolEvents(), 100)
+	require.NoError(t, err)
+
+	alice := crypto.Address{1}
+	bob := crypto.Address{2}
+	aliceFirst := tm.checkTx(t, alice, 1)
+	aliceSecond := tm.checkTx(t, alice, 2)
+	bobFirst := tm.checkTx(t, bob, 1)
+	// Bad sequence so not admitted
+	tm.checkTx(t, bob, 3)
+
+	for _, txEnv := range []*txs.Envelope{aliceFirst, aliceSecond, bobFirst} {
+		ev := receive(t, events)
+		assert.False(t, ev.Removed)
+		assert.Equal(t, txEnv.Tx.Hash(), ev.Tx.Envelope.Tx.Hash())
+	}
+
+	mtxs, err := mp.List()
+	require.NoError(t, err)
+	require.Len(t, mtxs, 3)
+	mtx, ok := mp.Get(bobFirst.Tx.Hash())
+	require.True(t, ok)
+	assert.Equal(t, bobFirst.Tx.Hash(), mtx.Receipt.TxHash)
+
+	// Evicting alice's first transaction invalidates her second
+	_, err = mp.Evict(aliceFirst.Tx.Hash(), "poisoned")
+	require.NoError(t, err)
+	ev := receive(t, events)
+	assert.True(t, ev.Removed)
+	assert.Equal(t, aliceFirst.Tx.Hash(), ev.Tx.Envelope.Tx.Hash())
+	assert.Equal(t, "poisoned", ev.Reason)
+	ev = receive(t, events)
+	assert.True(t, ev.Removed)
+	assert.Equal(t, aliceSecond.Tx.Hash(), ev.Tx.Envelope.Tx.Hash())
+	assert.Contains(t, ev.Reason, errors.ErrorCodeInvalidSequence.String())
+
+	mtxs, err = mp.List()
+	require.NoError(t, err)
+	require.Len(t, mtxs, 1)
+	assert.Equal(t, bobFirst.Tx.Hash(), mtxs[0].Envelope.Tx.Hash())
+
+	// An evicted transaction is not readmitted
+	_, err = checker.Execute(aliceFirst)
+	assert.Equal(t, errors.ErrorCodeTxEvicted, errors.AsException(err).ErrorCode())
+	_, err = mp.Evict(aliceFirst.Tx.Hash(), "again")
+	assert.Error(t, err)
+
+	_, err = committer.Execute(bobFirst)
+	require.NoError(t, err)
+	ev = receive(t, events)
+	assert.True(t, ev.Removed)
+	assert.Equal(t, MempoolReasonCommitted, ev.Reason)
+	_, ok = mp.Get(bobFirst.Tx.Hash())
+	assert.False(t, ok)
+
+	// Until its eviction expires
+	now := time.Now().Add(EvictedTxTTL)
+	mp.now = func() time.Time { return now }
+	_, ok = mp.evictedReason(aliceFirst.Tx.Hash())
+	assert.False(t, ok)
+	bobSecond := tm.checkTx(t, bob, 2)
+	_, err = mp.Evict(bobSecond.Tx.Hash(), "poisoned")
+	require.NoError(t, err)
+	assert.Len(t, mp.evicted, 1)
+}
+
+func receive(t *testing.T, events <-chan interface{}) *MempoolEvent {
+	select {
+	case msg := <-events:
+		return msg.(*MempoolEvent)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for MempoolEvent")
+		return nil
+	}
+}
+
+type testTip struct {
+	bcm.BlockchainInfo
+}
+
+func (testTip) LastBlockHeight() uint64 {
+	return 1
+}
+
+// Requires consecutive sequence numbers from 1 for each input
+type testChecker struct {
+	execution.BatchExecutor
+	sequences map[crypto.Address]uint64
+}
+
+func newTestChecker() *testChecker {
+	return &testChecker{sequences: make(map[crypto.Address]uint64)}
+}
+
+func (tc *testChecker) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	for _, input := range txEnv.Tx.GetInputs() {
+		if input.Sequence != tc.sequences[input.Address]+1 {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidSequence, "got sequence %d for %v",
+				input.Sequence, input.Address)
+		}
+		tc.sequences[input.Address] = input.Sequence
+	}
+	return exec.NewTxExecution(txEnv), nil
+}
+
+func (tc *testChecker) Reset() error {
+	tc.sequences = make(map[crypto.Address]uint64)
+	return nil
+}
+
+type testCommitter struct {
+	execution.BatchCommitter
+	checker *testChecker
+}
+
+func (tc *testCommitter) Execute(txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	return tc.checker.Execute(txEnv)
+}
+
+// Admits transactions that pass the checker and rechecks them on Update
+type testTendermintMempool struct {
+	sync.Mutex
+	checker execution.BatchExecutor
+	txCodec txs.Codec
+	txs     tmTypes.Txs
+}
+
+func (tm *testTendermintMempool) checkTx(t *testing.T, address crypto.Address, sequence uint64) *txs.Envelope {
+	txEnv := txs.Enclose("TestChain", &payload.SendTx{
+		Inputs: []*payload.TxInput{{Address: address, Amount: 1, Sequence: sequence}},
+	})
+	txBytes, err := tm.txCodec.EncodeTx(txEnv)
+	require.NoError(t, err)
+	tm.Lock()
+	defer tm.Unlock()
+	if _, err := tm.checker.Execute(txEnv); err == nil {
+		tm.txs = append(tm.txs, txBytes)
+	}
+	return txEnv
+}
+
+func (tm *testTendermintMempool) ReapMaxTxs(max int) tmTypes.Txs {
+	tm.Lock()
+	defer tm.Unlock()
+	return append(tmTypes.Txs(nil), tm.txs...)
+}
+
+func (tm *testTendermintMempool) Update(height int64, txs tmTypes.Txs, preCheck mempool.PreCheckFunc,
+	postCheck mempool.PostCheckFunc) error {
+
+	var txsLeft tmTypes.Txs
+	for _, txBytes := range tm.txs {
+		txEnv, err := tm.txCodec.DecodeTx(txBytes)
+		if err != nil {
+			return fmt.Errorf("could not decode tx: %v", err)
+		}
+		if _, err := tm.checker.Execute(txEnv); err == nil {
+			txsLeft = append(txsLeft, txBytes)
+		}
+	}
+	tm.txs = txsLeft
+	return nil
+}
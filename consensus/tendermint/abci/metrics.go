@@ -0,0 +1,16 @@
+package abci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var txRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "burrow",
+	Subsystem: "abci",
+	Name:      "tx_rejections_total",
+	Help:      "Number of transactions rejected by CheckTx or DeliverTx by error code",
+}, []string{"phase", "code"})
+
+func init() {
+	prometheus.MustRegister(txRejections)
+}
@@ -0,0 +1,112 @@
+package abci
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Configures the priority CheckTx assigns to transactions, which is reported in their receipts, and the minimum
+// priority admitted to the mempool under load. Priority only gates admission: Tendermint v0.30's mempool is FIFO and
+// has no way for the application to reorder it, so transactions are still proposed in the order they were admitted
+// whatever their priority.
+type PriorityConfig struct {
+	// Added to the priority of transactions by payload type name, for example "GovTx"
+	TypePriorities map[string]uint64 `json:",omitempty" toml:",omitempty"`
+	// Added to the priority of transactions with an input from one of PriorityAccounts
+	AccountPriority  uint64           `json:",omitempty" toml:",omitempty"`
+	PriorityAccounts []crypto.Address `json:",omitempty" toml:",omitempty"`
+	// When the mempool holds at least this fraction of its capacity new transactions with a priority below
+	// MinPriority are rejected, 0 to never reject
+	LoadThreshold float64 `json:",omitempty" toml:",omitempty"`
+	MinPriority   uint64  `json:",omitempty" toml:",omitempty"`
+}
+
+// Prioritiser assigns priorities to transactions, a nil Prioritiser prioritises by fee alone and admits everything
+type Prioritiser struct {
+	typePriorities  map[payload.Type]uint64
+	accountPriority uint64
+	accounts        map[crypto.Address]struct{}
+	loadThreshold   float64
+	minPriority     uint64
+}
+
+func NewPrioritiser(conf *PriorityConfig) (*Prioritiser, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	if conf.LoadThreshold < 0 || conf.LoadThreshold > 1 {
+		return nil, fmt.Errorf("mempool LoadThreshold must be a fraction between 0 and 1 but is %v",
+			conf.LoadThreshold)
+	}
+	p := &Prioritiser{
+		typePriorities:  make(map[payload.Type]uint64, len(conf.TypePriorities)),
+		accountPriority: conf.AccountPriority,
+		accounts:        make(map[crypto.Address]struct{}, len(conf.PriorityAccounts)),
+		loadThreshold:   conf.LoadThreshold,
+		minPriority:     conf.MinPriority,
+	}
+	for name, priority := range conf.TypePriorities {
+		ty := payload.TxTypeFromString(name)
+		if ty == payload.TypeUnknown {
+			return nil, fmt.Errorf("unknown payload type %s in mempool TypePriorities", name)
+		}
+		p.typePriorities[ty] = priority
+	}
+	for _, address := range conf.PriorityAccounts {
+		p.accounts[address] = struct{}{}
+	}
+	return p, nil
+}
+
+// Priority of a transaction is the fee it pays plus the priority of its type and the account priority if any of its
+// inputs are from priority accounts
+func (p *Prioritiser) Priority(tx *txs.Tx) uint64 {
+	priority := fee(tx)
+	if p == nil {
+		return priority
+	}
+	priority = addPriority(priority, p.typePriorities[tx.Type()])
+	for _, input := range tx.GetInputs() {
+		if _, ok := p.accounts[input.Address]; ok {
+			return addPriority(priority, p.accountPriority)
+		}
+	}
+	return priority
+}
+
+// Whether a new transaction with priority should be admitted to a mempool holding size transactions out of capacity
+func (p *Prioritiser) Admits(priority uint64, size, capacity int) bool {
+	if p == nil || p.loadThreshold == 0 || capacity <= 0 {
+		return true
+	}
+	return float64(size) < p.loadThreshold*float64(capacity) || priority >= p.minPriority
+}
+
+func (p *Prioritiser) MinPriority() uint64 {
+	if p == nil {
+		return 0
+	}
+	return p.minPriority
+}
+
+func fee(tx *txs.Tx) uint64 {
+	switch tx := tx.Payload.(type) {
+	case *payload.CallTx:
+		return tx.Fee
+	case *payload.NameTx:
+		return tx.Fee
+	}
+	return 0
+}
+
+// Saturating addition
+func addPriority(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
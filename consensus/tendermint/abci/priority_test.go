@@ -0,0 +1,66 @@
+package abci
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrioritiser(t *testing.T) {
+	settler := crypto.Address{1}
+	p, err := NewPrioritiser(&PriorityConfig{
+		TypePriorities:   map[string]uint64{"GovTx": 1000},
+		AccountPriority:  100,
+		PriorityAccounts: []crypto.Address{settler},
+	})
+	require.NoError(t, err)
+
+	bulk := &payload.CallTx{Input: &payload.TxInput{Address: crypto.Address{2}}, Fee: 5}
+	assert.Equal(t, uint64(5), p.Priority(txs.NewTx(bulk)))
+	assert.Equal(t, uint64(5), (*Prioritiser)(nil).Priority(txs.NewTx(bulk)))
+	settlement := &payload.CallTx{Input: &payload.TxInput{Address: settler}, Fee: 5}
+	assert.Equal(t, uint64(105), p.Priority(txs.NewTx(settlement)))
+	gov := &payload.GovTx{Inputs: []*payload.TxInput{{Address: crypto.Address{2}}}}
+	assert.Equal(t, uint64(1000), p.Priority(txs.NewTx(gov)))
+
+	_, err = NewPrioritiser(&PriorityConfig{TypePriorities: map[string]uint64{"FooTx": 1}})
+	assert.Error(t, err)
+	_, err = NewPrioritiser(&PriorityConfig{LoadThreshold: 2})
+	assert.Error(t, err)
+}
+
+func TestMempoolRejectsLowPriorityUnderLoad(t *testing.T) {
+	p, err := NewPrioritiser(&PriorityConfig{LoadThreshold: 0.5, MinPriority: 10})
+	require.NoError(t, err)
+	mp := NewMempool(testTip{}, txs.NewAminoCodec(), event.NewEmitter(logging.NewNoopLogger()), p, 2,
+		logging.NewNoopLogger())
+	checker := mp.Checker(newTestChecker())
+
+	callTx := func(sequence, fee uint64) *txs.Envelope {
+		return txs.Enclose("TestChain", &payload.CallTx{
+			Input: &payload.TxInput{Address: crypto.Address{1}, Sequence: sequence},
+			Fee:   fee,
+		})
+	}
+	// Below the load threshold so admitted despite its low priority
+	txe, err := checker.Execute(callTx(1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), txe.Receipt.Priority)
+
+	_, err = checker.Execute(callTx(2, 9))
+	assert.Equal(t, errors.ErrorCodeInsufficientPriority, errors.AsException(err).ErrorCode())
+
+	txe, err = checker.Execute(callTx(2, 10))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), txe.Receipt.Priority)
+	mtx, ok := mp.Get(txe.TxHash)
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), mtx.Receipt.Priority)
+}
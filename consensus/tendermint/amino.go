@@ -2,11 +2,12 @@ package tendermint
 
 import (
 	amino "github.com/tendermint/go-amino"
-	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/types"
 )
 
 var cdc = amino.NewCodec()
 
 func init() {
-	cryptoAmino.RegisterAmino(cdc)
+	// Registers crypto types as well as the evidence that blocks may carry
+	types.RegisterBlockAmino(cdc)
 }
@@ -33,6 +33,10 @@ type BurrowTendermintConfig struct {
 	// EmptyBlocks mode and possible interval between empty blocks in seconds
 	CreateEmptyBlocks         bool
 	CreateEmptyBlocksInterval time.Duration
+	// Priorities assigned to transactions and the minimum priority admitted to the mempool under load, if not set
+	// transactions are prioritised by fee and always admitted. Priority does not change the order in which admitted
+	// transactions are proposed, which remains FIFO.
+	MempoolPriority *abci.PriorityConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
@@ -62,6 +62,11 @@ func (nv *NodeView) RunID() simpleuuid.UUID {
 	return nv.runID
 }
 
+// The number of transactions in the mempool
+func (nv *NodeView) MempoolSize() int {
+	return nv.tmNode.MempoolReactor().Mempool.Size()
+}
+
 // Pass -1 to get all available transactions
 func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 	var transactions []*txs.Envelope
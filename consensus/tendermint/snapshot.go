@@ -0,0 +1,200 @@
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/snapshot"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/blockchain"
+	"github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+// The IDs Tendermint opens its databases with
+const (
+	stateDBName      = "state"
+	blockStoreDBName = "blockstore"
+)
+
+// How long to wait for Tendermint to save its state for a height we have been asked to snapshot
+const snapshotStateTimeout = time.Minute
+
+// SnapshotState reconstructs Tendermint's state as it was after the block at height was committed from its state and
+// block stores so that it can be included in a snapshot taken at height (see RestoreSnapshotState)
+func (n *Node) SnapshotState(height uint64) (*snapshot.TendermintState, error) {
+	if n.stateDB == nil {
+		return nil, fmt.Errorf("Tendermint state database has not been opened")
+	}
+	h := int64(height)
+	// We are asked for a snapshot as Burrow commits the block at height, which is before Tendermint saves its state
+	deadline := time.Now().Add(snapshotStateTimeout)
+	for sm.LoadState(n.stateDB).LastBlockHeight < h {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for Tendermint to save its state at height %d", height)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	block := n.BlockStore().LoadBlock(h)
+	seenCommit := n.BlockStore().LoadSeenCommit(h)
+	if block == nil || seenCommit == nil {
+		return nil, fmt.Errorf("could not find block and commit at height %d in Tendermint's block store", height)
+	}
+	lastValidators, err := sm.LoadValidators(n.stateDB, h)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := sm.LoadValidators(n.stateDB, h+1)
+	if err != nil {
+		return nil, err
+	}
+	nextValidators, err := sm.LoadValidators(n.stateDB, h+2)
+	if err != nil {
+		return nil, err
+	}
+	consensusParams, err := sm.LoadConsensusParams(n.stateDB, h+1)
+	if err != nil {
+		return nil, err
+	}
+	abciResponses, err := sm.LoadABCIResponses(n.stateDB, h)
+	if err != nil {
+		return nil, err
+	}
+	// The fields that can be derived from the block are filled in again when the state is restored
+	st := sm.State{
+		ChainID:         block.ChainID,
+		LastBlockHeight: h,
+		LastValidators:  lastValidators,
+		Validators:      validators,
+		NextValidators:  nextValidators,
+		ConsensusParams: consensusParams,
+		LastResultsHash: abciResponses.ResultsHash(),
+	}
+	tmState := new(snapshot.TendermintState)
+	tmState.Block, err = cdc.MarshalBinaryBare(block)
+	if err != nil {
+		return nil, err
+	}
+	tmState.SeenCommit, err = cdc.MarshalBinaryBare(seenCommit)
+	if err != nil {
+		return nil, err
+	}
+	tmState.State, err = cdc.MarshalBinaryBare(st)
+	if err != nil {
+		return nil, err
+	}
+	return tmState, nil
+}
+
+// RestoreSnapshotState seeds Tendermint's empty state and block stores with the state from a snapshot so that
+// Tendermint resumes consensus from the block after the snapshot height. The block must match header, which is the
+// header Burrow recorded for the snapshot height in state we have already verified against the trusted appHash, and
+// its commit must be signed by the validators it names. The next validator set, validator priorities, and last results
+// hash are not committed to by the snapshot block, but Tendermint checks each of them against the next block before
+// executing it, so a peer that serves bad values can stop us from syncing but cannot lead us onto another chain.
+// Nothing is written unless all of the checks pass. Returns the hash of the snapshot block.
+func RestoreSnapshotState(conf *config.Config, tmState *snapshot.TendermintState, header *abciTypes.Header,
+	appHash []byte) ([]byte, error) {
+
+	block := new(types.Block)
+	err := cdc.UnmarshalBinaryBare(tmState.Block, block)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot block: %v", err)
+	}
+	seenCommit := new(types.Commit)
+	err = cdc.UnmarshalBinaryBare(tmState.SeenCommit, seenCommit)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot block commit: %v", err)
+	}
+	var st sm.State
+	err = cdc.UnmarshalBinaryBare(tmState.State, &st)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode snapshot Tendermint state: %v", err)
+	}
+	if st.LastValidators == nil || st.Validators == nil || st.NextValidators == nil {
+		return nil, fmt.Errorf("snapshot Tendermint state is missing validator sets")
+	}
+
+	err = block.ValidateBasic()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot block is invalid: %v", err)
+	}
+	if !header.Equal(types.TM2PB.Header(&block.Header)) {
+		return nil, fmt.Errorf("snapshot block header does not match the header recorded in the restored state")
+	}
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+	if !bytes.Equal(st.LastValidators.Hash(), block.ValidatorsHash) {
+		return nil, fmt.Errorf("snapshot validators at height %d do not match the block", block.Height)
+	}
+	if !bytes.Equal(st.Validators.Hash(), block.NextValidatorsHash) {
+		return nil, fmt.Errorf("snapshot validators at height %d do not match the block", block.Height+1)
+	}
+	// Burrow never changes the consensus params so they must be those of the block
+	if !bytes.Equal(st.ConsensusParams.Hash(), block.ConsensusHash) {
+		return nil, fmt.Errorf("snapshot consensus params do not match the block")
+	}
+	err = st.LastValidators.VerifyCommit(block.ChainID, blockID, block.Height, seenCommit)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot block commit is invalid: %v", err)
+	}
+
+	st.Version = sm.Version{Consensus: block.Version, Software: version.TMCoreSemVer}
+	st.ChainID = block.ChainID
+	st.LastBlockHeight = block.Height
+	st.LastBlockTotalTx = block.TotalTxs
+	st.LastBlockID = blockID
+	st.LastBlockTime = block.Time
+	st.AppHash = appHash
+	// We only have the validators and consensus params from the snapshot height onwards, so record them as having
+	// changed at the heights we store them so that Tendermint never looks further back for them
+	st.LastHeightValidatorsChanged = block.Height + 2
+	st.LastHeightConsensusParamsChanged = block.Height + 1
+
+	stateDB := DBProvider(stateDBName, dbm.DBBackendType(conf.DBBackend), conf.DBDir())
+	defer stateDB.Close()
+	blockStoreDB := DBProvider(blockStoreDBName, dbm.DBBackendType(conf.DBBackend), conf.DBDir())
+	defer blockStoreDB.Close()
+	if sm.LoadState(stateDB).LastBlockHeight != 0 || blockchain.LoadBlockStoreStateJSON(blockStoreDB).Height != 0 {
+		return nil, fmt.Errorf("cannot restore snapshot over existing Tendermint stores in %s", conf.DBDir())
+	}
+	err = writeSnapshotState(stateDB, blockStoreDB, st, block, parts, seenCommit)
+	if err != nil {
+		return nil, err
+	}
+	return blockID.Hash, nil
+}
+
+// Tendermint's stores panic rather than return errors
+func writeSnapshotState(stateDB, blockStoreDB dbm.DB, st sm.State, block *types.Block, parts *types.PartSet,
+	seenCommit *types.Commit) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not write snapshot Tendermint state: %v", r)
+		}
+	}()
+	// SaveState stores the next validators, we store the validators for the snapshot height and the one after
+	for height, validators := range map[int64]*types.ValidatorSet{
+		block.Height:     st.LastValidators,
+		block.Height + 1: st.Validators,
+	} {
+		stateDB.Set(validatorsKey(height), cdc.MustMarshalBinaryBare(&sm.ValidatorsInfo{
+			ValidatorSet:      validators,
+			LastHeightChanged: height,
+		}))
+	}
+	sm.SaveState(stateDB, st)
+	// The block store only accepts the block following its current height
+	blockchain.BlockStoreStateJSON{Height: block.Height - 1}.Save(blockStoreDB)
+	blockchain.NewBlockStore(blockStoreDB).SaveBlock(block, parts, seenCommit)
+	return nil
+}
+
+// Must match the key Tendermint's state package stores validator sets under
+func validatorsKey(height int64) []byte {
+	return []byte(fmt.Sprintf("validatorsKey:%v", height))
+}
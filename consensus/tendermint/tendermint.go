@@ -21,6 +21,8 @@ import (
 // Serves as a wrapper around the Tendermint node's closeable resources (database connections)
 type Node struct {
 	*node.Node
+	// Tendermint does not expose its state database which we need in order to snapshot its state
+	stateDB dbm.DB
 	closers []interface {
 		Close()
 	}
@@ -33,6 +35,9 @@ func DBProvider(ID string, backendType dbm.DBBackendType, dbDir string) dbm.DB {
 // Since Tendermint doesn't close its DB connections
 func (n *Node) DBProvider(ctx *node.DBContext) (dbm.DB, error) {
 	db := DBProvider(ctx.ID, dbm.DBBackendType(ctx.Config.DBBackend), ctx.Config.DBDir())
+	if ctx.ID == stateDBName {
+		n.stateDB = db
+	}
 	n.closers = append(n.closers, db)
 	return db, nil
 }
@@ -87,6 +92,10 @@ func DeriveGenesisDoc(burrowGenesisDoc *genesis.GenesisDoc) *tmTypes.GenesisDoc
 		}
 	}
 	consensusParams := tmTypes.DefaultConsensusParams()
+	if burrowGenesisDoc.Params.BlockGasLimit > 0 {
+		// Tendermint will reap no more than this total GasWanted (as returned by CheckTx) from the mempool per block
+		consensusParams.BlockSize.MaxGas = int64(burrowGenesisDoc.Params.BlockGasLimit)
+	}
 
 	return &tmTypes.GenesisDoc{
 		ChainID:         burrowGenesisDoc.ChainID(),
@@ -17,12 +17,14 @@ package core
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -47,18 +49,23 @@ import (
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/server"
 	"github.com/hyperledger/burrow/rpc/metrics"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
+	"github.com/hyperledger/burrow/rpc/rpcmempool"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpcsnapshot"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/snapshot"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
 	tmConfig "github.com/tendermint/tendermint/config"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/node"
 	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -92,9 +99,10 @@ func NewBurrowDB(dbDir string) dbm.DB {
 	return dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir)
 }
 
+// NewKernel creates a Kernel, restore optionally gives a dump file to restore from followed by any diff dumps to apply
 func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTypes.PrivValidator,
 	genesisDoc *genesis.GenesisDoc, tmConf *tmConfig.Config, rpcConfig *rpc.RPCConfig, keyConfig *keys.KeysConfig,
-	keyStore *keys.KeyStore, exeOptions []execution.ExecutionOption, authorizedPeersProvider abci.PeersFilterProvider, restore string, logger *logging.Logger) (*Kernel, error) {
+	snapshotConfig *snapshot.SnapshotConfig, priorityConfig *abci.PriorityConfig, keyStore *keys.KeyStore, exeOptions []execution.ExecutionOption, authorizedPeersProvider abci.PeersFilterProvider, restore []string, logger *logging.Logger) (*Kernel, error) {
 
 	var err error
 	kern := &Kernel{
@@ -128,26 +136,27 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				"state gives %X, blockchain gives %X", kern.Blockchain.LastBlockHeight(),
 				kern.State.Hash(), kern.Blockchain.AppHashAfterLastBlock())
 		}
-		if restore != "" {
+		if len(restore) > 0 {
 			return nil, fmt.Errorf("Cannot restore onto existing chain; don't give --restore-dump argument")
 		}
+		kern.State.SetNameOwnership(genesisDoc.Params.NameOwnership)
+		kern.State.SetCountStorageWords(genesisDoc.Params.StorageRentPerWord > 0)
 	} else {
 		kern.State, err = state.MakeGenesisState(stateDB, genesisDoc)
 		if err != nil {
 			return nil, fmt.Errorf("could not build genesis state: %v", err)
 		}
 
-		if restore != "" {
+		if len(restore) > 0 {
 			if len(genesisDoc.AppHash) == 0 {
 				return nil, fmt.Errorf("AppHash is required when restoring chain")
 			}
 
-			reader, err := state.NewFileDumpReader(restore)
+			header, err := kern.State.LoadDumpFiles(restore[0], restore[1:]...)
 			if err != nil {
 				return nil, err
 			}
-
-			err = kern.State.LoadDump(reader)
+			err = state.CheckDumpGenesis(header, genesisDoc)
 			if err != nil {
 				return nil, err
 			}
@@ -169,11 +178,20 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 
 	txCodec := txs.NewAminoCodec()
 	tmGenesisDoc := tendermint.DeriveGenesisDoc(genesisDoc)
-	params := execution.ParamsFromGenesis(genesisDoc)
-	checker := execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
-
+	params, err := execution.ParamsFromGenesis(genesisDoc)
+	if err != nil {
+		return nil, err
+	}
 	kern.Emitter = event.NewEmitter(kern.Logger)
-	committer := execution.NewBatchCommitter(kern.State, params, kern.Blockchain, kern.Emitter, kern.Logger, exeOptions...)
+	prioritiser, err := abci.NewPrioritiser(priorityConfig)
+	if err != nil {
+		return nil, err
+	}
+	mempool := abci.NewMempool(kern.Blockchain, txCodec, kern.Emitter, prioritiser, tmConf.Mempool.Size, kern.Logger)
+	checker := mempool.Checker(execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger,
+		exeOptions...))
+	committer := mempool.Committer(execution.NewBatchCommitter(kern.State, params, kern.Blockchain, kern.Emitter,
+		kern.Logger, exeOptions...))
 
 	kern.nodeInfo = fmt.Sprintf("Burrow_%s_%s_ValidatorID:%X", project.History.CurrentVersion().String(),
 		genesisDoc.ChainID(), privValidator.GetPubKey().Address())
@@ -189,10 +207,11 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 	if err != nil {
 		return nil, err
 	}
+	mempool.SetTendermintMempool(kern.Node.MempoolReactor().Mempool)
 
-	kern.Transactor = execution.NewTransactor(kern.Blockchain, kern.Emitter,
+	kern.Transactor = execution.NewTransactor(kern.Blockchain, params, kern.Emitter,
 		execution.NewAccounts(checker, keyClient, AccountsRingMutexCount),
-		kern.Node.MempoolReactor().Mempool.CheckTx, txCodec, kern.Logger)
+		kern.Node.MempoolReactor().Mempool.CheckTx, txCodec, kern.Logger, exeOptions...)
 
 	nameRegState := kern.State
 	proposalRegState := kern.State
@@ -204,6 +223,35 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 	kern.Blockchain.SetBlockStore(bcm.NewBlockStore(nodeView.BlockStore()))
 	kern.Service = rpc.NewService(accountState, nameRegState, kern.Blockchain, kern.State, nodeView, kern.Logger)
 
+	if snapshotConfig == nil {
+		snapshotConfig = snapshot.DefaultSnapshotConfig()
+	}
+	snapshotStore := snapshot.NewStore(SnapshotDirectory(tmConf.RootDir, snapshotConfig))
+
+	if keyConfig.GRPCServiceEnabled && keyStore == nil {
+		keyStore = keys.NewKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions, kern.Logger)
+	}
+	registerServices := func(grpcServer *grpc.Server) {
+		if keyConfig.GRPCServiceEnabled {
+			keys.RegisterKeysServer(grpcServer, keyStore)
+		}
+
+		rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, nameRegState, proposalRegState,
+			kern.Blockchain, kern.State, nodeView, kern.Logger))
+
+		rpctransact.RegisterTransactServer(grpcServer, rpctransact.NewTransactServer(kern.Transactor, txCodec))
+
+		rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
+			kern.Emitter, kern.Blockchain, kern.Logger))
+
+		rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State,
+			kern.Blockchain, nodeView, kern.Logger))
+
+		rpcsnapshot.RegisterSnapshotServer(grpcServer, rpcsnapshot.NewSnapshotServer(snapshotStore))
+
+		rpcmempool.RegisterMempoolServer(grpcServer, rpcmempool.NewMempoolServer(mempool, kern.Emitter, kern.Logger))
+	}
+
 	kern.Launchers = []process.Launcher{
 		{
 			Name:    "Profiling Server",
@@ -258,6 +306,15 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				}), nil
 			},
 		},
+		{
+			Name:    "Snapshots",
+			Enabled: snapshotConfig.Enabled,
+			Launch: func() (process.Process, error) {
+				producer := snapshot.NewProducer(genesisDoc.ChainID(), snapshotConfig, kern.State, kern.Node,
+					snapshotStore, kern.Logger)
+				return producer.Start(kern.Emitter)
+			},
+		},
 		// Run announcer after Tendermint so it can get some details
 		{
 			Name:    "Startup Announcer",
@@ -291,7 +348,15 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/info",
 			Enabled: rpcConfig.Info.Enabled,
 			Launch: func() (process.Process, error) {
-				server, err := rpcinfo.StartServer(kern.Service, "/websocket", rpcConfig.Info.ListenAddress, kern.Logger)
+				tlsConfig, auth, limiter, err := serverSecurity("info", rpcConfig.Info.TLS, rpcConfig.Info.Auth,
+					rpcConfig.Info.RateLimit)
+				if err != nil {
+					return nil, err
+				}
+				subs := rpcinfo.NewSubscriptions(kern.State, kern.Emitter, kern.Blockchain,
+					rpcConfig.Info.MaxSubscriptions, kern.Logger)
+				server, err := rpcinfo.StartServer(kern.Service, subs, "/websocket", rpcConfig.Info.ListenAddress,
+					tlsConfig, auth, limiter, kern.Logger)
 				if err != nil {
 					return nil, err
 				}
@@ -302,8 +367,13 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/metrics",
 			Enabled: rpcConfig.Metrics.Enabled,
 			Launch: func() (process.Process, error) {
+				tlsConfig, auth, limiter, err := serverSecurity("metrics", rpcConfig.Metrics.TLS, rpcConfig.Metrics.Auth,
+					rpcConfig.Metrics.RateLimit)
+				if err != nil {
+					return nil, err
+				}
 				server, err := metrics.StartServer(kern.Service, rpcConfig.Metrics.MetricsPath,
-					rpcConfig.Metrics.ListenAddress, rpcConfig.Metrics.BlockSampleSize, kern.Logger)
+					rpcConfig.Metrics.ListenAddress, tlsConfig, auth, limiter, kern.Logger)
 				if err != nil {
 					return nil, err
 				}
@@ -314,34 +384,18 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/GRPC",
 			Enabled: rpcConfig.GRPC.Enabled,
 			Launch: func() (process.Process, error) {
-				listen, err := net.Listen("tcp", rpcConfig.GRPC.ListenAddress)
+				tlsConfig, auth, limiter, err := serverSecurity("grpc", rpcConfig.GRPC.TLS, rpcConfig.GRPC.Auth,
+					rpcConfig.GRPC.RateLimit)
 				if err != nil {
 					return nil, err
 				}
-
-				grpcServer := rpc.NewGRPCServer(kern.Logger)
-				var ks *keys.KeyStore
-				if keyStore != nil {
-					ks = keyStore
-				}
-
-				if keyConfig.GRPCServiceEnabled {
-					if keyStore == nil {
-						ks = keys.NewKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions, kern.Logger)
-					}
-					keys.RegisterKeysServer(grpcServer, ks)
+				listen, err := net.Listen("tcp", rpcConfig.GRPC.ListenAddress)
+				if err != nil {
+					return nil, err
 				}
 
-				rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, nameRegState, proposalRegState,
-					kern.Blockchain, kern.State, nodeView, kern.Logger))
-
-				rpctransact.RegisterTransactServer(grpcServer, rpctransact.NewTransactServer(kern.Transactor, txCodec))
-
-				rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
-					kern.Emitter, kern.Blockchain, kern.Logger))
-
-				rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State,
-					kern.Blockchain, nodeView, kern.Logger))
+				grpcServer := rpc.NewGRPCServer(tlsConfig, auth, limiter, kern.Logger)
+				registerServices(grpcServer)
 
 				// Provides metadata about services registered
 				//reflection.Register(grpcServer)
@@ -355,11 +409,58 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				}), nil
 			},
 		},
+		{
+			Name:    "RPC/gateway",
+			Enabled: rpcConfig.Gateway.Enabled,
+			Launch: func() (process.Process, error) {
+				tlsConfig, auth, limiter, err := serverSecurity("gateway", rpcConfig.Gateway.TLS,
+					rpcConfig.Gateway.Auth, rpcConfig.Gateway.RateLimit)
+				if err != nil {
+					return nil, err
+				}
+				// Calls are served through a gRPC server that does not listen itself so that they are intercepted in
+				// the same way as over gRPC
+				grpcServer := rpc.NewGRPCServer(nil, auth, limiter, kern.Logger)
+				registerServices(grpcServer)
+				gateway := rpc.NewGateway(grpcServer, rpcConfig.Gateway, kern.Logger)
+				srv, err := server.StartHTTPServer(rpcConfig.Gateway.ListenAddress, gateway, tlsConfig,
+					kern.Logger.With(structure.ComponentKey, "RPC_Gateway"))
+				if err != nil {
+					return nil, err
+				}
+				return srv, nil
+			},
+		},
 	}
 
 	return kern, nil
 }
 
+func serverSecurity(server string, tlsConf *rpc.TLSConfig, authConf *rpc.AuthConfig,
+	rateLimitConf *rpc.RateLimitConfig) (*tls.Config, *rpc.Authorizer, *rpc.RateLimiter, error) {
+	tlsConfig, err := tlsConf.ServerTLSConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	auth, err := rpc.NewAuthorizer(authConf)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	limiter, err := rpc.NewRateLimiter(server, rateLimitConf)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return tlsConfig, auth, limiter, nil
+}
+
+// Get the directory in which snapshots are stored, relative paths are taken relative to the Tendermint root
+func SnapshotDirectory(rootDir string, snapshotConfig *snapshot.SnapshotConfig) string {
+	if filepath.IsAbs(snapshotConfig.Directory) {
+		return snapshotConfig.Directory
+	}
+	return filepath.Join(rootDir, snapshotConfig.Directory)
+}
+
 // Boot the kernel starting Tendermint and RPC layers
 func (kern *Kernel) Boot() error {
 	for _, launcher := range kern.Launchers {
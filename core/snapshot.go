@@ -0,0 +1,95 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/snapshot"
+	tmConfig "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Restore the state of a fresh node from the snapshot in source whose app hash is the trusted appHash. Burrow's state
+// is restored into the database directory of tmConf and Tendermint's empty stores are seeded with the block at the
+// snapshot height so that the node can be started and sync the blocks that follow from its peers.
+func RestoreSnapshot(tmConf *tmConfig.Config, genesisDoc *genesis.GenesisDoc, source snapshot.Source, appHash []byte,
+	logger *logging.Logger) (*snapshot.Manifest, error) {
+
+	logger = logger.WithScope("RestoreSnapshot")
+	stateDB := NewBurrowDB(tmConf.DBDir())
+	defer stateDB.Close()
+
+	blockchain, err := bcm.LoadOrNewBlockchain(stateDB, genesisDoc, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating or loading blockchain state: %v", err)
+	}
+	if blockchain.LastBlockHeight() > 0 {
+		return nil, fmt.Errorf("cannot restore snapshot onto existing chain at height %d",
+			blockchain.LastBlockHeight())
+	}
+
+	manifest, err := snapshot.FindManifest(source, appHash)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Height == 0 {
+		return nil, fmt.Errorf("snapshot is of genesis state so there is nothing to restore")
+	}
+	if manifest.ChainID != genesisDoc.ChainID() {
+		return nil, fmt.Errorf("snapshot is from chain %s but our genesis is for chain %s",
+			manifest.ChainID, genesisDoc.ChainID())
+	}
+	if manifest.Tendermint == nil {
+		return nil, fmt.Errorf("snapshot does not include the Tendermint state needed to start from it")
+	}
+	logger.InfoMsg("Restoring state from snapshot", "height", manifest.Height, "app_hash", manifest.AppHash,
+		"chunks", len(manifest.ChunkHashes))
+
+	genesisHash, err := state.GenesisStateHash(genesisDoc)
+	if err != nil {
+		return nil, err
+	}
+	// The whole snapshot is restored into the scratch database before it is verified so keep it on disk
+	scratchDir, err := ioutil.TempDir(tmConf.DBDir(), "snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(scratchDir)
+	scratchDB := dbm.NewDB("scratch", dbm.GoLevelDBBackend, scratchDir)
+	defer scratchDB.Close()
+
+	st, err := state.RestoreSnapshot(stateDB, scratchDB, source, manifest, appHash, genesisHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not restore snapshot: %v", err)
+	}
+
+	// The header of the snapshot block is recorded in the restored (and so verified) state
+	ev, err := st.StreamEvent(manifest.Height, 0)
+	if err != nil {
+		return nil, err
+	}
+	if ev == nil || ev.BeginBlock == nil || ev.BeginBlock.Header == nil {
+		return nil, fmt.Errorf("could not find header of block %d in snapshot state", manifest.Height)
+	}
+	header := ev.BeginBlock.Header
+	blockHash, err := tendermint.RestoreSnapshotState(tmConf, manifest.Tendermint, header, st.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not restore Tendermint state from snapshot: %v", err)
+	}
+	err = blockchain.CommitBlockAtHeight(header.Time, blockHash, st.Hash(), manifest.Height)
+	if err != nil {
+		return nil, err
+	}
+	err = blockchain.Checkpoint()
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoMsg("Snapshot restored", "height", manifest.Height)
+	return manifest, nil
+}
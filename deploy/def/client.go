@@ -422,6 +422,8 @@ type CallArg struct {
 	Fee      string
 	Gas      string
 	Data     string
+	// Replace Gas, which becomes an upper bound, with the smallest gas limit with which the call succeeds
+	EstimateGas bool
 }
 
 func (c *Client) Call(arg *CallArg) (*payload.CallTx, error) {
@@ -457,9 +459,41 @@ func (c *Client) Call(arg *CallArg) (*payload.CallTx, error) {
 		Fee:      fee,
 		GasLimit: gas,
 	}
+	if arg.EstimateGas {
+		tx.GasLimit, err = c.EstimateGas(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return tx, nil
 }
 
+// EstimateGas simulates tx against the current chain state to find the smallest gas limit, no greater than
+// tx.GasLimit, with which it succeeds. If it fails regardless we keep tx.GasLimit so that the transaction is still
+// sent and its failure reported in the usual way.
+func (c *Client) EstimateGas(tx *payload.CallTx) (uint64, error) {
+	transact, err := c.Transact()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	estimate, err := transact.EstimateGas(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not estimate gas: %v", err)
+	}
+	if estimate.Exception != nil {
+		logrus.WithFields(logrus.Fields{
+			"exception":     estimate.Exception,
+			"revert_reason": estimate.RevertReason,
+			"gas":           tx.GasLimit,
+		}).Warn("Gas estimation failed, using default gas")
+		return tx.GasLimit, nil
+	}
+	logrus.WithField("gas", estimate.GasLimit).Info("Estimated gas")
+	return estimate.GasLimit, nil
+}
+
 type SendArg struct {
 	Input    string
 	Amount   string
@@ -89,7 +89,6 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	deploy.Instance = useDefault(deploy.Instance, contractName)
 	deploy.Amount = useDefault(deploy.Amount, do.DefaultAmount)
 	deploy.Fee = useDefault(deploy.Fee, do.DefaultFee)
-	deploy.Gas = useDefault(deploy.Gas, do.DefaultGas)
 
 	// assemble contract
 	contractPath, err := findContractFile(deploy.Contract, do.BinPath)
@@ -147,7 +146,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 			contractCode = contractCode + callData
 		}
 
-		tx, err := deployTx(client, deploy, contractName, string(contractCode))
+		tx, err := deployTx(client, deploy, do, contractName, string(contractCode))
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not deploy binary contract: %v", err)
 		}
@@ -338,10 +337,11 @@ func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook
 		contractCode = contractCode + callData
 	}
 
-	return deployTx(client, deploy, compilersResponse.Objectname, contractCode)
+	return deployTx(client, deploy, do, compilersResponse.Objectname, contractCode)
 }
 
-func deployTx(client *def.Client, deploy *def.Deploy, contractName, contractCode string) (*payload.CallTx, error) {
+func deployTx(client *def.Client, deploy *def.Deploy, do *def.DeployArgs, contractName,
+	contractCode string) (*payload.CallTx, error) {
 	// Deploy contract
 	log.WithFields(log.Fields{
 		"name": contractName,
@@ -353,13 +353,15 @@ func deployTx(client *def.Client, deploy *def.Deploy, contractName, contractCode
 		"chain-url": client.ChainAddress,
 	}).Info()
 
+	gas, estimateGas := jobGas(deploy.Gas, do)
 	return client.Call(&def.CallArg{
-		Input:    deploy.Source,
-		Amount:   deploy.Amount,
-		Fee:      deploy.Fee,
-		Gas:      deploy.Gas,
-		Data:     contractCode,
-		Sequence: deploy.Sequence,
+		Input:       deploy.Source,
+		Amount:      deploy.Amount,
+		Fee:         deploy.Fee,
+		Gas:         gas,
+		Data:        contractCode,
+		Sequence:    deploy.Sequence,
+		EstimateGas: estimateGas,
 	})
 }
 
@@ -375,7 +377,6 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 	call.Source = useDefault(call.Source, deployScript.Account)
 	call.Amount = useDefault(call.Amount, do.DefaultAmount)
 	call.Fee = useDefault(call.Fee, do.DefaultFee)
-	call.Gas = useDefault(call.Gas, do.DefaultGas)
 
 	// formulate call
 	var packedBytes []byte
@@ -407,14 +408,16 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 		"data":        callData,
 	}).Info("Calling")
 
+	gas, estimateGas := jobGas(call.Gas, do)
 	return client.Call(&def.CallArg{
-		Input:    call.Source,
-		Amount:   call.Amount,
-		Address:  call.Destination,
-		Fee:      call.Fee,
-		Gas:      call.Gas,
-		Data:     callData,
-		Sequence: call.Sequence,
+		Input:       call.Source,
+		Amount:      call.Amount,
+		Address:     call.Destination,
+		Fee:         call.Fee,
+		Gas:         gas,
+		Data:        callData,
+		Sequence:    call.Sequence,
+		EstimateGas: estimateGas,
 	})
 }
 
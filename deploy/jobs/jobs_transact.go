@@ -209,6 +209,20 @@ func PermissionJob(perm *def.Permission, account string, tx *payload.PermsTx, cl
 	return txe.Receipt.TxHash.String(), nil
 }
 
+// The upper bound on the gas estimated for jobs when neither they nor the user give any
+const estimateGasBound = "1111111111"
+
+// Use the gas given by a job or else the user's default gas, only when there is neither do we estimate the gas needed
+func jobGas(gas string, do *def.DeployArgs) (string, bool) {
+	if gas != "" {
+		return gas, false
+	}
+	if do.DefaultGas != "" {
+		return do.DefaultGas, false
+	}
+	return estimateGasBound, true
+}
+
 func useDefault(thisOne, defaultOne string) string {
 	if thisOne == "" {
 		return defaultOne
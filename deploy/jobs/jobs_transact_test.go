@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_jobGas(t *testing.T) {
+	tests := []struct {
+		name         string
+		jobGas       string
+		defaultGas   string
+		wantGas      string
+		wantEstimate bool
+	}{
+		{"job gas", "100", "200", "100", false},
+		{"default gas", "", "200", "200", false},
+		{"estimate", "", "", estimateGasBound, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gas, estimate := jobGas(tt.jobGas, &def.DeployArgs{DefaultGas: tt.defaultGas})
+			assert.Equal(t, tt.wantGas, gas)
+			assert.Equal(t, tt.wantEstimate, estimate)
+		})
+	}
+}
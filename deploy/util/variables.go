@@ -223,20 +223,7 @@ func PreProcessInputData(function string, data interface{}, do *def.DeployArgs,
 			case int, int32, int64:
 				newString = strconv.FormatInt(int64(s.Interface().(int)), 10)
 			case []interface{}:
-				var args []string
-				for _, index := range s.Interface().([]interface{}) {
-					value := reflect.ValueOf(index)
-					var stringified string
-					switch value.Kind() {
-					case reflect.Int:
-						stringified = strconv.FormatInt(value.Int(), 10)
-					case reflect.String:
-						stringified = value.String()
-					}
-					index, _ = PreProcess(stringified, do, script, client)
-					args = append(args, stringified)
-				}
-				newString = "[" + strings.Join(args, ",") + "]"
+				newString = stringifyArray(s.Interface().([]interface{}))
 				log.Debug(newString)
 			default:
 				newString = s.Interface().(string)
@@ -274,3 +261,23 @@ func GetReturnValue(vars []*abi.Variable) string {
 		return ""
 	}
 }
+
+// stringifyArray formats a (possibly nested) array from a deploy file in the form accepted by the abi package,
+// e.g. [[1,2],[3]]
+func stringifyArray(array []interface{}) string {
+	args := make([]string, len(array))
+	for i, index := range array {
+		value := reflect.ValueOf(index)
+		switch value.Kind() {
+		case reflect.Int:
+			args[i] = strconv.FormatInt(value.Int(), 10)
+		case reflect.String:
+			args[i] = value.String()
+		case reflect.Slice:
+			if nested, ok := index.([]interface{}); ok {
+				args[i] = stringifyArray(nested)
+			}
+		}
+	}
+	return "[" + strings.Join(args, ",") + "]"
+}
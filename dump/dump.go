@@ -0,0 +1,40 @@
+package dump
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+)
+
+// Version of the dump format written by this version of Burrow. Dumps without a Header are treated as version 0, which
+// carried only accounts, storage, names, and EVM events and had no Trailer.
+const Version = 1
+
+// Digest accumulates a checksum over the rows of a dump so that the reader can verify it against the Trailer
+type Digest struct {
+	hash hash.Hash
+}
+
+func NewDigest() *Digest {
+	return &Digest{hash: sha256.New()}
+}
+
+// Include row in the checksum, rows are taken in their canonical protobuf encoding so that the checksum is
+// independent of how the dump was serialised on its way to us
+func (d *Digest) Write(row *Dump) error {
+	bs, err := row.Marshal()
+	if err != nil {
+		return fmt.Errorf("could not encode dump row for checksum: %v", err)
+	}
+	// Length prefix so row boundaries are unambiguous
+	_, err = fmt.Fprintf(d.hash, "%d:", len(bs))
+	if err != nil {
+		return err
+	}
+	_, err = d.hash.Write(bs)
+	return err
+}
+
+func (d *Digest) Sum() []byte {
+	return d.hash.Sum(nil)
+}
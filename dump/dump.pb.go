@@ -1,25 +1,26 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dump.proto
 
-package dump // import "github.com/hyperledger/burrow/dump"
+package dump
 
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import _ "github.com/golang/protobuf/ptypes/timestamp"
-import acm "github.com/hyperledger/burrow/acm"
-import exec "github.com/hyperledger/burrow/execution/exec"
-import names "github.com/hyperledger/burrow/execution/names"
-
-import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-import time "time"
-
-import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-
-import io "io"
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	acm "github.com/hyperledger/burrow/acm"
+	validator "github.com/hyperledger/burrow/acm/validator"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	exec "github.com/hyperledger/burrow/execution/exec"
+	names "github.com/hyperledger/burrow/execution/names"
+	payload "github.com/hyperledger/burrow/txs/payload"
+	io "io"
+	math "math"
+	time "time"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -46,7 +47,7 @@ func (m *Storage) Reset()         { *m = Storage{} }
 func (m *Storage) String() string { return proto.CompactTextString(m) }
 func (*Storage) ProtoMessage()    {}
 func (*Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dump_9c8edb2ce3771f10, []int{0}
+	return fileDescriptor_58418148159c29a6, []int{0}
 }
 func (m *Storage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -63,8 +64,8 @@ func (m *Storage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Storage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Storage.Merge(dst, src)
+func (m *Storage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Storage.Merge(m, src)
 }
 func (m *Storage) XXX_Size() int {
 	return m.Size()
@@ -81,7 +82,7 @@ func (*Storage) XXX_MessageName() string {
 
 type AccountStorage struct {
 	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Storage              []*Storage                                   `protobuf:"bytes,2,rep,name=Storage" json:"Storage,omitempty"`
+	Storage              []*Storage                                   `protobuf:"bytes,2,rep,name=Storage,proto3" json:"Storage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
 	XXX_unrecognized     []byte                                       `json:"-"`
 	XXX_sizecache        int32                                        `json:"-"`
@@ -91,7 +92,7 @@ func (m *AccountStorage) Reset()         { *m = AccountStorage{} }
 func (m *AccountStorage) String() string { return proto.CompactTextString(m) }
 func (*AccountStorage) ProtoMessage()    {}
 func (*AccountStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dump_9c8edb2ce3771f10, []int{1}
+	return fileDescriptor_58418148159c29a6, []int{1}
 }
 func (m *AccountStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,8 +109,8 @@ func (m *AccountStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *AccountStorage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountStorage.Merge(dst, src)
+func (m *AccountStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStorage.Merge(m, src)
 }
 func (m *AccountStorage) XXX_Size() int {
 	return m.Size()
@@ -135,9 +136,9 @@ type EVMEvent struct {
 	// The original ChainID from for this event
 	ChainID string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
 	// The original block time for this transaction
-	Time time.Time `protobuf:"bytes,2,opt,name=Time,stdtime" json:"Time"`
+	Time time.Time `protobuf:"bytes,2,opt,name=Time,proto3,stdtime" json:"Time"`
 	// The event itself
-	Event                *exec.LogEvent `protobuf:"bytes,3,opt,name=Event" json:"Event,omitempty"`
+	Event                *exec.LogEvent `protobuf:"bytes,3,opt,name=Event,proto3" json:"Event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -147,7 +148,7 @@ func (m *EVMEvent) Reset()         { *m = EVMEvent{} }
 func (m *EVMEvent) String() string { return proto.CompactTextString(m) }
 func (*EVMEvent) ProtoMessage()    {}
 func (*EVMEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dump_9c8edb2ce3771f10, []int{2}
+	return fileDescriptor_58418148159c29a6, []int{2}
 }
 func (m *EVMEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,8 +165,8 @@ func (m *EVMEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *EVMEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMEvent.Merge(dst, src)
+func (m *EVMEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMEvent.Merge(m, src)
 }
 func (m *EVMEvent) XXX_Size() int {
 	return m.Size()
@@ -201,22 +202,367 @@ func (*EVMEvent) XXX_MessageName() string {
 	return "dump.EVMEvent"
 }
 
+// Chain parameters set at genesis that are not otherwise recorded in state (see genesis.GenesisDoc.Params)
+type ChainParams struct {
+	ProposalThreshold    uint64   `protobuf:"varint,1,opt,name=ProposalThreshold,proto3" json:"ProposalThreshold,omitempty"`
+	EVMVersion           string   `protobuf:"bytes,2,opt,name=EVMVersion,proto3" json:"EVMVersion,omitempty"`
+	BlockGasLimit        uint64   `protobuf:"varint,3,opt,name=BlockGasLimit,proto3" json:"BlockGasLimit,omitempty"`
+	WASM                 bool     `protobuf:"varint,4,opt,name=WASM,proto3" json:"WASM,omitempty"`
+	StorageRentPerWord   uint64   `protobuf:"varint,5,opt,name=StorageRentPerWord,proto3" json:"StorageRentPerWord,omitempty"`
+	StorageRentPeriod    uint64   `protobuf:"varint,6,opt,name=StorageRentPeriod,proto3" json:"StorageRentPeriod,omitempty"`
+	MaxTxExpiryBlocks    uint64   `protobuf:"varint,7,opt,name=MaxTxExpiryBlocks,proto3" json:"MaxTxExpiryBlocks,omitempty"`
+	NameOwnership        bool     `protobuf:"varint,8,opt,name=NameOwnership,proto3" json:"NameOwnership,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainParams) Reset()         { *m = ChainParams{} }
+func (m *ChainParams) String() string { return proto.CompactTextString(m) }
+func (*ChainParams) ProtoMessage()    {}
+func (*ChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{3}
+}
+func (m *ChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainParams.Merge(m, src)
+}
+func (m *ChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainParams proto.InternalMessageInfo
+
+func (m *ChainParams) GetProposalThreshold() uint64 {
+	if m != nil {
+		return m.ProposalThreshold
+	}
+	return 0
+}
+
+func (m *ChainParams) GetEVMVersion() string {
+	if m != nil {
+		return m.EVMVersion
+	}
+	return ""
+}
+
+func (m *ChainParams) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *ChainParams) GetWASM() bool {
+	if m != nil {
+		return m.WASM
+	}
+	return false
+}
+
+func (m *ChainParams) GetStorageRentPerWord() uint64 {
+	if m != nil {
+		return m.StorageRentPerWord
+	}
+	return 0
+}
+
+func (m *ChainParams) GetStorageRentPeriod() uint64 {
+	if m != nil {
+		return m.StorageRentPeriod
+	}
+	return 0
+}
+
+func (m *ChainParams) GetMaxTxExpiryBlocks() uint64 {
+	if m != nil {
+		return m.MaxTxExpiryBlocks
+	}
+	return 0
+}
+
+func (m *ChainParams) GetNameOwnership() bool {
+	if m != nil {
+		return m.NameOwnership
+	}
+	return false
+}
+
+func (*ChainParams) XXX_MessageName() string {
+	return "dump.ChainParams"
+}
+
+// The first row of a dump
+type Header struct {
+	// Version of the dump format
+	Version uint64 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	// The chain from which this dump was taken
+	ChainID string       `protobuf:"bytes,2,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Params  *ChainParams `protobuf:"bytes,3,opt,name=Params,proto3" json:"Params,omitempty"`
+	// If non-zero this is a diff dump containing only the changes since FromHeight, to be applied on top of a dump
+	// taken at FromHeight
+	FromHeight           uint64   `protobuf:"varint,4,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{4}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Header) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Header) GetParams() *ChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *Header) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (*Header) XXX_MessageName() string {
+	return "dump.Header"
+}
+
+type Proposal struct {
+	ProposalHash         github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash"`
+	Ballot               *payload.Ballot                               `protobuf:"bytes,2,opt,name=Ballot,proto3" json:"Ballot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{5}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetBallot() *payload.Ballot {
+	if m != nil {
+		return m.Ballot
+	}
+	return nil
+}
+
+func (*Proposal) XXX_MessageName() string {
+	return "dump.Proposal"
+}
+
+// Records an item deleted since the base of a diff dump
+type Removed struct {
+	Account              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Account,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Account,omitempty"`
+	Name                 string                                        `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ProposalHash         github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *Removed) Reset()         { *m = Removed{} }
+func (m *Removed) String() string { return proto.CompactTextString(m) }
+func (*Removed) ProtoMessage()    {}
+func (*Removed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{6}
+}
+func (m *Removed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Removed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Removed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Removed.Merge(m, src)
+}
+func (m *Removed) XXX_Size() int {
+	return m.Size()
+}
+func (m *Removed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Removed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Removed proto.InternalMessageInfo
+
+func (m *Removed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (*Removed) XXX_MessageName() string {
+	return "dump.Removed"
+}
+
+// The last row of a dump
+type Trailer struct {
+	// Digest of all preceding rows (see dump.Digest)
+	Checksum             github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Checksum,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Checksum"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *Trailer) Reset()         { *m = Trailer{} }
+func (m *Trailer) String() string { return proto.CompactTextString(m) }
+func (*Trailer) ProtoMessage()    {}
+func (*Trailer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{7}
+}
+func (m *Trailer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trailer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trailer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trailer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trailer.Merge(m, src)
+}
+func (m *Trailer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trailer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trailer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trailer proto.InternalMessageInfo
+
+func (*Trailer) XXX_MessageName() string {
+	return "dump.Trailer"
+}
+
 type Dump struct {
-	Height               uint64          `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Account              *acm.Account    `protobuf:"bytes,2,opt,name=Account" json:"Account,omitempty"`
-	AccountStorage       *AccountStorage `protobuf:"bytes,3,opt,name=AccountStorage" json:"AccountStorage,omitempty"`
-	EVMEvent             *EVMEvent       `protobuf:"bytes,4,opt,name=EVMEvent" json:"EVMEvent,omitempty"`
-	Name                 *names.Entry    `protobuf:"bytes,5,opt,name=Name" json:"Name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Height               uint64               `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Account              *acm.Account         `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	AccountStorage       *AccountStorage      `protobuf:"bytes,3,opt,name=AccountStorage,proto3" json:"AccountStorage,omitempty"`
+	EVMEvent             *EVMEvent            `protobuf:"bytes,4,opt,name=EVMEvent,proto3" json:"EVMEvent,omitempty"`
+	Name                 *names.Entry         `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	Header               *Header              `protobuf:"bytes,6,opt,name=Header,proto3" json:"Header,omitempty"`
+	Validator            *validator.Validator `protobuf:"bytes,7,opt,name=Validator,proto3" json:"Validator,omitempty"`
+	Proposal             *Proposal            `protobuf:"bytes,8,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	Trailer              *Trailer             `protobuf:"bytes,9,opt,name=Trailer,proto3" json:"Trailer,omitempty"`
+	Removed              *Removed             `protobuf:"bytes,10,opt,name=Removed,proto3" json:"Removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Dump) Reset()         { *m = Dump{} }
 func (m *Dump) String() string { return proto.CompactTextString(m) }
 func (*Dump) ProtoMessage()    {}
 func (*Dump) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dump_9c8edb2ce3771f10, []int{3}
+	return fileDescriptor_58418148159c29a6, []int{8}
 }
 func (m *Dump) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,8 +579,8 @@ func (m *Dump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Dump) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Dump.Merge(dst, src)
+func (m *Dump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dump.Merge(m, src)
 }
 func (m *Dump) XXX_Size() int {
 	return m.Size()
@@ -280,6 +626,41 @@ func (m *Dump) GetName() *names.Entry {
 	return nil
 }
 
+func (m *Dump) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Dump) GetValidator() *validator.Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *Dump) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *Dump) GetTrailer() *Trailer {
+	if m != nil {
+		return m.Trailer
+	}
+	return nil
+}
+
+func (m *Dump) GetRemoved() *Removed {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
 func (*Dump) XXX_MessageName() string {
 	return "dump.Dump"
 }
@@ -290,9 +671,81 @@ func init() {
 	golang_proto.RegisterType((*AccountStorage)(nil), "dump.AccountStorage")
 	proto.RegisterType((*EVMEvent)(nil), "dump.EVMEvent")
 	golang_proto.RegisterType((*EVMEvent)(nil), "dump.EVMEvent")
+	proto.RegisterType((*ChainParams)(nil), "dump.ChainParams")
+	golang_proto.RegisterType((*ChainParams)(nil), "dump.ChainParams")
+	proto.RegisterType((*Header)(nil), "dump.Header")
+	golang_proto.RegisterType((*Header)(nil), "dump.Header")
+	proto.RegisterType((*Proposal)(nil), "dump.Proposal")
+	golang_proto.RegisterType((*Proposal)(nil), "dump.Proposal")
+	proto.RegisterType((*Removed)(nil), "dump.Removed")
+	golang_proto.RegisterType((*Removed)(nil), "dump.Removed")
+	proto.RegisterType((*Trailer)(nil), "dump.Trailer")
+	golang_proto.RegisterType((*Trailer)(nil), "dump.Trailer")
 	proto.RegisterType((*Dump)(nil), "dump.Dump")
 	golang_proto.RegisterType((*Dump)(nil), "dump.Dump")
 }
+
+func init() { proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
+func init() { golang_proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
+
+var fileDescriptor_58418148159c29a6 = []byte{
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x67, 0xb2, 0xce, 0xfe, 0x79, 0x49, 0x5a, 0x75, 0x84, 0xd0, 0x28, 0x87, 0xcd, 0xca, 0x8a,
+	0x48, 0x40, 0xad, 0x83, 0x02, 0x45, 0x3d, 0x70, 0xc9, 0xb6, 0x5b, 0x42, 0x69, 0x4a, 0x98, 0xae,
+	0xb6, 0x02, 0x71, 0x99, 0x5d, 0x0f, 0xb6, 0x55, 0xdb, 0x63, 0x8d, 0xed, 0x74, 0x7d, 0x47, 0x42,
+	0x5c, 0x10, 0x5f, 0x80, 0x2f, 0xc0, 0xa7, 0xe0, 0x80, 0x44, 0x8e, 0x9c, 0x39, 0x14, 0x94, 0x7e,
+	0x11, 0xe4, 0xf9, 0xb3, 0xde, 0xed, 0x22, 0x04, 0xb4, 0xb7, 0xf7, 0x7e, 0xef, 0xcd, 0xf3, 0x6f,
+	0xde, 0xef, 0xcd, 0x33, 0x80, 0x5f, 0x26, 0x99, 0x97, 0x49, 0x51, 0x08, 0xec, 0xd4, 0xf6, 0xee,
+	0xad, 0x20, 0x2a, 0xc2, 0x72, 0xea, 0xcd, 0x44, 0x72, 0x14, 0x88, 0x40, 0x1c, 0xa9, 0xe0, 0xb4,
+	0xfc, 0x5a, 0x79, 0xca, 0x51, 0x96, 0x3e, 0xb4, 0xbb, 0x17, 0x08, 0x11, 0xc4, 0xbc, 0xc9, 0x2a,
+	0xa2, 0x84, 0xe7, 0x05, 0xb3, 0x55, 0x77, 0x7b, 0x6c, 0x96, 0x18, 0x13, 0xf8, 0x9c, 0xcf, 0x8c,
+	0xbd, 0x95, 0xb2, 0x84, 0xe7, 0xc6, 0xd9, 0xc9, 0x58, 0x15, 0x0b, 0xe6, 0x1b, 0xf7, 0xfa, 0x05,
+	0x8b, 0x23, 0x9f, 0x15, 0x42, 0x6a, 0xc0, 0xfd, 0x11, 0x41, 0xe7, 0x71, 0x21, 0x24, 0x0b, 0x38,
+	0xbe, 0x0f, 0xad, 0x4f, 0x79, 0x45, 0xd0, 0x00, 0x1d, 0x6e, 0x0f, 0x3f, 0xb8, 0x7c, 0xbe, 0xf7,
+	0xc6, 0xef, 0xcf, 0xf7, 0x6e, 0x2e, 0x91, 0x0e, 0xab, 0x8c, 0xcb, 0x98, 0xfb, 0x01, 0x97, 0x47,
+	0xd3, 0x52, 0x4a, 0xf1, 0xec, 0x68, 0x1a, 0xa5, 0x4c, 0x56, 0xde, 0x13, 0x21, 0xfd, 0xe3, 0xdb,
+	0x1f, 0xd2, 0xba, 0x00, 0x7e, 0x00, 0x9b, 0x13, 0x16, 0x97, 0x9c, 0x6c, 0xbc, 0x42, 0x25, 0x5d,
+	0xc2, 0xfd, 0x0e, 0xc1, 0xb5, 0x93, 0xd9, 0x4c, 0x94, 0x69, 0x61, 0x69, 0x3e, 0x82, 0xce, 0x89,
+	0xef, 0x4b, 0x9e, 0xe7, 0xff, 0x8d, 0xea, 0x4c, 0x56, 0x59, 0x21, 0x3c, 0x73, 0x96, 0xda, 0x22,
+	0xf8, 0x60, 0xd1, 0x01, 0xb2, 0x31, 0x68, 0x1d, 0x6e, 0x1d, 0xef, 0x78, 0x4a, 0x3a, 0x03, 0x52,
+	0x1b, 0x75, 0xbf, 0x41, 0xd0, 0x1d, 0x4d, 0xce, 0x46, 0x17, 0x3c, 0x2d, 0x30, 0x81, 0xce, 0xdd,
+	0x90, 0x45, 0xe9, 0x27, 0xf7, 0x14, 0x8b, 0x1e, 0xb5, 0x2e, 0xbe, 0x03, 0xce, 0x38, 0x4a, 0xf4,
+	0xed, 0xb7, 0x8e, 0x77, 0x3d, 0x2d, 0xa3, 0x67, 0x65, 0xf4, 0xc6, 0x56, 0xc6, 0x61, 0xb7, 0x26,
+	0xfe, 0xc3, 0x1f, 0x7b, 0x88, 0xaa, 0x13, 0x78, 0x1f, 0x36, 0x55, 0x71, 0xd2, 0x52, 0x47, 0xaf,
+	0x79, 0x4a, 0xd5, 0x87, 0x22, 0x50, 0x28, 0xd5, 0x41, 0xf7, 0xd7, 0x0d, 0xd8, 0x52, 0xdf, 0x3a,
+	0x67, 0x92, 0x25, 0x39, 0xbe, 0x09, 0x37, 0xce, 0xa5, 0xc8, 0x44, 0xce, 0xe2, 0x71, 0x28, 0x79,
+	0x1e, 0x8a, 0xd8, 0x57, 0x9c, 0x1c, 0xba, 0x1e, 0xc0, 0x7d, 0x80, 0xd1, 0xe4, 0x6c, 0xc2, 0x65,
+	0x1e, 0x89, 0x54, 0x71, 0xec, 0xd1, 0x25, 0x04, 0xef, 0xc3, 0xce, 0x30, 0x16, 0xb3, 0xa7, 0x1f,
+	0xb3, 0xfc, 0x61, 0x94, 0x44, 0x9a, 0x8b, 0x43, 0x57, 0x41, 0x8c, 0xc1, 0x79, 0x72, 0xf2, 0xf8,
+	0x8c, 0x38, 0x03, 0x74, 0xd8, 0xa5, 0xca, 0xc6, 0x1e, 0x60, 0xdb, 0x32, 0x9e, 0x16, 0xe7, 0x5c,
+	0xd6, 0x52, 0x92, 0x4d, 0x75, 0xfc, 0x6f, 0x22, 0x35, 0xef, 0x55, 0x34, 0x12, 0x3e, 0x69, 0x6b,
+	0xde, 0x6b, 0x81, 0x3a, 0xfb, 0x8c, 0xcd, 0xc7, 0xf3, 0xd1, 0x3c, 0x8b, 0x64, 0xa5, 0xd8, 0xe4,
+	0xa4, 0xa3, 0xb3, 0xd7, 0x02, 0xf5, 0x2d, 0x1e, 0xb1, 0x84, 0x7f, 0xf6, 0x2c, 0xe5, 0x32, 0x0f,
+	0xa3, 0x8c, 0x74, 0x15, 0xd1, 0x55, 0xd0, 0xfd, 0x16, 0x41, 0xfb, 0x94, 0x33, 0x9f, 0xcb, 0x5a,
+	0x4e, 0xdb, 0x13, 0xdd, 0x3a, 0xeb, 0x2e, 0x0b, 0xbd, 0xb1, 0x2a, 0xf4, 0x3b, 0xd0, 0xd6, 0x12,
+	0x18, 0xbd, 0x6e, 0xe8, 0xb9, 0x59, 0xd2, 0x86, 0x9a, 0x84, 0xba, 0xeb, 0xf7, 0xa5, 0x48, 0x4e,
+	0x79, 0x14, 0x84, 0x85, 0xea, 0x9a, 0x43, 0x97, 0x10, 0xf7, 0x7b, 0x04, 0x5d, 0xab, 0x15, 0xfe,
+	0x02, 0xb6, 0xad, 0x7d, 0xca, 0xf2, 0xd0, 0x4c, 0xf9, 0x6d, 0x33, 0xe5, 0xb7, 0xfe, 0xd5, 0x33,
+	0x3a, 0xe5, 0xf3, 0x61, 0x55, 0xf0, 0x9c, 0xae, 0x94, 0xc2, 0x07, 0xd0, 0x1e, 0xb2, 0x38, 0x16,
+	0x85, 0x99, 0xce, 0xeb, 0x9e, 0xdd, 0x0f, 0x1a, 0xa6, 0x26, 0xec, 0xfe, 0x82, 0xa0, 0x43, 0x79,
+	0x22, 0x2e, 0xb8, 0x8f, 0x1f, 0x40, 0xc7, 0x3c, 0x41, 0x43, 0xe5, 0xbd, 0xff, 0xf1, 0xd8, 0x74,
+	0x81, 0x7a, 0x70, 0x6a, 0x0d, 0x4c, 0x2b, 0x95, 0xbd, 0x76, 0xdf, 0xd6, 0x6b, 0xbb, 0xaf, 0xfb,
+	0x15, 0x74, 0xc6, 0x92, 0x45, 0x31, 0x97, 0xf8, 0x73, 0xe8, 0xde, 0x0d, 0xf9, 0xec, 0x69, 0x5e,
+	0x26, 0xaf, 0xd6, 0xd1, 0x45, 0x19, 0xf7, 0xa7, 0x16, 0x38, 0xf7, 0xca, 0x24, 0xc3, 0x6f, 0x41,
+	0x5b, 0x0b, 0x69, 0x86, 0xc7, 0x78, 0xf8, 0xed, 0xa6, 0x73, 0xba, 0xdf, 0xdb, 0x5e, 0xbd, 0xb3,
+	0x0d, 0xd6, 0x74, 0xe5, 0xa3, 0x97, 0x97, 0x9c, 0x99, 0xa8, 0x37, 0xf5, 0x44, 0xad, 0xc6, 0xe8,
+	0xcb, 0x0b, 0xf1, 0xdd, 0x66, 0x2d, 0x11, 0xc7, 0x6c, 0x0e, 0x75, 0xce, 0xa2, 0xb4, 0x59, 0x5b,
+	0x03, 0xd3, 0xff, 0x4d, 0x43, 0x47, 0xff, 0x2b, 0x46, 0x69, 0x21, 0x2b, 0xa3, 0xc6, 0xbe, 0x7d,
+	0x13, 0xa4, 0x6d, 0x72, 0x54, 0x2d, 0x8d, 0x51, 0xfb, 0x5e, 0x8e, 0xa1, 0x37, 0xb1, 0xbf, 0x12,
+	0xd2, 0x31, 0x64, 0x9b, 0x9f, 0xcb, 0x22, 0x46, 0x9b, 0xb4, 0x9a, 0xa7, 0x15, 0x87, 0x74, 0x97,
+	0x79, 0x5a, 0x94, 0x36, 0x6f, 0xe0, 0x60, 0x21, 0x1c, 0xe9, 0x0d, 0x50, 0xb3, 0x94, 0x0d, 0x48,
+	0x17, 0xb2, 0x1e, 0x2c, 0xe6, 0x94, 0xc0, 0x72, 0xa2, 0x01, 0xa9, 0x8d, 0x0e, 0xef, 0x5c, 0x5e,
+	0xf5, 0xd1, 0x6f, 0x57, 0x7d, 0xf4, 0xe7, 0x55, 0x1f, 0xfd, 0xfc, 0xa2, 0x8f, 0x2e, 0x5f, 0xf4,
+	0xd1, 0x97, 0xee, 0x3f, 0x6b, 0x5f, 0x97, 0x9a, 0xb6, 0xd5, 0xea, 0x7e, 0xff, 0xaf, 0x01, 0x00,
+	0xa3, 0x9d, 0x42, 0x40, 0xd2, 0x07, 0x00, 0x00,
+}
+
 func (m *Storage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -416,7 +869,7 @@ func (m *EVMEvent) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Dump) Marshal() (dAtA []byte, err error) {
+func (m *ChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -426,55 +879,61 @@ func (m *Dump) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Dump) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainParams) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
+	if m.ProposalThreshold != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintDump(dAtA, i, uint64(m.Height))
+		i = encodeVarintDump(dAtA, i, uint64(m.ProposalThreshold))
 	}
-	if m.Account != nil {
+	if len(m.EVMVersion) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintDump(dAtA, i, uint64(m.Account.Size()))
-		n6, err := m.Account.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+		i = encodeVarintDump(dAtA, i, uint64(len(m.EVMVersion)))
+		i += copy(dAtA[i:], m.EVMVersion)
 	}
-	if m.AccountStorage != nil {
-		dAtA[i] = 0x1a
+	if m.BlockGasLimit != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintDump(dAtA, i, uint64(m.AccountStorage.Size()))
-		n7, err := m.AccountStorage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+		i = encodeVarintDump(dAtA, i, uint64(m.BlockGasLimit))
 	}
-	if m.EVMEvent != nil {
-		dAtA[i] = 0x22
+	if m.WASM {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintDump(dAtA, i, uint64(m.EVMEvent.Size()))
-		n8, err := m.EVMEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.WASM {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n8
+		i++
 	}
-	if m.Name != nil {
-		dAtA[i] = 0x2a
+	if m.StorageRentPerWord != 0 {
+		dAtA[i] = 0x28
 		i++
-		i = encodeVarintDump(dAtA, i, uint64(m.Name.Size()))
-		n9, err := m.Name.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintDump(dAtA, i, uint64(m.StorageRentPerWord))
+	}
+	if m.StorageRentPeriod != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.StorageRentPeriod))
+	}
+	if m.MaxTxExpiryBlocks != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.MaxTxExpiryBlocks))
+	}
+	if m.NameOwnership {
+		dAtA[i] = 0x40
+		i++
+		if m.NameOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n9
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -482,8 +941,284 @@ func (m *Dump) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintDump(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Version))
+	}
+	if len(m.ChainID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(len(m.ChainID)))
+		i += copy(dAtA[i:], m.ChainID)
+	}
+	if m.Params != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Params.Size()))
+		n6, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.FromHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.FromHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDump(dAtA, i, uint64(m.ProposalHash.Size()))
+	n7, err := m.ProposalHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.Ballot != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Ballot.Size()))
+		n8, err := m.Ballot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Removed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Removed) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Account.Size()))
+		n9, err := m.Account.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintDump(dAtA, i, uint64(m.ProposalHash.Size()))
+	n10, err := m.ProposalHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Trailer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trailer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDump(dAtA, i, uint64(m.Checksum.Size()))
+	n11, err := m.Checksum.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Dump) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dump) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Height))
+	}
+	if m.Account != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Account.Size()))
+		n12, err := m.Account.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.AccountStorage != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.AccountStorage.Size()))
+		n13, err := m.AccountStorage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.EVMEvent != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.EVMEvent.Size()))
+		n14, err := m.EVMEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Name != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Name.Size()))
+		n15, err := m.Name.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Header != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Header.Size()))
+		n16, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Validator != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Validator.Size()))
+		n17, err := m.Validator.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.Proposal != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Proposal.Size()))
+		n18, err := m.Proposal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Trailer != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Trailer.Size()))
+		n19, err := m.Trailer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.Removed != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintDump(dAtA, i, uint64(m.Removed.Size()))
+		n20, err := m.Removed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintDump(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -549,30 +1284,36 @@ func (m *EVMEvent) Size() (n int) {
 	return n
 }
 
-func (m *Dump) Size() (n int) {
+func (m *ChainParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovDump(uint64(m.Height))
+	if m.ProposalThreshold != 0 {
+		n += 1 + sovDump(uint64(m.ProposalThreshold))
 	}
-	if m.Account != nil {
-		l = m.Account.Size()
+	l = len(m.EVMVersion)
+	if l > 0 {
 		n += 1 + l + sovDump(uint64(l))
 	}
-	if m.AccountStorage != nil {
-		l = m.AccountStorage.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovDump(uint64(m.BlockGasLimit))
 	}
-	if m.EVMEvent != nil {
-		l = m.EVMEvent.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if m.WASM {
+		n += 2
 	}
-	if m.Name != nil {
-		l = m.Name.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if m.StorageRentPerWord != 0 {
+		n += 1 + sovDump(uint64(m.StorageRentPerWord))
+	}
+	if m.StorageRentPeriod != 0 {
+		n += 1 + sovDump(uint64(m.StorageRentPeriod))
+	}
+	if m.MaxTxExpiryBlocks != 0 {
+		n += 1 + sovDump(uint64(m.MaxTxExpiryBlocks))
+	}
+	if m.NameOwnership {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -580,20 +1321,151 @@ func (m *Dump) Size() (n int) {
 	return n
 }
 
-func sovDump(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovDump(uint64(m.Version))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovDump(uint64(m.FromHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozDump(x uint64) (n int) {
-	return sovDump(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Storage) Unmarshal(dAtA []byte) error {
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposalHash.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if m.Ballot != nil {
+		l = m.Ballot.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	l = m.ProposalHash.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Trailer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checksum.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDump(uint64(m.Height))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.AccountStorage != nil {
+		l = m.AccountStorage.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.EVMEvent != nil {
+		l = m.EVMEvent.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Trailer != nil {
+		l = m.Trailer.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDump(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDump(x uint64) (n int) {
+	return sovDump(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Storage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -608,7 +1480,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -624,9 +1496,944 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, &Storage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &exec.LogEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalThreshold", wireType)
+			}
+			m.ProposalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASM", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WASM = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPerWord", wireType)
+			}
+			m.StorageRentPerWord = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageRentPerWord |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRentPeriod", wireType)
+			}
+			m.StorageRentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageRentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxExpiryBlocks", wireType)
+			}
+			m.MaxTxExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NameOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &ChainParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ballot == nil {
+				m.Ballot = &payload.Ballot{}
+			}
+			if err := m.Ballot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Removed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Removed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Removed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Account = &v
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -636,25 +2443,27 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -666,7 +2475,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -675,10 +2484,13 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProposalHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -691,6 +2503,9 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthDump
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -704,7 +2519,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountStorage) Unmarshal(dAtA []byte) error {
+func (m *Trailer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -719,7 +2534,7 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -727,15 +2542,15 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountStorage: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trailer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trailer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -747,7 +2562,7 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -756,41 +2571,13 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDump
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Storage = append(m.Storage, &Storage{})
-			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -803,6 +2590,9 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthDump
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -816,7 +2606,7 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EVMEvent) Unmarshal(dAtA []byte) error {
+func (m *Dump) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -831,7 +2621,7 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -839,17 +2629,36 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EVMEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dump: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EVMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dump: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -859,24 +2668,31 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if m.Account == nil {
+				m.Account = &acm.Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -888,7 +2704,7 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -897,16 +2713,22 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountStorage == nil {
+				m.AccountStorage = &AccountStorage{}
+			}
+			if err := m.AccountStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -918,7 +2740,7 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -927,72 +2749,60 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &exec.LogEvent{}
+			if m.EVMEvent == nil {
+				m.EVMEvent = &EVMEvent{}
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EVMEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDump(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDump
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDump
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Dump) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDump
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Name == nil {
+				m.Name = &names.Entry{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Dump: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dump: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Height = 0
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1002,14 +2812,31 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1021,7 +2848,7 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1030,19 +2857,22 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &acm.Account{}
+			if m.Validator == nil {
+				m.Validator = &validator.Validator{}
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1054,7 +2884,7 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1063,19 +2893,22 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccountStorage == nil {
-				m.AccountStorage = &AccountStorage{}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
 			}
-			if err := m.AccountStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EVMEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trailer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1087,7 +2920,7 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1096,19 +2929,22 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EVMEvent == nil {
-				m.EVMEvent = &EVMEvent{}
+			if m.Trailer == nil {
+				m.Trailer = &Trailer{}
 			}
-			if err := m.EVMEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Trailer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1120,7 +2956,7 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1129,13 +2965,16 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthDump
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &names.Entry{}
+			if m.Removed == nil {
+				m.Removed = &Removed{}
 			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Removed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1148,6 +2987,9 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthDump
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1215,10 +3057,13 @@ func skipDump(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthDump
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthDump
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -1247,6 +3092,9 @@ func skipDump(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthDump
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -1265,39 +3113,3 @@ var (
 	ErrInvalidLengthDump = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowDump   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("dump.proto", fileDescriptor_dump_9c8edb2ce3771f10) }
-func init() { golang_proto.RegisterFile("dump.proto", fileDescriptor_dump_9c8edb2ce3771f10) }
-
-var fileDescriptor_dump_9c8edb2ce3771f10 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbb, 0x6e, 0x13, 0x41,
-	0x14, 0x65, 0xe2, 0x75, 0x9c, 0x5c, 0x87, 0x14, 0x23, 0x84, 0x56, 0x2e, 0xd6, 0xd6, 0x0a, 0x41,
-	0x84, 0x60, 0x2c, 0x99, 0x87, 0x52, 0xd0, 0xc4, 0xc4, 0x88, 0x67, 0x8a, 0x21, 0x0a, 0x12, 0xdd,
-	0x3e, 0x86, 0xf1, 0x4a, 0xde, 0x9d, 0xd5, 0xec, 0x2c, 0xb0, 0x3d, 0x0d, 0x1d, 0x3f, 0xc0, 0xbf,
-	0x50, 0xba, 0x44, 0x94, 0x14, 0x01, 0x39, 0x3f, 0x82, 0x76, 0x1e, 0x18, 0x52, 0x20, 0xa1, 0x74,
-	0xf7, 0xde, 0x33, 0xf7, 0xcc, 0x99, 0x73, 0x06, 0x20, 0xad, 0xf3, 0x92, 0x94, 0x52, 0x28, 0x81,
-	0xbd, 0xb6, 0x1e, 0xdc, 0xe6, 0x99, 0x9a, 0xd7, 0x31, 0x49, 0x44, 0x3e, 0xe6, 0x82, 0x8b, 0xb1,
-	0x06, 0xe3, 0xfa, 0x8d, 0xee, 0x74, 0xa3, 0x2b, 0xb3, 0x34, 0x18, 0x72, 0x21, 0xf8, 0x82, 0xad,
-	0x4f, 0xa9, 0x2c, 0x67, 0x95, 0x8a, 0x1c, 0xeb, 0x60, 0x3b, 0x4a, 0x72, 0x5b, 0x02, 0x7b, 0xcf,
-	0x12, 0x5b, 0xf7, 0x8b, 0x28, 0x67, 0x95, 0x69, 0xc2, 0xcf, 0x08, 0x7a, 0x2f, 0x95, 0x90, 0x11,
-	0x67, 0xf8, 0x11, 0x74, 0x9e, 0xb1, 0xc6, 0x47, 0x23, 0xb4, 0xb7, 0x33, 0xbd, 0xbb, 0x3c, 0x1d,
-	0x5e, 0xfa, 0x7e, 0x3a, 0xbc, 0xf5, 0x87, 0xa8, 0x79, 0x53, 0x32, 0xb9, 0x60, 0x29, 0x67, 0x72,
-	0x1c, 0xd7, 0x52, 0x8a, 0x77, 0xe3, 0x38, 0x2b, 0x22, 0xd9, 0x90, 0x57, 0x42, 0xa6, 0x93, 0x7b,
-	0xf7, 0x69, 0x4b, 0x80, 0x9f, 0x42, 0xf7, 0x24, 0x5a, 0xd4, 0xcc, 0xdf, 0xb8, 0x00, 0x93, 0xa1,
-	0x08, 0x3f, 0x22, 0xd8, 0x3d, 0x48, 0x12, 0x51, 0x17, 0xca, 0xc9, 0x3c, 0x82, 0xde, 0x41, 0x9a,
-	0x4a, 0x56, 0x55, 0xff, 0x27, 0x35, 0x91, 0x4d, 0xa9, 0x04, 0xb1, 0xbb, 0xd4, 0x91, 0xe0, 0x1b,
-	0xbf, 0x1d, 0xf0, 0x37, 0x46, 0x9d, 0xbd, 0xfe, 0xe4, 0x32, 0xd1, 0xd1, 0xd8, 0x21, 0x75, 0x68,
-	0xf8, 0x01, 0xc1, 0xd6, 0xec, 0xe4, 0xc5, 0xec, 0x2d, 0x2b, 0x14, 0xf6, 0xa1, 0xf7, 0x70, 0x1e,
-	0x65, 0xc5, 0x93, 0x43, 0xad, 0x62, 0x9b, 0xba, 0x16, 0xef, 0x83, 0x77, 0x9c, 0xe5, 0xe6, 0xf5,
-	0xfd, 0xc9, 0x80, 0x98, 0x98, 0x88, 0x8b, 0x89, 0x1c, 0xbb, 0x98, 0xa6, 0x5b, 0xad, 0xf0, 0x4f,
-	0x3f, 0x86, 0x88, 0xea, 0x0d, 0x7c, 0x0d, 0xba, 0x9a, 0xdc, 0xef, 0xe8, 0xd5, 0x5d, 0xa2, 0x53,
-	0x7b, 0x2e, 0xb8, 0x9e, 0x52, 0x03, 0x86, 0xdf, 0x10, 0x78, 0x87, 0x75, 0x5e, 0xe2, 0xab, 0xb0,
-	0xf9, 0x98, 0x65, 0x7c, 0xae, 0xb4, 0x02, 0x8f, 0xda, 0x0e, 0x5f, 0x87, 0x9e, 0xb5, 0xcc, 0x6a,
-	0xd8, 0x21, 0xed, 0x4f, 0xb0, 0x33, 0xea, 0x40, 0xfc, 0xe0, 0xbc, 0xb5, 0xf6, 0xde, 0x2b, 0xe6,
-	0xfd, 0x7f, 0x63, 0xf4, 0x7c, 0x0c, 0x37, 0xd7, 0x66, 0xf8, 0x9e, 0xd5, 0xab, 0xf7, 0xdc, 0x94,
-	0xae, 0xcd, 0x1a, 0x81, 0x77, 0x14, 0xe5, 0xcc, 0xef, 0x5a, 0x39, 0xe6, 0x07, 0xce, 0x0a, 0x25,
-	0x1b, 0xaa, 0x91, 0xe9, 0xfe, 0x72, 0x15, 0xa0, 0xaf, 0xab, 0x00, 0xfd, 0x5c, 0x05, 0xe8, 0xcb,
-	0x59, 0x80, 0x96, 0x67, 0x01, 0x7a, 0x1d, 0xfe, 0x3b, 0xd1, 0xf6, 0xba, 0x78, 0x53, 0x1b, 0x7b,
-	0xe7, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xbc, 0xb3, 0xac, 0x50, 0x03, 0x00, 0x00,
-}
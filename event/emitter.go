@@ -82,7 +82,12 @@ func (em *emitter) Shutdown(ctx context.Context) error {
 
 // Publisher
 func (em *emitter) Publish(ctx context.Context, message interface{}, tags query.Tagged) error {
-	return em.pubsubServer.PublishWithTags(ctx, message, tags)
+	err := em.pubsubServer.PublishWithTags(ctx, message, tags)
+	if err != nil {
+		return err
+	}
+	publishedEvents.Inc()
+	return nil
 }
 
 // Subscribable
@@ -91,7 +96,12 @@ func (em *emitter) Subscribe(ctx context.Context, subscriber string, queryable q
 	if err != nil {
 		return nil, err
 	}
-	return em.pubsubServer.Subscribe(ctx, subscriber, qry, bufferSize)
+	ch, err := em.pubsubServer.Subscribe(ctx, subscriber, qry, bufferSize)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions.Inc()
+	return ch, nil
 }
 
 func (em *emitter) Unsubscribe(ctx context.Context, subscriber string, queryable query.Queryable) error {
@@ -99,11 +109,22 @@ func (em *emitter) Unsubscribe(ctx context.Context, subscriber string, queryable
 	if err != nil {
 		return nil
 	}
-	return em.pubsubServer.Unsubscribe(ctx, subscriber, pubsubQuery)
+	err = em.pubsubServer.Unsubscribe(ctx, subscriber, pubsubQuery)
+	if err != nil {
+		return err
+	}
+	subscriptions.Dec()
+	return nil
 }
 
 func (em *emitter) UnsubscribeAll(ctx context.Context, subscriber string) error {
-	return em.pubsubServer.UnsubscribeAll(ctx, subscriber)
+	n := em.pubsubServer.NumClientSubscriptions(subscriber)
+	err := em.pubsubServer.UnsubscribeAll(ctx, subscriber)
+	if err != nil {
+		return err
+	}
+	subscriptions.Sub(float64(n))
+	return nil
 }
 
 // NoOpPublisher
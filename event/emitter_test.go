@@ -9,6 +9,8 @@ import (
 
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +38,24 @@ func TestEmitter(t *testing.T) {
 	}
 }
 
+func TestEmitterMetrics(t *testing.T) {
+	em := NewEmitter(logging.NewNoopLogger())
+	ctx := context.Background()
+	before := gaugeValue(t, subscriptions)
+
+	qry := query.NewBuilder().AndEquals("foo", "bar")
+	_, err := em.Subscribe(ctx, "TestEmitterMetrics", qry, 1)
+	require.NoError(t, err)
+	_, err = em.Subscribe(ctx, "TestEmitterMetrics", query.NewBuilder().AndEquals("foo", "baz"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, before+2, gaugeValue(t, subscriptions))
+
+	require.NoError(t, em.Unsubscribe(ctx, "TestEmitterMetrics", qry))
+	assert.Equal(t, before+1, gaugeValue(t, subscriptions))
+	require.NoError(t, em.UnsubscribeAll(ctx, "TestEmitterMetrics"))
+	assert.Equal(t, before, gaugeValue(t, subscriptions))
+}
+
 func TestOrdering(t *testing.T) {
 	em := NewEmitter(logging.NewNoopLogger())
 	ctx := context.Background()
@@ -75,3 +95,9 @@ func TestOrdering(t *testing.T) {
 		}
 	}
 }
+
+func gaugeValue(t *testing.T, gauge prometheus.Gauge) float64 {
+	metric := new(dto.Metric)
+	require.NoError(t, gauge.Write(metric))
+	return metric.GetGauge().GetValue()
+}
@@ -0,0 +1,24 @@
+package event
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	subscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "burrow",
+		Subsystem: "event",
+		Name:      "subscriptions",
+		Help:      "Number of active event subscriptions",
+	})
+	publishedEvents = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "event",
+		Name:      "published_total",
+		Help:      "Number of events published to subscribers",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(subscriptions, publishedEvents)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type ReflectTagged struct {
@@ -108,8 +109,11 @@ func (rt *ReflectTagged) Len() int {
 
 // Avoid the need to iterate over reflected type each time we need a reflect tagged
 var reflectTaggedCache = make(map[reflect.Type]map[string]ReflectTagged)
+var reflectTaggedCacheMtx sync.RWMutex
 
 func cacheGet(ty reflect.Type, keys []string) (*ReflectTagged, bool) {
+	reflectTaggedCacheMtx.RLock()
+	defer reflectTaggedCacheMtx.RUnlock()
 	if _, ok := reflectTaggedCache[ty]; ok {
 		key := strings.Join(keys, ",")
 		if rt, ok := reflectTaggedCache[ty][key]; ok {
@@ -120,6 +124,8 @@ func cacheGet(ty reflect.Type, keys []string) (*ReflectTagged, bool) {
 }
 
 func cachePut(ty reflect.Type, rt *ReflectTagged, fieldNames []string) {
+	reflectTaggedCacheMtx.Lock()
+	defer reflectTaggedCacheMtx.Unlock()
 	if _, ok := reflectTaggedCache[ty]; !ok {
 		reflectTaggedCache[ty] = make(map[string]ReflectTagged)
 	}
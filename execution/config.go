@@ -2,6 +2,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hyperledger/burrow/execution/evm"
 )
@@ -18,6 +19,15 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// Percentage added to the smallest sufficient gas limit found by EstimateGas to allow for state changing between
+	// estimation and execution
+	EstimateGasMarginPercent uint64
+	// Simulated calls and gas estimates are interrupted after running for this long, 0 for no limit beyond that of
+	// the request
+	SimulationTimeout time.Duration `json:",omitempty" toml:",omitempty"`
+	// Native contracts to register with the VM, set from Go before the kernel is started since they cannot be
+	// loaded from config, if nil only the built-in precompiles and SNatives are available
+	Natives *evm.Natives `json:"-" toml:"-"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -25,9 +35,16 @@ func DefaultExecutionConfig() *ExecutionConfig {
 		CallStackMaxDepth:        0, // Unlimited by default
 		DataStackInitialCapacity: evm.DataStackInitialCapacity,
 		DataStackMaxDepth:        0, // Unlimited by default
+		EstimateGasMarginPercent: DefaultEstimateGasMarginPercent,
+		SimulationTimeout:        DefaultSimulationTimeout,
 	}
 }
 
+const (
+	DefaultEstimateGasMarginPercent = 10
+	DefaultSimulationTimeout        = 10 * time.Second
+)
+
 type ExecutionOption func(*executor)
 
 func VMOptions(vmOptions ...func(*evm.VM)) func(*executor) {
@@ -36,6 +53,28 @@ func VMOptions(vmOptions ...func(*evm.VM)) func(*executor) {
 	}
 }
 
+// Natives provides the registry of native contracts available to the EVM, use evm.DefaultNatives() to extend the
+// built-in precompiles and SNatives
+func Natives(natives *evm.Natives) ExecutionOption {
+	return func(exe *executor) {
+		exe.natives = natives
+	}
+}
+
+// EstimateGasMargin sets the percentage EstimateGas adds to the gas limit it finds
+func EstimateGasMargin(percent uint64) ExecutionOption {
+	return func(exe *executor) {
+		exe.estimateGasMarginPercent = percent
+	}
+}
+
+// SimulationTimeout bounds how long simulated calls and gas estimates may run
+func SimulationTimeout(timeout time.Duration) ExecutionOption {
+	return func(exe *executor) {
+		exe.simulationTimeout = timeout
+	}
+}
+
 func (ec *ExecutionConfig) ExecutionOptions() ([]ExecutionOption, error) {
 	var exeOptions []ExecutionOption
 	var vmOptions []func(*evm.VM)
@@ -51,5 +90,14 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]ExecutionOption, error) {
 	}
 	vmOptions = append(vmOptions, evm.StackOptions(ec.CallStackMaxDepth, ec.DataStackInitialCapacity, ec.DataStackMaxDepth))
 	exeOptions = append(exeOptions, VMOptions(vmOptions...))
+	if ec.EstimateGasMarginPercent > 0 {
+		exeOptions = append(exeOptions, EstimateGasMargin(ec.EstimateGasMarginPercent))
+	}
+	if ec.SimulationTimeout > 0 {
+		exeOptions = append(exeOptions, SimulationTimeout(ec.SimulationTimeout))
+	}
+	if ec.Natives != nil {
+		exeOptions = append(exeOptions, Natives(ec.Natives))
+	}
 	return exeOptions, nil
 }
@@ -22,7 +22,11 @@ type CallContext struct {
 	StateWriter acmstate.ReaderWriter
 	RunCall     bool
 	Blockchain  Blockchain
+	ChainID     string
+	EVMVersion  evm.Version
+	WASM        bool
 	VMOptions   []func(*evm.VM)
+	Natives     *evm.Natives
 	Logger      *logging.Logger
 	tx          *payload.CallTx
 	txe         *exec.TxExecution
@@ -81,7 +85,7 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 			return nil, nil, fmt.Errorf("account %s does not have Call permission", ctx.tx.Input.Address)
 		}
 		// check if its a native contract
-		if evm.IsRegisteredNativeContract(*ctx.tx.Address) {
+		if ctx.isNativeContract(*ctx.tx.Address) {
 			return nil, nil, errors.ErrorCodef(errors.ErrorCodeReservedAddress,
 				"attempt to call a native contract at %s, "+
 					"but native contracts cannot be called using CallTx. Use a "+
@@ -133,6 +137,9 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 			BlockHeight: ctx.Blockchain.LastBlockHeight() + 1,
 			BlockTime:   ctx.Blockchain.LastBlockTime().Unix(),
 			GasLimit:    GasLimit,
+			ChainID:     ctx.ChainID,
+			EVMVersion:  ctx.EVMVersion,
+			WASM:        ctx.WASM,
 		}
 	)
 
@@ -181,7 +188,11 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 	}
 	ctx.Logger.Trace.Log("callee", callee)
 
-	vmach := evm.NewVM(params, caller, ctx.txe.Envelope.Tx, ctx.Logger, ctx.VMOptions...)
+	vmOptions := ctx.VMOptions
+	if ctx.Natives != nil {
+		vmOptions = append(vmOptions[:len(vmOptions):len(vmOptions)], evm.UseNatives(ctx.Natives))
+	}
+	vmach := evm.NewVM(params, caller, ctx.txe.Envelope.Tx, ctx.Logger, vmOptions...)
 	ret, exception := vmach.Call(txCache, ctx.txe, caller, callee, code, ctx.tx.Data, value, &gas)
 	if exception != nil {
 		// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
@@ -218,3 +229,11 @@ func (ctx *CallContext) CallEvents(err error) {
 		ctx.txe.Input(*ctx.tx.Address, errors.AsException(err))
 	}
 }
+
+// Native contracts are those in Natives or the defaults if Natives is nil that are available under EVMVersion
+func (ctx *CallContext) isNativeContract(address crypto.Address) bool {
+	if ctx.Natives != nil {
+		return ctx.Natives.IsAvailable(address, ctx.EVMVersion)
+	}
+	return evm.IsAvailableNativeContract(address, ctx.EVMVersion)
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
@@ -22,8 +23,11 @@ type NameContext struct {
 	Blockchain  BlockchainHeight
 	StateWriter acmstate.ReaderWriter
 	NameReg     names.ReaderWriter
-	Logger      *logging.Logger
-	tx          *payload.NameTx
+	// Allows names to be transferred and the owner of name to control sub.name, otherwise only the owner of a name
+	// controls it
+	NameOwnership bool
+	Logger        *logging.Logger
+	tx            *payload.NameTx
 }
 
 func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
@@ -56,13 +60,44 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if err := validateStrings(ctx.tx); err != nil {
 		return err
 	}
+	if ctx.tx.Owner != nil && !ctx.NameOwnership {
+		return fmt.Errorf("NameTx cannot transfer name %s since NameOwnership is not enabled on this chain",
+			ctx.tx.Name)
+	}
 
 	value := ctx.tx.Input.Amount - ctx.tx.Fee
+	lastBlockHeight := ctx.Blockchain.LastBlockHeight()
+
+	// check if the name exists
+	entry, err := ctx.NameReg.GetName(ctx.tx.Name)
+	if err != nil {
+		return err
+	}
+	expired := entry == nil || entry.Expires <= lastBlockHeight
+
+	// if the name or a name enclosing it is live, we must be owner of one of them
+	owned, claimed, err := ctx.ownership(ctx.tx.Name, ctx.tx.Input.Address, lastBlockHeight)
+	if err != nil {
+		return err
+	}
+	if claimed && !owned {
+		if !ctx.NameOwnership {
+			return fmt.Errorf("permission denied: sender %s is trying to update a name (%s) for "+
+				"which they are not an owner", ctx.tx.Input.Address, ctx.tx.Name)
+		}
+		return fmt.Errorf("permission denied: sender %s is trying to update a name (%s) for "+
+			"which they are not an owner of it or of any name enclosing it", ctx.tx.Input.Address, ctx.tx.Name)
+	}
+
+	// transferring a live name without data keeps its existing data
+	data := ctx.tx.Data
+	if ctx.tx.Owner != nil && !expired && len(data) == 0 {
+		data = entry.Data
+	}
 
 	// let's say cost of a name for one block is len(data) + 32
-	costPerBlock := names.NameCostPerBlock(names.NameBaseCost(ctx.tx.Name, ctx.tx.Data))
+	costPerBlock := names.NameCostPerBlock(names.NameBaseCost(ctx.tx.Name, data))
 	expiresIn := value / uint64(costPerBlock)
-	lastBlockHeight := ctx.Blockchain.LastBlockHeight()
 
 	ctx.Logger.TraceMsg("New NameTx",
 		"value", value,
@@ -70,28 +105,15 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		"expires_in", expiresIn,
 		"last_block_height", lastBlockHeight)
 
-	// check if the name exists
-	entry, err := ctx.NameReg.GetName(ctx.tx.Name)
-	if err != nil {
-		return err
+	// the new owner if registering or transferring
+	owner := ctx.tx.Input.Address
+	if ctx.tx.Owner != nil {
+		owner = *ctx.tx.Owner
 	}
 
 	if entry != nil {
-		var expired bool
-
-		// if the entry already exists, and hasn't expired, we must be owner
-		if entry.Expires > lastBlockHeight {
-			// ensure we are owner
-			if entry.Owner != ctx.tx.Input.Address {
-				return fmt.Errorf("permission denied: sender %s is trying to update a name (%s) for "+
-					"which they are not an owner", ctx.tx.Input.Address, ctx.tx.Name)
-			}
-		} else {
-			expired = true
-		}
-
-		// no value and empty data means delete the entry
-		if value == 0 && len(ctx.tx.Data) == 0 {
+		// no value and empty data means delete the entry (unless we are transferring it)
+		if ctx.tx.Owner == nil && value == 0 && len(data) == 0 {
 			// maybe we reward you for telling us we can delete this crap
 			// (owners if not expired, anyone if expired)
 			ctx.Logger.TraceMsg("Removing NameReg entry (no value and empty data in tx requests this)",
@@ -108,7 +130,7 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 					return fmt.Errorf("names must be registered for at least %d blocks", names.MinNameRegistrationPeriod)
 				}
 				entry.Expires = lastBlockHeight + expiresIn
-				entry.Owner = ctx.tx.Input.Address
+				entry.Owner = owner
 				ctx.Logger.TraceMsg("An old NameReg entry has expired and been reclaimed",
 					"name", entry.Name,
 					"expires_in", expiresIn,
@@ -129,8 +151,15 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 					"old_credit", oldCredit,
 					"value", value,
 					"credit", credit)
+				if ctx.tx.Owner != nil {
+					ctx.Logger.TraceMsg("Transferring NameReg entry",
+						"name", entry.Name,
+						"old_owner", entry.Owner,
+						"owner", owner)
+					entry.Owner = owner
+				}
 			}
-			entry.Data = ctx.tx.Data
+			entry.Data = data
 			err := ctx.NameReg.UpdateName(entry)
 			if err != nil {
 				return err
@@ -143,8 +172,8 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		// entry does not exist, so create it
 		entry = &names.Entry{
 			Name:    ctx.tx.Name,
-			Owner:   ctx.tx.Input.Address,
-			Data:    ctx.tx.Data,
+			Owner:   owner,
+			Data:    data,
 			Expires: lastBlockHeight + expiresIn,
 		}
 		ctx.Logger.TraceMsg("Creating NameReg entry",
@@ -180,6 +209,28 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	return nil
 }
 
+// Walk name and the names enclosing it (sub.name is enclosed by name) returning whether address owns a live entry for
+// any of them and whether any of them has a live entry at all. Unclaimed names may be registered by anyone. Without
+// NameOwnership only name itself is considered.
+func (ctx *NameContext) ownership(name string, address crypto.Address, height uint64) (owned, claimed bool, err error) {
+	for ; name != ""; name = names.ParentName(name) {
+		entry, err := ctx.NameReg.GetName(name)
+		if err != nil {
+			return false, false, err
+		}
+		if entry != nil && entry.Expires > height {
+			if entry.Owner == address {
+				return true, true, nil
+			}
+			claimed = true
+		}
+		if !ctx.NameOwnership {
+			break
+		}
+	}
+	return false, claimed, nil
+}
+
 func validateStrings(tx *payload.NameTx) error {
 	if len(tx.Name) == 0 {
 		return errors.ErrorCodef(errors.ErrorCodeInvalidString, "name must not be empty")
@@ -139,6 +139,12 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 			return fmt.Errorf("account %s does not have batch permission", i.Address)
 		}
 
+		// Batches are executed in order so their inputs must be protected from replay by sequence numbers
+		if i.Expires() {
+			return fmt.Errorf("batched input from account %s has an ExpiryHeight but batches must use sequence numbers",
+				i.Address)
+		}
+
 		if proposeAcc.GetSequence()+1 != i.Sequence {
 			return fmt.Errorf("proposal expired, sequence number for account %s wrong", i.Address)
 		}
@@ -60,6 +60,13 @@ const (
 	ErrorCodeInvalidBlockNumber
 	ErrorCodeBlockNumberOutOfRange
 	ErrorCodeAlreadyVoted
+	ErrorCodeBlockGasLimitExceeded
+	ErrorCodeExecutionInterrupted
+	ErrorCodeDormantAccount
+	ErrorCodeTxEvicted
+	ErrorCodeInsufficientPriority
+	ErrorCodeInvalidExpiryHeight
+	ErrorCodeDuplicateTx
 )
 
 func (c Code) ErrorCode() Code {
@@ -152,6 +159,20 @@ func (c Code) String() string {
 		return "block number out of range"
 	case ErrorCodeAlreadyVoted:
 		return "vote already registered for this address"
+	case ErrorCodeBlockGasLimitExceeded:
+		return "transaction gas limit exceeds the gas remaining in the block"
+	case ErrorCodeExecutionInterrupted:
+		return "execution interrupted"
+	case ErrorCodeDormantAccount:
+		return "account is dormant since its storage was evicted for non-payment of rent"
+	case ErrorCodeTxEvicted:
+		return "transaction was evicted from the mempool"
+	case ErrorCodeInsufficientPriority:
+		return "transaction priority is too low to be admitted to the mempool under load"
+	case ErrorCodeInvalidExpiryHeight:
+		return "transaction has expired or its expiry height is too far in the future"
+	case ErrorCodeDuplicateTx:
+		return "transaction has already been executed"
 	default:
 		return "Unknown error"
 	}
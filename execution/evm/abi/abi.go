@@ -141,45 +141,52 @@ func (e EVMUint) pack(v interface{}) ([]byte, error) {
 	n := new(big.Int)
 
 	arg := reflect.ValueOf(v)
-	switch arg.Kind() {
-	case reflect.String:
-		_, ok := n.SetString(arg.String(), 0)
-		if !ok {
-			return nil, fmt.Errorf("Failed to parse `%s", arg.String())
-		}
-		if n.Sign() < 0 {
-			return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
-		}
-	case reflect.Uint8:
-		fallthrough
-	case reflect.Uint16:
-		fallthrough
-	case reflect.Uint32:
-		fallthrough
-	case reflect.Uint64:
-		fallthrough
-	case reflect.Uint:
-		n.SetUint64(arg.Uint())
-	case reflect.Int8:
-		fallthrough
-	case reflect.Int16:
-		fallthrough
-	case reflect.Int32:
-		fallthrough
-	case reflect.Int64:
-		fallthrough
-	case reflect.Int:
-		x := arg.Int()
-		if x < 0 {
+	if b, ok := v.(*big.Int); ok {
+		if b.Sign() < 0 {
 			return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
 		}
-		n.SetInt64(x)
-	default:
-		t := reflect.TypeOf(new(uint64))
-		if reflect.TypeOf(v).ConvertibleTo(t) {
-			n.SetUint64(reflect.ValueOf(v).Convert(t).Uint())
-		} else {
-			return nil, fmt.Errorf("cannot convert type %s to uint%d", arg.Kind().String(), e.M)
+		n.Set(b)
+	} else {
+		switch arg.Kind() {
+		case reflect.String:
+			_, ok := n.SetString(arg.String(), 0)
+			if !ok {
+				return nil, fmt.Errorf("Failed to parse `%s", arg.String())
+			}
+			if n.Sign() < 0 {
+				return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
+			}
+		case reflect.Uint8:
+			fallthrough
+		case reflect.Uint16:
+			fallthrough
+		case reflect.Uint32:
+			fallthrough
+		case reflect.Uint64:
+			fallthrough
+		case reflect.Uint:
+			n.SetUint64(arg.Uint())
+		case reflect.Int8:
+			fallthrough
+		case reflect.Int16:
+			fallthrough
+		case reflect.Int32:
+			fallthrough
+		case reflect.Int64:
+			fallthrough
+		case reflect.Int:
+			x := arg.Int()
+			if x < 0 {
+				return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
+			}
+			n.SetInt64(x)
+		default:
+			t := reflect.TypeOf(new(uint64))
+			if reflect.TypeOf(v).ConvertibleTo(t) {
+				n.SetUint64(reflect.ValueOf(v).Convert(t).Uint())
+			} else {
+				return nil, fmt.Errorf("cannot convert type %s to uint%d", arg.Kind().String(), e.M)
+			}
 		}
 	}
 
@@ -301,38 +308,42 @@ func (e EVMInt) pack(v interface{}) ([]byte, error) {
 	n := new(big.Int)
 
 	arg := reflect.ValueOf(v)
-	switch arg.Kind() {
-	case reflect.String:
-		_, ok := n.SetString(arg.String(), 0)
-		if !ok {
-			return nil, fmt.Errorf("Failed to parse `%s", arg.String())
-		}
-	case reflect.Uint8:
-		fallthrough
-	case reflect.Uint16:
-		fallthrough
-	case reflect.Uint32:
-		fallthrough
-	case reflect.Uint64:
-		fallthrough
-	case reflect.Uint:
-		n.SetUint64(arg.Uint())
-	case reflect.Int8:
-		fallthrough
-	case reflect.Int16:
-		fallthrough
-	case reflect.Int32:
-		fallthrough
-	case reflect.Int64:
-		fallthrough
-	case reflect.Int:
-		n.SetInt64(arg.Int())
-	default:
-		t := reflect.TypeOf(new(int64))
-		if reflect.TypeOf(v).ConvertibleTo(t) {
-			n.SetInt64(reflect.ValueOf(v).Convert(t).Int())
-		} else {
-			return nil, fmt.Errorf("cannot convert type %s to int%d", arg.Kind().String(), e.M)
+	if b, ok := v.(*big.Int); ok {
+		n.Set(b)
+	} else {
+		switch arg.Kind() {
+		case reflect.String:
+			_, ok := n.SetString(arg.String(), 0)
+			if !ok {
+				return nil, fmt.Errorf("Failed to parse `%s", arg.String())
+			}
+		case reflect.Uint8:
+			fallthrough
+		case reflect.Uint16:
+			fallthrough
+		case reflect.Uint32:
+			fallthrough
+		case reflect.Uint64:
+			fallthrough
+		case reflect.Uint:
+			n.SetUint64(arg.Uint())
+		case reflect.Int8:
+			fallthrough
+		case reflect.Int16:
+			fallthrough
+		case reflect.Int32:
+			fallthrough
+		case reflect.Int64:
+			fallthrough
+		case reflect.Int:
+			n.SetInt64(arg.Int())
+		default:
+			t := reflect.TypeOf(new(int64))
+			if reflect.TypeOf(v).ConvertibleTo(t) {
+				n.SetInt64(reflect.ValueOf(v).Convert(t).Int())
+			} else {
+				return nil, fmt.Errorf("cannot convert type %s to int%d", arg.Kind().String(), e.M)
+			}
 		}
 	}
 
@@ -426,6 +437,14 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
 		*v = binary.BigEndian.Uint16(data[ElementSize-maxLen : ElementSize])
+	case *uint8:
+		if sign {
+			return 0, fmt.Errorf("cannot convert negative EVM int to %s", toType)
+		}
+		if length > 1 {
+			return 0, fmt.Errorf("value to large for uint8")
+		}
+		*v = data[ElementSize-1]
 	case *int64:
 		maxLen := int(unsafe.Sizeof(*v))
 		if length > maxLen || (inv[ElementSize-maxLen]&0x80) != 0 {
@@ -444,6 +463,11 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
 		*v = int16(binary.BigEndian.Uint16(data[ElementSize-maxLen : ElementSize]))
+	case *int8:
+		if length > 1 || (inv[ElementSize-1]&0x80) != 0 {
+			return 0, fmt.Errorf("value to large for int8")
+		}
+		*v = int8(data[ElementSize-1])
 	default:
 		return 0, fmt.Errorf("unable to convert %s to %s", e.GetSignature(), toType)
 	}
@@ -473,26 +497,23 @@ func (e EVMAddress) GetSignature() string {
 }
 
 func (e EVMAddress) pack(v interface{}) ([]byte, error) {
+	var a crypto.Address
 	var err error
-	a, ok := v.(crypto.Address)
-	if !ok {
-		s, ok := v.(string)
-		if ok {
-			a, err = crypto.AddressFromHexString(s)
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
-		b, ok := v.([]byte)
-		if !ok {
-			return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
+	switch v := v.(type) {
+	case crypto.Address:
+		a = v
+	case string:
+		a, err = crypto.AddressFromHexString(v)
+		if err != nil {
+			return nil, err
 		}
-
-		a, err = crypto.AddressFromBytes(b)
+	case []byte:
+		a, err = crypto.AddressFromBytes(v)
 		if err != nil {
 			return nil, err
 		}
+	default:
+		return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
 	}
 
 	return pad(a[:], ElementSize, true), nil
@@ -538,6 +559,10 @@ func (e EVMBytes) pack(v interface{}) ([]byte, error) {
 		s, ok := v.(string)
 		if ok {
 			b = []byte(s)
+		} else if arg := reflect.ValueOf(v); arg.Kind() == reflect.Array && arg.Type().Elem().Kind() == reflect.Uint8 {
+			// Fixed size byte arrays like [32]byte
+			b = make([]byte, arg.Len())
+			reflect.Copy(reflect.ValueOf(b), arg)
 		} else {
 			return nil, fmt.Errorf("cannot map to %s to EVM bytes", reflect.ValueOf(v).Kind().String())
 		}
@@ -587,7 +612,7 @@ func (e EVMBytes) unpack(data []byte, offset int, v interface{}) (int, error) {
 		}
 		v2.SetString(string(data[offset+start : offset+end]))
 	case reflect.Array:
-		fallthrough
+		reflect.Copy(v2, reflect.ValueOf(data[offset:offset+int(e.M)]))
 	case reflect.Slice:
 		v2.SetBytes(data[offset : offset+int(e.M)])
 	default:
@@ -634,18 +659,21 @@ func (e EVMString) pack(v interface{}) ([]byte, error) {
 
 func (e EVMString) unpack(data []byte, offset int, v interface{}) (int, error) {
 	lenType := EVMInt{M: 64}
-	var len int64
-	l, err := lenType.unpack(data, offset, &len)
+	var length int64
+	l, err := lenType.unpack(data, offset, &length)
 	if err != nil {
 		return 0, err
 	}
 	offset += l
+	if length < 0 || length > int64(len(data)-offset) {
+		return 0, fmt.Errorf("not enough data for string of length %d", length)
+	}
 
 	switch v := v.(type) {
 	case *string:
-		*v = string(data[offset : offset+int(len)])
+		*v = string(data[offset : offset+int(length)])
 	case *[]byte:
-		*v = data[offset : offset+int(len)]
+		*v = data[offset : offset+int(length)]
 	default:
 		return 0, fmt.Errorf("cannot map EVM string to %s", reflect.ValueOf(v).Kind().String())
 	}
@@ -697,6 +725,359 @@ func (e EVMFixed) Dynamic() bool {
 	return false
 }
 
+var _ EVMType = (*EVMTuple)(nil)
+
+// EVMTuple is a Solidity struct, or anything else described in the ABI as a tuple with components
+type EVMTuple struct {
+	Components []Argument
+}
+
+func (e EVMTuple) GetSignature() string {
+	sigs := make([]string, len(e.Components))
+	for i, c := range e.Components {
+		sigs[i] = c.evm().GetSignature()
+	}
+	return "(" + strings.Join(sigs, ",") + ")"
+}
+
+func (e EVMTuple) getGoType() interface{} {
+	return new(map[string]interface{})
+}
+
+func (e EVMTuple) pack(v interface{}) ([]byte, error) {
+	if s, ok := v.(string); ok {
+		list, ok := splitList(s, "(", ")")
+		if !ok {
+			return nil, fmt.Errorf("%s is not a valid value for %s", s, e.GetSignature())
+		}
+		v = list
+	}
+
+	arg := reflect.ValueOf(v)
+	if arg.Kind() == reflect.Ptr {
+		arg = arg.Elem()
+	}
+	switch arg.Kind() {
+	case reflect.Map:
+		m, ok := arg.Interface().(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot map %s to EVM tuple", arg.Type().String())
+		}
+		for i := range e.Components {
+			if _, ok := m[e.componentName(i)]; !ok {
+				return nil, fmt.Errorf("no value for component %s of %s", e.componentName(i), e.GetSignature())
+			}
+		}
+		return pack(e.Components, func(i int) interface{} {
+			return m[e.componentName(i)]
+		})
+	case reflect.Struct:
+		if arg.NumField() != len(e.Components) {
+			return nil, fmt.Errorf("%d fields expected for %s, %d received", len(e.Components), e.GetSignature(),
+				arg.NumField())
+		}
+		return pack(e.Components, func(i int) interface{} {
+			return arg.Field(i).Interface()
+		})
+	case reflect.Slice, reflect.Array:
+		if arg.Len() != len(e.Components) {
+			return nil, fmt.Errorf("%d values expected for %s, %d received", len(e.Components), e.GetSignature(),
+				arg.Len())
+		}
+		return pack(e.Components, func(i int) interface{} {
+			return arg.Index(i).Interface()
+		})
+	default:
+		return nil, fmt.Errorf("cannot map %s to EVM tuple", arg.Kind().String())
+	}
+}
+
+func (e EVMTuple) unpack(data []byte, offset int, v interface{}) (int, error) {
+	if offset < 0 || offset > len(data) {
+		return 0, fmt.Errorf("not enough data")
+	}
+	data = data[offset:]
+	switch v := v.(type) {
+	case *string:
+		values := make([]string, len(e.Components))
+		err := unpack(e.Components, data, func(i int) interface{} {
+			return &values[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+		*v = "(" + strings.Join(values, ",") + ")"
+	case *[]interface{}:
+		if len(*v) == 0 {
+			*v = GetPackingTypes(e.Components)
+		}
+		if len(*v) != len(e.Components) {
+			return 0, fmt.Errorf("%d values expected for %s, %d provided", len(e.Components), e.GetSignature(),
+				len(*v))
+		}
+		err := unpack(e.Components, data, func(i int) interface{} {
+			return (*v)[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+	case *map[string]interface{}:
+		values := GetPackingTypes(e.Components)
+		err := unpack(e.Components, data, func(i int) interface{} {
+			return values[i]
+		})
+		if err != nil {
+			return 0, err
+		}
+		*v = make(map[string]interface{}, len(values))
+		for i, value := range values {
+			(*v)[e.componentName(i)] = value
+		}
+	default:
+		st := reflect.ValueOf(v)
+		if st.Kind() != reflect.Ptr || st.Elem().Kind() != reflect.Struct {
+			return 0, fmt.Errorf("cannot map EVM tuple to %s", st.Kind().String())
+		}
+		st = st.Elem()
+		if st.NumField() != len(e.Components) {
+			return 0, fmt.Errorf("%d fields expected for %s, %s has %d", len(e.Components), e.GetSignature(),
+				st.Type().String(), st.NumField())
+		}
+		err := unpack(e.Components, data, func(i int) interface{} {
+			return st.Field(i).Addr().Interface()
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return headSize(e), nil
+}
+
+func (e EVMTuple) Dynamic() bool {
+	for _, c := range e.Components {
+		if c.evm().Dynamic() {
+			return true
+		}
+	}
+	return false
+}
+
+// componentName is the name of the ith component, or its index if it is unnamed
+func (e EVMTuple) componentName(i int) string {
+	if e.Components[i].Name == "" {
+		return strconv.Itoa(i)
+	}
+	return e.Components[i].Name
+}
+
+var _ EVMType = (*EVMArray)(nil)
+
+// EVMArray is an array of Elem, either of fixed Length or dynamic when Length is zero. The outermost dimension of an
+// argument is described by Argument.IsArray and Argument.ArrayLength so EVMArray only appears for the inner
+// dimensions of multidimensional arrays (and internally when packing and unpacking).
+type EVMArray struct {
+	Elem   EVMType
+	Length uint64
+}
+
+func (e EVMArray) GetSignature() string {
+	if e.Length > 0 {
+		return fmt.Sprintf("%s[%d]", e.Elem.GetSignature(), e.Length)
+	}
+	return e.Elem.GetSignature() + "[]"
+}
+
+func (e EVMArray) getGoType() interface{} {
+	t := reflect.TypeOf(e.Elem.getGoType()).Elem()
+	return reflect.New(reflect.SliceOf(t)).Interface()
+}
+
+func (e EVMArray) pack(v interface{}) ([]byte, error) {
+	if s, ok := v.(string); ok {
+		list, ok := splitList(s, "[", "]")
+		if !ok {
+			return nil, fmt.Errorf("%s is not a valid value for %s", s, e.GetSignature())
+		}
+		v = list
+	}
+
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%s should be array or slice, not %s", e.GetSignature(), val.Kind().String())
+	}
+	if e.Length > 0 && e.Length != uint64(val.Len()) {
+		return nil, fmt.Errorf("%s should be array of %d, not %d", e.GetSignature(), e.Length, val.Len())
+	}
+
+	packed, err := pack(e.elements(val.Len()), func(n int) interface{} {
+		return val.Index(n).Interface()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if e.Length == 0 {
+		length := EVMUint{M: 256}
+		p, err := length.pack(val.Len())
+		if err != nil {
+			return nil, err
+		}
+		packed = append(p, packed...)
+	}
+	return packed, nil
+}
+
+func (e EVMArray) unpack(data []byte, offset int, v interface{}) (int, error) {
+	if offset < 0 || offset > len(data) {
+		return 0, fmt.Errorf("offset %d for %s is out of range", offset, e.GetSignature())
+	}
+	length := int(e.Length)
+	if e.Length == 0 {
+		lenType := EVMInt{M: 64}
+		var l int64
+		_, err := lenType.unpack(data, offset, &l)
+		if err != nil {
+			return 0, err
+		}
+		if l < 0 {
+			return 0, fmt.Errorf("negative length %d for %s", l, e.GetSignature())
+		}
+		length = int(l)
+		offset += ElementSize
+	}
+	data = data[offset:]
+	// The length may come from untrusted data so check there is room for the heads of that many elements before
+	// allocating anything for them
+	size := headSize(e.Elem)
+	if size < 1 {
+		size = 1
+	}
+	if length > len(data)/size {
+		return 0, fmt.Errorf("not enough data for %d elements of %s", length, e.GetSignature())
+	}
+	elements := e.elements(length)
+
+	switch v := v.(type) {
+	case *string:
+		values := make([]string, length)
+		err := unpack(elements, data, func(n int) interface{} {
+			return &values[n]
+		})
+		if err != nil {
+			return 0, err
+		}
+		*v = "[" + strings.Join(values, ",") + "]"
+	case *[]interface{}:
+		if len(*v) == 0 {
+			*v = GetPackingTypes(elements)
+		}
+		if len(*v) != length {
+			return 0, fmt.Errorf("%s should be array or slice of %d elements", e.GetSignature(), length)
+		}
+		err := unpack(elements, data, func(n int) interface{} {
+			return (*v)[n]
+		})
+		if err != nil {
+			return 0, err
+		}
+	default:
+		val := reflect.ValueOf(v)
+		if val.Kind() != reflect.Ptr {
+			return 0, fmt.Errorf("cannot map %s to %s", e.GetSignature(), val.Kind().String())
+		}
+		val = val.Elem()
+		switch val.Kind() {
+		case reflect.Slice:
+			val.Set(reflect.MakeSlice(val.Type(), length, length))
+		case reflect.Array:
+			if val.Len() != length {
+				return 0, fmt.Errorf("%s should be array or slice of %d elements", e.GetSignature(), length)
+			}
+		default:
+			return 0, fmt.Errorf("cannot map %s to %s", e.GetSignature(), val.Kind().String())
+		}
+		err := unpack(elements, data, func(n int) interface{} {
+			elem := val.Index(n)
+			if elem.Kind() == reflect.Ptr {
+				if elem.IsNil() {
+					elem.Set(reflect.New(elem.Type().Elem()))
+				}
+				return elem.Interface()
+			}
+			return elem.Addr().Interface()
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return headSize(e), nil
+}
+
+func (e EVMArray) Dynamic() bool {
+	return e.Length == 0 || e.Elem.Dynamic()
+}
+
+// elements returns an argument spec with one (unnamed) argument of the element type for each element in the array
+func (e EVMArray) elements(length int) []Argument {
+	elements := make([]Argument, length)
+	for n := range elements {
+		elements[n].EVM = e.Elem
+	}
+	return elements
+}
+
+// staticSize is the number of bytes used to encode a value of a static (non-dynamic) type
+func staticSize(t EVMType) int {
+	switch t := t.(type) {
+	case EVMTuple:
+		size := 0
+		for _, c := range t.Components {
+			size += headSize(c.evm())
+		}
+		return size
+	case EVMArray:
+		return int(t.Length) * staticSize(t.Elem)
+	default:
+		return ElementSize
+	}
+}
+
+// headSize is the number of bytes a value of the type takes in the head of an encoding; dynamic types are
+// represented there by an offset to their data
+func headSize(t EVMType) int {
+	if t.Dynamic() {
+		return ElementSize
+	}
+	return staticSize(t)
+}
+
+// splitList splits a string value such as "[1,2,[3,4]]" or "(1,abc)" into its top-level elements
+func splitList(s, open, close string) ([]string, bool) {
+	if len(s) < 2 || s[0:1] != open || s[len(s)-1:] != close {
+		return nil, false
+	}
+	s = s[1 : len(s)-1]
+	if s == "" {
+		return []string{}, true
+	}
+	var list []string
+	depth := 0
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				list = append(list, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(list, s[start:]), true
+}
+
 type Argument struct {
 	Name        string
 	EVM         EVMType
@@ -706,6 +1087,14 @@ type Argument struct {
 	ArrayLength uint64
 }
 
+// evm returns the EVM type of the argument including its outermost array dimension, if any
+func (a Argument) evm() EVMType {
+	if a.IsArray {
+		return EVMArray{Elem: a.EVM, Length: a.ArrayLength}
+	}
+	return a.EVM
+}
+
 const FunctionIDSize = 4
 
 type FunctionID [FunctionIDSize]byte
@@ -754,6 +1143,8 @@ type AbiSpecJSON struct {
 	Anonymous       bool
 }
 
+var isArrayType = regexp.MustCompile("^(.*)\\[([0-9]*)\\]$")
+
 func readArgSpec(argsJ []ArgumentJSON) ([]Argument, error) {
 	args := make([]Argument, len(argsJ))
 	var err error
@@ -763,97 +1154,125 @@ func readArgSpec(argsJ []ArgumentJSON) ([]Argument, error) {
 		args[i].Indexed = a.Indexed
 
 		baseType := a.Type
-		isArray := regexp.MustCompile("(.*)\\[([0-9]+)\\]")
-		m := isArray.FindStringSubmatch(a.Type)
+		m := isArrayType.FindStringSubmatch(a.Type)
 		if m != nil {
 			args[i].IsArray = true
-			args[i].ArrayLength, err = strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
+			if m[2] != "" {
+				args[i].ArrayLength, err = strconv.ParseUint(m[2], 10, 32)
+				if err != nil {
+					return nil, err
+				}
 			}
 			baseType = m[1]
-		} else if strings.HasSuffix(a.Type, "[]") {
-			args[i].IsArray = true
-			baseType = strings.TrimSuffix(a.Type, "[]")
 		}
 
-		isM := regexp.MustCompile("(bytes|uint|int)([0-9]+)")
-		m = isM.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
-			}
-			switch m[1] {
-			case "bytes":
-				if M < 1 || M > 32 {
-					return nil, fmt.Errorf("bytes%d is not valid type", M)
-				}
-				args[i].EVM = EVMBytes{M}
-			case "uint":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMUint{M}
-			case "int":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMInt{M}
-			}
-			continue
+		args[i].EVM, err = readEVMType(baseType, a.Components)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		isMxN := regexp.MustCompile("(fixed|ufixed)([0-9]+)x([0-9]+)")
-		m = isMxN.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
+	return args, nil
+}
+
+func readEVMType(typ string, components []ArgumentJSON) (EVMType, error) {
+	// Inner dimensions of a multidimensional array, e.g. the [2] of uint[2][]
+	m := isArrayType.FindStringSubmatch(typ)
+	if m != nil {
+		elem, err := readEVMType(m[1], components)
+		if err != nil {
+			return nil, err
+		}
+		var length uint64
+		if m[2] != "" {
+			length, err = strconv.ParseUint(m[2], 10, 32)
 			if err != nil {
 				return nil, err
 			}
-			N, err := strconv.ParseUint(m[3], 10, 32)
-			if err != nil {
-				return nil, err
+		}
+		return EVMArray{Elem: elem, Length: length}, nil
+	}
+
+	if typ == "tuple" {
+		args, err := readArgSpec(components)
+		if err != nil {
+			return nil, err
+		}
+		return EVMTuple{Components: args}, nil
+	}
+
+	isM := regexp.MustCompile("(bytes|uint|int)([0-9]+)")
+	m = isM.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		switch m[1] {
+		case "bytes":
+			if M < 1 || M > 32 {
+				return nil, fmt.Errorf("bytes%d is not valid type", M)
 			}
+			return EVMBytes{M}, nil
+		case "uint":
 			if M < 8 || M > 256 || (M%8) != 0 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
+				return nil, fmt.Errorf("uint%d is not valid type", M)
 			}
-			if N <= 0 || N > 80 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
-			}
-			if m[1] == "fixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: true}
-			} else if m[1] == "ufixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: false}
-			} else {
-				panic(m[1])
-			}
-			continue
-		}
-		switch baseType {
-		case "uint":
-			args[i].EVM = EVMUint{M: 256}
+			return EVMUint{M}, nil
 		case "int":
-			args[i].EVM = EVMInt{M: 256}
-		case "address":
-			args[i].EVM = EVMAddress{}
-		case "bool":
-			args[i].EVM = EVMBool{}
-		case "fixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: true}
-		case "ufixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: false}
-		case "bytes":
-			args[i].EVM = EVMBytes{M: 0}
-		case "string":
-			args[i].EVM = EVMString{}
-		default:
-			// Assume it is a type of Contract
-			args[i].EVM = EVMAddress{}
+			if M < 8 || M > 256 || (M%8) != 0 {
+				return nil, fmt.Errorf("uint%d is not valid type", M)
+			}
+			return EVMInt{M}, nil
 		}
 	}
 
-	return args, nil
+	isMxN := regexp.MustCompile("(fixed|ufixed)([0-9]+)x([0-9]+)")
+	m = isMxN.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		N, err := strconv.ParseUint(m[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if M < 8 || M > 256 || (M%8) != 0 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		if N <= 0 || N > 80 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		if m[1] == "fixed" {
+			return EVMFixed{N: N, M: M, signed: true}, nil
+		} else if m[1] == "ufixed" {
+			return EVMFixed{N: N, M: M, signed: false}, nil
+		} else {
+			panic(m[1])
+		}
+	}
+	switch typ {
+	case "uint":
+		return EVMUint{M: 256}, nil
+	case "int":
+		return EVMInt{M: 256}, nil
+	case "address":
+		return EVMAddress{}, nil
+	case "bool":
+		return EVMBool{}, nil
+	case "fixed":
+		return EVMFixed{M: 128, N: 8, signed: true}, nil
+	case "ufixed":
+		return EVMFixed{M: 128, N: 8, signed: false}, nil
+	case "bytes":
+		return EVMBytes{M: 0}, nil
+	case "string":
+		return EVMString{}, nil
+	default:
+		// Assume it is a type of Contract
+		return EVMAddress{}, nil
+	}
 }
 
 func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
@@ -895,9 +1314,11 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 			// Get signature before we deal with hashed types
 			sig := Signature(s.Name, inputs)
 			for i := range inputs {
-				if inputs[i].Indexed && inputs[i].EVM.Dynamic() {
-					// For Dynamic types, the hash is stored in stead
+				if inputs[i].Indexed && isReferenceType(inputs[i]) {
+					// For Dynamic types, arrays and structs the hash is stored in stead
 					inputs[i].EVM = EVMBytes{M: 32}
+					inputs[i].IsArray = false
+					inputs[i].ArrayLength = 0
 					inputs[i].Hashed = true
 				}
 			}
@@ -922,6 +1343,13 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 	return &abiSpec, nil
 }
 
+func isReferenceType(arg Argument) bool {
+	if _, ok := arg.EVM.(EVMTuple); ok {
+		return true
+	}
+	return arg.IsArray || arg.EVM.Dynamic()
+}
+
 func ReadAbiSpecFile(filename string) (*AbiSpec, error) {
 	specBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -964,6 +1392,10 @@ func EVMTypeFromReflect(v reflect.Type) Argument {
 		arg.EVM = EVMAddress{}
 	} else if v == reflect.TypeOf(big.Int{}) {
 		arg.EVM = EVMInt{M: 256}
+	} else if v.Kind() == reflect.Slice && v.Elem().Kind() == reflect.Uint8 {
+		arg.EVM = EVMBytes{}
+	} else if isFixedBytes(v) {
+		arg.EVM = EVMBytes{M: uint64(v.Len())}
 	} else {
 		if v.Kind() == reflect.Array {
 			arg.IsArray = true
@@ -975,6 +1407,19 @@ func EVMTypeFromReflect(v reflect.Type) Argument {
 		}
 
 		switch v.Kind() {
+		case reflect.Array:
+			if !isFixedBytes(v) {
+				panic(fmt.Sprintf("no mapping for array of %v", v.Elem().Kind()))
+			}
+			arg.EVM = EVMBytes{M: uint64(v.Len())}
+		case reflect.Struct:
+			tuple := EVMTuple{Components: make([]Argument, v.NumField())}
+			for i := range tuple.Components {
+				f := v.Field(i)
+				tuple.Components[i] = EVMTypeFromReflect(f.Type)
+				tuple.Components[i].Name = f.Name
+			}
+			arg.EVM = tuple
 		case reflect.Bool:
 			arg.EVM = EVMBool{}
 		case reflect.String:
@@ -991,6 +1436,11 @@ func EVMTypeFromReflect(v reflect.Type) Argument {
 	return arg
 }
 
+// Byte arrays of up to 32 bytes (like binary.Word256) map to the EVM's fixed size bytes types
+func isFixedBytes(v reflect.Type) bool {
+	return v.Kind() == reflect.Array && v.Elem().Kind() == reflect.Uint8 && v.Len() > 0 && v.Len() <= ElementSize
+}
+
 // SpecFromStructReflect generates a FunctionSpec where the arguments and return values are
 // described a struct. Both args and rets should be set to the return value of reflect.TypeOf()
 // with the respective struct as an argument.
@@ -1045,14 +1495,7 @@ func Signature(name string, args []Argument) (sig string) {
 		if i > 0 {
 			sig += ","
 		}
-		sig += a.EVM.GetSignature()
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				sig += fmt.Sprintf("[%d]", a.ArrayLength)
-			} else {
-				sig += "[]"
-			}
-		}
+		sig += a.evm().GetSignature()
 	}
 	sig += ")"
 	return
@@ -1108,7 +1551,7 @@ func UnpackEvent(eventSpec *EventSpec, topics []burrow_binary.Word256, data []by
 
 	for i, a := range eventSpec.Inputs {
 		if a.Indexed {
-			_, err := a.EVM.unpack(topics[topicIndex].Bytes(), 0, args[i])
+			_, err := a.EVM.unpack(topics[topicIndex].Bytes(), 0, settable(args[i]))
 			if err != nil {
 				return err
 			}
@@ -1240,86 +1683,40 @@ func pack(argSpec []Argument, getArg func(int) interface{}) ([]byte, error) {
 	// block contains byte offsets to the data. We need to know the length of the fixed
 	// block, so we can calcute the offsets
 	for _, a := range argSpec {
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				fixedSize += ElementSize * int(a.ArrayLength)
-			} else {
-				fixedSize += ElementSize
-			}
-		} else {
-			fixedSize += ElementSize
-		}
-	}
-
-	addArg := func(v interface{}, a Argument) error {
-		var b []byte
-		var err error
-		if a.EVM.Dynamic() {
-			offset := EVMUint{M: 256}
-			b, _ = offset.pack(fixedSize)
-			d, err := a.EVM.pack(v)
-			if err != nil {
-				return err
-			}
-			fixedSize += len(d)
-			packedDynamic = append(packedDynamic, d...)
-		} else {
-			b, err = a.EVM.pack(v)
-			if err != nil {
-				return err
-			}
-		}
-		packed = append(packed, b...)
-		return nil
+		fixedSize += headSize(a.evm())
 	}
 
 	for i, as := range argSpec {
 		a := getArg(i)
+		evm := as.evm()
 		if as.IsArray {
 			s, ok := a.(string)
-			if ok && s[0:1] == "[" && s[len(s)-1:] == "]" {
-				a = strings.Split(s[1:len(s)-1], ",")
+			if ok {
+				if list, ok := splitList(s, "[", "]"); ok {
+					a = list
+				}
 			}
 
 			val := reflect.ValueOf(a)
 			if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
 				return nil, fmt.Errorf("argument %d should be array or slice, not %s", i, val.Kind().String())
 			}
-
-			if as.ArrayLength > 0 {
-				if as.ArrayLength != uint64(val.Len()) {
-					return nil, fmt.Errorf("argumment %d should be array of %d, not %d", i, as.ArrayLength, val.Len())
-				}
-
-				for n := 0; n < val.Len(); n++ {
-					err := addArg(val.Index(n).Interface(), as)
-					if err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				// dynamic array
-				offset := EVMUint{M: 256}
-				b, _ := offset.pack(fixedSize)
-				packed = append(packed, b...)
-				fixedSize += len(b)
-
-				// store length
-				b, _ = offset.pack(val.Len())
-				packedDynamic = append(packedDynamic, b...)
-				for n := 0; n < val.Len(); n++ {
-					d, err := as.EVM.pack(val.Index(n).Interface())
-					if err != nil {
-						return nil, err
-					}
-					packedDynamic = append(packedDynamic, d...)
-				}
+			if as.ArrayLength > 0 && as.ArrayLength != uint64(val.Len()) {
+				return nil, fmt.Errorf("argumment %d should be array of %d, not %d", i, as.ArrayLength, val.Len())
 			}
+		}
+
+		b, err := evm.pack(a)
+		if err != nil {
+			return nil, err
+		}
+		if evm.Dynamic() {
+			offset := EVMUint{M: 256}
+			o, _ := offset.pack(fixedSize + len(packedDynamic))
+			packed = append(packed, o...)
+			packedDynamic = append(packedDynamic, b...)
 		} else {
-			err := addArg(a, as)
-			if err != nil {
-				return nil, err
-			}
+			packed = append(packed, b...)
 		}
 	}
 
@@ -1330,12 +1727,7 @@ func GetPackingTypes(args []Argument) []interface{} {
 	res := make([]interface{}, len(args))
 
 	for i, a := range args {
-		if a.IsArray {
-			t := reflect.TypeOf(a.EVM.getGoType())
-			res[i] = reflect.MakeSlice(reflect.SliceOf(t), int(a.ArrayLength), 0).Interface()
-		} else {
-			res[i] = a.EVM.getGoType()
-		}
+		res[i] = a.evm().getGoType()
 	}
 
 	return res
@@ -1358,126 +1750,53 @@ func unpack(argSpec []Argument, data []byte, getArg func(int) interface{}) error
 	offset := 0
 	offType := EVMInt{M: 64}
 
-	getPrimitive := func(e interface{}, a Argument) error {
-		if a.EVM.Dynamic() {
+	for i, a := range argSpec {
+		if a.Indexed {
+			continue
+		}
+
+		evm := a.evm()
+		if evm.Dynamic() {
 			var o int64
-			l, err := offType.unpack(data, offset, &o)
+			_, err := offType.unpack(data, offset, &o)
 			if err != nil {
 				return err
 			}
-			offset += l
-			l, err = a.EVM.unpack(data, int(o), e)
+			if o < 0 || int(o) > len(data) {
+				return fmt.Errorf("offset %d for argument %d is out of range", o, i)
+			}
+			_, err = evm.unpack(data, int(o), settable(getArg(i)))
 			if err != nil {
 				return err
 			}
+			offset += ElementSize
 		} else {
-			l, err := a.EVM.unpack(data, offset, e)
+			size := staticSize(evm)
+			if len(data)-offset < size {
+				return fmt.Errorf("not enough data")
+			}
+			_, err := evm.unpack(data, offset, settable(getArg(i)))
 			if err != nil {
 				return err
 			}
-			offset += l
+			offset += size
 		}
-
-		return nil
 	}
 
-	for i, a := range argSpec {
-		if a.Indexed {
-			continue
-		}
-
-		arg := getArg(i)
-		if a.IsArray {
-			var array *[]interface{}
-
-			array, ok := arg.(*[]interface{})
-			if !ok {
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					intermediate := make([]interface{}, a.ArrayLength)
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-					array = &intermediate
-				} else {
-					return fmt.Errorf("argument %d should be array, slice or string", i)
-				}
-			}
-
-			if a.ArrayLength > 0 {
-				if int(a.ArrayLength) != len(*array) {
-					return fmt.Errorf("argument %d should be array or slice of %d elements", i, a.ArrayLength)
-				}
-
-				for n := 0; n < len(*array); n++ {
-					err := getPrimitive((*array)[n], a)
-					if err != nil {
-						return err
-					}
-				}
-			} else {
-				var o int64
-				var length int64
-
-				l, err := offType.unpack(data, offset, &o)
-				if err != nil {
-					return err
-				}
-
-				offset += l
-				s, err := offType.unpack(data, int(o), &length)
-				if err != nil {
-					return err
-				}
-				o += int64(s)
-
-				intermediate := make([]interface{}, length)
-
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-				} else {
-					for i, _ := range intermediate {
-						intermediate[i] = a.EVM.getGoType()
-					}
-				}
-
-				for i := 0; i < int(length); i++ {
-					l, err = a.EVM.unpack(data, int(o), intermediate[i])
-					if err != nil {
-						return err
-					}
-					o += int64(l)
-				}
-
-				array = &intermediate
-			}
+	return nil
+}
 
-			// If we were supposed to return a string, convert it back
-			if ret, ok := arg.(*string); ok {
-				s := "["
-				for i, e := range *array {
-					if i > 0 {
-						s += ","
-					}
-					s += *(e.(*string))
-				}
-				s += "]"
-				*ret = s
-			}
-		} else {
-			err := getPrimitive(arg, a)
-			if err != nil {
-				return err
-			}
+// settable allows a pointer to a pointer, such as a **big.Int, to be passed as a target to unpack into, allocating
+// the inner value if it is nil
+func settable(arg interface{}) interface{} {
+	v := reflect.ValueOf(arg)
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Ptr {
+		if v.Elem().IsNil() {
+			v.Elem().Set(reflect.New(v.Type().Elem().Elem()))
 		}
+		return v.Elem().Interface()
 	}
-
-	return nil
+	return arg
 }
 
 // quick helper padding
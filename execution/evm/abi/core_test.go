@@ -466,6 +466,155 @@ func TestUnpacker(t *testing.T) {
 	}
 }
 
+func TestPackNestedDynamic(t *testing.T) {
+	// Examples from the Solidity ABI specification
+	abiSpec, err := ReadAbiSpec([]byte(`[
+  {"name":"f","type":"function","inputs":[{"type":"uint256"},{"type":"uint32[]"},{"type":"bytes10"},{"type":"bytes"}]},
+  {"name":"g","type":"function","inputs":[{"type":"uint256[][]"},{"type":"string[]"}],
+   "outputs":[{"type":"uint256[][]"},{"type":"string[]"}]}
+]`))
+	require.NoError(t, err)
+
+	packed, _, err := abiSpec.Pack("f", "0x123", []string{"0x456", "0x789"}, "1234567890", "Hello, world!")
+	require.NoError(t, err)
+	assert.Equal(t, hexToBytes(t, "8be65246"+
+		"0000000000000000000000000000000000000000000000000000000000000123"+
+		"0000000000000000000000000000000000000000000000000000000000000080"+
+		"3132333435363738393000000000000000000000000000000000000000000000"+
+		"00000000000000000000000000000000000000000000000000000000000000e0"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000456"+
+		"0000000000000000000000000000000000000000000000000000000000000789"+
+		"000000000000000000000000000000000000000000000000000000000000000d"+
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000"), packed)
+
+	gEncoded := hexToBytes(t, ""+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"0000000000000000000000000000000000000000000000000000000000000140"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"00000000000000000000000000000000000000000000000000000000000000a0"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"0000000000000000000000000000000000000000000000000000000000000060"+
+		"00000000000000000000000000000000000000000000000000000000000000a0"+
+		"00000000000000000000000000000000000000000000000000000000000000e0"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"6f6e650000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"74776f0000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000005"+
+		"7468726565000000000000000000000000000000000000000000000000000000")
+
+	packed, _, err = abiSpec.Pack("g", "[[1,2],[3]]", "[one,two,three]")
+	require.NoError(t, err)
+	assert.Equal(t, hexToBytes(t, "2289b18c"), packed[:4])
+	assert.Equal(t, gEncoded, packed[4:])
+
+	var uints [][]uint64
+	var strs []string
+	err = abiSpec.Unpack(gEncoded, "g", &uints, &strs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{{1, 2}, {3}}, uints)
+	assert.Equal(t, []string{"one", "two", "three"}, strs)
+
+	vars, err := DecodeFunctionReturn(`[{"name":"g","type":"function","outputs":[{"type":"uint256[][]"},{"type":"string[]"}]}]`,
+		"g", gEncoded)
+	require.NoError(t, err)
+	assert.Equal(t, "[[1,2],[3]]", vars[0].Value)
+	assert.Equal(t, "[one,two,three]", vars[1].Value)
+}
+
+func TestUnpackUntrustedLengths(t *testing.T) {
+	var uints [][]*big.Int
+	uintsSpec := []Argument{{EVM: EVMArray{Elem: EVMArray{Elem: EVMUint{M: 256}}}}}
+	// A uint256[][] whose only element claims 0x1000000000 elements
+	err := Unpack(uintsSpec, hexToBytes(t, ""+
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+		"0000000000000000000000000000000000000000000000000000001000000000"), &uints)
+	assert.Error(t, err)
+
+	// A negative length
+	err = Unpack(uintsSpec, hexToBytes(t, ""+
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), &uints)
+	assert.Error(t, err)
+
+	// A string longer than the data
+	var str string
+	err = Unpack([]Argument{{EVM: EVMString{}}}, hexToBytes(t, ""+
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"6869000000000000000000000000000000000000000000000000000000000000"), &str)
+	assert.Error(t, err)
+
+	// Negative offsets
+	_, err = EVMArray{Elem: EVMUint{M: 256}}.unpack(make([]byte, 64), -32, &uints)
+	assert.Error(t, err)
+	_, err = EVMTuple{}.unpack(make([]byte, 64), -32, &str)
+	assert.Error(t, err)
+}
+
+func TestTuple(t *testing.T) {
+	abiSpec, err := ReadAbiSpec([]byte(`[{"name":"setOrder","type":"function",
+  "inputs":[{"name":"order","type":"tuple","components":[{"name":"amount","type":"uint256"},{"name":"note","type":"string"}]},
+    {"name":"lines","type":"tuple[2]","components":[{"name":"qty","type":"uint64"},{"name":"ok","type":"bool"}]}],
+  "outputs":[{"name":"order","type":"tuple","components":[{"name":"amount","type":"uint256"},{"name":"note","type":"string"}]}]}]`))
+	require.NoError(t, err)
+
+	fs := abiSpec.Functions["setOrder"]
+	assert.Equal(t, "setOrder((uint256,string),(uint64,bool)[2])", Signature("setOrder", fs.Inputs))
+
+	type line struct {
+		Qty uint64
+		Ok  bool
+	}
+	packed, _, err := abiSpec.Pack("setOrder", "(1,hi)", []line{{Qty: 3, Ok: true}, {Qty: 4}})
+	require.NoError(t, err)
+	assert.Equal(t, hexToBytes(t, ""+
+		"00000000000000000000000000000000000000000000000000000000000000a0"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000004"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000040"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"6869000000000000000000000000000000000000000000000000000000000000"), packed[4:])
+
+	// Map and struct forms encode the same as the string form
+	packedMap, _, err := abiSpec.Pack("setOrder", map[string]interface{}{"amount": 1, "note": "hi"},
+		"[(3,true),(4,false)]")
+	require.NoError(t, err)
+	assert.Equal(t, packed, packedMap)
+
+	order := packed[4+3*ElementSize+2*ElementSize:]
+	output := append(hexToBytes(t, "0000000000000000000000000000000000000000000000000000000000000020"), order...)
+
+	var st struct {
+		Amount big.Int
+		Note   string
+	}
+	require.NoError(t, abiSpec.Unpack(output, "setOrder", &st))
+	assert.Equal(t, int64(1), st.Amount.Int64())
+	assert.Equal(t, "hi", st.Note)
+
+	var m map[string]interface{}
+	require.NoError(t, abiSpec.Unpack(output, "setOrder", &m))
+	assert.Equal(t, big.NewInt(1), m["amount"])
+	assert.Equal(t, "hi", *m["note"].(*string))
+
+	var str string
+	require.NoError(t, abiSpec.Unpack(output, "setOrder", &str))
+	assert.Equal(t, "(1,hi)", str)
+}
+
 func hexToBytes(t testing.TB, hexString string) []byte {
 	bs, err := hex.DecodeString(hexString)
 	require.NoError(t, err)
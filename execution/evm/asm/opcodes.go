@@ -84,6 +84,8 @@ const (
 	BLOCKHEIGHT
 	DIFFICULTY_DEPRECATED
 	GASLIMIT
+	CHAINID     // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1344.md
+	SELFBALANCE // https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1884.md
 )
 
 const (
@@ -253,6 +255,8 @@ var opCodeNames = map[OpCode]string{
 	BLOCKHEIGHT:           "BLOCKHEIGHT",
 	DIFFICULTY_DEPRECATED: "DIFFICULTY_DEPRECATED",
 	GASLIMIT:              "GASLIMIT",
+	CHAINID:               "CHAINID",
+	SELFBALANCE:           "SELFBALANCE",
 
 	// 0x50 range - 'storage' and execution
 	POP:      "POP",
@@ -19,15 +19,28 @@ const (
 	GasGetAccount    uint64 = 1
 	GasStorageUpdate uint64 = 1
 	GasCreateAccount uint64 = 1
+	GasStorageLoad   uint64 = 1
+	GasLogBase       uint64 = 1
+	GasLogTopic      uint64 = 1
+	GasLogWord       uint64 = 1
 
 	GasBaseOp  uint64 = 0 // TODO: make this 1
 	GasStackOp uint64 = 1
 
-	GasEcRecover     uint64 = 1
+	GasEcRecover     uint64 = 3000
 	GasSha256Word    uint64 = 1
 	GasSha256Base    uint64 = 1
 	GasRipemd160Word uint64 = 1
 	GasRipemd160Base uint64 = 1
 	GasIdentityWord  uint64 = 1
 	GasIdentityBase  uint64 = 1
+
+	// Precompiles priced as on Ethereum (EIP-1108, EIP-152, EIP-2565)
+	GasBn256Add            uint64 = 150
+	GasBn256ScalarMul      uint64 = 6000
+	GasBn256PairingBase    uint64 = 45000
+	GasBn256PairingPerPair uint64 = 34000
+	GasBlake2FRound        uint64 = 1
+	GasModExpMin                  = 200
+	GasModExpDivisor              = 3
 )
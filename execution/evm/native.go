@@ -16,48 +16,139 @@ package evm
 
 import (
 	"crypto/sha256"
+	goBinary "encoding/binary"
+	"fmt"
+	"math/big"
 
+	"github.com/btcsuite/btcd/btcec"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/blake2b"
+	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
 	"golang.org/x/crypto/ripemd160"
 )
 
-var registeredNativeContracts = make(map[crypto.Address]NativeContract)
+// Natives is a registry of native contracts by address. Calls to an address registered with the Natives used by a VM
+// are dispatched to the native contract rather than to any code held by the account at that address.
+type Natives struct {
+	contracts map[crypto.Address]NativeContract
+	snatives  map[string]*SNativeContractDescription
+}
 
-func IsRegisteredNativeContract(address crypto.Address) bool {
-	_, ok := registeredNativeContracts[address]
-	return ok
+type NativeContract func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error)
+
+// The registry used by a VM unless it is given the UseNatives option
+var defaultNatives = DefaultNatives()
+
+// Before Istanbul the only precompiles are those at the addresses Burrow first gave them, where Solidity never reaches
+// them, so that existing chains replay identically
+var byzantiumPrecompiles = map[crypto.Address]NativeContract{
+	{2}: sha256Func,
+	{3}: ripemd160Func,
+	{4}: identityFunc,
 }
 
-func RegisterNativeContract(address crypto.Address, fn NativeContract) bool {
-	_, exists := registeredNativeContracts[address]
-	if exists {
-		return false
+// From Istanbul the precompiles live at the addresses Ethereum gives them, that is the small integers
+var istanbulPrecompiles = map[crypto.Address]NativeContract{
+	precompileAddress(1): ecrecoverFunc,
+	precompileAddress(2): sha256Func,
+	precompileAddress(3): ripemd160Func,
+	precompileAddress(4): identityFunc,
+	precompileAddress(5): modExpFunc,
+	precompileAddress(6): bn256AddFunc,
+	precompileAddress(7): bn256ScalarMulFunc,
+	precompileAddress(8): bn256PairingFunc,
+	precompileAddress(9): blake2FFunc,
+}
+
+// NewNatives returns an empty registry
+func NewNatives() *Natives {
+	return &Natives{
+		contracts: make(map[crypto.Address]NativeContract),
+		snatives:  make(map[string]*SNativeContractDescription),
 	}
-	registeredNativeContracts[address] = fn
-	return true
 }
 
-func init() {
-	registerNativeContracts()
-	registerSNativeContracts()
+// DefaultNatives returns a new registry holding the precompiles of every EVM version and the built-in SNative contracts
+// to which further native contracts may be added before it is handed to a VM (or an executor via execution.Natives)
+func DefaultNatives() *Natives {
+	natives := NewNatives()
+	for address, contract := range byzantiumPrecompiles {
+		natives.contracts[address] = contract
+	}
+	for address, contract := range istanbulPrecompiles {
+		natives.contracts[address] = contract
+	}
+	for _, contract := range SNativeContracts() {
+		err := natives.RegisterSNative(contract)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return natives
 }
 
-func registerNativeContracts() {
-	// registeredNativeContracts[Int64ToWord256(1)] = ecrecoverFunc
-	registeredNativeContracts[crypto.Address{2}] = sha256Func
-	registeredNativeContracts[crypto.Address{3}] = ripemd160Func
-	registeredNativeContracts[crypto.Address{4}] = identityFunc
+// Register contract at address
+func (ns *Natives) Register(address crypto.Address, contract NativeContract) error {
+	if _, exists := ns.contracts[address]; exists {
+		return fmt.Errorf("a native contract is already registered at address %v", address)
+	}
+	ns.contracts[address] = contract
+	return nil
 }
 
-//-----------------------------------------------------------------------------
+// RegisterSNative registers contract at the address derived from its name, its functions are then dispatched
+// according to their ABI
+func (ns *Natives) RegisterSNative(contract *SNativeContractDescription) error {
+	if _, exists := ns.snatives[contract.Name]; exists {
+		return fmt.Errorf("an SNative contract named %s is already registered", contract.Name)
+	}
+	err := ns.Register(contract.Address(), contract.Dispatch)
+	if err != nil {
+		return fmt.Errorf("could not register SNative contract %s: %v", contract.Name, err)
+	}
+	ns.snatives[contract.Name] = contract
+	return nil
+}
 
-func ExecuteNativeContract(address crypto.Address, st Interface, caller crypto.Address, input []byte, gas *uint64,
+func (ns *Natives) IsRegistered(address crypto.Address) bool {
+	_, ok := ns.contracts[address]
+	return ok
+}
+
+// IsAvailable returns whether a native contract registered at address may be called under version, which for the
+// addresses of the precompiles depends on the version that introduced them
+func (ns *Natives) IsAvailable(address crypto.Address, version Version) bool {
+	if !ns.IsRegistered(address) {
+		return false
+	}
+	if _, ok := byzantiumPrecompiles[address]; ok {
+		return version < Istanbul
+	}
+	if _, ok := istanbulPrecompiles[address]; ok {
+		return version >= Istanbul
+	}
+	return true
+}
+
+// SNativeContracts returns the registered SNative contracts indexed by name
+func (ns *Natives) SNativeContracts() map[string]*SNativeContractDescription {
+	contracts := make(map[string]*SNativeContractDescription, len(ns.snatives))
+	for name, contract := range ns.snatives {
+		contracts[name] = contract
+	}
+	return contracts
+}
+
+func (ns *Natives) Execute(address crypto.Address, st Interface, caller crypto.Address, input []byte, gas *uint64,
 	logger *logging.Logger) ([]byte, errors.CodedError) {
 
-	contract, ok := registeredNativeContracts[address]
+	contract, ok := ns.contracts[address]
 	if !ok {
 		return nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
 			"no native contract registered at address: %v", address)
@@ -69,32 +160,104 @@ func ExecuteNativeContract(address crypto.Address, st Interface, caller crypto.A
 	return output, nil
 }
 
-type NativeContract func(state Interface, caller crypto.Address, input []byte, gas *uint64,
-	logger *logging.Logger) (output []byte, err error)
+func precompileAddress(n byte) crypto.Address {
+	var address crypto.Address
+	address[crypto.AddressLength-1] = n
+	return address
+}
 
-/* Removed due to C dependency
-func ecrecoverFunc(state State, caller crypto.Address, input []byte, gas *int64) (output []byte, err error) {
-	// Deduct gas
-	gasRequired := GasEcRecover
+// The following operate on the default registry
+
+func IsRegisteredNativeContract(address crypto.Address) bool {
+	return defaultNatives.IsRegistered(address)
+}
+
+func IsAvailableNativeContract(address crypto.Address, version Version) bool {
+	return defaultNatives.IsAvailable(address, version)
+}
+
+func RegisterNativeContract(address crypto.Address, fn NativeContract) bool {
+	return defaultNatives.Register(address, fn) == nil
+}
+
+func ExecuteNativeContract(address crypto.Address, st Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) ([]byte, errors.CodedError) {
+	return defaultNatives.Execute(address, st, caller, input, gas, logger)
+}
+
+//-----------------------------------------------------------------------------
+
+func useNativeGas(gas *uint64, gasRequired uint64) error {
 	if *gas < gasRequired {
-		return nil, ErrInsufficientGas
-	} else {
-		*gas -= gasRequired
+		return errors.ErrorCodeInsufficientGas
 	}
-	// Recover
-	hash := input[:32]
-	v := byte(input[32] - 27) // ignore input[33:64], v is small.
-	sig := append(input[64:], v)
+	*gas -= gasRequired
+	return nil
+}
 
-	recovered, err := secp256k1.RecoverPubkey(hash, sig)
+// Return size bytes of input from start padding with zeroes where input is too short
+func paddedInput(input []byte, start, size uint64) []byte {
+	bs := make([]byte, size)
+	if start < uint64(len(input)) {
+		copy(bs, input[start:])
+	}
+	return bs
+}
+
+// Read the big-endian integer in size bytes of input from start padding with zeroes where input is too short, without
+// allocating for the padding
+func paddedInt(input []byte, start, size uint64) *big.Int {
+	length := uint64(len(input))
+	if start >= length {
+		return new(big.Int)
+	}
+	end := start + size
+	if end <= length {
+		return new(big.Int).SetBytes(input[start:end])
+	}
+	i := new(big.Int).SetBytes(input[start:])
+	return i.Lsh(i, uint(8*(end-length)))
+}
+
+// Recover the address that signed a hash, on any failure the output is empty
+func ecrecoverFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	err = useNativeGas(gas, GasEcRecover)
 	if err != nil {
 		return nil, err
-OH NO STOCASTIC CAT CODING!!!!
 	}
-	hashed := sha3.Sha3(recovered[1:])
-	return LeftPadBytes(hashed, 32), nil
+	input = paddedInput(input, 0, 128)
+	hash := input[:32]
+	// v is a word that must be 27 or 28
+	v := input[63]
+	r := new(big.Int).SetBytes(input[64:96])
+	s := new(big.Int).SetBytes(input[96:128])
+	n := btcec.S256().N
+	if !allZero(input[32:63]) || (v != 27 && v != 28) || r.Sign() == 0 || s.Sign() == 0 ||
+		r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return nil, nil
+	}
+	// Compact signatures are the recovery byte followed by r and s
+	sig := make([]byte, 65)
+	sig[0] = v
+	copy(sig[1:], input[64:128])
+	publicKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, hash)
+	if err != nil {
+		logger.TraceMsg("ecrecover could not recover public key", structure.ErrorKey, err)
+		return nil, nil
+	}
+	hashed := sha3.Sha3(publicKey.SerializeUncompressed()[1:])
+	return LeftPadBytes(hashed[len(hashed)-crypto.AddressLength:], 32), nil
+}
+
+func allZero(bs []byte) bool {
+	for _, b := range bs {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
 }
-*/
 
 func sha256Func(state Interface, caller crypto.Address, input []byte, gas *uint64,
 	logger *logging.Logger) (output []byte, err error) {
@@ -140,3 +303,192 @@ func identityFunc(state Interface, caller crypto.Address, input []byte, gas *uin
 	// Return identity
 	return input, nil
 }
+
+// The longest base, exponent or modulus in bytes accepted by modExp. Gas is charged according to the lengths (see
+// modExpGas), but pricing and reading the operands with paddedInt allocate for the lengths before that gas can be
+// charged, so they are bounded here before anything is allocated for them. This is a deliberate deviation from EIP-198,
+// under which Ethereum accepts any lengths that can be paid for, calls with a longer operand fail here.
+const MaxModExpLength = 1024
+
+// Arbitrary precision modular exponentiation as specified by EIP-198 with the pricing of EIP-2565
+func modExpFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	baseLength := paddedInt(input, 0, 32)
+	expLength := paddedInt(input, 32, 32)
+	modLength := paddedInt(input, 64, 32)
+	maxLength := big.NewInt(MaxModExpLength)
+	if baseLength.Cmp(maxLength) > 0 || expLength.Cmp(maxLength) > 0 || modLength.Cmp(maxLength) > 0 {
+		return nil, fmt.Errorf("modExp lengths (base: %v, exponent: %v, modulus: %v) must not exceed %d bytes",
+			baseLength, expLength, modLength, MaxModExpLength)
+	}
+	gasRequired := modExpGas(input, baseLength, expLength, modLength)
+	if !gasRequired.IsUint64() {
+		return nil, errors.ErrorCodeInsufficientGas
+	}
+	err = useNativeGas(gas, gasRequired.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	baseLen, expLen, modLen := baseLength.Uint64(), expLength.Uint64(), modLength.Uint64()
+	if modLen == 0 {
+		return []byte{}, nil
+	}
+	base := paddedInt(input, 96, baseLen)
+	exp := paddedInt(input, 96+baseLen, expLen)
+	mod := paddedInt(input, 96+baseLen+expLen, modLen)
+	output = make([]byte, modLen)
+	if mod.Sign() == 0 {
+		return output, nil
+	}
+	result := new(big.Int).Exp(base, exp, mod).Bytes()
+	copy(output[modLen-uint64(len(result)):], result)
+	return output, nil
+}
+
+func modExpGas(input []byte, baseLength, expLength, modLength *big.Int) *big.Int {
+	// ceil(max(baseLength, modLength)/8)^2
+	words := new(big.Int).Set(baseLength)
+	if modLength.Cmp(words) > 0 {
+		words.Set(modLength)
+	}
+	words.Add(words, big.NewInt(7))
+	words.Rsh(words, 3)
+	complexity := words.Mul(words, words)
+	// The number of squarings is roughly the bit length of the exponent, of which we only read the first 32 bytes
+	iterations := new(big.Int)
+	var expHead *big.Int
+	if expLength.IsUint64() && baseLength.IsUint64() {
+		headLength := expLength.Uint64()
+		if headLength > 32 {
+			headLength = 32
+		}
+		expHead = paddedInt(input, 96+baseLength.Uint64(), headLength)
+	} else {
+		expHead = new(big.Int)
+	}
+	if expLength.Cmp(big.NewInt(32)) > 0 {
+		iterations.Sub(expLength, big.NewInt(32))
+		iterations.Lsh(iterations, 3)
+	}
+	if expHead.Sign() > 0 {
+		iterations.Add(iterations, big.NewInt(int64(expHead.BitLen()-1)))
+	}
+	if iterations.Sign() == 0 {
+		iterations.SetInt64(1)
+	}
+	gasRequired := complexity.Mul(complexity, iterations)
+	gasRequired.Div(gasRequired, big.NewInt(GasModExpDivisor))
+	if gasRequired.Cmp(big.NewInt(GasModExpMin)) < 0 {
+		gasRequired.SetInt64(GasModExpMin)
+	}
+	return gasRequired
+}
+
+// Encoded sizes of points on the alt_bn128 curve and its twist
+const (
+	bn256G1Size = 64
+	bn256G2Size = 128
+)
+
+// Add two points on the alt_bn128 curve as specified by EIP-196
+func bn256AddFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	err = useNativeGas(gas, GasBn256Add)
+	if err != nil {
+		return nil, err
+	}
+	a, b := new(bn256.G1), new(bn256.G1)
+	_, err = a.Unmarshal(paddedInput(input, 0, bn256G1Size))
+	if err != nil {
+		return nil, err
+	}
+	_, err = b.Unmarshal(paddedInput(input, bn256G1Size, bn256G1Size))
+	if err != nil {
+		return nil, err
+	}
+	return new(bn256.G1).Add(a, b).Marshal(), nil
+}
+
+// Multiply a point on the alt_bn128 curve by a scalar as specified by EIP-196
+func bn256ScalarMulFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	err = useNativeGas(gas, GasBn256ScalarMul)
+	if err != nil {
+		return nil, err
+	}
+	a := new(bn256.G1)
+	_, err = a.Unmarshal(paddedInput(input, 0, bn256G1Size))
+	if err != nil {
+		return nil, err
+	}
+	k := new(big.Int).SetBytes(paddedInput(input, bn256G1Size, 32))
+	return new(bn256.G1).ScalarMult(a, k).Marshal(), nil
+}
+
+// Check the product of pairings of pairs of points on the alt_bn128 curve and its twist is one as specified by EIP-197
+func bn256PairingFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	const pairSize = bn256G1Size + bn256G2Size
+	if len(input)%pairSize != 0 {
+		return nil, fmt.Errorf("bn256 pairing input must be a multiple of %d bytes but is %d bytes",
+			pairSize, len(input))
+	}
+	pairs := uint64(len(input) / pairSize)
+	err = useNativeGas(gas, GasBn256PairingBase+pairs*GasBn256PairingPerPair)
+	if err != nil {
+		return nil, err
+	}
+	g1s := make([]*bn256.G1, pairs)
+	g2s := make([]*bn256.G2, pairs)
+	for i := range g1s {
+		pair := input[i*pairSize : (i+1)*pairSize]
+		g1s[i], g2s[i] = new(bn256.G1), new(bn256.G2)
+		_, err = g1s[i].Unmarshal(pair[:bn256G1Size])
+		if err != nil {
+			return nil, err
+		}
+		_, err = g2s[i].Unmarshal(pair[bn256G1Size:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if bn256.PairingCheck(g1s, g2s) {
+		return One256.Bytes(), nil
+	}
+	return Zero256.Bytes(), nil
+}
+
+const blake2FInputLength = 213
+
+// Run the BLAKE2b compression function F as specified by EIP-152
+func blake2FFunc(state Interface, caller crypto.Address, input []byte, gas *uint64,
+	logger *logging.Logger) (output []byte, err error) {
+	if len(input) != blake2FInputLength {
+		return nil, fmt.Errorf("blake2f input must be %d bytes but is %d bytes", blake2FInputLength, len(input))
+	}
+	if input[212] > 1 {
+		return nil, fmt.Errorf("blake2f final block indicator must be 0 or 1 but is %d", input[212])
+	}
+	rounds := goBinary.BigEndian.Uint32(input[0:4])
+	err = useNativeGas(gas, uint64(rounds)*GasBlake2FRound)
+	if err != nil {
+		return nil, err
+	}
+	var h [8]uint64
+	var m [16]uint64
+	var t [2]uint64
+	for i := range h {
+		h[i] = goBinary.LittleEndian.Uint64(input[4+i*8:])
+	}
+	for i := range m {
+		m[i] = goBinary.LittleEndian.Uint64(input[68+i*8:])
+	}
+	t[0] = goBinary.LittleEndian.Uint64(input[196:])
+	t[1] = goBinary.LittleEndian.Uint64(input[204:])
+	blake2b.F(&h, m, t, input[212] == 1, rounds)
+	output = make([]byte, 64)
+	for i := range h {
+		goBinary.LittleEndian.PutUint64(output[i*8:], h[i])
+	}
+	return output, nil
+}
@@ -0,0 +1,349 @@
+package evm
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callPrecompile(t *testing.T, n byte, input string) ([]byte, uint64, error) {
+	bs, err := hex.DecodeString(strings.Replace(input, " ", "", -1))
+	require.NoError(t, err)
+	gas := uint64(1000000)
+	output, err := defaultNatives.contracts[precompileAddress(n)](nil, crypto.ZeroAddress, bs, &gas,
+		logging.NewNoopLogger())
+	return output, 1000000 - gas, err
+}
+
+func TestEcrecover(t *testing.T) {
+	output, gasUsed, err := callPrecompile(t, 1,
+		"38d18acb67d25c8bb9942764b62f18e17054f66a817bd4295423adf9ed98873e"+
+			"000000000000000000000000000000000000000000000000000000000000001b"+
+			"38d18acb67d25c8bb9942764b62f18e17054f66a817bd4295423adf9ed98873e"+
+			"789d1dd423d25f0772d2748d60f7e4b81bb14d086eba8e8e8efb6dcff8a4ae02")
+	require.NoError(t, err)
+	assert.Equal(t, GasEcRecover, gasUsed)
+	assert.Equal(t, "000000000000000000000000ceaccac640adf55b2028469bd36ba501f28b699d", hex.EncodeToString(output))
+
+	// Round trip a signature of our own
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	hash := sha3.Sha3([]byte("meta-transaction"))
+	sig, err := btcec.SignCompact(btcec.S256(), key, hash, false)
+	require.NoError(t, err)
+	input := hex.EncodeToString(hash) + strings.Repeat("00", 31) + hex.EncodeToString(sig)
+	output, _, err = callPrecompile(t, 1, input)
+	require.NoError(t, err)
+	expected := sha3.Sha3(key.PubKey().SerializeUncompressed()[1:])[12:]
+	assert.Equal(t, expected, output[12:])
+
+	// Invalid v gives no output
+	output, _, err = callPrecompile(t, 1, hex.EncodeToString(hash)+strings.Repeat("00", 31)+"1d"+
+		hex.EncodeToString(sig[1:]))
+	require.NoError(t, err)
+	assert.Len(t, output, 0)
+}
+
+func TestModExp(t *testing.T) {
+	// Fermat's little theorem for the secp256k1 field prime as in EIP-198
+	output, gasUsed, err := callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000020"+
+			"0000000000000000000000000000000000000000000000000000000000000020"+
+			"03"+
+			"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e"+
+			"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	require.NoError(t, err)
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000001", hex.EncodeToString(output))
+	// ceil(32/8)^2 * (256 - 1) / 3
+	assert.Equal(t, uint64(1360), gasUsed)
+
+	// Zero modulus
+	output, _, err = callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000002"+
+			"03"+"05")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 0}, output)
+
+	// Absurd lengths cannot be paid for
+	_, _, err = callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"+
+			"0000000000000000000000000000000000000000000000000000000000000001")
+	assert.Error(t, err)
+
+	// Lengths over the bound are rejected before anything is allocated however much gas is available
+	gas := uint64(1) << 40
+	_, err = modExpFunc(nil, crypto.ZeroAddress, append(binary.LeftPadBytes([]byte{1}, 32),
+		append(binary.LeftPadBytes([]byte{2, 0, 0, 0, 0}, 32), binary.LeftPadBytes([]byte{1}, 32)...)...), &gas,
+		logging.NewNoopLogger())
+	assert.Error(t, err)
+	assert.Equal(t, uint64(1)<<40, gas)
+	_, _, err = callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000401")
+	assert.Error(t, err)
+	// But lengths at the bound are accepted
+	output, gasUsed, err = callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000400"+
+			"03"+"02"+"01")
+	require.NoError(t, err)
+	// ceil(1024/8)^2 * (2 - 1) / 3
+	assert.Equal(t, uint64(5461), gasUsed)
+	// 3^2 mod 2^8184
+	require.Len(t, output, MaxModExpLength)
+	assert.Equal(t, append(make([]byte, MaxModExpLength-1), 9), output)
+
+	// Input shorter than the lengths is padded with zeroes
+	output, _, err = callPrecompile(t, 5,
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000001"+
+			"0000000000000000000000000000000000000000000000000000000000000002"+
+			"03"+"02"+"01")
+	require.NoError(t, err)
+	// 3^2 mod 0x0100
+	assert.Equal(t, []byte{0, 9}, output)
+}
+
+const (
+	g1Generator = "0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002"
+	// -G1 = (1, p - 2)
+	g1GeneratorNeg = "0000000000000000000000000000000000000000000000000000000000000001" +
+		"30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd45"
+	g2Generator = "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+		"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+		"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" +
+		"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+)
+
+func TestBn256(t *testing.T) {
+	output, gasUsed, err := callPrecompile(t, 6,
+		"18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9"+
+			"063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266"+
+			"07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed"+
+			"06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7")
+	require.NoError(t, err)
+	assert.Equal(t, GasBn256Add, gasUsed)
+	assert.Equal(t, "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703"+
+		"301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915", hex.EncodeToString(output))
+
+	double, _, err := callPrecompile(t, 6, g1Generator+g1Generator)
+	require.NoError(t, err)
+	output, gasUsed, err = callPrecompile(t, 7, g1Generator+
+		"0000000000000000000000000000000000000000000000000000000000000002")
+	require.NoError(t, err)
+	assert.Equal(t, GasBn256ScalarMul, gasUsed)
+	assert.Equal(t, double, output)
+
+	// Points not on the curve are rejected
+	_, _, err = callPrecompile(t, 6, g1Generator+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000003")
+	assert.Error(t, err)
+
+	output, gasUsed, err = callPrecompile(t, 8, g1Generator+g2Generator+g1GeneratorNeg+g2Generator)
+	require.NoError(t, err)
+	assert.Equal(t, GasBn256PairingBase+2*GasBn256PairingPerPair, gasUsed)
+	assert.Equal(t, binary.One256.Bytes(), output)
+
+	output, _, err = callPrecompile(t, 8, g1Generator+g2Generator)
+	require.NoError(t, err)
+	assert.Equal(t, binary.Zero256.Bytes(), output)
+
+	output, _, err = callPrecompile(t, 8, "")
+	require.NoError(t, err)
+	assert.Equal(t, binary.One256.Bytes(), output)
+
+	_, _, err = callPrecompile(t, 8, g1Generator)
+	assert.Error(t, err)
+}
+
+// Vectors from the Ethereum precompile test suite named as there, along with a few of our own
+var bn256Vectors = []struct {
+	name     string
+	n        byte
+	input    string
+	expected string
+}{
+	{
+		name: "bn256Add chfast1",
+		n:    6,
+		input: "18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9" +
+			"063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266" +
+			"07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed" +
+			"06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7",
+		expected: "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703" +
+			"301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915",
+	},
+	{
+		name: "bn256Add chfast2",
+		n:    6,
+		input: "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703" +
+			"301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915" +
+			"18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9" +
+			"063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266",
+		expected: "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb7" +
+			"21611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204",
+	},
+	{
+		name:     "bn256Add empty input",
+		n:        6,
+		input:    "",
+		expected: strings.Repeat("00", 64),
+	},
+	{
+		name:  "bn256Add generator doubled",
+		n:     6,
+		input: g1Generator + g1Generator,
+		expected: "030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" +
+			"15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4",
+	},
+	{
+		name: "bn256ScalarMul chfast1",
+		n:    7,
+		input: "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb7" +
+			"21611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204" +
+			"00000000000000000000000000000000000000000000000011138ce750fa15c2",
+		expected: "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c" +
+			"031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc",
+	},
+	{
+		name: "bn256ScalarMul chfast2",
+		n:    7,
+		input: "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c" +
+			"031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc" +
+			"30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd46",
+		expected: "025a6f4181d2b4ea8b724290ffb40156eb0adb514c688556eb79cdea0752c2bb" +
+			"2eff3f31dea215f1eb86023a133a996eb6300b44da664d64251d05381bb8a02e",
+	},
+	{
+		name: "bn256ScalarMul chfast3",
+		n:    7,
+		input: "025a6f4181d2b4ea8b724290ffb40156eb0adb514c688556eb79cdea0752c2bb" +
+			"2eff3f31dea215f1eb86023a133a996eb6300b44da664d64251d05381bb8a02e" +
+			"183227397098d014dc2822db40c0ac2ecbc0b548b438e5469e10460b6c3e7ea3",
+		expected: "14789d0d4a730b354403b5fac948113739e276c23e0258d8596ee72f9cd9d323" +
+			"0af18a63153e0ec25ff9f2951dd3fa90ed0197bfef6e2a1a62b5095b9d2b4a27",
+	},
+	{
+		name: "bn256ScalarMul cdetrio1",
+		n:    7,
+		input: "1a87b0584ce92f4593d161480614f2989035225609f08058ccfa3d0f940febe3" +
+			"1a2f3c951f6dadcc7ee9007dff81504b0fcd6d7cf59996efdc33d92bf7f9f8f6" +
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		expected: "2cde5879ba6f13c0b5aa4ef627f159a3347df9722efce88a9afbb20b763b4c41" +
+			"1aa7e43076f6aee272755a7f9b84832e71559ba0d2e0b17d5f9f01755e5b0d11",
+	},
+	{
+		name: "bn256Pairing jeff1",
+		n:    8,
+		input: "1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f59" +
+			"3034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41" +
+			"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf7" +
+			"04bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678" +
+			"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d" +
+			"120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550" +
+			"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c" +
+			"2032c61a830e3c17286de9462bf242fca2883585b93870a73853face6a6bf411" +
+			g2Generator,
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "bn256Pairing jeff2",
+		n:    8,
+		input: "2eca0c7238bf16e83e7a1e6c5d49540685ff51380f309842a98561558019fc02" +
+			"03d3260361bb8451de5ff5ecd17f010ff22f5c31cdf184e9020b06fa5997db84" +
+			"1213d2149b006137fcfb23036606f848d638d576a120ca981b5b1a5f9300b3ee" +
+			"2276cf730cf493cd95d64677bbb75fc42db72513a4c1e387b476d056f80aa75f" +
+			"21ee6226d31426322afcda621464d0611d226783262e21bb3bc86b537e986237" +
+			"096df1f82dff337dd5972e32a8ad43e28a78a96a823ef1cd4debe12b6552ea5f" +
+			"06967a1237ebfeca9aaae0d6d0bab8e28c198c5a339ef8a2407e31cdac516db9" +
+			"22160fa257a5fd5b280642ff47b65eca77e626cb685c84fa6d3b6882a283ddd1" +
+			g2Generator,
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "bn256Pairing jeff3",
+		n:    8,
+		input: "0f25929bcb43d5a57391564615c9e70a992b10eafa4db109709649cf48c50dd2" +
+			"16da2f5cb6be7a0aa72c440c53c9bbdfec6c36c7d515536431b3a865468acbba" +
+			"2e89718ad33c8bed92e210e81d1853435399a271913a6520736a4729cf0d51eb" +
+			"01a9e2ffa2e92599b68e44de5bcf354fa2642bd4f26b259daa6f7ce3ed57aeb3" +
+			"14a9a87b789a58af499b314e13c3d65bede56c07ea2d418d6874857b70763713" +
+			"178fb49a2d6cd347dc58973ff49613a20757d0fcc22079f9abd10c3baee24590" +
+			"1b9e027bd5cfc2cb5db82d4dc9677ac795ec500ecd47deee3b5da006d6d049b8" +
+			"11d7511c78158de484232fc68daf8a45cf217d1c2fae693ff5871e8752d73b21" +
+			g2Generator,
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "bn256Pairing jeff6",
+		n:    8,
+		input: "1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f59" +
+			"3034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41" +
+			"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf7" +
+			"04bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678" +
+			"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d" +
+			"120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550" +
+			"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c" +
+			"103188585e2364128fe25c70558f1560f4f9350baf3959e603cc91486e110936" +
+			g2Generator,
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		name:     "bn256Pairing empty_data",
+		n:        8,
+		input:    "",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name:     "bn256Pairing one_point",
+		n:        8,
+		input:    g1Generator + g2Generator,
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+	},
+}
+
+func TestBn256Vectors(t *testing.T) {
+	for _, vector := range bn256Vectors {
+		output, _, err := callPrecompile(t, vector.n, vector.input)
+		if assert.NoError(t, err, vector.name) {
+			assert.Equal(t, vector.expected, hex.EncodeToString(output), vector.name)
+		}
+	}
+}
+
+func TestBlake2F(t *testing.T) {
+	// EIP-152 test vector 5, which is BLAKE2b-512 of "abc"
+	input := "0000000c" +
+		"48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5" +
+		"d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e1319cde05b" +
+		"6162630000000000000000000000000000000000000000000000000000000000" +
+		strings.Repeat("00", 96) +
+		"0300000000000000" + "0000000000000000" +
+		"01"
+	output, gasUsed, err := callPrecompile(t, 9, input)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), gasUsed)
+	assert.Equal(t, "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d1"+
+		"7d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923", hex.EncodeToString(output))
+
+	_, _, err = callPrecompile(t, 9, input[:len(input)-2])
+	assert.Error(t, err)
+	_, _, err = callPrecompile(t, 9, input[:len(input)-2]+"02")
+	assert.Error(t, err)
+}
@@ -1,6 +1,10 @@
 package evm
 
-import "github.com/hyperledger/burrow/execution/errors"
+import (
+	"context"
+
+	"github.com/hyperledger/burrow/execution/errors"
+)
 
 func MemoryProvider(memoryProvider func(errors.Sink) Memory) func(*VM) {
 	return func(vm *VM) {
@@ -23,3 +27,18 @@ func StackOptions(callStackMaxDepth uint64, dataStackInitialCapacity uint64, dat
 		vm.params.DataStackMaxDepth = dataStackMaxDepth
 	}
 }
+
+// UseNatives has the VM dispatch calls to the native contracts registered in natives rather than the defaults
+func UseNatives(natives *Natives) func(*VM) {
+	return func(vm *VM) {
+		vm.natives = natives
+	}
+}
+
+// Interruptible has the VM abort execution with ErrorCodeExecutionInterrupted once ctx is done, for example because
+// the client that requested a simulated call has gone away or a deadline has passed
+func Interruptible(ctx context.Context) func(*VM) {
+	return func(vm *VM) {
+		vm.ctx = ctx
+	}
+}
@@ -0,0 +1,211 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// EmptyStorageHash is the StorageHash of no storage
+var EmptyStorageHash = sha3.NewKeccak256().Sum(nil)
+
+// StorageRent is the rent due on words of storage at rentPerWord, which saturates at the largest uint64 rather than
+// wrapping around to a rent small enough to pay
+func StorageRent(words, rentPerWord uint64) uint64 {
+	if words != 0 && rentPerWord > math.MaxUint64/words {
+		return math.MaxUint64
+	}
+	return words * rentPerWord
+}
+
+// StorageHash is the hash retained by a dormant account in place of its evicted storage. Starting from EmptyStorageHash
+// it chains the Keccak-256 hash of the hash so far, each key, and its value, where keys are in ascending order and
+// values are non-zero, so that storage can be hashed a page at a time by passing the hash of the preceding pages.
+func StorageHash(hash []byte, keys, values []Word256) []byte {
+	for i, key := range keys {
+		hasher := sha3.NewKeccak256()
+		hasher.Write(hash)
+		hasher.Write(key.Bytes())
+		hasher.Write(values[i].Bytes())
+		hash = hasher.Sum(nil)
+	}
+	return hash
+}
+
+// StorageRentContract returns the SNative contract through which contracts can pay and inspect the storage rent
+// charged on them at rentPerWord per word of storage each rent period
+func StorageRentContract(rentPerWord uint64) *SNativeContractDescription {
+	return NewSNativeContract(`
+		* Interface for paying storage rent and restoring storage evicted from dormant accounts.
+		* @dev When storage rent is enabled each account holding storage is charged rent per word of storage from its
+		* @dev balance. Accounts that cannot pay become dormant and their storage is evicted, it can be restored by
+		* @dev anyone holding a copy once the account can pay rent again.
+		`,
+		"StorageRent",
+		&SNativeFunctionDescription{Comment: `
+			* @notice Transfers funds from the caller to an account to pay its storage rent
+			* @param Account account address
+			* @param Amount amount to transfer
+			* @return result true if the transfer was made
+			`,
+			Name:      "topUp",
+			Arguments: reflect.TypeOf(topUpArgs{}),
+			Returns:   reflect.TypeOf(topUpRets{}),
+			F:         topUp,
+		},
+		&SNativeFunctionDescription{Comment: `
+			* @notice Gets the rent due on an account's storage each rent period
+			* @param Account account address
+			* @return rent the rent due as of the last collection
+			`,
+			Name:      "rentDue",
+			Arguments: reflect.TypeOf(rentDueArgs{}),
+			Returns:   reflect.TypeOf(rentDueRets{}),
+			F: func(st Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+				a interface{}) (interface{}, error) {
+				args := a.(*rentDueArgs)
+				return rentDueRets{Rent: StorageRent(st.GetStorageWords(args.Account), rentPerWord)}, nil
+			},
+		},
+		&SNativeFunctionDescription{Comment: `
+			* @notice Indicates whether an account is dormant having had its storage evicted
+			* @param Account account address
+			* @return result whether account is dormant
+			`,
+			Name:      "isDormant",
+			Arguments: reflect.TypeOf(isDormantArgs{}),
+			Returns:   reflect.TypeOf(isDormantRets{}),
+			F:         isDormant,
+		},
+		&SNativeFunctionDescription{Comment: `
+			* @notice Restores the evicted storage of a dormant account charging it the rent due on the storage
+			* @param Account account address
+			* @param Keys storage keys in ascending order
+			* @param Values non-zero storage values corresponding to keys
+			* @return result true if storage was restored
+			`,
+			Name:      "restore",
+			Arguments: reflect.TypeOf(restoreArgs{}),
+			Returns:   reflect.TypeOf(restoreRets{}),
+			F: func(st Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+				a interface{}) (interface{}, error) {
+				return restore(st, rentPerWord, gas, logger, a.(*restoreArgs))
+			},
+		},
+	)
+}
+
+type topUpArgs struct {
+	Account crypto.Address
+	Amount  uint64
+}
+
+type topUpRets struct {
+	Result bool
+}
+
+func topUp(st Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*topUpArgs)
+
+	if !st.Exists(args.Account) {
+		return false, fmt.Errorf("unknown account %s", args.Account)
+	}
+	if st.GetBalance(caller) < args.Amount {
+		return false, fmt.Errorf("caller %v has insufficient balance to top up %v by %d", caller, args.Account,
+			args.Amount)
+	}
+	st.SubtractFromBalance(caller, args.Amount)
+	st.AddToBalance(args.Account, args.Amount)
+	logger.Trace.Log("function", "topUp", "address", args.Account.String(),
+		"amount", args.Amount)
+	return topUpRets{Result: true}, nil
+}
+
+type rentDueArgs struct {
+	Account crypto.Address
+}
+
+type rentDueRets struct {
+	Rent uint64
+}
+
+type isDormantArgs struct {
+	Account crypto.Address
+}
+
+type isDormantRets struct {
+	Result bool
+}
+
+func isDormant(st Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+	a interface{}) (interface{}, error) {
+	args := a.(*isDormantArgs)
+	return isDormantRets{Result: len(st.GetEvictedStorageHash(args.Account)) > 0}, nil
+}
+
+type restoreArgs struct {
+	Account crypto.Address
+	Keys    []Word256
+	Values  []Word256
+}
+
+type restoreRets struct {
+	Result bool
+}
+
+func restore(st Interface, rentPerWord uint64, gas *uint64, logger *logging.Logger,
+	args *restoreArgs) (interface{}, error) {
+
+	evictedStorageHash := st.GetEvictedStorageHash(args.Account)
+	if len(evictedStorageHash) == 0 {
+		return false, fmt.Errorf("account %v is not dormant", args.Account)
+	}
+	if len(args.Keys) != len(args.Values) {
+		return false, fmt.Errorf("restore passed %d keys but %d values", len(args.Keys), len(args.Values))
+	}
+	// Charge for the storage before checking and hashing it
+	words := uint64(len(args.Keys))
+	if words > math.MaxUint64/GasStorageUpdate {
+		return false, errors.ErrorCodeInsufficientGas
+	}
+	err := useNativeGas(gas, words*GasStorageUpdate)
+	if err != nil {
+		return false, err
+	}
+	for i, key := range args.Keys {
+		if i > 0 && args.Keys[i-1].Compare(key) >= 0 {
+			return false, fmt.Errorf("restore keys must be in ascending order")
+		}
+		if args.Values[i] == Zero256 {
+			return false, fmt.Errorf("restore passed zero value for key %v", key)
+		}
+	}
+	if st.GetStorageWords(args.Account) > 0 {
+		return false, fmt.Errorf("the storage of account %v is still being evicted", args.Account)
+	}
+	if !bytes.Equal(StorageHash(EmptyStorageHash, args.Keys, args.Values), evictedStorageHash) {
+		return false, fmt.Errorf("restore passed storage that does not match the evicted storage of %v",
+			args.Account)
+	}
+	rent := StorageRent(words, rentPerWord)
+	if st.GetBalance(args.Account) < rent {
+		return false, fmt.Errorf("account %v must have a balance of at least %d to pay the rent on its storage",
+			args.Account, rent)
+	}
+	st.SubtractFromBalance(args.Account, rent)
+	for i, key := range args.Keys {
+		st.SetStorage(args.Account, key, args.Values[i])
+	}
+	st.SetEvictedStorageHash(args.Account, nil)
+	logger.Trace.Log("function", "restore", "address", args.Account.String(),
+		"words", words)
+	return restoreRets{Result: true}, nil
+}
@@ -0,0 +1,121 @@
+package evm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageRent(t *testing.T) {
+	assert.Equal(t, uint64(0), StorageRent(0, math.MaxUint64))
+	assert.Equal(t, uint64(6), StorageRent(3, 2))
+	assert.Equal(t, uint64(math.MaxUint64), StorageRent(1, math.MaxUint64))
+	// Would wrap around to 2
+	assert.Equal(t, uint64(math.MaxUint64), StorageRent(3, math.MaxUint64/3+1))
+	assert.Equal(t, uint64(math.MaxUint64), StorageRent(math.MaxUint64, math.MaxUint64))
+}
+
+func TestStorageRentContract(t *testing.T) {
+	contract := StorageRentContract(3)
+	st := newAppState()
+	caller := &acm.Account{Address: crypto.Address{1, 1, 1}, Balance: 1000}
+	require.NoError(t, st.UpdateAccount(caller))
+	keys := []Word256{Int64ToWord256(1), Int64ToWord256(2)}
+	values := []Word256{Int64ToWord256(10), Int64ToWord256(20)}
+	dormant := &acm.Account{
+		Address:            crypto.Address{2, 2, 2},
+		Code:               MustSplice(PUSH1, 1, SLOAD, return1()),
+		EvictedStorageHash: StorageHash(EmptyStorageHash, keys, values),
+	}
+	require.NoError(t, st.UpdateAccount(dormant))
+	// Dormant but with storage still to be evicted
+	evicting := &acm.Account{
+		Address:            crypto.Address{3, 3, 3},
+		Balance:            100,
+		StorageWords:       2,
+		EvictedStorageHash: StorageHash(EmptyStorageHash, keys[:1], values[:1]),
+	}
+	require.NoError(t, st.UpdateAccount(evicting))
+	cache := NewState(st, blockHashGetter)
+
+	dispatch := func(name string, args ...interface{}) ([]byte, error) {
+		function, err := contract.FunctionByName(name)
+		require.NoError(t, err)
+		input, err := abi.Pack(function.Abi.Inputs, args...)
+		require.NoError(t, err)
+		gas := uint64(1000)
+		return contract.Dispatch(cache, caller.Address, append(function.Abi.FunctionID[:], input...), &gas, logger)
+	}
+
+	ret, err := dispatch("isDormant", dormant.Address)
+	require.NoError(t, err)
+	assert.Equal(t, LeftPadBytes([]byte{1}, 32), ret)
+
+	ret, err = dispatch("rentDue", evicting.Address)
+	require.NoError(t, err)
+	assert.Equal(t, Uint64ToWord256(6).Bytes(), ret)
+
+	// Cannot restore until eviction is complete
+	_, err = dispatch("restore", evicting.Address, keys, values)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "still being evicted")
+
+	// The code of a dormant account cannot be run
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+	gas := uint64(1000)
+	_, err = ourVm.Call(cache.NewCache(), NewNoopEventSink(), caller.Address, dormant.Address, dormant.Code, nil, 0,
+		&gas)
+	assertErrorCode(t, errors.ErrorCodeDormantAccount, err)
+
+	// Cannot restore until the account can pay its rent
+	_, err = dispatch("restore", dormant.Address, keys, values)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "balance of at least 6")
+
+	_, err = dispatch("topUp", dormant.Address, uint64(6))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), cache.GetBalance(dormant.Address))
+	assert.Equal(t, uint64(994), cache.GetBalance(caller.Address))
+
+	// Must provide the evicted storage
+	_, err = dispatch("restore", dormant.Address, keys, []Word256{Int64ToWord256(10), Int64ToWord256(21)})
+	require.Error(t, err)
+	_, err = dispatch("restore", dormant.Address, []Word256{keys[1], keys[0]}, []Word256{values[1], values[0]})
+	require.Error(t, err)
+
+	// Storage is paid for before it is checked
+	function, err := contract.FunctionByName("restore")
+	require.NoError(t, err)
+	input, err := abi.Pack(function.Abi.Inputs, dormant.Address, []Word256{keys[1], keys[0]},
+		[]Word256{values[1], values[0]})
+	require.NoError(t, err)
+	gas = function.Gas + GasStorageUpdate
+	_, err = contract.Dispatch(cache, caller.Address, append(function.Abi.FunctionID[:], input...), &gas, logger)
+	assertErrorCode(t, errors.ErrorCodeInsufficientGas, err)
+
+	ret, err = dispatch("restore", dormant.Address, keys, values)
+	require.NoError(t, err)
+	assert.Equal(t, LeftPadBytes([]byte{1}, 32), ret)
+	assert.Equal(t, values[0], cache.GetStorage(dormant.Address, keys[0]))
+	assert.Len(t, cache.GetEvictedStorageHash(dormant.Address), 0)
+	// Having paid the rent due
+	assert.Equal(t, uint64(0), cache.GetBalance(dormant.Address))
+
+	gas = 1000
+	output, err := ourVm.Call(cache.NewCache(), NewNoopEventSink(), caller.Address, dormant.Address, dormant.Code, nil,
+		0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, values[0].Bytes(), output)
+
+	_, err = dispatch("restore", dormant.Address, keys, values)
+	require.Error(t, err, "account is no longer dormant")
+}
@@ -61,22 +61,15 @@ type SNativeFunctionDescription struct {
 	Returns reflect.Type
 	// The abi
 	Abi abi.FunctionSpec
-	// Permissions required to call function
+	// Permissions required to call function, if zero any caller may call it
 	PermFlag permission.PermFlag
+	// Gas charged for each call to the function in addition to any used by F
+	Gas uint64
 	// Native function to which calls will be dispatched when a containing
 	F func(stateWriter Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
 		v interface{}) (interface{}, error)
 }
 
-func registerSNativeContracts() {
-	for _, contract := range SNativeContracts() {
-		if !RegisterNativeContract(contract.Address(), contract.Dispatch) {
-			panic(fmt.Errorf("could not register SNative contract %s because address %s already registered",
-				contract.Address(), contract.Name))
-		}
-	}
-}
-
 // Returns a map of all SNative contracts defined indexed by name
 func SNativeContracts() map[string]*SNativeContractDescription {
 	contracts := []*SNativeContractDescription{
@@ -210,7 +203,7 @@ func NewSNativeContract(comment, name string,
 }
 
 // This function is designed to be called from the EVM once a SNative contract
-// has been selected. It is also placed in a registry by registerSNativeContracts
+// has been selected. It is also placed in a registry by Natives.RegisterSNative
 // So it can be looked up by SNative address
 func (contract *SNativeContractDescription) Dispatch(st Interface, caller crypto.Address,
 	args []byte, gas *uint64, logger *logging.Logger) (output []byte, err error) {
@@ -237,10 +230,15 @@ func (contract *SNativeContractDescription) Dispatch(st Interface, caller crypto
 	remainingArgs := args[abi.FunctionIDSize:]
 
 	// check if we have permission to call this function
-	if !HasPermission(st, caller, function.PermFlag) {
+	if function.PermFlag != 0 && !HasPermission(st, caller, function.PermFlag) {
 		return nil, errors.LacksSNativePermission{Address: caller, SNative: function.Name}
 	}
 
+	err = useNativeGas(gas, function.Gas)
+	if err != nil {
+		return nil, err
+	}
+
 	nativeArgs := reflect.New(function.Arguments).Interface()
 	err = abi.UnpackIntoStruct(function.Abi.Inputs, remainingArgs, nativeArgs)
 	if err != nil {
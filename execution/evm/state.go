@@ -31,6 +31,9 @@ type Reader interface {
 	GetCode(address crypto.Address) acm.Bytecode
 	GetSequence(address crypto.Address) uint64
 	Exists(address crypto.Address) bool
+	// Storage rent accounting, see acm.Account
+	GetStorageWords(address crypto.Address) uint64
+	GetEvictedStorageHash(address crypto.Address) []byte
 	// GetBlockHash returns	hash of the specific block
 	GetBlockHash(blockNumber uint64) (binary.Word256, error)
 }
@@ -46,6 +49,7 @@ type Writer interface {
 	UnsetPermission(address crypto.Address, permFlag permission.PermFlag)
 	AddRole(address crypto.Address, role string) bool
 	RemoveRole(address crypto.Address, role string) bool
+	SetEvictedStorageHash(address crypto.Address, evictedStorageHash []byte)
 }
 
 type State struct {
@@ -159,6 +163,22 @@ func (st *State) GetSequence(address crypto.Address) uint64 {
 	return acc.Sequence
 }
 
+func (st *State) GetStorageWords(address crypto.Address) uint64 {
+	acc := st.account(address)
+	if acc == nil {
+		return 0
+	}
+	return acc.StorageWords
+}
+
+func (st *State) GetEvictedStorageHash(address crypto.Address) []byte {
+	acc := st.account(address)
+	if acc == nil {
+		return nil
+	}
+	return acc.EvictedStorageHash
+}
+
 // Writer
 
 func (st *State) CreateAccount(address crypto.Address) {
@@ -269,6 +289,15 @@ func (st *State) RemoveRole(address crypto.Address, role string) bool {
 	return removed
 }
 
+func (st *State) SetEvictedStorageHash(address crypto.Address, evictedStorageHash []byte) {
+	acc := st.mustAccount(address)
+	if acc == nil {
+		return
+	}
+	acc.EvictedStorageHash = evictedStorageHash
+	st.updateAccount(acc)
+}
+
 func (st *State) GetBlockHash(height uint64) (binary.Word256, error) {
 	hash := st.blockHashGetter(height)
 	if len(hash) == 0 {
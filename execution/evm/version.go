@@ -0,0 +1,54 @@
+package evm
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto/sha3"
+)
+
+// Version selects the set of Ethereum hard fork opcodes the VM will execute. Chains opt in to newer opcodes
+// through the EVMVersion genesis parameter so that existing chains replay identically.
+type Version uint8
+
+const (
+	// Byzantium plus SHL, SHR, SAR, CREATE2 and EXTCODEHASH from Constantinople - the default
+	Byzantium Version = iota
+	// Adds CHAINID and SELFBALANCE (EIP-1344, EIP-1884) and moves the precompiles to the addresses Ethereum uses,
+	// adding ecrecover, modexp, bn256 and blake2f
+	Istanbul
+)
+
+const DefaultVersion = Byzantium
+
+var versionNames = map[Version]string{
+	Byzantium: "byzantium",
+	Istanbul:  "istanbul",
+}
+
+func (v Version) String() string {
+	name, ok := versionNames[v]
+	if !ok {
+		return fmt.Sprintf("Version(%d)", v)
+	}
+	return name
+}
+
+// VersionFromString returns the Version named by name, ignoring case. The empty string returns DefaultVersion.
+func VersionFromString(name string) (Version, error) {
+	if name == "" {
+		return DefaultVersion, nil
+	}
+	for v, n := range versionNames {
+		if strings.EqualFold(n, name) {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown EVM version '%s'", name)
+}
+
+// ChainIDWord deterministically maps a Burrow ChainID string to the word returned by the CHAINID opcode
+func ChainIDWord(chainID string) Word256 {
+	return LeftPadWord256(sha3.Sha3([]byte(chainID)))
+}
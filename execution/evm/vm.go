@@ -16,6 +16,7 @@ package evm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -31,8 +32,10 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/wasm"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/tracing"
 	"github.com/hyperledger/burrow/txs"
 )
 
@@ -40,6 +43,8 @@ const (
 	DataStackInitialCapacity    = 1024
 	MaximumAllowedBlockLookBack = 256
 	uint64Length                = 8
+	// Number of instructions executed between checks of whether an Interruptible VM's context is done
+	InterruptCheckInterval = 1024
 )
 
 type Params struct {
@@ -49,6 +54,10 @@ type Params struct {
 	CallStackMaxDepth        uint64
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
+	ChainID                  string
+	EVMVersion               Version
+	// Whether code starting with the WASM magic is run as a WASM module rather than as EVM bytecode
+	WASM bool
 }
 
 type VM struct {
@@ -61,6 +70,12 @@ type VM struct {
 	debugOpcodes   bool
 	dumpTokens     bool
 	sequence       uint64
+	natives        *Natives
+	ctx            context.Context
+	// Instructions executed across all frames, used to rate limit interrupt checks
+	steps uint64
+	// Pages of memory that may still be allocated by WASM instances in this VM
+	wasmPages uint64
 }
 
 func NewVM(params Params, origin crypto.Address, tx *txs.Tx, logger *logging.Logger, options ...func(*VM)) *VM {
@@ -71,6 +86,8 @@ func NewVM(params Params, origin crypto.Address, tx *txs.Tx, logger *logging.Log
 		stackDepth:     0,
 		tx:             tx,
 		logger:         logger.WithScope("NewVM"),
+		natives:        defaultNatives,
+		wasmPages:      WASMMaxMemoryPages,
 	}
 	for _, option := range options {
 		option(vm)
@@ -86,6 +103,44 @@ func (vm *VM) Debugf(format string, a ...interface{}) {
 	}
 }
 
+// interrupted checks, every InterruptCheckInterval instructions, whether the VM's context is done pushing an error if so
+func (vm *VM) interrupted(errSink errors.Sink) bool {
+	err := vm.interruption()
+	if err != nil {
+		errSink.PushError(err)
+		return true
+	}
+	return false
+}
+
+// interruption counts an instruction returning an error, every InterruptCheckInterval instructions, if the VM's context
+// is done. Both EVM and WASM instructions are counted.
+func (vm *VM) interruption() errors.CodedError {
+	if vm.ctx == nil {
+		return nil
+	}
+	vm.steps++
+	if vm.steps%InterruptCheckInterval != 0 {
+		return nil
+	}
+	if err := vm.ctx.Err(); err != nil {
+		vm.Debugf(" => execution interrupted after %d instructions: %v\n", vm.steps, err)
+		return errors.ErrorCodef(errors.ErrorCodeExecutionInterrupted, "%v", err)
+	}
+	return nil
+}
+
+// requireVersion checks that op is available under the EVMVersion in effect, pushing an error if not
+func (vm *VM) requireVersion(version Version, op OpCode, errSink errors.Sink) bool {
+	if vm.params.EVMVersion < version {
+		vm.Debugf(" => opcode %v requires EVM version %v but chain is running %v\n", op, version,
+			vm.params.EVMVersion)
+		errSink.PushError(errors.Errorf("unknown opcode %v", op))
+		return false
+	}
+	return true
+}
+
 // CONTRACT: it is the duty of the contract writer to call known permissions
 // we do not convey if a permission is not set
 // (unlike in state/execution, where we guarantee HasPermission is called
@@ -167,7 +222,7 @@ func (vm *VM) call(callState Interface, eventSink EventSink, caller, callee cryp
 
 	if len(code) > 0 {
 		vm.stackDepth += 1
-		output = vm.execute(callState, eventSink, caller, callee, code, input, value, gas)
+		output = vm.executeCode(callState, eventSink, caller, callee, code, input, value, gas)
 		vm.stackDepth -= 1
 		if err != nil {
 			callState.PushError(err)
@@ -203,12 +258,39 @@ func (vm *VM) delegateCall(callState Interface, eventSink EventSink, caller, cal
 
 	if len(code) > 0 {
 		vm.stackDepth += 1
-		output = vm.execute(callState, eventSink, caller, callee, code, input, value, gas)
+		output = vm.executeCode(callState, eventSink, caller, callee, code, input, value, gas)
 		vm.stackDepth -= 1
 	}
 	return
 }
 
+// Executes code as WASM or EVM bytecode as appropriate
+func (vm *VM) executeCode(callState Interface, eventSink EventSink, caller, callee crypto.Address,
+	code, input []byte, value uint64, gas *uint64) []byte {
+	if tracing.Enabled() {
+		_, span := tracing.StartTx(context.Background(), vm.tx.Hash(), "evm.Call")
+		span.SetAttribute("caller", caller.String())
+		span.SetAttribute("callee", callee.String())
+		span.SetAttribute("stack_depth", vm.stackDepth)
+		gasBefore := *gas
+		defer func() {
+			span.SetAttribute("gas_used", gasBefore-*gas)
+			span.SetError(callState.Error())
+			span.End()
+		}()
+	}
+	// The code of a dormant account would see its storage as empty
+	if len(callState.GetEvictedStorageHash(callee)) > 0 {
+		callState.PushError(errors.ErrorCodef(errors.ErrorCodeDormantAccount,
+			"cannot execute code in the context of dormant account %v", callee))
+		return nil
+	}
+	if vm.params.WASM && wasm.IsWASM(code) {
+		return vm.executeWASM(callState, eventSink, caller, callee, code, input, value, gas)
+	}
+	return vm.execute(callState, eventSink, caller, callee, code, input, value, gas)
+}
+
 // Try to deduct gasToUse from gasLeft.  If ok return false, otherwise
 // set err and return true.
 func useGasNegative(gasLeft *uint64, gasToUse uint64, err errors.Sink) {
@@ -241,6 +323,9 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 		if callState.Error() != nil {
 			return
 		}
+		if vm.interrupted(callState) {
+			return
+		}
 
 		var op = codeGetOp(code, pc)
 		vm.Debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), *gas)
@@ -571,7 +656,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 				stack.Push64(l)
 				vm.Debugf(" => %d\n", l)
 			} else {
-				if _, ok := registeredNativeContracts[address]; !ok {
+				if !vm.natives.IsAvailable(address, vm.params.EVMVersion) {
 					callState.PushError(errors.ErrorCodeUnknownAddress)
 					continue
 				}
@@ -582,7 +667,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			address := stack.PopAddress()
 			useGasNegative(gas, GasGetAccount, callState)
 			if !callState.Exists(address) {
-				if _, ok := registeredNativeContracts[address]; ok {
+				if vm.natives.IsAvailable(address, vm.params.EVMVersion) {
 					vm.Debugf(" => attempted to copy native contract at %v but this is not supported\n", address)
 					callState.PushError(errors.ErrorCodeNativeContractCodeCopy)
 				}
@@ -674,6 +759,22 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			stack.PushU64(vm.params.GasLimit)
 			vm.Debugf(" => %v\n", vm.params.GasLimit)
 
+		case CHAINID: // 0x46
+			if !vm.requireVersion(Istanbul, op, callState) {
+				return nil
+			}
+			chainID := ChainIDWord(vm.params.ChainID)
+			stack.Push(chainID)
+			vm.Debugf(" => 0x%X (%s)\n", chainID, vm.params.ChainID)
+
+		case SELFBALANCE: // 0x47
+			if !vm.requireVersion(Istanbul, op, callState) {
+				return nil
+			}
+			balance := callState.GetBalance(callee)
+			stack.PushU64(balance)
+			vm.Debugf(" => %v (%X)\n", balance, callee)
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			vm.Debugf(" => 0x%X\n", popped)
@@ -803,7 +904,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 			// Establish a frame in which the putative account exists
 			childCallState := callState.NewCache()
-			create(childCallState, newAccount)
+			vm.create(childCallState, newAccount)
 
 			// Run the input to get the contract code.
 			// NOTE: no need to copy 'input' as per Call contract.
@@ -858,10 +959,10 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			var callErr errors.CodedError
 			// Establish a stack frame and perform the call
 			var childCallState Interface
-			if IsRegisteredNativeContract(address) {
+			if vm.natives.IsAvailable(address, vm.params.EVMVersion) {
 				// Native contract
 				childCallState = callState.NewCache()
-				returnData, callErr = ExecuteNativeContract(address, childCallState, callee, args, &gasLimit, logger)
+				returnData, callErr = vm.natives.Execute(address, childCallState, callee, args, &gasLimit, logger)
 				childCallState.PushError(callErr)
 				// for now we fire the Call event. maybe later we'll fire more particulars
 				// NOTE: these fire call go_events and not particular go_events for eg name reg or permissions
@@ -880,7 +981,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 						continue
 					}
 					// We're sending funds to a new account so we must create it first
-					createAccount(callState, callee, address)
+					vm.createAccount(callState, callee, address)
 					if callState.Error() != nil {
 						continue
 					}
@@ -963,7 +1064,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			if !callState.Exists(receiver) {
 				// If receiver address doesn't exist, try to create it
 				useGasNegative(gas, GasCreateAccount, callState)
-				createAccount(callState, callee, receiver)
+				vm.createAccount(callState, callee, receiver)
 				if callState.Error() != nil {
 					continue
 				}
@@ -987,13 +1088,13 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 	return
 }
 
-func createAccount(st Interface, creator, address crypto.Address) {
+func (vm *VM) createAccount(st Interface, creator, address crypto.Address) {
 	EnsurePermission(st, creator, permission.CreateAccount)
-	create(st, address)
+	vm.create(st, address)
 }
 
-func create(st Interface, address crypto.Address) {
-	if IsRegisteredNativeContract(address) {
+func (vm *VM) create(st Interface, address crypto.Address) {
+	if vm.natives.IsAvailable(address, vm.params.EVMVersion) {
 		st.PushError(errors.ErrorCodef(errors.ErrorCodeReservedAddress,
 			"cannot create account at %v because that address is reserved for a native contract", address))
 	}
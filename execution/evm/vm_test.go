@@ -15,6 +15,7 @@
 package evm
 
 import (
+	"context"
 	"strconv"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/execution/errors"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
@@ -1478,6 +1480,125 @@ func TestExtCodeHash(t *testing.T) {
 	assert.Equal(t, hex.MustDecodeString("010da270094b5199d3e54f89afe4c66cdd658dd8111a41998714227e14e171bd"), output)
 }
 
+func TestChainID(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	params := newParams()
+	params.ChainID = "BurrowChain_7D3F1A-5EA5C2"
+	params.EVMVersion = Istanbul
+	ourVm := NewVM(params, crypto.ZeroAddress, nil, logger)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+
+	var gas uint64 = 100000
+
+	bytecode := MustSplice(CHAINID, return1())
+	output, err := ourVm.Call(cache, NewNoopEventSink(), account1, account2, bytecode, []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, sha3.Sha3([]byte(params.ChainID)), output)
+	assert.Equal(t, ChainIDWord(params.ChainID).Bytes(), output)
+
+	// Different chains must see different identifiers
+	assert.NotEqual(t, ChainIDWord("BurrowChain_7D3F1A-5EA5C3"), ChainIDWord(params.ChainID))
+}
+
+func TestSelfBalance(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	params := newParams()
+	params.EVMVersion = Istanbul
+	ourVm := NewVM(params, crypto.ZeroAddress, nil, logger)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	cache.AddToBalance(account2, 4321)
+
+	var gas uint64 = 100000
+
+	bytecode := MustSplice(SELFBALANCE, return1())
+	output, err := ourVm.Call(cache, NewNoopEventSink(), account1, account2, bytecode, []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Uint64ToWord256(4321).Bytes(), output)
+}
+
+func TestIstanbulOpcodesRequireVersion(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+
+	var gas uint64 = 100000
+
+	for _, op := range []OpCode{CHAINID, SELFBALANCE} {
+		_, err := ourVm.Call(cache, NewNoopEventSink(), account1, account2, MustSplice(op, return1()), []byte{}, 0, &gas)
+		assert.Error(t, err, "%v should not be available under %v", op, DefaultVersion)
+	}
+}
+
+func TestPrecompilesRequireVersion(t *testing.T) {
+	legacyIdentity := crypto.Address{4}
+	identity := precompileAddress(4)
+	modExp := precompileAddress(5)
+
+	exists := func(version Version, address crypto.Address) bool {
+		cache := NewState(newAppState(), blockHashGetter)
+		account1 := newAccount(cache, "1")
+		account2 := newAccount(cache, "101")
+		params := newParams()
+		params.EVMVersion = version
+		ourVm := NewVM(params, crypto.ZeroAddress, nil, logger)
+		var gas uint64 = 100000
+		output, err := ourVm.Call(cache, NewNoopEventSink(), account1, account2,
+			MustSplice(PUSH20, address, EXTCODESIZE, return1()), []byte{}, 0, &gas)
+		return err == nil && assert.Equal(t, One256.Bytes(), output)
+	}
+
+	// Chains that have not opted in keep the precompiles where they were
+	assert.True(t, exists(Byzantium, legacyIdentity))
+	assert.False(t, exists(Byzantium, identity))
+	assert.False(t, exists(Byzantium, modExp))
+
+	assert.False(t, exists(Istanbul, legacyIdentity))
+	assert.True(t, exists(Istanbul, identity))
+	assert.True(t, exists(Istanbul, modExp))
+}
+
+func TestInterruptible(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	// Loop forever
+	bytecode := MustSplice(JUMPDEST, PUSH1, 0, JUMP)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger, Interruptible(ctx))
+	var gas uint64 = 1 << 62
+	_, err := ourVm.Call(cache, NewNoopEventSink(), account1, account2, bytecode, []byte{}, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionInterrupted, err)
+	assert.True(t, gas > 1<<61, "should have been interrupted long before running out of gas")
+
+	// Interrupted by a deadline partway through
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ourVm = NewVM(newParams(), crypto.ZeroAddress, nil, logger, Interruptible(ctx))
+	gas = 1 << 62
+	start := time.Now()
+	_, err = ourVm.Call(cache, NewNoopEventSink(), account1, account2, bytecode, []byte{}, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionInterrupted, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestVersionFromString(t *testing.T) {
+	version, err := VersionFromString("")
+	require.NoError(t, err)
+	assert.Equal(t, Byzantium, version)
+
+	version, err = VersionFromString("Istanbul")
+	require.NoError(t, err)
+	assert.Equal(t, Istanbul, version)
+
+	_, err = VersionFromString("frontier")
+	assert.Error(t, err)
+}
+
 func BasePermissionsFromStrings(t *testing.T, perms, setBit string) permission.BasePermissions {
 	return permission.BasePermissions{
 		Perms:  PermFlagFromString(t, perms),
@@ -0,0 +1,395 @@
+package evm
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/wasm"
+	"github.com/hyperledger/burrow/permission"
+)
+
+// WASM contracts import their host functions from this module. The functions provided are the subset of the ewasm
+// Ethereum Environment Interface (EEI) that maps onto our state and event model.
+const WASMImportModule = "ethereum"
+
+// WASM contracts must export their entry point under this name and their memory as WASMExportMemory
+const (
+	WASMExportMain   = "main"
+	WASMExportMemory = "memory"
+)
+
+// Results of the call host function
+const (
+	wasmCallSuccess uint64 = 0
+	wasmCallFailure uint64 = 1
+	wasmCallRevert  uint64 = 2
+)
+
+// The pages of memory that all the WASM instances run by a VM may allocate between them (64 MiB), which bounds the
+// memory a transaction can use by nesting calls
+const WASMMaxMemoryPages = 1024
+
+// Sizes of values exchanged through WASM memory, values are little-endian 128-bit integers as per the EEI
+const (
+	wasmValueLength = 16
+)
+
+// Returned by the finish and revert host functions to halt the instance
+var (
+	errWASMFinish = fmt.Errorf("WASM contract finished")
+	errWASMRevert = fmt.Errorf("WASM contract reverted")
+)
+
+// The context of a single WASM contract invocation
+type wasmContext struct {
+	vm         *VM
+	callState  Interface
+	eventSink  EventSink
+	caller     crypto.Address
+	callee     crypto.Address
+	input      []byte
+	value      uint64
+	gas        *uint64
+	returnData []byte
+	output     []byte
+}
+
+type hostFunction struct {
+	wasm.FuncType
+	call func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error)
+}
+
+func hostFunc(params, results []wasm.ValueType,
+	call func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error)) hostFunction {
+	return hostFunction{FuncType: wasm.FuncType{Params: params, Results: results}, call: call}
+}
+
+var (
+	i32  = wasm.I32
+	i64  = wasm.I64
+	none []wasm.ValueType
+)
+
+// The host functions available to WASM contracts by name - initialised in init since call is itself recursive
+var hostFunctions map[string]hostFunction
+
+func init() {
+	hostFunctions = map[string]hostFunction{
+		"useGas": hostFunc([]wasm.ValueType{i64}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, inst.UseGas(args[0])
+		}),
+		"getGasLeft": hostFunc(none, []wasm.ValueType{i64}, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return []uint64{inst.Gas()}, nil
+		}),
+		"getAddress": hostFunc([]wasm.ValueType{i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, inst.Write(uint32(args[0]), ctx.callee.Bytes())
+		}),
+		"getCaller": hostFunc([]wasm.ValueType{i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, inst.Write(uint32(args[0]), ctx.caller.Bytes())
+		}),
+		"getTxOrigin": hostFunc([]wasm.ValueType{i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, inst.Write(uint32(args[0]), ctx.vm.origin.Bytes())
+		}),
+		"getCallValue": hostFunc([]wasm.ValueType{i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, inst.Write(uint32(args[0]), wasmValue(ctx.value))
+		}),
+		"getExternalBalance": hostFunc([]wasm.ValueType{i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			address, err := wasmAddress(inst, uint32(args[0]))
+			if err != nil {
+				return nil, err
+			}
+			err = inst.UseGas(GasGetAccount)
+			if err != nil {
+				return nil, err
+			}
+			balance := ctx.callState.GetBalance(address)
+			if err := ctx.callState.Error(); err != nil {
+				return nil, err
+			}
+			return nil, inst.Write(uint32(args[1]), wasmValue(balance))
+		}),
+		"getBlockNumber": hostFunc(none, []wasm.ValueType{i64}, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return []uint64{ctx.vm.params.BlockHeight}, nil
+		}),
+		"getBlockTimestamp": hostFunc(none, []wasm.ValueType{i64}, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return []uint64{uint64(ctx.vm.params.BlockTime)}, nil
+		}),
+		"getCallDataSize": hostFunc(none, []wasm.ValueType{i32}, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return []uint64{uint64(len(ctx.input))}, nil
+		}),
+		"callDataCopy": hostFunc([]wasm.ValueType{i32, i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, wasmCopy(inst, ctx.input, uint32(args[0]), uint32(args[1]), uint32(args[2]))
+		}),
+		"storageStore": hostFunc([]wasm.ValueType{i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			key, err := wasmWord(inst, uint32(args[0]))
+			if err != nil {
+				return nil, err
+			}
+			value, err := wasmWord(inst, uint32(args[1]))
+			if err != nil {
+				return nil, err
+			}
+			err = inst.UseGas(GasStorageUpdate)
+			if err != nil {
+				return nil, err
+			}
+			ctx.callState.SetStorage(ctx.callee, key, value)
+			return nil, ctx.callState.Error()
+		}),
+		"storageLoad": hostFunc([]wasm.ValueType{i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			key, err := wasmWord(inst, uint32(args[0]))
+			if err != nil {
+				return nil, err
+			}
+			err = inst.UseGas(GasStorageLoad)
+			if err != nil {
+				return nil, err
+			}
+			value := ctx.callState.GetStorage(ctx.callee, key)
+			if err := ctx.callState.Error(); err != nil {
+				return nil, err
+			}
+			return nil, inst.Write(uint32(args[1]), value.Bytes())
+		}),
+		"log": hostFunc([]wasm.ValueType{i32, i32, i32, i32, i32, i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			numTopics := uint32(args[2])
+			if numTopics > 4 {
+				return nil, errors.ErrorCodef(errors.ErrorCodeExecutionAborted, "log may have at most 4 topics but got %d",
+					numTopics)
+			}
+			length := uint32(args[1])
+			err := inst.UseGas(GasLogBase + uint64(numTopics)*GasLogTopic + (uint64(length)+31)/32*GasLogWord)
+			if err != nil {
+				return nil, err
+			}
+			data, err := inst.Read(uint32(args[0]), length)
+			if err != nil {
+				return nil, err
+			}
+			topics := make([]Word256, numTopics)
+			for i := range topics {
+				topics[i], err = wasmWord(inst, uint32(args[3+i]))
+				if err != nil {
+					return nil, err
+				}
+			}
+			return nil, ctx.eventSink.Log(&exec.LogEvent{
+				Address: ctx.callee,
+				Topics:  topics,
+				Data:    data,
+			})
+		}),
+		"call": hostFunc([]wasm.ValueType{i64, i32, i32, i32, i32}, []wasm.ValueType{i32}, (*wasmContext).call),
+		"getReturnDataSize": hostFunc(none, []wasm.ValueType{i32}, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return []uint64{uint64(len(ctx.returnData))}, nil
+		}),
+		"returnDataCopy": hostFunc([]wasm.ValueType{i32, i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return nil, wasmCopy(inst, ctx.returnData, uint32(args[0]), uint32(args[1]), uint32(args[2]))
+		}),
+		"finish": hostFunc([]wasm.ValueType{i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			var err error
+			ctx.output, err = inst.Read(uint32(args[0]), uint32(args[1]))
+			if err != nil {
+				return nil, err
+			}
+			return nil, errWASMFinish
+		}),
+		"revert": hostFunc([]wasm.ValueType{i32, i32}, none, func(ctx *wasmContext, inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			var err error
+			ctx.output, err = inst.Read(uint32(args[0]), uint32(args[1]))
+			if err != nil {
+				return nil, err
+			}
+			return nil, errWASMRevert
+		}),
+	}
+}
+
+// Executes the WASM module passed in code by invoking its main export. WASM code is executed with the same calling
+// convention as EVM code: state changes and errors are accumulated in callState and the output is returned.
+func (vm *VM) executeWASM(callState Interface, eventSink EventSink, caller, callee crypto.Address,
+	code, input []byte, value uint64, gas *uint64) []byte {
+	vm.Debugf("(%d) (%s) %s (wasm=%d) gas: %v (d) %X\n", vm.stackDepth, caller, callee, len(code), *gas, input)
+
+	module, err := wasm.DecodeModule(code)
+	if err != nil {
+		callState.PushError(errors.ErrorCodef(errors.ErrorCodeInvalidContract, "%v", err))
+		return nil
+	}
+	ctx := &wasmContext{
+		vm:        vm,
+		callState: callState,
+		eventSink: eventSink,
+		caller:    caller,
+		callee:    callee,
+		input:     input,
+		value:     value,
+		gas:       gas,
+	}
+	imports, err := ctx.imports(module)
+	if err != nil {
+		callState.PushError(err)
+		return nil
+	}
+	if _, ok := module.Export(WASMExportMemory, wasm.ExportMemory); !ok {
+		callState.PushError(errors.ErrorCodef(errors.ErrorCodeInvalidContract,
+			"WASM contract must export its memory as '%s'", WASMExportMemory))
+		return nil
+	}
+	var options []func(*wasm.Instance)
+	if vm.ctx != nil {
+		options = append(options, wasm.Interrupt(func() error {
+			if err := vm.interruption(); err != nil {
+				return err
+			}
+			return nil
+		}))
+	}
+	inst, err := wasm.Instantiate(module, imports, gas, &vm.wasmPages, options...)
+	if err == nil {
+		_, err = inst.Invoke(WASMExportMain)
+	}
+	switch err {
+	case nil, errWASMFinish:
+		return ctx.output
+	case errWASMRevert:
+		callState.PushError(newRevertException(ctx.output))
+		return ctx.output
+	default:
+		if p, ok := err.(*wasm.Panic); ok {
+			vm.logger.InfoMsg("WASM module panicked", "callee", callee, "panic", fmt.Sprint(p.Value),
+				"stack", string(p.Stack))
+		}
+		vm.Debugf(" => WASM error: %v\n", err)
+		callState.PushError(err)
+		return nil
+	}
+}
+
+// Bind the host functions imported by module checking they are imported with the correct types
+func (ctx *wasmContext) imports(module *wasm.Module) (wasm.Imports, error) {
+	funcs := make(map[string]wasm.HostFunc)
+	for i, imp := range module.Imports {
+		hf, ok := hostFunctions[imp.Name]
+		if imp.Module != WASMImportModule || !ok {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidContract, "unknown WASM import %s.%s",
+				imp.Module, imp.Name)
+		}
+		if ft := module.FuncType(uint32(i)); !ft.Equal(hf.FuncType) {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidContract,
+				"WASM import %s.%s has type %v but expected %v", imp.Module, imp.Name, ft, hf.FuncType)
+		}
+		call := hf.call
+		funcs[imp.Name] = func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+			return call(ctx, inst, args)
+		}
+	}
+	return wasm.Imports{WASMImportModule: funcs}, nil
+}
+
+// call(gas i64, addressOffset, valueOffset, dataOffset, dataLength i32) i32 makes a message call to another contract
+// (EVM, WASM, or native) in the same way as the CALL opcode
+func (ctx *wasmContext) call(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+	callState := ctx.callState
+	gasLimit := args[0]
+	address, err := wasmAddress(inst, uint32(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	bs, err := inst.Read(uint32(args[2]), wasmValueLength)
+	if err != nil {
+		return nil, err
+	}
+	if binary.LittleEndian.Uint64(bs[8:]) != 0 {
+		return nil, errors.ErrorCodef(errors.ErrorCodeIntegerOverflow, "call value exceeds 64 bits")
+	}
+	value := binary.LittleEndian.Uint64(bs)
+	input, err := inst.Read(uint32(args[3]), uint32(args[4]))
+	if err != nil {
+		return nil, err
+	}
+
+	EnsurePermission(callState, ctx.callee, permission.Call)
+	if err := callState.Error(); err != nil {
+		return nil, err
+	}
+	gas := ctx.gas
+	// EIP150 - the 63/64 rule
+	if *gas < gasLimit {
+		gasLimit = *gas - *gas/64
+	}
+	*gas -= gasLimit
+
+	var callErr errors.CodedError
+	childCallState := callState.NewCache()
+	if ctx.vm.natives.IsAvailable(address, ctx.vm.params.EVMVersion) {
+		ctx.returnData, callErr = ctx.vm.natives.Execute(address, childCallState, ctx.callee, input, &gasLimit,
+			ctx.vm.logger)
+		childCallState.PushError(callErr)
+		ctx.vm.fireCallEvent(ctx.eventSink, exec.CallTypeSNative, childCallState, &ctx.returnData, ctx.callee,
+			address, input, value, &gasLimit, childCallState)
+	} else {
+		useGasNegative(gas, GasGetAccount, callState)
+		if !callState.Exists(address) {
+			// We're sending funds to a new account so we must create it first
+			ctx.vm.createAccount(callState, ctx.callee, address)
+		}
+		if err := callState.Error(); err != nil {
+			return nil, err
+		}
+		ctx.returnData, callErr = ctx.vm.call(childCallState, ctx.eventSink, ctx.callee, address,
+			callState.GetCode(address), input, value, &gasLimit, exec.CallTypeCall)
+	}
+	// Return unused gas
+	*gas += gasLimit
+
+	switch {
+	case callErr == nil:
+		// Sync error is a hard stop
+		callState.PushError(childCallState.Sync())
+		if err := callState.Error(); err != nil {
+			return nil, err
+		}
+		return []uint64{wasmCallSuccess}, nil
+	case callErr.ErrorCode() == errors.ErrorCodeExecutionReverted:
+		return []uint64{wasmCallRevert}, nil
+	default:
+		ctx.returnData = nil
+		return []uint64{wasmCallFailure}, nil
+	}
+}
+
+func wasmAddress(inst *wasm.Instance, offset uint32) (crypto.Address, error) {
+	bs, err := inst.Read(offset, crypto.AddressLength)
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	return crypto.AddressFromBytes(bs)
+}
+
+func wasmWord(inst *wasm.Instance, offset uint32) (Word256, error) {
+	bs, err := inst.Read(offset, Word256Length)
+	if err != nil {
+		return Zero256, err
+	}
+	return LeftPadWord256(bs), nil
+}
+
+func wasmValue(value uint64) []byte {
+	bs := make([]byte, wasmValueLength)
+	binary.LittleEndian.PutUint64(bs, value)
+	return bs
+}
+
+// Copy length bytes of data from dataOffset into memory at resultOffset
+func wasmCopy(inst *wasm.Instance, data []byte, resultOffset, dataOffset, length uint32) error {
+	if uint64(dataOffset)+uint64(length) > uint64(len(data)) {
+		return errors.ErrorCodef(errors.ErrorCodeInputOutOfBounds,
+			"cannot copy %d bytes from offset %d of data of length %d", length, dataOffset, len(data))
+	}
+	return inst.Write(resultOffset, data[dataOffset:dataOffset+length])
+}
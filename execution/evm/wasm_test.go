@@ -0,0 +1,291 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/wasm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Offset in memory of any data passed to wasmContract
+const wasmDataOffset = 1024
+
+// Assemble a WASM contract whose main function has a body made up of instructions: an int32 is an i32.const, an int64
+// an i64.const, a string a call to the named EEI host function, and a []byte raw instructions
+func wasmContract(t *testing.T, data []byte, instructions ...interface{}) []byte {
+	module := &wasm.Module{
+		Memory: &wasm.Limits{Min: 1},
+	}
+	imports := make(map[string]uint32)
+	var body []byte
+	for _, instruction := range instructions {
+		switch ins := instruction.(type) {
+		case int32:
+			body = wasm.AppendSLEB(append(body, 0x41), int64(ins))
+		case int64:
+			body = wasm.AppendSLEB(append(body, 0x42), ins)
+		case string:
+			index, ok := imports[ins]
+			if !ok {
+				hf, ok := hostFunctions[ins]
+				require.True(t, ok, "no such host function %s", ins)
+				index = uint32(len(module.Imports))
+				imports[ins] = index
+				module.Imports = append(module.Imports, wasm.Import{Module: WASMImportModule, Name: ins,
+					Type: uint32(len(module.Types))})
+				module.Types = append(module.Types, hf.FuncType)
+			}
+			body = wasm.AppendULEB(append(body, 0x10), uint64(index))
+		case []byte:
+			body = append(body, ins...)
+		default:
+			t.Fatalf("unexpected instruction %v", instruction)
+		}
+	}
+	module.Types = append(module.Types, wasm.FuncType{})
+	module.Funcs = []uint32{uint32(len(module.Types) - 1)}
+	module.Codes = []wasm.Code{{Body: append(body, 0x0b)}}
+	module.Exports = []wasm.Export{
+		{Name: WASMExportMain, Kind: wasm.ExportFunc, Index: uint32(len(module.Imports))},
+		{Name: WASMExportMemory, Kind: wasm.ExportMemory},
+	}
+	if len(data) > 0 {
+		module.Data = []wasm.Data{{Offset: wasm.ConstExpr{Value: wasmDataOffset}, Init: data}}
+	}
+	bs := module.Encode()
+	_, err := wasm.DecodeModule(bs)
+	require.NoError(t, err)
+	return bs
+}
+
+func wasmParams() Params {
+	params := newParams()
+	params.WASM = true
+	return params
+}
+
+func TestWASMContract(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	// Store the call data at key 0, log it with topic 0, and return the caller's address
+	code := wasmContract(t, nil,
+		int32(0), int32(0), "getCallDataSize", "callDataCopy",
+		int32(64), int32(0), "storageStore",
+		int32(128), "getCaller",
+		int32(0), int32(32), int32(1), int32(64), int32(0), int32(0), int32(0), "log",
+		int32(128), int32(crypto.AddressLength), "finish",
+	)
+	callee := makeAccountWithCode(cache, "wasm", code)
+
+	input := Int64ToWord256(0xdeadbeef).Bytes()
+	txe := new(exec.TxExecution)
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache, txe, caller, callee, code, input, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, caller.Bytes(), output)
+	assert.Equal(t, Int64ToWord256(0xdeadbeef), cache.GetStorage(callee, Zero256))
+	assert.True(t, gas < 100000-GasStorageUpdate)
+
+	var logs []*exec.LogEvent
+	for _, ev := range txe.Events {
+		if ev.Log != nil {
+			logs = append(logs, ev.Log)
+		}
+	}
+	require.Len(t, logs, 1)
+	assert.Equal(t, callee, logs[0].Address)
+	assert.Equal(t, []Word256{Zero256}, logs[0].Topics)
+	assert.Equal(t, input, logs[0].Data.Bytes())
+}
+
+func TestWASMRevert(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	code := wasmContract(t, []byte("no"), int32(wasmDataOffset), int32(2), "revert")
+	callee := makeAccountWithCode(cache, "wasm", code)
+
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache.NewCache(), NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionReverted, err)
+	assert.Equal(t, []byte("no"), output)
+
+	// A trap aborts execution
+	code = wasmContract(t, nil, []byte{0x00})
+	_, err = ourVm.Call(cache.NewCache(), NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionAborted, err)
+
+	// Importing a host function with the wrong type is rejected
+	code = (&wasm.Module{
+		Types:   []wasm.FuncType{{Params: []wasm.ValueType{wasm.I64}}, {}},
+		Imports: []wasm.Import{{Module: WASMImportModule, Name: "finish", Type: 0}},
+		Funcs:   []uint32{1},
+		Memory:  &wasm.Limits{Min: 1},
+		Exports: []wasm.Export{
+			{Name: WASMExportMain, Kind: wasm.ExportFunc, Index: 1},
+			{Name: WASMExportMemory, Kind: wasm.ExportMemory},
+		},
+		Codes: []wasm.Code{{Body: []byte{0x0b}}},
+	}).Encode()
+	_, err = ourVm.Call(cache.NewCache(), NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeInvalidContract, err)
+}
+
+func TestWASMCreate(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	// As with EVM contracts the output of the deployment code is the contract's code
+	runtime := wasmContract(t, nil, int32(0), int32(0), "finish")
+	code := wasmContract(t, runtime, int32(wasmDataOffset), int32(len(runtime)), "finish")
+
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache, NewNoopEventSink(), caller, newAccount(cache, "wasm"), code, code, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, runtime, output)
+}
+
+func TestEVMCallsWASM(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	// Return the call value (as a 128-bit little-endian integer) padded to a word
+	wasmAddress := makeAccountWithCode(cache, "wasm", wasmContract(t, nil,
+		int32(0), "getCallValue",
+		int32(0), int32(32), "finish",
+	))
+	// As callContractCode but with enough gas for the WASM contract's memory
+	evmAddress := makeAccountWithCode(cache, "evm", MustSplice(PUSH1, 0x20, PUSH1, 0, PUSH1, 0, PUSH1, 0,
+		PUSH1, 0x69, PUSH20, wasmAddress, PUSH2, 0xff, 0xff, CALL, PUSH1, 0x20, PUSH1, 0, RETURN))
+
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache, NewNoopEventSink(), caller, evmAddress, cache.GetCode(evmAddress), nil, 0, &gas)
+	require.NoError(t, err)
+	require.Len(t, output, 32)
+	assert.Equal(t, byte(0x69), output[0])
+	assert.Equal(t, uint64(9999999+0x69), cache.GetBalance(wasmAddress))
+}
+
+func TestWASMCallsEVM(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	evmAddress := makeAccountWithCode(cache, "evm", MustSplice(PUSH1, 42, return1()))
+	// Call the contract whose address is passed as call data and return its output
+	code := wasmContract(t, nil,
+		int32(0), int32(0), int32(crypto.AddressLength), "callDataCopy",
+		int64(10000), int32(0), int32(32), int32(0), int32(0), "call",
+		// unreachable if the call was unsuccessful
+		[]byte{0x04, 0x40, 0x00, 0x0b},
+		int32(64), int32(0), "getReturnDataSize", "returnDataCopy",
+		int32(64), "getReturnDataSize", "finish",
+	)
+	wasmAddress := makeAccountWithCode(cache, "wasm", code)
+
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache, NewNoopEventSink(), caller, wasmAddress, code, evmAddress.Bytes(), 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Int64ToWord256(42).Bytes(), output)
+
+	// Calls to a reverting contract report the revert
+	revertAddress := makeAccountWithCode(cache, "revert", MustSplice(PUSH1, 0, PUSH1, 0, REVERT))
+	code = wasmContract(t, nil,
+		int32(0), int32(0), int32(crypto.AddressLength), "callDataCopy",
+		int32(64),
+		int64(10000), int32(0), int32(32), int32(0), int32(0), "call",
+		// i32.store the result
+		[]byte{0x36, 0x02, 0x00},
+		int32(64), int32(4), "finish",
+	)
+	output, err = ourVm.Call(cache, NewNoopEventSink(), caller, wasmAddress, code, revertAddress.Bytes(), 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{byte(wasmCallRevert), 0, 0, 0}, output)
+}
+
+func TestWASMDisabled(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	code := wasmContract(t, nil, int32(0), int32(0), "revert")
+	callee := makeAccountWithCode(cache, "wasm", code)
+
+	// Without WASM enabled the module is EVM bytecode starting with STOP
+	gas := uint64(100000)
+	output, err := ourVm.Call(cache, NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func TestWASMMemoryLimit(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	code := wasmContract(t, nil, int32(0), int32(0), "finish")
+	callee := makeAccountWithCode(cache, "wasm", code)
+
+	// Each instance takes a page from those the VM has left to give
+	ourVm.wasmPages = 1
+	gas := uint64(100000)
+	_, err := ourVm.Call(cache, NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	require.NoError(t, err)
+	assert.True(t, gas <= 100000-wasm.GasMemoryPage)
+	_, err = ourVm.Call(cache, NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionAborted, err)
+}
+
+func TestWASMHostGas(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger)
+	caller := newAccount(cache, "caller")
+	var contracts int
+	gasUsed := func(instructions ...interface{}) uint64 {
+		code := wasmContract(t, nil, instructions...)
+		contracts++
+		callee := makeAccountWithCode(cache, fmt.Sprintf("wasm%d", contracts), code)
+		gas := uint64(100000)
+		_, err := ourVm.Call(cache, NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+		require.NoError(t, err)
+		return 100000 - gas
+	}
+	drop := []byte{0x1a}
+
+	// Compare the cost of calling each host function with that of dropping its arguments instead, storing first so that
+	// the key exists to be loaded
+	store := []interface{}{int32(0), int32(0), "storageStore", int32(0), int32(32)}
+	assert.Equal(t, gasUsed(append(store, drop, drop)...)-2*wasm.GasInstruction+GasStorageLoad,
+		gasUsed(append(store, "storageLoad")...)-wasm.GasInstruction)
+
+	logArgs := []interface{}{int32(0), int32(64), int32(1), int32(64), int32(0), int32(0), int32(0)}
+	var drops []interface{}
+	for range logArgs {
+		drops = append(drops, drop)
+	}
+	assert.Equal(t, gasUsed(append(logArgs, drops...)...)-7*wasm.GasInstruction+GasLogBase+GasLogTopic+2*GasLogWord,
+		gasUsed(append(logArgs, "log")...)-wasm.GasInstruction)
+}
+
+func TestWASMInterruptible(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	caller := newAccount(cache, "caller")
+	// Loop forever
+	code := wasmContract(t, nil, []byte{0x03, 0x40, 0x0c, 0x00, 0x0b})
+	callee := makeAccountWithCode(cache, "wasm", code)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ourVm := NewVM(wasmParams(), crypto.ZeroAddress, nil, logger, Interruptible(ctx))
+	var gas uint64 = 1 << 62
+	_, err := ourVm.Call(cache, NewNoopEventSink(), caller, callee, code, nil, 0, &gas)
+	assertErrorCode(t, errors.ErrorCodeExecutionInterrupted, err)
+	assert.True(t, gas > 1<<61, "should have been interrupted long before running out of gas")
+}
@@ -1,30 +1,29 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: exec.proto
 
-package exec // import "github.com/hyperledger/burrow/execution/exec"
-
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import _ "github.com/golang/protobuf/ptypes/timestamp"
-import errors "github.com/hyperledger/burrow/execution/errors"
-import names "github.com/hyperledger/burrow/execution/names"
-import spec "github.com/hyperledger/burrow/genesis/spec"
-import permission "github.com/hyperledger/burrow/permission"
-import txs "github.com/hyperledger/burrow/txs"
-import types "github.com/tendermint/tendermint/abci/types"
-
-import github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
-import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
-import github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
-import time "time"
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-
-import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-
-import io "io"
+package exec
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	errors "github.com/hyperledger/burrow/execution/errors"
+	names "github.com/hyperledger/burrow/execution/names"
+	spec "github.com/hyperledger/burrow/genesis/spec"
+	permission "github.com/hyperledger/burrow/permission"
+	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
+	txs "github.com/hyperledger/burrow/txs"
+	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
+	types "github.com/tendermint/tendermint/abci/types"
+	io "io"
+	math "math"
+	time "time"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -40,12 +39,12 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type StreamEvent struct {
-	BeginBlock           *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock" json:"BeginBlock,omitempty"`
-	BeginTx              *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx" json:"BeginTx,omitempty"`
-	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
-	Event                *Event                                      `protobuf:"bytes,4,opt,name=Event" json:"Event,omitempty"`
-	EndTx                *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx" json:"EndTx,omitempty"`
-	EndBlock             *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock" json:"EndBlock,omitempty"`
+	BeginBlock           *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock,proto3" json:"BeginBlock,omitempty"`
+	BeginTx              *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx,proto3" json:"BeginTx,omitempty"`
+	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Event                *Event                                      `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
+	EndTx                *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx,proto3" json:"EndTx,omitempty"`
+	EndBlock             *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
 	XXX_unrecognized     []byte                                      `json:"-"`
 	XXX_sizecache        int32                                       `json:"-"`
@@ -55,7 +54,7 @@ func (m *StreamEvent) Reset()         { *m = StreamEvent{} }
 func (m *StreamEvent) String() string { return proto.CompactTextString(m) }
 func (*StreamEvent) ProtoMessage()    {}
 func (*StreamEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{0}
+	return fileDescriptor_4d737c7315c25422, []int{0}
 }
 func (m *StreamEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -72,8 +71,8 @@ func (m *StreamEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *StreamEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamEvent.Merge(dst, src)
+func (m *StreamEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEvent.Merge(m, src)
 }
 func (m *StreamEvent) XXX_Size() int {
 	return m.Size()
@@ -138,7 +137,7 @@ func (m *StreamKey) Reset()         { *m = StreamKey{} }
 func (m *StreamKey) String() string { return proto.CompactTextString(m) }
 func (*StreamKey) ProtoMessage()    {}
 func (*StreamKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{1}
+	return fileDescriptor_4d737c7315c25422, []int{1}
 }
 func (m *StreamKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,8 +154,8 @@ func (m *StreamKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *StreamKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamKey.Merge(dst, src)
+func (m *StreamKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamKey.Merge(m, src)
 }
 func (m *StreamKey) XXX_Size() int {
 	return m.Size()
@@ -188,7 +187,7 @@ func (*StreamKey) XXX_MessageName() string {
 type BeginBlock struct {
 	// The height of this block
 	Height               uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Header               *types.Header `protobuf:"bytes,2,opt,name=Header" json:"Header,omitempty"`
+	Header               *types.Header `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -198,7 +197,7 @@ func (m *BeginBlock) Reset()         { *m = BeginBlock{} }
 func (m *BeginBlock) String() string { return proto.CompactTextString(m) }
 func (*BeginBlock) ProtoMessage()    {}
 func (*BeginBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{2}
+	return fileDescriptor_4d737c7315c25422, []int{2}
 }
 func (m *BeginBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,8 +214,8 @@ func (m *BeginBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *BeginBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeginBlock.Merge(dst, src)
+func (m *BeginBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginBlock.Merge(m, src)
 }
 func (m *BeginBlock) XXX_Size() int {
 	return m.Size()
@@ -256,7 +255,7 @@ func (m *EndBlock) Reset()         { *m = EndBlock{} }
 func (m *EndBlock) String() string { return proto.CompactTextString(m) }
 func (*EndBlock) ProtoMessage()    {}
 func (*EndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{3}
+	return fileDescriptor_4d737c7315c25422, []int{3}
 }
 func (m *EndBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,8 +272,8 @@ func (m *EndBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *EndBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndBlock.Merge(dst, src)
+func (m *EndBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlock.Merge(m, src)
 }
 func (m *EndBlock) XXX_Size() int {
 	return m.Size()
@@ -297,11 +296,11 @@ func (*EndBlock) XXX_MessageName() string {
 }
 
 type BeginTx struct {
-	TxHeader *TxHeader `protobuf:"bytes,1,opt,name=TxHeader" json:"TxHeader,omitempty"`
+	TxHeader *TxHeader `protobuf:"bytes,1,opt,name=TxHeader,proto3" json:"TxHeader,omitempty"`
 	// Result of tx execution
-	Result *Result `protobuf:"bytes,2,opt,name=Result" json:"Result,omitempty"`
+	Result *Result `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
 	// If tx execution was an exception
-	Exception            *errors.Exception `protobuf:"bytes,4,opt,name=Exception" json:"Exception,omitempty"`
+	Exception            *errors.Exception `protobuf:"bytes,4,opt,name=Exception,proto3" json:"Exception,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -311,7 +310,7 @@ func (m *BeginTx) Reset()         { *m = BeginTx{} }
 func (m *BeginTx) String() string { return proto.CompactTextString(m) }
 func (*BeginTx) ProtoMessage()    {}
 func (*BeginTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{4}
+	return fileDescriptor_4d737c7315c25422, []int{4}
 }
 func (m *BeginTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,8 +327,8 @@ func (m *BeginTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *BeginTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeginTx.Merge(dst, src)
+func (m *BeginTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTx.Merge(m, src)
 }
 func (m *BeginTx) XXX_Size() int {
 	return m.Size()
@@ -377,7 +376,7 @@ func (m *EndTx) Reset()         { *m = EndTx{} }
 func (m *EndTx) String() string { return proto.CompactTextString(m) }
 func (*EndTx) ProtoMessage()    {}
 func (*EndTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{5}
+	return fileDescriptor_4d737c7315c25422, []int{5}
 }
 func (m *EndTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,8 +393,8 @@ func (m *EndTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *EndTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndTx.Merge(dst, src)
+func (m *EndTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndTx.Merge(m, src)
 }
 func (m *EndTx) XXX_Size() int {
 	return m.Size()
@@ -420,7 +419,7 @@ type TxHeader struct {
 	// The index of this transaction within the block
 	Index uint64 `protobuf:"varint,4,opt,name=Index,proto3" json:"Index,omitempty"`
 	// The origin information from the chain on which this tx was originally committed (if restored or otherwise imported)
-	Origin               *Origin  `protobuf:"bytes,5,opt,name=Origin" json:"Origin,omitempty"`
+	Origin               *Origin  `protobuf:"bytes,5,opt,name=Origin,proto3" json:"Origin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -430,7 +429,7 @@ func (m *TxHeader) Reset()         { *m = TxHeader{} }
 func (m *TxHeader) String() string { return proto.CompactTextString(m) }
 func (*TxHeader) ProtoMessage()    {}
 func (*TxHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{6}
+	return fileDescriptor_4d737c7315c25422, []int{6}
 }
 func (m *TxHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,8 +446,8 @@ func (m *TxHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *TxHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxHeader.Merge(dst, src)
+func (m *TxHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHeader.Merge(m, src)
 }
 func (m *TxHeader) XXX_Size() int {
 	return m.Size()
@@ -493,19 +492,21 @@ func (*TxHeader) XXX_MessageName() string {
 
 type BlockExecution struct {
 	// The height of this block
-	Height               uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Header               *types.Header  `protobuf:"bytes,2,opt,name=Header" json:"Header,omitempty"`
-	TxExecutions         []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Height       uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Header       *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	TxExecutions []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// Total gas used by the transactions in this block
+	GasUsed              uint64   `protobuf:"varint,4,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BlockExecution) Reset()         { *m = BlockExecution{} }
 func (m *BlockExecution) String() string { return proto.CompactTextString(m) }
 func (*BlockExecution) ProtoMessage()    {}
 func (*BlockExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{7}
+	return fileDescriptor_4d737c7315c25422, []int{7}
 }
 func (m *BlockExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,8 +523,8 @@ func (m *BlockExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *BlockExecution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockExecution.Merge(dst, src)
+func (m *BlockExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockExecution.Merge(m, src)
 }
 func (m *BlockExecution) XXX_Size() int {
 	return m.Size()
@@ -555,24 +556,31 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
 
 type TxExecution struct {
-	*TxHeader `protobuf:"bytes,1,opt,name=Header,embedded=Header" json:"Header,omitempty"`
+	*TxHeader `protobuf:"bytes,1,opt,name=Header,proto3,embedded=Header" json:"Header,omitempty"`
 	// Signed Tx that triggered this execution
-	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,6,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,6,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	// Execution events
-	Events []*Event `protobuf:"bytes,7,rep,name=Events" json:"Events,omitempty"`
+	Events []*Event `protobuf:"bytes,7,rep,name=Events,proto3" json:"Events,omitempty"`
 	// The execution results
-	Result *Result `protobuf:"bytes,8,opt,name=Result" json:"Result,omitempty"`
+	Result *Result `protobuf:"bytes,8,opt,name=Result,proto3" json:"Result,omitempty"`
 	// The transaction receipt
-	Receipt *txs.Receipt `protobuf:"bytes,9,opt,name=Receipt" json:"Receipt,omitempty"`
+	Receipt *txs.Receipt `protobuf:"bytes,9,opt,name=Receipt,proto3" json:"Receipt,omitempty"`
 	// If execution was an exception
-	Exception *errors.Exception `protobuf:"bytes,10,opt,name=Exception" json:"Exception,omitempty"`
+	Exception *errors.Exception `protobuf:"bytes,10,opt,name=Exception,proto3" json:"Exception,omitempty"`
 	// A proposal may contain other transactions
-	TxExecutions         []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions" json:"TxExecutions,omitempty"`
+	TxExecutions         []*TxExecution `protobuf:"bytes,11,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -582,7 +590,7 @@ func (m *TxExecution) Reset()         { *m = TxExecution{} }
 func (m *TxExecution) String() string { return proto.CompactTextString(m) }
 func (*TxExecution) ProtoMessage()    {}
 func (*TxExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{8}
+	return fileDescriptor_4d737c7315c25422, []int{8}
 }
 func (m *TxExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,8 +607,8 @@ func (m *TxExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *TxExecution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxExecution.Merge(dst, src)
+func (m *TxExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxExecution.Merge(m, src)
 }
 func (m *TxExecution) XXX_Size() int {
 	return m.Size()
@@ -658,7 +666,7 @@ type Origin struct {
 	// The original index in the block
 	Index uint64 `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`
 	// The original block time for this transaction
-	Time                 time.Time `protobuf:"bytes,4,opt,name=Time,stdtime" json:"Time"`
+	Time                 time.Time `protobuf:"bytes,4,opt,name=Time,proto3,stdtime" json:"Time"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -668,7 +676,7 @@ func (m *Origin) Reset()         { *m = Origin{} }
 func (m *Origin) String() string { return proto.CompactTextString(m) }
 func (*Origin) ProtoMessage()    {}
 func (*Origin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{9}
+	return fileDescriptor_4d737c7315c25422, []int{9}
 }
 func (m *Origin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,8 +693,8 @@ func (m *Origin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Origin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Origin.Merge(dst, src)
+func (m *Origin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Origin.Merge(m, src)
 }
 func (m *Origin) XXX_Size() int {
 	return m.Size()
@@ -743,7 +751,7 @@ type Header struct {
 	// The index of this event relative to other events generated by the same transaction
 	Index uint64 `protobuf:"varint,6,opt,name=Index,proto3" json:"Index,omitempty"`
 	// If event is exception
-	Exception            *errors.Exception `protobuf:"bytes,7,opt,name=Exception" json:"Exception,omitempty"`
+	Exception            *errors.Exception `protobuf:"bytes,7,opt,name=Exception,proto3" json:"Exception,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -752,7 +760,7 @@ type Header struct {
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{10}
+	return fileDescriptor_4d737c7315c25422, []int{10}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,8 +777,8 @@ func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Header) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Header.Merge(dst, src)
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
 }
 func (m *Header) XXX_Size() int {
 	return m.Size()
@@ -828,12 +836,12 @@ func (*Header) XXX_MessageName() string {
 }
 
 type Event struct {
-	Header               *Header             `protobuf:"bytes,1,opt,name=Header" json:"Header,omitempty"`
-	Input                *InputEvent         `protobuf:"bytes,2,opt,name=Input" json:"Input,omitempty"`
-	Output               *OutputEvent        `protobuf:"bytes,3,opt,name=Output" json:"Output,omitempty"`
-	Call                 *CallEvent          `protobuf:"bytes,4,opt,name=Call" json:"Call,omitempty"`
-	Log                  *LogEvent           `protobuf:"bytes,5,opt,name=Log" json:"Log,omitempty"`
-	GovernAccount        *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount" json:"GovernAccount,omitempty"`
+	Header               *Header             `protobuf:"bytes,1,opt,name=Header,proto3" json:"Header,omitempty"`
+	Input                *InputEvent         `protobuf:"bytes,2,opt,name=Input,proto3" json:"Input,omitempty"`
+	Output               *OutputEvent        `protobuf:"bytes,3,opt,name=Output,proto3" json:"Output,omitempty"`
+	Call                 *CallEvent          `protobuf:"bytes,4,opt,name=Call,proto3" json:"Call,omitempty"`
+	Log                  *LogEvent           `protobuf:"bytes,5,opt,name=Log,proto3" json:"Log,omitempty"`
+	GovernAccount        *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount,proto3" json:"GovernAccount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -842,7 +850,7 @@ type Event struct {
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{11}
+	return fileDescriptor_4d737c7315c25422, []int{11}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,8 +867,8 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(dst, src)
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
 }
 func (m *Event) XXX_Size() int {
 	return m.Size()
@@ -924,9 +932,9 @@ type Result struct {
 	// Gas used in computation
 	GasUsed uint64 `protobuf:"varint,2,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
 	// Name entry created
-	NameEntry *names.Entry `protobuf:"bytes,3,opt,name=NameEntry" json:"NameEntry,omitempty"`
+	NameEntry *names.Entry `protobuf:"bytes,3,opt,name=NameEntry,proto3" json:"NameEntry,omitempty"`
 	// Permission update performed
-	PermArgs             *permission.PermArgs `protobuf:"bytes,4,opt,name=PermArgs" json:"PermArgs,omitempty"`
+	PermArgs             *permission.PermArgs `protobuf:"bytes,4,opt,name=PermArgs,proto3" json:"PermArgs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -936,7 +944,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{12}
+	return fileDescriptor_4d737c7315c25422, []int{12}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,8 +961,8 @@ func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Result) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Result.Merge(dst, src)
+func (m *Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Result.Merge(m, src)
 }
 func (m *Result) XXX_Size() int {
 	return m.Size()
@@ -1000,7 +1008,7 @@ func (*Result) XXX_MessageName() string {
 type LogEvent struct {
 	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	Data                 github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
-	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
 	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
 	XXX_unrecognized     []byte                                         `json:"-"`
 	XXX_sizecache        int32                                          `json:"-"`
@@ -1010,7 +1018,7 @@ func (m *LogEvent) Reset()         { *m = LogEvent{} }
 func (m *LogEvent) String() string { return proto.CompactTextString(m) }
 func (*LogEvent) ProtoMessage()    {}
 func (*LogEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{13}
+	return fileDescriptor_4d737c7315c25422, []int{13}
 }
 func (m *LogEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,8 +1035,8 @@ func (m *LogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *LogEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogEvent.Merge(dst, src)
+func (m *LogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEvent.Merge(m, src)
 }
 func (m *LogEvent) XXX_Size() int {
 	return m.Size()
@@ -1045,7 +1053,7 @@ func (*LogEvent) XXX_MessageName() string {
 
 type CallEvent struct {
 	CallType             CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
-	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData" json:"CallData,omitempty"`
+	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
 	Origin               github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
 	StackDepth           uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
 	Return               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
@@ -1058,7 +1066,7 @@ func (m *CallEvent) Reset()         { *m = CallEvent{} }
 func (m *CallEvent) String() string { return proto.CompactTextString(m) }
 func (*CallEvent) ProtoMessage()    {}
 func (*CallEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{14}
+	return fileDescriptor_4d737c7315c25422, []int{14}
 }
 func (m *CallEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,8 +1083,8 @@ func (m *CallEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *CallEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallEvent.Merge(dst, src)
+func (m *CallEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallEvent.Merge(m, src)
 }
 func (m *CallEvent) XXX_Size() int {
 	return m.Size()
@@ -1113,7 +1121,7 @@ func (*CallEvent) XXX_MessageName() string {
 }
 
 type GovernAccountEvent struct {
-	AccountUpdate        *spec.TemplateAccount `protobuf:"bytes,1,opt,name=AccountUpdate" json:"AccountUpdate,omitempty"`
+	AccountUpdate        *spec.TemplateAccount `protobuf:"bytes,1,opt,name=AccountUpdate,proto3" json:"AccountUpdate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1123,7 +1131,7 @@ func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
 func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
 func (*GovernAccountEvent) ProtoMessage()    {}
 func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{15}
+	return fileDescriptor_4d737c7315c25422, []int{15}
 }
 func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,8 +1148,8 @@ func (m *GovernAccountEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GovernAccountEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernAccountEvent.Merge(dst, src)
+func (m *GovernAccountEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernAccountEvent.Merge(m, src)
 }
 func (m *GovernAccountEvent) XXX_Size() int {
 	return m.Size()
@@ -1174,7 +1182,7 @@ func (m *InputEvent) Reset()         { *m = InputEvent{} }
 func (m *InputEvent) String() string { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()    {}
 func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{16}
+	return fileDescriptor_4d737c7315c25422, []int{16}
 }
 func (m *InputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,8 +1199,8 @@ func (m *InputEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *InputEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InputEvent.Merge(dst, src)
+func (m *InputEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputEvent.Merge(m, src)
 }
 func (m *InputEvent) XXX_Size() int {
 	return m.Size()
@@ -1218,7 +1226,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{17}
+	return fileDescriptor_4d737c7315c25422, []int{17}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,8 +1243,8 @@ func (m *OutputEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *OutputEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputEvent.Merge(dst, src)
+func (m *OutputEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputEvent.Merge(m, src)
 }
 func (m *OutputEvent) XXX_Size() int {
 	return m.Size()
@@ -1266,7 +1274,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_exec_bec10a2fd3823f07, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{18}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,8 +1291,8 @@ func (m *CallData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *CallData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallData.Merge(dst, src)
+func (m *CallData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallData.Merge(m, src)
 }
 func (m *CallData) XXX_Size() int {
 	return m.Size()
@@ -1352,6 +1360,92 @@ func init() {
 	proto.RegisterType((*CallData)(nil), "exec.CallData")
 	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
 }
+
+func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
+func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
+
+var fileDescriptor_4d737c7315c25422 = []byte{
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0xda, 0xeb, 0xaf, 0x67, 0xa7, 0x94, 0x51, 0x41, 0xab, 0x1e, 0xec, 0xb0, 0x2d, 0xa5,
+	0x94, 0x76, 0x5d, 0x15, 0xc2, 0x47, 0x90, 0x90, 0xe2, 0x26, 0x4a, 0x42, 0x43, 0x0b, 0x53, 0xb7,
+	0x08, 0x04, 0x87, 0xf5, 0xee, 0xb0, 0x5e, 0xd5, 0xde, 0x5d, 0xed, 0x8e, 0x83, 0xfd, 0x2f, 0x20,
+	0x0e, 0x70, 0x2b, 0x17, 0x94, 0xff, 0x83, 0x0b, 0xc7, 0xdc, 0xe8, 0xb9, 0x07, 0x83, 0xd2, 0xbf,
+	0x00, 0x71, 0x22, 0x27, 0x34, 0x5f, 0xeb, 0x31, 0x4d, 0x93, 0x0a, 0xe7, 0xc0, 0xc5, 0x9a, 0xf7,
+	0xde, 0x6f, 0x9e, 0xdf, 0xc7, 0xef, 0xbd, 0x59, 0x00, 0x32, 0x26, 0x9e, 0x93, 0xa4, 0x31, 0x8d,
+	0x91, 0xc9, 0xce, 0x17, 0xae, 0x07, 0x21, 0xed, 0x8f, 0x7a, 0x8e, 0x17, 0x0f, 0xdb, 0x41, 0x1c,
+	0xc4, 0x6d, 0x6e, 0xec, 0x8d, 0xbe, 0xe1, 0x12, 0x17, 0xf8, 0x49, 0x5c, 0xba, 0xf0, 0x9e, 0x06,
+	0xa7, 0x24, 0xf2, 0x49, 0x3a, 0x0c, 0x23, 0xaa, 0x1f, 0xdd, 0x9e, 0x17, 0xb6, 0xe9, 0x24, 0x21,
+	0x99, 0xf8, 0x95, 0x17, 0x5b, 0x41, 0x1c, 0x07, 0x03, 0x32, 0x73, 0x4f, 0xc3, 0x21, 0xc9, 0xa8,
+	0x3b, 0x4c, 0x24, 0xa0, 0x41, 0xd2, 0x34, 0x4e, 0x15, 0xbc, 0x1e, 0xb9, 0xc3, 0xfc, 0x6e, 0x8d,
+	0x8e, 0xd5, 0xf1, 0x5c, 0xc2, 0xfe, 0x26, 0xcb, 0xc2, 0x38, 0x92, 0x1a, 0xc8, 0x12, 0x95, 0x92,
+	0xfd, 0x4b, 0x01, 0xea, 0xf7, 0x68, 0x4a, 0xdc, 0xe1, 0xc6, 0x2e, 0x89, 0x28, 0xba, 0x01, 0xd0,
+	0x21, 0x41, 0x18, 0x75, 0x06, 0xb1, 0xf7, 0xd0, 0x32, 0x96, 0x8d, 0x2b, 0xf5, 0x9b, 0xe7, 0x1c,
+	0x5e, 0x83, 0x99, 0x1e, 0x6b, 0x18, 0xf4, 0x06, 0x54, 0xb8, 0xd4, 0x1d, 0x5b, 0x05, 0x0e, 0x5f,
+	0xd2, 0xe0, 0xdd, 0x31, 0x56, 0x56, 0xf4, 0x05, 0x54, 0x37, 0xa2, 0x5d, 0x32, 0x88, 0x13, 0x62,
+	0x15, 0x25, 0x92, 0x85, 0xa9, 0x94, 0x1d, 0xe7, 0xc9, 0xb4, 0x75, 0x55, 0xab, 0x56, 0x7f, 0x92,
+	0x90, 0x74, 0x40, 0xfc, 0x80, 0xa4, 0xed, 0xde, 0x28, 0x4d, 0xe3, 0x6f, 0xdb, 0x3a, 0x1e, 0xe7,
+	0xee, 0xd0, 0x6b, 0x50, 0xe2, 0xe1, 0x5b, 0x26, 0xf7, 0x5b, 0x17, 0x11, 0x70, 0x15, 0x16, 0x16,
+	0x0e, 0x89, 0xfc, 0xee, 0xd8, 0x2a, 0xcd, 0x41, 0x98, 0x0a, 0x0b, 0x0b, 0xba, 0xca, 0x02, 0xf4,
+	0x45, 0xe6, 0x65, 0x8e, 0x3a, 0x9b, 0xa3, 0x44, 0xde, 0xb9, 0x7d, 0xd5, 0xdc, 0xdf, 0x6b, 0x19,
+	0xf6, 0x07, 0x50, 0x13, 0xc5, 0xbb, 0x4d, 0x26, 0xe8, 0x55, 0x28, 0x6f, 0x91, 0x30, 0xe8, 0x53,
+	0x5e, 0x36, 0x13, 0x4b, 0x09, 0x9d, 0x87, 0xd2, 0x76, 0xe4, 0x13, 0x51, 0x1e, 0x13, 0x0b, 0xc1,
+	0xbe, 0xad, 0x17, 0xfa, 0xb9, 0x77, 0x5f, 0x67, 0x7a, 0xd7, 0x27, 0x69, 0x5e, 0x5b, 0xc1, 0x10,
+	0xa1, 0xc4, 0xd2, 0x68, 0xdb, 0xb3, 0xc8, 0x9f, 0xe7, 0xca, 0xfe, 0xde, 0xc8, 0x1b, 0xc5, 0x32,
+	0xed, 0x8e, 0xa5, 0x63, 0x43, 0xcf, 0x54, 0x69, 0x71, 0x6e, 0x47, 0x97, 0xa0, 0x8c, 0x49, 0x36,
+	0x1a, 0x50, 0x19, 0x42, 0x43, 0x20, 0x85, 0x0e, 0x4b, 0x1b, 0x6a, 0x43, 0x6d, 0x63, 0xec, 0x91,
+	0x84, 0x86, 0x71, 0x24, 0xbb, 0xf0, 0xb2, 0x23, 0xf9, 0x99, 0x1b, 0xf0, 0x0c, 0x63, 0x3f, 0x90,
+	0xfd, 0x40, 0x9f, 0x40, 0xb9, 0x3b, 0xde, 0x72, 0xb3, 0x3e, 0x27, 0x45, 0xa3, 0xb3, 0xb2, 0x3f,
+	0x6d, 0x9d, 0x79, 0x32, 0x6d, 0x5d, 0x3f, 0x9e, 0x09, 0xbd, 0x30, 0x72, 0xd3, 0x89, 0xb3, 0x45,
+	0xc6, 0x9d, 0x09, 0x25, 0x19, 0x96, 0x4e, 0xec, 0xbf, 0x8d, 0x59, 0x6e, 0xe8, 0x63, 0xe6, 0xbb,
+	0x3b, 0x49, 0x08, 0xcf, 0x72, 0xa9, 0x73, 0xf3, 0x70, 0xda, 0x72, 0x4e, 0x64, 0x58, 0x3b, 0x71,
+	0x27, 0x83, 0xd8, 0xf5, 0x1d, 0x76, 0x13, 0x4b, 0x0f, 0x5a, 0x9c, 0x85, 0x53, 0x88, 0x53, 0x6b,
+	0x53, 0xf1, 0x68, 0xb6, 0x98, 0x1a, 0x5b, 0x58, 0x13, 0xee, 0xa6, 0x61, 0x10, 0x46, 0x56, 0x49,
+	0x6f, 0x82, 0xd0, 0x61, 0x69, 0xb3, 0xf7, 0x0c, 0x38, 0xcb, 0x49, 0xb0, 0x31, 0x26, 0xde, 0x88,
+	0x95, 0x79, 0x41, 0x62, 0xa1, 0x15, 0x68, 0x74, 0xc7, 0xb9, 0xb7, 0xcc, 0x2a, 0x2e, 0x17, 0x45,
+	0x67, 0x05, 0x59, 0x72, 0x0b, 0x9e, 0x83, 0x21, 0x0b, 0x2a, 0x9b, 0x6e, 0x76, 0x3f, 0x23, 0xbe,
+	0x4c, 0x43, 0x89, 0xf6, 0x9f, 0x05, 0xa8, 0x6b, 0x50, 0x74, 0x2d, 0x8f, 0xe3, 0x48, 0x1e, 0x76,
+	0xcc, 0xc7, 0xd3, 0x96, 0x91, 0x87, 0xa3, 0xaf, 0x90, 0xf2, 0xe9, 0xae, 0x90, 0x8b, 0x50, 0xe6,
+	0x8b, 0x22, 0xb3, 0x2a, 0xcb, 0x45, 0x6d, 0x41, 0x30, 0x1d, 0x96, 0x26, 0x6d, 0x16, 0xaa, 0xc7,
+	0xcc, 0xc2, 0x65, 0xa8, 0x60, 0xe2, 0x91, 0x30, 0xa1, 0x56, 0x4d, 0xc2, 0xd8, 0x9f, 0x4a, 0x1d,
+	0x56, 0xc6, 0xf9, 0x99, 0x81, 0x93, 0x67, 0xe6, 0x99, 0x6e, 0xd4, 0x5f, 0xa8, 0x1b, 0xf6, 0x77,
+	0x86, 0x62, 0x0f, 0x6b, 0xcc, 0xad, 0xbe, 0x1b, 0x46, 0xdb, 0xeb, 0xbc, 0xde, 0x35, 0xac, 0x44,
+	0x8d, 0x28, 0x85, 0xa3, 0xf9, 0x58, 0xd4, 0xf9, 0xf8, 0x3e, 0x98, 0xdd, 0x70, 0x48, 0xe4, 0xa4,
+	0x5f, 0x70, 0xc4, 0x53, 0xe5, 0xa8, 0xa7, 0xca, 0xe9, 0xaa, 0xa7, 0xaa, 0x53, 0x65, 0x63, 0xf2,
+	0xc3, 0xef, 0x2d, 0x03, 0xf3, 0x1b, 0xf6, 0x6f, 0x05, 0x28, 0xff, 0xff, 0xa7, 0xf3, 0x2d, 0xa8,
+	0xf1, 0x96, 0xf3, 0xe8, 0x8a, 0x3c, 0xba, 0xa5, 0xc3, 0x69, 0x6b, 0xa6, 0xc4, 0xb3, 0x23, 0x2b,
+	0x2a, 0x17, 0xb6, 0xd7, 0x79, 0x3d, 0x6a, 0x58, 0x89, 0x5a, 0x51, 0x4b, 0x47, 0x17, 0xb5, 0xac,
+	0x17, 0x75, 0x8e, 0x0f, 0x95, 0x93, 0xf9, 0xb0, 0x6a, 0x3e, 0xda, 0x6b, 0x9d, 0xb1, 0x7f, 0x2c,
+	0xc8, 0xd7, 0x0f, 0x5d, 0x52, 0xa5, 0xb5, 0x0c, 0x9d, 0x9e, 0xff, 0x9a, 0xe9, 0xcb, 0xec, 0xcf,
+	0x93, 0x91, 0xda, 0xe7, 0xf2, 0x75, 0xe7, 0x2a, 0xf9, 0x62, 0xf2, 0x33, 0x7a, 0x13, 0xca, 0x77,
+	0x47, 0x94, 0x01, 0x8b, 0x2a, 0x16, 0xbe, 0x73, 0x46, 0x34, 0x47, 0x4a, 0x00, 0xba, 0x08, 0xe6,
+	0x2d, 0x77, 0x30, 0x90, 0x74, 0x78, 0x49, 0x00, 0x99, 0x46, 0xc0, 0xb8, 0x11, 0x2d, 0x43, 0x71,
+	0x27, 0x0e, 0xac, 0x92, 0x3e, 0xe7, 0x3b, 0x71, 0x20, 0x20, 0xcc, 0x84, 0x3e, 0x82, 0xa5, 0xcd,
+	0x78, 0x97, 0xa4, 0xd1, 0x9a, 0xe7, 0xc5, 0xa3, 0x88, 0xca, 0x19, 0xb7, 0x04, 0x76, 0xce, 0x24,
+	0x6e, 0xcd, 0xc3, 0x57, 0xab, 0xac, 0x1e, 0xfc, 0x61, 0x7e, 0x64, 0xa8, 0x49, 0x65, 0x3d, 0xc0,
+	0x84, 0x8e, 0xd2, 0x88, 0x17, 0xa5, 0x81, 0xa5, 0xa4, 0xef, 0xa8, 0xc2, 0xdc, 0x8e, 0x42, 0x57,
+	0xa1, 0x76, 0xc7, 0x1d, 0x92, 0x8d, 0x88, 0xa6, 0x13, 0x99, 0x7b, 0xc3, 0x11, 0x5f, 0x57, 0x5c,
+	0x87, 0x67, 0x66, 0x74, 0x03, 0xaa, 0x9f, 0x92, 0x74, 0xb8, 0x96, 0x06, 0x99, 0xcc, 0xfe, 0xbc,
+	0xa3, 0x7d, 0x70, 0x29, 0x1b, 0xce, 0x51, 0xf6, 0x5f, 0x06, 0x54, 0x55, 0xda, 0xe8, 0x0e, 0x54,
+	0xd6, 0x7c, 0x3f, 0x25, 0x59, 0x26, 0xa2, 0xeb, 0xbc, 0x23, 0x79, 0x7b, 0xed, 0x78, 0xde, 0x7a,
+	0xe9, 0x24, 0xa1, 0xb1, 0x23, 0xef, 0x62, 0xe5, 0x04, 0x6d, 0x83, 0xb9, 0xee, 0x52, 0x77, 0xb1,
+	0x21, 0xe0, 0x2e, 0xd0, 0x0e, 0x94, 0xbb, 0x71, 0x12, 0x7a, 0x62, 0xe9, 0xbf, 0x70, 0x64, 0xd2,
+	0xd9, 0xe7, 0x71, 0xea, 0xdf, 0x5c, 0x79, 0x17, 0x4b, 0x1f, 0xf6, 0xcf, 0x05, 0xa8, 0xe5, 0x84,
+	0x40, 0x57, 0xa0, 0xca, 0x04, 0x3e, 0x5d, 0x25, 0x3e, 0x5d, 0x8d, 0xc3, 0x69, 0x2b, 0xd7, 0xe1,
+	0xfc, 0xc4, 0xbe, 0x54, 0xd8, 0x99, 0x27, 0x35, 0xf7, 0x42, 0x28, 0x2d, 0xce, 0xed, 0x68, 0x47,
+	0xad, 0x39, 0x99, 0xfe, 0x7f, 0xab, 0xa5, 0x5a, 0x95, 0x4d, 0x80, 0x7b, 0xd4, 0xf5, 0x1e, 0xae,
+	0x93, 0x84, 0xf6, 0xe5, 0xf6, 0xd3, 0x34, 0x6c, 0xe3, 0x48, 0x5e, 0x99, 0x0b, 0x6d, 0x1c, 0xe1,
+	0xc4, 0xfe, 0x0c, 0xd0, 0xb3, 0x04, 0x47, 0x1f, 0xc2, 0x92, 0x94, 0xef, 0x27, 0xbe, 0x4b, 0x89,
+	0xac, 0xc1, 0x2b, 0x0e, 0xff, 0x84, 0xef, 0x92, 0x61, 0x32, 0x70, 0x29, 0x91, 0x10, 0x3c, 0x8f,
+	0xb5, 0xbf, 0x02, 0x98, 0x4d, 0xf5, 0x69, 0x53, 0xcd, 0xfe, 0x1a, 0xea, 0xda, 0x2a, 0x38, 0x75,
+	0xf7, 0x3f, 0x15, 0x60, 0xae, 0xb3, 0xec, 0x4c, 0xd2, 0x85, 0x7c, 0x4b, 0x1f, 0xb9, 0x37, 0xb2,
+	0x18, 0x4f, 0x84, 0x8f, 0x7c, 0xe4, 0x8a, 0x8b, 0x8f, 0xdc, 0x79, 0x28, 0x3d, 0x70, 0x07, 0x23,
+	0xa2, 0xbe, 0xfd, 0xb8, 0x80, 0xce, 0x41, 0x71, 0xd3, 0xcd, 0xe4, 0x0b, 0xc2, 0x8e, 0x9d, 0xce,
+	0xfe, 0x41, 0xd3, 0x78, 0x7c, 0xd0, 0x34, 0xfe, 0x38, 0x68, 0x1a, 0xbf, 0x3e, 0x6d, 0x1a, 0xfb,
+	0x4f, 0x9b, 0xc6, 0x97, 0x27, 0x84, 0x4f, 0xd4, 0x07, 0x01, 0x3f, 0xf5, 0xca, 0xfc, 0xad, 0x7e,
+	0xfb, 0x9f, 0x01, 0x00, 0x02, 0x74, 0x26, 0x87, 0xe0, 0x0e, 0x00, 0x00,
+}
+
 func (m *StreamEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1702,6 +1796,11 @@ func (m *BlockExecution) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.GasUsed))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2477,6 +2576,9 @@ func (m *BlockExecution) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2879,7 +2981,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2907,7 +3009,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2916,6 +3018,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2940,7 +3045,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2949,6 +3054,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2973,7 +3081,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2982,6 +3090,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3006,7 +3117,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3015,6 +3126,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3039,7 +3153,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3048,6 +3162,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3072,7 +3189,7 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3081,6 +3198,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3100,6 +3220,9 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3128,7 +3251,7 @@ func (m *StreamKey) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3156,7 +3279,7 @@ func (m *StreamKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3175,7 +3298,7 @@ func (m *StreamKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3189,6 +3312,9 @@ func (m *StreamKey) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3217,7 +3343,7 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3245,7 +3371,7 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3264,7 +3390,7 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3273,6 +3399,9 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3292,6 +3421,9 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3320,7 +3452,7 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3348,7 +3480,7 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3362,6 +3494,9 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3390,7 +3525,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3418,7 +3553,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3427,6 +3562,9 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3451,7 +3589,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3460,6 +3598,9 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3484,7 +3625,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3493,6 +3634,9 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3512,6 +3656,9 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3540,7 +3687,7 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3568,7 +3715,7 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3577,6 +3724,9 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3593,6 +3743,9 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3621,7 +3774,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3649,7 +3802,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3668,7 +3821,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3677,6 +3830,9 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3698,7 +3854,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3717,7 +3873,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3736,7 +3892,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3745,6 +3901,9 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3764,6 +3923,9 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3792,7 +3954,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3820,7 +3982,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3839,7 +4001,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3848,6 +4010,9 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3872,7 +4037,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3881,6 +4046,9 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3889,6 +4057,25 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -3898,6 +4085,9 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3926,7 +4116,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3954,7 +4144,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3963,6 +4153,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3987,7 +4180,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3996,6 +4189,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4020,7 +4216,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4029,6 +4225,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4051,7 +4250,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4060,6 +4259,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4084,7 +4286,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4093,6 +4295,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4117,7 +4322,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4126,6 +4331,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4150,7 +4358,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4159,6 +4367,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4176,6 +4387,9 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4204,7 +4418,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4232,7 +4446,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4242,6 +4456,9 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4261,7 +4478,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4280,7 +4497,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4299,7 +4516,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4308,6 +4525,9 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4324,6 +4544,9 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4352,7 +4575,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4380,7 +4603,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4399,7 +4622,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4408,6 +4631,9 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4429,7 +4655,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventType |= (EventType(b) & 0x7F) << shift
+				m.EventType |= EventType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4448,7 +4674,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4458,6 +4684,9 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4477,7 +4706,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4496,7 +4725,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4515,7 +4744,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4524,6 +4753,9 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4543,6 +4775,9 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4571,7 +4806,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4599,7 +4834,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4608,6 +4843,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4632,7 +4870,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4641,6 +4879,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4665,7 +4906,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4674,6 +4915,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4698,7 +4942,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4707,6 +4951,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4731,7 +4978,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4740,6 +4987,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4764,7 +5014,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4773,6 +5023,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4792,6 +5045,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4820,7 +5076,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4848,7 +5104,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4857,6 +5113,9 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4879,7 +5138,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= (uint64(b) & 0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4898,7 +5157,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4907,6 +5166,9 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4931,7 +5193,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4940,6 +5202,9 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4959,6 +5224,9 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4987,7 +5255,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5015,7 +5283,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5024,6 +5292,9 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5045,7 +5316,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5054,6 +5325,9 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5075,7 +5349,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5084,6 +5358,9 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5102,6 +5379,9 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5130,7 +5410,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5158,7 +5438,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5167,6 +5447,9 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5191,7 +5474,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5200,6 +5483,9 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5221,7 +5507,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StackDepth |= (uint64(b) & 0x7F) << shift
+				m.StackDepth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5240,7 +5526,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5249,6 +5535,9 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5270,7 +5559,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallType |= (CallType(b) & 0x7F) << shift
+				m.CallType |= CallType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5284,6 +5573,9 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5312,7 +5604,7 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5340,7 +5632,7 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5349,6 +5641,9 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5368,6 +5663,9 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5396,7 +5694,7 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5424,7 +5722,7 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5433,6 +5731,9 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5449,6 +5750,9 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5477,7 +5781,7 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5505,7 +5809,7 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5514,6 +5818,9 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5530,6 +5837,9 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5558,7 +5868,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -5586,7 +5896,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5595,6 +5905,9 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5616,7 +5929,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5625,6 +5938,9 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5646,7 +5962,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5655,6 +5971,9 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthExec
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5676,7 +5995,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5695,7 +6014,7 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= (uint64(b) & 0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5709,6 +6028,9 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5776,10 +6098,13 @@ func skipExec(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthExec
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthExec
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -5808,6 +6133,9 @@ func skipExec(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthExec
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -5826,88 +6154,3 @@ var (
 	ErrInvalidLengthExec = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowExec   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("exec.proto", fileDescriptor_exec_bec10a2fd3823f07) }
-func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_exec_bec10a2fd3823f07) }
-
-var fileDescriptor_exec_bec10a2fd3823f07 = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0xaf, 0x6c, 0xf9, 0xdf, 0xda, 0x29, 0x61, 0x27, 0x30, 0x9e, 0x1c, 0xec, 0xa0, 0x42, 0x29,
-	0xa1, 0x95, 0x3b, 0x81, 0xf0, 0x27, 0xcc, 0x30, 0x13, 0x37, 0x99, 0x24, 0x34, 0xb4, 0xb0, 0x75,
-	0xcb, 0xc0, 0xc0, 0x41, 0x96, 0x16, 0x59, 0x53, 0x5b, 0xd2, 0x48, 0xeb, 0x20, 0x7f, 0x01, 0x0e,
-	0x0c, 0x07, 0xb8, 0x95, 0x0b, 0xd3, 0xef, 0xc1, 0x85, 0x63, 0x6e, 0xf4, 0xdc, 0x83, 0x61, 0xd2,
-	0x4f, 0xc0, 0x70, 0x22, 0x27, 0x66, 0x77, 0xdf, 0xca, 0x12, 0x4d, 0x93, 0x0e, 0xce, 0x81, 0x8b,
-	0x66, 0xdf, 0x7b, 0xbf, 0x7d, 0x7a, 0xef, 0xed, 0xef, 0xbd, 0x5d, 0x84, 0x68, 0x42, 0x6d, 0x33,
-	0x8c, 0x02, 0x16, 0x60, 0x9d, 0xaf, 0x97, 0xaf, 0xb9, 0x1e, 0x1b, 0x8c, 0xfb, 0xa6, 0x1d, 0x8c,
-	0x3a, 0x6e, 0xe0, 0x06, 0x1d, 0x61, 0xec, 0x8f, 0xbf, 0x16, 0x92, 0x10, 0xc4, 0x4a, 0x6e, 0x5a,
-	0x7e, 0x37, 0x03, 0x67, 0xd4, 0x77, 0x68, 0x34, 0xf2, 0x7c, 0x96, 0x5d, 0x5a, 0x7d, 0xdb, 0xeb,
-	0xb0, 0x49, 0x48, 0x63, 0xf9, 0x85, 0x8d, 0x6d, 0x37, 0x08, 0xdc, 0x21, 0x9d, 0xb9, 0x67, 0xde,
-	0x88, 0xc6, 0xcc, 0x1a, 0x85, 0x00, 0x68, 0xd0, 0x28, 0x0a, 0x22, 0x05, 0xaf, 0xfb, 0xd6, 0x28,
-	0xdd, 0x5b, 0x63, 0x89, 0x5a, 0x2e, 0x86, 0xfc, 0x37, 0x71, 0xec, 0x05, 0x3e, 0x68, 0x50, 0x1c,
-	0xaa, 0x94, 0x8c, 0x5f, 0x0a, 0xa8, 0x7e, 0x87, 0x45, 0xd4, 0x1a, 0x6d, 0x1f, 0x50, 0x9f, 0xe1,
-	0xeb, 0x08, 0x75, 0xa9, 0xeb, 0xf9, 0xdd, 0x61, 0x60, 0xdf, 0x6f, 0x6a, 0x2b, 0xda, 0x95, 0xfa,
-	0xda, 0xa2, 0x29, 0x6a, 0x30, 0xd3, 0x93, 0x0c, 0x06, 0xbf, 0x8e, 0x2a, 0x42, 0xea, 0x25, 0xcd,
-	0x82, 0x80, 0x2f, 0x64, 0xe0, 0xbd, 0x84, 0x28, 0x2b, 0xfe, 0x1c, 0x55, 0xb7, 0xfd, 0x03, 0x3a,
-	0x0c, 0x42, 0xda, 0x2c, 0x02, 0x92, 0x87, 0xa9, 0x94, 0x5d, 0xf3, 0xf1, 0xb4, 0xbd, 0x9a, 0xa9,
-	0xd6, 0x60, 0x12, 0xd2, 0x68, 0x48, 0x1d, 0x97, 0x46, 0x9d, 0xfe, 0x38, 0x8a, 0x82, 0x6f, 0x3a,
-	0x59, 0x3c, 0x49, 0xdd, 0xe1, 0x57, 0x50, 0x49, 0x84, 0xdf, 0xd4, 0x85, 0xdf, 0xba, 0x8c, 0x40,
-	0xa8, 0x88, 0xb4, 0x08, 0x88, 0xef, 0xf4, 0x92, 0x66, 0x29, 0x07, 0xe1, 0x2a, 0x22, 0x2d, 0x78,
-	0x95, 0x07, 0xe8, 0xc8, 0xcc, 0xcb, 0x02, 0x75, 0x31, 0x45, 0xc9, 0xbc, 0x53, 0xfb, 0x86, 0x7e,
-	0xf8, 0xb0, 0xad, 0x19, 0xef, 0xa3, 0x9a, 0x2c, 0xde, 0x4d, 0x3a, 0xc1, 0x2f, 0xa3, 0xf2, 0x2e,
-	0xf5, 0xdc, 0x01, 0x13, 0x65, 0xd3, 0x09, 0x48, 0x78, 0x09, 0x95, 0xf6, 0x7c, 0x87, 0xca, 0xf2,
-	0xe8, 0x44, 0x0a, 0xc6, 0xcd, 0x6c, 0xa1, 0x9f, 0xb9, 0xf7, 0x35, 0xae, 0xb7, 0x1c, 0x1a, 0xa5,
-	0xb5, 0x95, 0x0c, 0x91, 0x4a, 0x02, 0x46, 0xc3, 0x98, 0x45, 0xfe, 0x2c, 0x57, 0xc6, 0xf7, 0x5a,
-	0x7a, 0x50, 0x3c, 0xd3, 0x5e, 0x02, 0x8e, 0xb5, 0x6c, 0xa6, 0x4a, 0x4b, 0x52, 0x3b, 0x7e, 0x15,
-	0x95, 0x09, 0x8d, 0xc7, 0x43, 0x06, 0x21, 0x34, 0x24, 0x52, 0xea, 0x08, 0xd8, 0x70, 0x07, 0xd5,
-	0xb6, 0x13, 0x9b, 0x86, 0xcc, 0x0b, 0x7c, 0x38, 0x85, 0x17, 0x4d, 0xe0, 0x67, 0x6a, 0x20, 0x33,
-	0x8c, 0x71, 0x0f, 0xce, 0x03, 0x7f, 0x8c, 0xca, 0xbd, 0x64, 0xd7, 0x8a, 0x07, 0x82, 0x14, 0x8d,
-	0xee, 0xfa, 0xe1, 0xb4, 0x7d, 0xe1, 0xf1, 0xb4, 0x7d, 0xed, 0x74, 0x26, 0xf4, 0x3d, 0xdf, 0x8a,
-	0x26, 0xe6, 0x2e, 0x4d, 0xba, 0x13, 0x46, 0x63, 0x02, 0x4e, 0x8c, 0xbf, 0xb5, 0x59, 0x6e, 0xf8,
-	0x23, 0xee, 0xbb, 0x37, 0x09, 0xa9, 0xc8, 0x72, 0xa1, 0xbb, 0x76, 0x3c, 0x6d, 0x9b, 0x67, 0x32,
-	0xac, 0x13, 0x5a, 0x93, 0x61, 0x60, 0x39, 0x26, 0xdf, 0x49, 0xc0, 0x43, 0x26, 0xce, 0xc2, 0x39,
-	0xc4, 0x99, 0x39, 0xa6, 0xe2, 0xc9, 0x6c, 0xd1, 0x33, 0x6c, 0xe1, 0x87, 0x70, 0x3b, 0xf2, 0x5c,
-	0xcf, 0x07, 0xfa, 0xc2, 0x21, 0x48, 0x1d, 0x01, 0x9b, 0xf1, 0xad, 0x86, 0x2e, 0x0a, 0x12, 0x6c,
-	0x27, 0xd4, 0x1e, 0xf3, 0x32, 0xcf, 0x49, 0x2c, 0xbc, 0x8e, 0x1a, 0xbd, 0x24, 0xf5, 0x16, 0x37,
-	0x8b, 0x2b, 0x45, 0x79, 0xb2, 0x92, 0x2c, 0xa9, 0x85, 0xe4, 0x60, 0xc6, 0x9f, 0x05, 0x54, 0xcf,
-	0x28, 0xf0, 0xd5, 0xf4, 0x6f, 0x27, 0xb2, 0xad, 0xab, 0x3f, 0x9a, 0xb6, 0xb5, 0xf4, 0xa7, 0xd9,
-	0x41, 0x51, 0x3e, 0xdf, 0x41, 0x71, 0x09, 0x95, 0xc5, 0x38, 0x88, 0x9b, 0x15, 0x91, 0x49, 0x6e,
-	0x52, 0x80, 0x29, 0xc3, 0xf8, 0xea, 0x29, 0x8c, 0xbf, 0x8c, 0x2a, 0x84, 0xda, 0xd4, 0x0b, 0x59,
-	0xb3, 0x06, 0x30, 0xfe, 0x53, 0xd0, 0x11, 0x65, 0xcc, 0x77, 0x06, 0x3a, 0xbb, 0x33, 0x9e, 0xaa,
-	0x79, 0xfd, 0xf9, 0x6a, 0xfe, 0x9d, 0xa6, 0x38, 0x82, 0x9b, 0xa8, 0x72, 0x63, 0x60, 0x79, 0xfe,
-	0xde, 0x96, 0xa8, 0x77, 0x8d, 0x28, 0x31, 0x43, 0x87, 0xc2, 0xc9, 0xac, 0x2b, 0x66, 0x59, 0xf7,
-	0x1e, 0xd2, 0x7b, 0xde, 0x88, 0x42, 0x3f, 0x2f, 0x9b, 0xf2, 0x42, 0x32, 0xd5, 0x85, 0x64, 0xf6,
-	0xd4, 0x85, 0xd4, 0xad, 0xf2, 0x66, 0xf8, 0xe1, 0xf7, 0xb6, 0x46, 0xc4, 0x0e, 0xe3, 0xb7, 0x82,
-	0x3a, 0xf1, 0xff, 0x73, 0x0f, 0xbe, 0x89, 0x6a, 0xe2, 0xc8, 0x45, 0x74, 0x45, 0x11, 0xdd, 0xc2,
-	0xf1, 0xb4, 0x3d, 0x53, 0x92, 0xd9, 0x92, 0x17, 0x55, 0x08, 0x7b, 0x5b, 0xa2, 0x1e, 0x35, 0xa2,
-	0xc4, 0x4c, 0x51, 0x4b, 0x27, 0x17, 0xb5, 0x9c, 0x2d, 0x6a, 0x8e, 0x0f, 0x95, 0xb3, 0xf9, 0xb0,
-	0xa1, 0x3f, 0x78, 0xd8, 0xbe, 0x60, 0xfc, 0x58, 0x80, 0x3b, 0x8e, 0xd3, 0x33, 0xd7, 0x4c, 0x40,
-	0xcf, 0x7f, 0x75, 0xee, 0x65, 0xfe, 0xf3, 0x70, 0xac, 0xa6, 0x36, 0xdc, 0xe1, 0x42, 0x05, 0xf7,
-	0xa2, 0x58, 0xe3, 0x37, 0x50, 0xf9, 0xf6, 0x98, 0x71, 0x60, 0x51, 0xc5, 0x22, 0x26, 0x8b, 0xd0,
-	0x41, 0x5f, 0x48, 0x01, 0x5f, 0x42, 0xfa, 0x0d, 0x6b, 0x38, 0x04, 0x3a, 0xbc, 0x20, 0x81, 0x5c,
-	0x23, 0x61, 0xc2, 0x88, 0x57, 0x50, 0x71, 0x3f, 0x70, 0x61, 0x4c, 0x41, 0x9f, 0xef, 0x07, 0xae,
-	0x84, 0x70, 0x13, 0xfe, 0x10, 0x2d, 0xec, 0x04, 0x07, 0x34, 0xf2, 0x37, 0x6d, 0x3b, 0x18, 0xfb,
-	0x0c, 0x7a, 0xbc, 0x29, 0xb1, 0x39, 0x93, 0xdc, 0x95, 0x87, 0x6f, 0x54, 0x79, 0x3d, 0xc4, 0xf5,
-	0xfb, 0x40, 0x53, 0x9d, 0xca, 0xcf, 0x80, 0x50, 0x36, 0x8e, 0x7c, 0x51, 0x94, 0x06, 0x01, 0x89,
-	0x9f, 0xda, 0x8e, 0x15, 0xdf, 0x8d, 0xa9, 0x03, 0x8c, 0x57, 0x22, 0x5e, 0x45, 0xb5, 0x5b, 0xd6,
-	0x88, 0x6e, 0xfb, 0x2c, 0x9a, 0x40, 0xee, 0x0d, 0x53, 0xbe, 0xa1, 0x84, 0x8e, 0xcc, 0xcc, 0xf8,
-	0x3a, 0xaa, 0x7e, 0x42, 0xa3, 0xd1, 0x66, 0xe4, 0xc6, 0x90, 0xfd, 0x92, 0x99, 0x79, 0x56, 0x29,
-	0x1b, 0x49, 0x51, 0xc6, 0x5f, 0x1a, 0xaa, 0xaa, 0xb4, 0xf1, 0x2d, 0x54, 0xd9, 0x74, 0x9c, 0x88,
-	0xc6, 0xb1, 0x8c, 0xae, 0xfb, 0x36, 0xf0, 0xf6, 0xea, 0xe9, 0xbc, 0xb5, 0xa3, 0x49, 0xc8, 0x02,
-	0x13, 0xf6, 0x12, 0xe5, 0x04, 0xef, 0x21, 0x7d, 0xcb, 0x62, 0xd6, 0x7c, 0x4d, 0x20, 0x5c, 0xe0,
-	0x7d, 0x54, 0xee, 0x05, 0xa1, 0x67, 0xcb, 0xd1, 0xfe, 0xdc, 0x91, 0x81, 0xb3, 0xcf, 0x82, 0xc8,
-	0x59, 0x5b, 0x7f, 0x87, 0x80, 0x0f, 0xe3, 0xe7, 0x02, 0xaa, 0xa5, 0x84, 0xe0, 0xaf, 0x0c, 0x2e,
-	0x88, 0x50, 0x73, 0x73, 0x5f, 0x69, 0x49, 0x6a, 0xe7, 0x71, 0xc0, 0x05, 0x57, 0x98, 0xa3, 0x42,
-	0x6a, 0x00, 0xb6, 0x10, 0xba, 0xc3, 0x2c, 0xfb, 0xfe, 0x16, 0x0d, 0xd9, 0x00, 0x66, 0x5a, 0x46,
-	0xc3, 0xe7, 0x08, 0xb0, 0x45, 0x9f, 0x6b, 0x8e, 0x00, 0xc9, 0xae, 0xc8, 0x44, 0xc5, 0x18, 0x29,
-	0x89, 0x31, 0xd2, 0x38, 0x9e, 0xb6, 0x53, 0x1d, 0x49, 0x57, 0xc6, 0xa7, 0x08, 0x3f, 0x4d, 0x70,
-	0xfc, 0x01, 0x5a, 0x00, 0xf9, 0x6e, 0xe8, 0x58, 0x8c, 0x42, 0xb5, 0x5e, 0x32, 0xc5, 0x43, 0xbd,
-	0x47, 0x47, 0xe1, 0xd0, 0x62, 0x14, 0x20, 0x24, 0x8f, 0x35, 0xbe, 0x44, 0x68, 0xd6, 0xd5, 0xe7,
-	0x4d, 0x35, 0xe3, 0x2b, 0x54, 0xcf, 0x8c, 0x82, 0x73, 0x77, 0xff, 0x53, 0x01, 0xe5, 0x38, 0xc0,
-	0xd7, 0x30, 0xd8, 0xfe, 0x33, 0x07, 0xa4, 0x8f, 0xd4, 0x1b, 0x9d, 0x8f, 0x51, 0xd2, 0x47, 0xda,
-	0x72, 0xc5, 0xf9, 0x5b, 0x6e, 0x09, 0x95, 0xee, 0x59, 0xc3, 0x31, 0x55, 0x2f, 0x3c, 0x21, 0xe0,
-	0x45, 0x54, 0xdc, 0xb1, 0x62, 0xb8, 0x41, 0xf8, 0xb2, 0xdb, 0x3d, 0x3c, 0x6a, 0x69, 0x8f, 0x8e,
-	0x5a, 0xda, 0x1f, 0x47, 0x2d, 0xed, 0xd7, 0x27, 0x2d, 0xed, 0xf0, 0x49, 0x4b, 0xfb, 0xe2, 0x8c,
-	0xf0, 0xa9, 0x7a, 0x10, 0x88, 0x55, 0xbf, 0x2c, 0xee, 0xea, 0xb7, 0xfe, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x91, 0xae, 0x78, 0x84, 0xc6, 0x0e, 0x00, 0x00,
-}
@@ -17,8 +17,11 @@ package execution
 import (
 	"context"
 	"fmt"
+	"math"
 	"runtime/debug"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/genesis"
@@ -34,11 +37,13 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/expiry"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/tracing"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
@@ -52,8 +57,13 @@ type ExecutorState interface {
 	Update(updater func(ws state.Updatable) error) (hash []byte, version int64, err error)
 	names.Reader
 	proposal.Reader
+	expiry.Reader
 	acmstate.IterableReader
 	validator.IterableReader
+	// Storage rent is collected in batches of accounts starting from the cursor
+	GetStorageRentCursor() (*state.StorageRentCursor, error)
+	IterateAccountsFrom(start crypto.Address, consumer func(*acm.Account) error) error
+	IterateStorageFrom(address crypto.Address, start binary.Word256, consumer func(key, value binary.Word256) error) error
 }
 
 type BatchExecutor interface {
@@ -76,6 +86,7 @@ type BatchCommitter interface {
 
 type executor struct {
 	sync.RWMutex
+	name             string
 	runCall          bool
 	params           Params
 	state            ExecutorState
@@ -83,23 +94,61 @@ type executor struct {
 	nameRegCache     *names.Cache
 	proposalRegCache *proposal.Cache
 	validatorCache   *validator.Cache
+	txExpiryCache    *expiry.Cache
+	blockchain       contexts.BlockchainHeight
 	publisher        event.Publisher
 	block            *exec.BlockExecution
 	logger           *logging.Logger
 	vmOptions        []func(*evm.VM)
+	natives          *evm.Natives
 	contexts         map[payload.Type]contexts.Context
+	// Only used by simulated calls
+	estimateGasMarginPercent uint64
+	simulationTimeout        time.Duration
 }
 
 type Params struct {
 	ChainID           string
 	ProposalThreshold uint64
+	EVMVersion        evm.Version
+	// The maximum total gas the CallTxs in a block may reserve, unlimited when zero
+	BlockGasLimit uint64
+	// Rent charged per word of contract storage every StorageRentPeriod blocks, disabled when zero
+	StorageRentPerWord uint64
+	StorageRentPeriod  uint64
+	// How far beyond the current height a transaction input's ExpiryHeight may be, expiring inputs are disabled when zero
+	MaxTxExpiryBlocks uint64
+	// Whether names can be transferred and are hierarchical
+	NameOwnership bool
+	// Whether contracts may be WASM modules
+	WASM bool
 }
 
-func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
-	return Params{
-		ChainID:           genesisDoc.ChainID(),
-		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
+func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) (Params, error) {
+	evmVersion, err := evm.VersionFromString(genesisDoc.Params.EVMVersion)
+	if err != nil {
+		return Params{}, err
+	}
+	// Tendermint takes the block gas limit as an int64 (see tendermint.DeriveGenesisDoc)
+	if genesisDoc.Params.BlockGasLimit > math.MaxInt64 {
+		return Params{}, fmt.Errorf("BlockGasLimit %d exceeds the maximum of %d", genesisDoc.Params.BlockGasLimit,
+			int64(math.MaxInt64))
 	}
+	storageRentPeriod := genesisDoc.Params.StorageRentPeriod
+	if storageRentPeriod == 0 {
+		storageRentPeriod = genesis.DefaultStorageRentPeriod
+	}
+	return Params{
+		ChainID:            genesisDoc.ChainID(),
+		ProposalThreshold:  genesisDoc.Params.ProposalThreshold,
+		EVMVersion:         evmVersion,
+		BlockGasLimit:      genesisDoc.Params.BlockGasLimit,
+		StorageRentPerWord: genesisDoc.Params.StorageRentPerWord,
+		StorageRentPeriod:  storageRentPeriod,
+		MaxTxExpiryBlocks:  genesisDoc.Params.MaxTxExpiryBlocks,
+		NameOwnership:      genesisDoc.Params.NameOwnership,
+		WASM:               genesisDoc.Params.WASM,
+	}, nil
 }
 
 var _ BatchExecutor = (*executor)(nil)
@@ -123,6 +172,7 @@ func NewBatchCommitter(backend ExecutorState, params Params, blockchain contexts
 func newExecutor(name string, runCall bool, params Params, backend ExecutorState, blockchain contexts.Blockchain,
 	publisher event.Publisher, logger *logging.Logger, options ...ExecutionOption) *executor {
 	exe := &executor{
+		name:             name,
 		runCall:          runCall,
 		params:           params,
 		state:            backend,
@@ -130,6 +180,8 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		nameRegCache:     names.NewCache(backend),
 		proposalRegCache: proposal.NewCache(backend),
 		validatorCache:   validator.NewCache(backend),
+		txExpiryCache:    expiry.NewCache(backend),
+		blockchain:       blockchain,
 		publisher:        publisher,
 		block: &exec.BlockExecution{
 			Height: blockchain.LastBlockHeight() + 1,
@@ -139,6 +191,9 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 	for _, option := range options {
 		option(exe)
 	}
+	if exe.storageRentEnabled() {
+		exe.registerStorageRentContract()
+	}
 
 	baseContexts := map[payload.Type]contexts.Context{
 		payload.TypeSend: &contexts.SendContext{
@@ -149,14 +204,19 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			Blockchain:  blockchain,
 			StateWriter: exe.stateCache,
 			RunCall:     runCall,
+			ChainID:     params.ChainID,
+			EVMVersion:  params.EVMVersion,
+			WASM:        params.WASM,
 			VMOptions:   exe.vmOptions,
+			Natives:     exe.natives,
 			Logger:      exe.logger,
 		},
 		payload.TypeName: &contexts.NameContext{
-			Blockchain:  blockchain,
-			StateWriter: exe.stateCache,
-			NameReg:     exe.nameRegCache,
-			Logger:      exe.logger,
+			Blockchain:    blockchain,
+			StateWriter:   exe.stateCache,
+			NameReg:       exe.nameRegCache,
+			NameOwnership: params.NameOwnership,
+			Logger:        exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
 			StateWriter: exe.stateCache,
@@ -196,6 +256,21 @@ func (exe *executor) AddContext(ty payload.Type, ctx contexts.Context) *executor
 // If the tx is invalid, an error will be returned.
 // Unlike ExecBlock(), state will not be altered.
 func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err error) {
+	// Registered first so that it observes any error recovered from a panic
+	start := time.Now()
+	payloadType := txEnv.Tx.Type().String()
+	defer func() {
+		txDuration.WithLabelValues(exe.name, payloadType).Observe(time.Since(start).Seconds())
+		if err != nil {
+			txErrors.WithLabelValues(exe.name, payloadType).Inc()
+		}
+	}()
+	_, span := tracing.StartTx(context.Background(), txEnv.Tx.Hash(), "executor.Execute")
+	span.SetAttribute("executor", exe.name)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("recovered from panic in executor.Execute(%s): %v\n%s", txEnv.String(), r,
@@ -217,6 +292,11 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 	}
 
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
+		err = exe.checkBlockGas(txEnv.Tx.Payload)
+		if err != nil {
+			logger.InfoMsg("Transaction exceeds block gas limit", structure.ErrorKey, err)
+			return nil, err
+		}
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
 		defer func() {
@@ -236,7 +316,15 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			return nil, err
 		}
 
-		err = txExecutor.Execute(txe, txe.Envelope.Tx.Payload)
+		// Check expiry heights and that transactions protected from replay by their hash have not already executed
+		err = exe.checkExpiry(txEnv.Tx)
+		if err != nil {
+			logger.InfoMsg("Transaction expiry check failed", structure.ErrorKey, err)
+			txe.PushError(err)
+			return nil, err
+		}
+
+		err = executeContext(txExecutor, txe)
 		if err != nil {
 			logger.InfoMsg("Transaction execution failed", structure.ErrorKey, err)
 			txe.PushError(err)
@@ -250,12 +338,89 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			txe.PushError(err)
 			return nil, err
 		}
+		exe.block.GasUsed += txe.Result.GetGasUsed()
+		gasUsed.WithLabelValues(exe.name).Add(float64(txe.Result.GetGasUsed()))
 		// Return execution for this tx
 		return txe, nil
 	}
 	return nil, fmt.Errorf("unknown transaction type: %v", txEnv.Tx.Type())
 }
 
+// Ensures that a CallTx could not take the gas used by the current block over the block gas limit were it to consume
+// its entire GasLimit. The checker never runs calls so this just rejects CallTxs that could never fit in any block,
+// whereas when delivering it bounds the total gas consumed by the block.
+func (exe *executor) checkBlockGas(pay payload.Payload) error {
+	if exe.params.BlockGasLimit == 0 {
+		return nil
+	}
+	callTx, ok := pay.(*payload.CallTx)
+	if !ok {
+		return nil
+	}
+	if callTx.GasLimit > exe.params.BlockGasLimit-exe.block.GasUsed {
+		return errors.ErrorCodef(errors.ErrorCodeBlockGasLimitExceeded,
+			"CallTx has GasLimit %d but only %d of the block gas limit %d remains at height %d",
+			callTx.GasLimit, exe.params.BlockGasLimit-exe.block.GasUsed, exe.params.BlockGasLimit, exe.block.Height)
+	}
+	return nil
+}
+
+// Execute the payload of txe in a span named for the context type, for example contexts.CallContext.Execute
+func executeContext(txExecutor contexts.Context, txe *exec.TxExecution) (err error) {
+	if tracing.Enabled() {
+		name := strings.TrimPrefix(fmt.Sprintf("%T.Execute", txExecutor), "*")
+		_, span := tracing.StartTx(context.Background(), txe.TxHash, name)
+		defer func() {
+			span.SetError(err)
+			span.End()
+		}()
+	}
+	return txExecutor.Execute(txe, txe.Envelope.Tx.Payload)
+}
+
+// Inputs with an ExpiryHeight must expire no earlier than the height at which they execute and no later than
+// MaxTxExpiryBlocks beyond it, and their transaction must not already have executed. They are rejected if
+// MaxTxExpiryBlocks is zero.
+func (exe *executor) checkExpiry(tx *txs.Tx) error {
+	expiryHeight, ok := txExpiryHeight(tx)
+	if !ok {
+		return nil
+	}
+	if exe.params.MaxTxExpiryBlocks == 0 {
+		return errors.ErrorCodef(errors.ErrorCodeInvalidExpiryHeight, "transaction %v has an input with an "+
+			"ExpiryHeight but expiring transactions are not enabled on this chain", tx.Hash())
+	}
+	// The block being checked or delivered, the checker's block height is not advanced on commit
+	height := exe.blockchain.LastBlockHeight() + 1
+	for _, in := range tx.GetInputs() {
+		if in.Expires() && (in.ExpiryHeight < height || in.ExpiryHeight-height > exe.params.MaxTxExpiryBlocks) {
+			return errors.ErrorCodef(errors.ErrorCodeInvalidExpiryHeight, "input %v has ExpiryHeight %d but "+
+				"inputs executing at height %d must have an ExpiryHeight between %d and %d", in, in.ExpiryHeight,
+				height, height, height+exe.params.MaxTxExpiryBlocks)
+		}
+	}
+	executed, err := exe.txExpiryCache.HasTx(expiryHeight, tx.Hash())
+	if err != nil {
+		return err
+	}
+	if executed {
+		return errors.ErrorCodef(errors.ErrorCodeDuplicateTx, "transaction %v has already been executed", tx.Hash())
+	}
+	return nil
+}
+
+// The earliest ExpiryHeight of a transaction's inputs after which it can no longer execute and need not be remembered,
+// false if none of its inputs expire
+func txExpiryHeight(tx *txs.Tx) (uint64, bool) {
+	var expiryHeight uint64
+	for _, in := range tx.GetInputs() {
+		if in.Expires() && (expiryHeight == 0 || in.ExpiryHeight < expiryHeight) {
+			expiryHeight = in.ExpiryHeight
+		}
+	}
+	return expiryHeight, expiryHeight != 0
+}
+
 func validateInputs(tx *txs.Tx, getter acmstate.AccountGetter) error {
 	for _, in := range tx.GetInputs() {
 		acc, err := getter.GetAccount(in.Address)
@@ -270,8 +435,8 @@ func validateInputs(tx *txs.Tx, getter acmstate.AccountGetter) error {
 			return fmt.Errorf("trying to validate input from address %v but passed account %v", in.Address,
 				acc.GetAddress())
 		}
-		// Check sequences
-		if acc.Sequence+1 != uint64(in.Sequence) {
+		// Check sequences unless the transaction is protected from replay by its hash
+		if !in.Expires() && acc.Sequence+1 != uint64(in.Sequence) {
 			return errors.ErrorCodef(errors.ErrorCodeInvalidSequence, "Error invalid sequence in input %v: input has sequence %d, but account has sequence %d, "+
 				"so expected input to have sequence %d", in, in.Sequence, acc.Sequence, acc.Sequence+1)
 		}
@@ -304,6 +469,13 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	// Capture height
 	height := exe.block.Height
 	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	var rentCursor *state.StorageRentCursor
+	if exe.storageRentEnabled() {
+		rentCursor, err = exe.collectStorageRent(height)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
@@ -329,6 +501,21 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 		if err != nil {
 			return err
 		}
+		err = exe.txExpiryCache.Flush(ws, exe.state)
+		if err != nil {
+			return err
+		}
+		if exe.storageRentEnabled() {
+			err = ws.SetStorageRentCursor(rentCursor)
+			if err != nil {
+				return err
+			}
+		}
+		// Transactions expiring at this height cannot be executed in any later block so we can forget them
+		err = ws.PruneTxs(height + 1)
+		if err != nil {
+			return err
+		}
 		err = ws.AddBlock(blockExecution)
 		if err != nil {
 			return err
@@ -343,6 +530,7 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 		return nil, fmt.Errorf("expected height at state tree version %d is %d but actual height is %d",
 			version, expectedHeight, height)
 	}
+	blockTxs.Observe(float64(len(blockExecution.TxExecutions)))
 	// Now state is fully committed publish events (this should be the last thing we do)
 	exe.publishBlock(blockExecution)
 	return hash, nil
@@ -354,6 +542,7 @@ func (exe *executor) Reset() error {
 	exe.nameRegCache.Reset(exe.state)
 	exe.proposalRegCache.Reset(exe.state)
 	exe.validatorCache.Reset(exe.state)
+	exe.txExpiryCache.Reset(exe.state)
 	return nil
 }
 
@@ -396,9 +585,10 @@ func (exe *executor) finaliseBlockExecution(header *abciTypes.Header) (*exec.Blo
 	return be, nil
 }
 
-// Capture public keys and update sequence numbers
+// Capture public keys and update sequence numbers, or record the hash of transactions protected from replay by it
 func (exe *executor) updateSignatories(txEnv *txs.Envelope) error {
-	for _, sig := range txEnv.Signatories {
+	inputs := txEnv.Tx.GetInputs()
+	for i, sig := range txEnv.Signatories {
 		// pointer dereferences are safe since txEnv.Validate() is run by txEnv.Verify() above which checks they are
 		// non-nil
 		acc, err := exe.stateCache.GetAccount(*sig.Address)
@@ -412,17 +602,23 @@ func (exe *executor) updateSignatories(txEnv *txs.Envelope) error {
 		}
 		acc.PublicKey = *sig.PublicKey
 
-		exe.logger.TraceMsg("Incrementing sequence number Tx signatory/input",
-			"tag", "sequence",
-			"account", acc.Address,
-			"old_sequence", acc.Sequence,
-			"new_sequence", acc.Sequence+1)
-		acc.Sequence++
+		// Verify has checked that signatories and inputs are in the same order
+		if !inputs[i].Expires() {
+			exe.logger.TraceMsg("Incrementing sequence number Tx signatory/input",
+				"tag", "sequence",
+				"account", acc.Address,
+				"old_sequence", acc.Sequence,
+				"new_sequence", acc.Sequence+1)
+			acc.Sequence++
+		}
 		err = exe.stateCache.UpdateAccount(acc)
 		if err != nil {
 			return fmt.Errorf("error updating account after setting public key: %v", err)
 		}
 	}
+	if expiryHeight, ok := txExpiryHeight(txEnv.Tx); ok {
+		return exe.txExpiryCache.AddTx(expiryHeight, txEnv.Tx.Hash())
+	}
 	return nil
 }
 
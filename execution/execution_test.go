@@ -16,7 +16,11 @@ package execution
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
 	"runtime/debug"
 	"strconv"
 	"testing"
@@ -39,6 +43,7 @@ import (
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
@@ -48,6 +53,7 @@ import (
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/tracing"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
@@ -940,6 +946,68 @@ func TestTxSequence(t *testing.T) {
 	}
 }
 
+func TestExpiringTxs(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, true, 1000, 1, true, 1000)
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+
+	exe := makeExecutor(st)
+	height := exe.LastBlockHeight() + 1
+
+	send := func(nonce, expiryHeight uint64) *txs.Envelope {
+		txEnv := txs.Enclose(testChainID, &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: acc0.Address, Amount: 1, Sequence: nonce, ExpiryHeight: expiryHeight}},
+			Outputs: []*payload.TxOutput{{Address: acc1.Address, Amount: 1}},
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		return txEnv
+	}
+
+	// Disabled by default
+	_, err := exe.Execute(send(1, height+5))
+	assertErrorCode(t, errors.ErrorCodeInvalidExpiryHeight, err)
+	exe.params.MaxTxExpiryBlocks = 10
+
+	// Executed in any order without regard to the account's sequence
+	second := send(2, height+5)
+	first := send(1, height+5)
+	for _, txEnv := range []*txs.Envelope{second, first} {
+		_, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+	}
+	_, err = exe.Execute(first)
+	assertErrorCode(t, errors.ErrorCodeDuplicateTx, err)
+
+	// Sequential transactions from the account are unaffected
+	tx := payload.NewSendTx()
+	require.NoError(t, tx.AddInputWithSequence(privAccounts[0].GetPublicKey(), 1, acc0.Sequence+1))
+	tx.AddOutput(acc1.Address, 1)
+	require.NoError(t, exe.signExecuteCommit(tx, privAccounts[0]))
+	assert.Equal(t, acc0.Sequence+1, exe.getAccount(t, acc0.Address).Sequence)
+	assert.Equal(t, acc0.Balance-3, exe.getAccount(t, acc0.Address).Balance)
+
+	// Remembered once committed
+	_, err = exe.Execute(second)
+	assertErrorCode(t, errors.ErrorCodeDuplicateTx, err)
+	executed, err := st.HasTx(height+5, second.Tx.Hash())
+	require.NoError(t, err)
+	assert.True(t, executed)
+
+	_, err = exe.Execute(send(3, height))
+	assertErrorCode(t, errors.ErrorCodeInvalidExpiryHeight, err)
+	_, err = exe.Execute(send(4, height+12))
+	assertErrorCode(t, errors.ErrorCodeInvalidExpiryHeight, err)
+
+	// And forgotten once they can no longer execute
+	for exe.LastBlockHeight() < height+5 {
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+	}
+	executed, err = st.HasTx(height+5, second.Tx.Hash())
+	require.NoError(t, err)
+	assert.False(t, executed)
+}
+
 func TestNameTxs(t *testing.T) {
 	st, err := state.MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
 	require.NoError(t, err)
@@ -1105,6 +1173,115 @@ func TestNameTxs(t *testing.T) {
 	}
 }
 
+func TestNameTxOwnership(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
+	require.NoError(t, err)
+	st.SetNameOwnership(true)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+
+	names.MinNameRegistrationPeriod = 5
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	params.NameOwnership = true
+	exe := makeExecutorWithParams(st, params)
+
+	fee := uint64(1000)
+	data := "service directory"
+	amt := fee + 10*names.NameBaseCost("", data)
+	owner := testPrivAccounts[0]
+	other := testPrivAccounts[1]
+
+	namesOwnedBy := func(address crypto.Address) []string {
+		var owned []string
+		err := st.IterateNamesByOwner(address, func(entry *names.Entry) error {
+			owned = append(owned, entry.Name)
+			return nil
+		})
+		require.NoError(t, err)
+		return owned
+	}
+
+	tx, _ := payload.NewNameTx(st, owner.GetPublicKey(), "burrow", data, amt, fee)
+	require.NoError(t, exe.signExecuteCommit(tx, owner))
+
+	// Only the owner of the parent may register a subdomain
+	tx, _ = payload.NewNameTx(st, other.GetPublicKey(), "api.burrow", data, amt, fee)
+	require.Error(t, exe.signExecuteCommit(tx, other))
+
+	// The parent owner may register a subdomain on behalf of another account
+	tx, _ = payload.NewNameTx(st, owner.GetPublicKey(), "api.burrow", data, amt, fee)
+	otherAddress := other.GetAddress()
+	tx.Owner = &otherAddress
+	require.NoError(t, exe.signExecuteCommit(tx, owner))
+
+	entry, err := st.GetName("api.burrow")
+	require.NoError(t, err)
+	assert.Equal(t, otherAddress, entry.Owner)
+	assert.Equal(t, []string{"burrow"}, namesOwnedBy(owner.GetAddress()))
+	assert.Equal(t, []string{"api.burrow"}, namesOwnedBy(otherAddress))
+
+	// Which remains under the control of the parent owner
+	tx, _ = payload.NewNameTx(st, owner.GetPublicKey(), "api.burrow", "moved", amt, fee)
+	require.NoError(t, exe.signExecuteCommit(tx, owner))
+
+	// Transfer the parent without changing its data or spending anything on it
+	tx, _ = payload.NewNameTx(st, owner.GetPublicKey(), "burrow", "", fee, fee)
+	tx.Owner = &otherAddress
+	require.NoError(t, exe.signExecuteCommit(tx, owner))
+
+	entry, err = st.GetName("burrow")
+	require.NoError(t, err)
+	assert.Equal(t, otherAddress, entry.Owner)
+	assert.Equal(t, data, entry.Data)
+	assert.Len(t, namesOwnedBy(owner.GetAddress()), 0)
+	assert.Equal(t, []string{"api.burrow", "burrow"}, namesOwnedBy(otherAddress))
+
+	// Previous owner has lost control
+	tx, _ = payload.NewNameTx(st, owner.GetPublicKey(), "burrow", data, amt, fee)
+	require.Error(t, exe.signExecuteCommit(tx, owner))
+
+	// Removal drops the name from the reverse index
+	tx, _ = payload.NewNameTx(st, other.GetPublicKey(), "api.burrow", "", fee, fee)
+	require.NoError(t, exe.signExecuteCommit(tx, other))
+	assert.Equal(t, []string{"burrow"}, namesOwnedBy(otherAddress))
+}
+
+func TestNameTxOwnershipDisabled(t *testing.T) {
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+
+	names.MinNameRegistrationPeriod = 5
+	exe := makeExecutor(st)
+
+	fee := uint64(1000)
+	data := "service directory"
+	amt := fee + 10*names.NameBaseCost("", data)
+	owner := testPrivAccounts[0]
+	other := testPrivAccounts[1]
+
+	tx, _ := payload.NewNameTx(st, owner.GetPublicKey(), "burrow", data, amt, fee)
+	require.NoError(t, exe.signExecuteCommit(tx, owner))
+
+	// Names are flat so anyone may register what would be a subdomain
+	tx, _ = payload.NewNameTx(st, other.GetPublicKey(), "api.burrow", data, amt, fee)
+	require.NoError(t, exe.signExecuteCommit(tx, other))
+
+	// But not transfer a name
+	tx, _ = payload.NewNameTx(st, owner.GetPublicKey(), "burrow", "", fee, fee)
+	otherAddress := other.GetAddress()
+	tx.Owner = &otherAddress
+	require.Error(t, exe.signExecuteCommit(tx, owner))
+
+	// Nor look names up by owner
+	err = st.IterateNamesByOwner(owner.GetAddress(), func(entry *names.Entry) error {
+		return nil
+	})
+	require.Error(t, err)
+}
+
 // Test creating a contract from futher down the call stack
 /*
 contract Factory {
@@ -1494,6 +1671,582 @@ func TestSelfDestruct(t *testing.T) {
 	}
 }
 
+func TestParamsFromGenesis_BlockGasLimit(t *testing.T) {
+	genesisDoc := *testGenesisDoc
+	genesisDoc.Params.BlockGasLimit = math.MaxInt64
+	params, err := ParamsFromGenesis(&genesisDoc)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxInt64), params.BlockGasLimit)
+
+	// Would wrap around to a negative MaxGas for Tendermint
+	genesisDoc.Params.BlockGasLimit = math.MaxInt64 + 1
+	_, err = ParamsFromGenesis(&genesisDoc)
+	require.Error(t, err)
+}
+
+func TestBlockGasLimit(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, true, 1000, 1, true, 1000)
+
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+	// store 0x1 at 0x1 and 0x2
+	acc1.Code = []byte{0x60, 0x01, 0x60, 0x01, 0x55, 0x60, 0x01, 0x60, 0x02, 0x55}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+
+	exe := makeExecutor(st)
+	exe.params.BlockGasLimit = 100
+	sequence := acc0.Sequence
+
+	execute := func(gasLimit uint64) (*exec.TxExecution, error) {
+		sequence++
+		txEnv := txs.Enclose(testChainID,
+			payload.NewCallTxWithSequence(privAccounts[0].GetPublicKey(), addressPtr(acc1), nil, 1, gasLimit, 0,
+				sequence))
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		txe, err := exe.Execute(txEnv)
+		if err != nil {
+			// Rejected txs do not consume their sequence number
+			sequence--
+		}
+		return txe, err
+	}
+
+	// Could never fit in a block
+	_, err = execute(101)
+	assertErrorCode(t, errors.ErrorCodeBlockGasLimitExceeded, err)
+
+	txe, err := execute(60)
+	require.NoError(t, err)
+	gasUsed := txe.Result.GasUsed
+	require.True(t, gasUsed > 0, "call should use some gas")
+	assert.Equal(t, gasUsed, exe.block.GasUsed)
+
+	// Would fit in an empty block but not in what remains of this one
+	_, err = execute(101 - gasUsed)
+	assertErrorCode(t, errors.ErrorCodeBlockGasLimitExceeded, err)
+
+	_, err = execute(100 - gasUsed)
+	require.NoError(t, err)
+
+	blockExecution := exe.block
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2*gasUsed, blockExecution.GasUsed)
+	assert.Len(t, blockExecution.TxExecutions, 2)
+
+	// Budget is restored for the next block
+	_, err = execute(100)
+	require.NoError(t, err)
+}
+
+func TestEstimateGas(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	blockchain := newBlockchain(testGenesisDoc)
+
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+	acc2 := getAccount(st, privAccounts[2].GetAddress())
+	// store 0x1 at 0x1 and 0x2
+	acc1.Code = []byte{0x60, 0x01, 0x60, 0x01, 0x55, 0x60, 0x01, 0x60, 0x02, 0x55}
+	// revert with no data
+	acc2.Code = []byte{0x60, 0x00, 0x60, 0x00, 0xfd}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(acc1)
+		if err != nil {
+			return err
+		}
+		return up.UpdateAccount(acc2)
+	})
+	require.NoError(t, err)
+
+	tx := &payload.CallTx{
+		Input:   &payload.TxInput{Address: acc0.Address},
+		Address: addressPtr(acc1),
+	}
+	gasLimit, txe, err := EstimateGas(context.Background(), st, Params{}, blockchain, tx, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.True(t, gasLimit > 0, "storing should cost gas")
+	assert.Equal(t, txe.Result.GasUsed, gasLimit)
+	assert.Equal(t, uint64(0), tx.GasLimit, "EstimateGas should not modify its argument")
+
+	// One less fails
+	tx.GasLimit = gasLimit - 1
+	_, txe, err = EstimateGas(context.Background(), st, Params{}, blockchain, tx, logger)
+	require.NoError(t, err)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, txe.Exception.ErrorCode())
+
+	// A margin is added to the estimate but does not take it above the gas limit given
+	tx.GasLimit = 0
+	withMargin, txe, err := EstimateGas(context.Background(), st, Params{}, blockchain, tx, logger,
+		EstimateGasMargin(10))
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, gasLimit+gasLimit/10, withMargin)
+	tx.GasLimit = gasLimit + 1
+	withMargin, _, err = EstimateGas(context.Background(), st, Params{}, blockchain, tx, logger,
+		EstimateGasMargin(10))
+	require.NoError(t, err)
+	assert.Equal(t, gasLimit+1, withMargin)
+
+	tx.Address = addressPtr(acc2)
+	tx.GasLimit = 0
+	gasLimit, txe, err = EstimateGas(context.Background(), st, Params{}, blockchain, tx, logger)
+	require.NoError(t, err)
+	assert.Equal(t, contexts.GasLimit, gasLimit)
+	assert.Equal(t, errors.ErrorCodeExecutionReverted, txe.Exception.ErrorCode())
+}
+
+func TestCallSimChainParams(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, true, 1000, 1, true, 1000)
+	blockchain := newBlockchain(testGenesisDoc)
+
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+	// return CHAINID
+	acc1.Code = []byte{0x46, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+
+	txe, err := CallSim(context.Background(), st, Params{}, blockchain, acc0.Address, acc1.Address, nil, logger)
+	require.NoError(t, err)
+	require.NotNil(t, txe.Exception, "CHAINID should require Istanbul")
+
+	params := Params{EVMVersion: evm.Istanbul}
+	txe, err = CallSim(context.Background(), st, params, blockchain, acc0.Address, acc1.Address, nil, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	chainID := evm.ChainIDWord(blockchain.ChainID())
+	assert.Equal(t, chainID.Bytes(), txe.Result.Return)
+
+	gasLimit, txe, err := EstimateGas(context.Background(), st, params, blockchain, &payload.CallTx{
+		Input:   &payload.TxInput{Address: acc0.Address},
+		Address: addressPtr(acc1),
+	}, logger)
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception, "estimate should run with the chain's EVMVersion")
+	assert.True(t, gasLimit > 0)
+}
+
+func TestCallSimTimeout(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, true, 1000, 1, true, 1000)
+	blockchain := newBlockchain(testGenesisDoc)
+
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+	// loop forever
+	acc1.Code = []byte{0x5b, 0x60, 0x00, 0x56}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	txe, err := CallSim(context.Background(), st, Params{}, blockchain, acc0.Address, acc1.Address, nil, logger,
+		SimulationTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, errors.ErrorCodeExecutionInterrupted, txe.Exception.ErrorCode())
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+type adderArgs struct {
+	A uint64
+	B uint64
+}
+
+type adderRets struct {
+	Sum uint64
+}
+
+func TestCustomNatives(t *testing.T) {
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Call, true)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.Input, true)
+	st, err := state.MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	err = st.InitialCommit()
+	require.NoError(t, err)
+
+	add := func(st evm.Interface, caller crypto.Address, gas *uint64, logger *logging.Logger,
+		v interface{}) (interface{}, error) {
+		args := v.(*adderArgs)
+		return adderRets{Sum: args.A + args.B}, nil
+	}
+	adder := evm.NewSNativeContract(`
+		* @notice Adds numbers natively
+		`,
+		"Adder",
+		&evm.SNativeFunctionDescription{
+			Name:      "add",
+			Arguments: reflect.TypeOf(adderArgs{}),
+			Returns:   reflect.TypeOf(adderRets{}),
+			Gas:       100,
+			F:         add,
+		},
+		&evm.SNativeFunctionDescription{
+			Name:      "addExpensively",
+			Arguments: reflect.TypeOf(adderArgs{}),
+			Returns:   reflect.TypeOf(adderRets{}),
+			Gas:       1 << 40,
+			F:         add,
+		},
+	)
+	natives := evm.DefaultNatives()
+	require.NoError(t, natives.RegisterSNative(adder))
+	require.Error(t, natives.RegisterSNative(adder), "should not be able to register twice")
+	assert.False(t, evm.IsRegisteredNativeContract(adder.Address()), "default registry should be unaffected")
+
+	exe := makeExecutor(st, Natives(natives))
+	caller := &acm.Account{
+		Address:     crypto.Address{1, 2, 3},
+		Code:        callContractCode(adder.Address()),
+		Permissions: permission.ZeroAccountPermissions,
+	}
+	caller.Permissions.Base.Set(permission.Call, true)
+	exe.updateAccounts(t, caller)
+
+	callAdder := func(name string) (*exec.CallEvent, error) {
+		function, err := adder.FunctionByName(name)
+		require.NoError(t, err)
+		data := append(function.Abi.FunctionID[:], Uint64ToWord256(2).Bytes()...)
+		data = append(data, Uint64ToWord256(3).Bytes()...)
+		tx, _ := payload.NewCallTx(exe.stateCache, users[0].GetPublicKey(), &caller.Address, data, 100, 10000, 100)
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(users[0]))
+		return execTxWaitAccountCall(t, exe, txEnv, adder.Address())
+	}
+
+	ev, err := callAdder("add")
+	require.NoError(t, err)
+	assert.Equal(t, Uint64ToWord256(5).Bytes(), ev.Return.Bytes())
+
+	// The function's gas is charged in addition to the cost of the call
+	_, err = callAdder("addExpensively")
+	assertErrorCode(t, errors.ErrorCodeNativeFunction, err)
+	assert.Contains(t, err.Error(), "insufficient gas")
+}
+
+func TestStorageRent(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	st.SetCountStorageWords(true)
+	storageKeys := Words256{Int64ToWord256(1), Int64ToWord256(2), Int64ToWord256(3)}
+	storageValues := Words256{Int64ToWord256(4), Int64ToWord256(5), Int64ToWord256(6)}
+	// Return the word at key 1
+	code := bc.MustSplice(PUSH1, 1, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	payer := &acm.Account{Address: crypto.Address{1}, Balance: 10, Code: code}
+	defaulter := &acm.Account{Address: crypto.Address{2, 2}, Balance: 5, Code: code}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		for _, acc := range []*acm.Account{payer, defaulter} {
+			err := up.UpdateAccount(acc)
+			if err != nil {
+				return err
+			}
+			for i, key := range storageKeys {
+				err = up.SetStorage(acc.Address, key, storageValues[i])
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), getAccount(st, payer.Address).StorageWords)
+
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	params.StorageRentPerWord = 2
+	params.StorageRentPeriod = 1
+	exe := makeExecutorWithParams(st, params)
+
+	// Rent is charged on the words held at the end of the previous block, a word set in this block is counted once
+	// committed
+	require.NoError(t, exe.stateCache.SetStorage(payer.Address, Int64ToWord256(7), Int64ToWord256(8)))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	acc := getAccount(exe.state, payer.Address)
+	assert.Equal(t, uint64(4), acc.Balance)
+	assert.Equal(t, uint64(4), acc.StorageWords)
+	assert.False(t, acc.Dormant())
+
+	// The defaulter becomes dormant immediately
+	acc = getAccount(exe.state, defaulter.Address)
+	assert.Equal(t, uint64(5), acc.Balance)
+	assert.True(t, acc.Dormant())
+	assert.Equal(t, uint64(3), acc.StorageWords)
+
+	// Dormant contracts cannot be called
+	tx, _ := payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), &defaulter.Address, nil, 1, 10000, 1)
+	err = exe.signExecuteCommit(tx, privAccounts[0])
+	assertErrorCode(t, errors.ErrorCodeDormantAccount, err)
+
+	// And have their storage evicted from the next block
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	acc = getAccount(exe.state, defaulter.Address)
+	assert.Equal(t, evm.StorageHash(evm.EmptyStorageHash, storageKeys, storageValues), acc.EvictedStorageHash)
+	assert.Equal(t, uint64(0), acc.StorageWords)
+	err = exe.state.IterateStorage(defaulter.Address, func(key, value Word256) error {
+		return fmt.Errorf("storage should have been evicted but found %v -> %v", key, value)
+	})
+	require.NoError(t, err)
+
+	// The payer cannot pay for its 4 words at the next collection
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.True(t, getAccount(exe.state, payer.Address).Dormant())
+
+	// Top up the defaulter and restore its storage via the StorageRent SNative
+	rent := evm.StorageRentContract(params.StorageRentPerWord)
+	caller := &acm.Account{
+		Address:     crypto.Address{3, 3},
+		Balance:     100,
+		Code:        callContractCode(rent.Address()),
+		Permissions: permission.ZeroAccountPermissions,
+	}
+	caller.Permissions.Base.Set(permission.Call, true)
+	exe.updateAccounts(t, caller)
+	callRent := func(name string, args ...interface{}) (*exec.CallEvent, error) {
+		function, err := rent.FunctionByName(name)
+		require.NoError(t, err)
+		input, err := abi.Pack(function.Abi.Inputs, args...)
+		require.NoError(t, err)
+		data := append(function.Abi.FunctionID[:], input...)
+		tx, _ := payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), &caller.Address, data, 1, 10000, 1)
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		return execTxWaitAccountCall(t, exe, txEnv, rent.Address())
+	}
+	_, err = callRent("topUp", defaulter.Address, uint64(10))
+	require.NoError(t, err)
+	_, err = callRent("restore", defaulter.Address, []Word256(storageKeys), []Word256(storageValues))
+	require.NoError(t, err)
+
+	// Restoring charges the rent due on the storage
+	acc = getAccount(exe.state, defaulter.Address)
+	assert.Equal(t, uint64(15-3*params.StorageRentPerWord), acc.Balance)
+	assert.Equal(t, uint64(3), acc.StorageWords)
+	assert.False(t, acc.Dormant())
+
+	tx, _ = payload.NewCallTx(exe.stateCache, privAccounts[0].GetPublicKey(), &defaulter.Address, nil, 1, 10000, 1)
+	txEnv := txs.Enclose(testChainID, tx)
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+	ev, err := execTxWaitAccountCall(t, exe, txEnv, defaulter.Address)
+	require.NoError(t, err)
+	assert.Equal(t, storageValues[0].Bytes(), ev.Return.Bytes())
+
+	// The StorageRent SNative is available to simulated calls
+	function, err := rent.FunctionByName("rentDue")
+	require.NoError(t, err)
+	input, err := abi.Pack(function.Abi.Inputs, defaulter.Address)
+	require.NoError(t, err)
+	txe, err := CallSim(context.Background(), exe.stateCache, params, exe.Blockchain, privAccounts[0].GetAddress(),
+		caller.Address, append(function.Abi.FunctionID[:], input...), logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, Uint64ToWord256(3*params.StorageRentPerWord).Bytes(), txe.Result.Return)
+}
+
+func TestStorageRentOverflow(t *testing.T) {
+	st, _ := makeGenesisState(3, true, 1000, 1, true, 1000)
+	st.SetCountStorageWords(true)
+	acc := &acm.Account{Address: crypto.Address{1}, Balance: 10}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(acc)
+		if err != nil {
+			return err
+		}
+		for i := int64(1); i <= 3; i++ {
+			err = up.SetStorage(acc.Address, Int64ToWord256(i), Int64ToWord256(i))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	// The rent on 3 words would wrap around to 2
+	params.StorageRentPerWord = math.MaxUint64/3 + 1
+	params.StorageRentPeriod = 1
+	exe := makeExecutorWithParams(st, params)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	acc = getAccount(exe.state, acc.Address)
+	assert.Equal(t, uint64(10), acc.Balance)
+	assert.True(t, acc.Dormant())
+}
+
+func TestStorageRentBatches(t *testing.T) {
+	st, _ := makeGenesisState(3, true, 1000, 1, true, 1000)
+	st.SetCountStorageWords(true)
+	// More accounts holding storage than can be charged in one batch
+	var tenants []crypto.Address
+	for i := 0; i <= StorageRentBatchSize; i++ {
+		tenants = append(tenants, crypto.Address{1, byte(i >> 8), byte(i)})
+	}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		for _, address := range tenants {
+			err := up.UpdateAccount(&acm.Account{Address: address, Balance: 10})
+			if err != nil {
+				return err
+			}
+			err = up.SetStorage(address, Int64ToWord256(1), Int64ToWord256(1))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	params.StorageRentPerWord = 1
+	params.StorageRentPeriod = 1
+	exe := makeExecutorWithParams(st, params)
+
+	charged := func() (n int) {
+		for _, address := range tenants {
+			if getAccount(exe.state, address).Balance < 10 {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The first block charges only part of the accounts
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.True(t, charged() < len(tenants))
+	cursor, err := exe.state.GetStorageRentCursor()
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+
+	// The next continues from where it left off to complete the collection
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	for _, address := range tenants {
+		assert.Equal(t, uint64(9), getAccount(exe.state, address).Balance)
+	}
+	cursor, err = exe.state.GetStorageRentCursor()
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestStorageRentEvictionBatches(t *testing.T) {
+	st, _ := makeGenesisState(3, true, 1000, 1, true, 1000)
+	st.SetCountStorageWords(true)
+	// Holds more storage than can be evicted in one batch
+	defaulter := &acm.Account{Address: crypto.Address{1}}
+	var storageKeys, storageValues Words256
+	for i := 1; i <= StorageRentBatchSize+1; i++ {
+		storageKeys = append(storageKeys, Int64ToWord256(int64(i)))
+		storageValues = append(storageValues, Int64ToWord256(int64(i)))
+	}
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(defaulter)
+		if err != nil {
+			return err
+		}
+		for i, key := range storageKeys {
+			err = up.SetStorage(defaulter.Address, key, storageValues[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	params.StorageRentPerWord = 1
+	params.StorageRentPeriod = 1
+	exe := makeExecutorWithParams(st, params)
+
+	// Becomes dormant
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, evm.EmptyStorageHash, getAccount(exe.state, defaulter.Address).EvictedStorageHash)
+
+	// Evicts a batch of storage
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	acc := getAccount(exe.state, defaulter.Address)
+	assert.Equal(t, uint64(1), acc.StorageWords)
+	assert.Equal(t, evm.StorageHash(evm.EmptyStorageHash, storageKeys[:StorageRentBatchSize],
+		storageValues[:StorageRentBatchSize]), acc.EvictedStorageHash)
+	cursor, err := exe.state.GetStorageRentCursor()
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.Equal(t, defaulter.Address, cursor.Address)
+	assert.Equal(t, &storageKeys[StorageRentBatchSize], cursor.EvictFrom)
+
+	// Evicts the rest then moves on
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	acc = getAccount(exe.state, defaulter.Address)
+	assert.Equal(t, uint64(0), acc.StorageWords)
+	assert.Equal(t, evm.StorageHash(evm.EmptyStorageHash, storageKeys, storageValues), acc.EvictedStorageHash)
+	cursor, err = exe.state.GetStorageRentCursor()
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestTracing(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, true, 1000, 1, true, 1000)
+	buf := new(bytes.Buffer)
+	tracing.SetTracer(tracing.NewTracer(tracing.NewWriterExporter(buf), logger))
+	defer tracing.SetTracer(nil)
+
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+	acc1 := getAccount(st, privAccounts[1].GetAddress())
+	acc1.Code = hex.MustDecodeString("600560005260206000F3")
+	_, _, err := st.Update(func(up state.Updatable) error {
+		return up.UpdateAccount(acc1)
+	})
+	require.NoError(t, err)
+
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address,
+			Amount:   1,
+			Sequence: acc0.Sequence + 1,
+		},
+		Address:  addressPtr(acc1),
+		GasLimit: 1000,
+	}
+	exe := makeExecutor(st)
+	require.NoError(t, exe.signExecuteCommit(tx, privAccounts[0]))
+
+	spans := make(map[string]*tracing.SpanData)
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		span := new(tracing.SpanData)
+		require.NoError(t, decoder.Decode(span))
+		spans[span.Name] = span
+	}
+	require.Contains(t, spans, "executor.Execute")
+	require.Contains(t, spans, "contexts.CallContext.Execute")
+	require.Contains(t, spans, "evm.Call")
+	assert.Equal(t, "", spans["executor.Execute"].ParentSpanID)
+	assert.Equal(t, spans["executor.Execute"].SpanID, spans["contexts.CallContext.Execute"].ParentSpanID)
+	assert.Equal(t, spans["contexts.CallContext.Execute"].SpanID, spans["evm.Call"].ParentSpanID)
+	assert.Equal(t, acc1.Address.String(), spans["evm.Call"].Attributes["callee"])
+}
+
 //-------------------------------------------------------------------------------------
 // helpers
 
@@ -1582,13 +2335,21 @@ type testExecutor struct {
 	*bcm.Blockchain
 }
 
-func makeExecutor(state *state.State) *testExecutor {
+func makeExecutor(state *state.State, options ...ExecutionOption) *testExecutor {
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	if err != nil {
+		panic(err)
+	}
+	return makeExecutorWithParams(state, params, options...)
+}
+
+func makeExecutorWithParams(state *state.State, params Params, options ...ExecutionOption) *testExecutor {
 	blockchain := newBlockchain(testGenesisDoc)
 	blockchain.CommitBlockAtHeight(time.Now(), []byte("hashily"), state.Hash(), HeightAtVersion(state.Version()))
 	return &testExecutor{
 		Blockchain: blockchain,
-		executor: newExecutor("makeExecutorCache", true, ParamsFromGenesis(testGenesisDoc), state,
-			blockchain, event.NewNoOpPublisher(), logger),
+		executor: newExecutor("makeExecutorCache", true, params, state,
+			blockchain, event.NewNoOpPublisher(), logger, options...),
 	}
 }
 
@@ -0,0 +1,95 @@
+package expiry
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/txs"
+)
+
+// The Cache holds the transactions executed in the current block until they are written to state
+type Cache struct {
+	sync.RWMutex
+	backend Reader
+	txs     map[txKey]struct{}
+}
+
+type txKey struct {
+	expiryHeight uint64
+	txHash       [txs.HashLength]byte
+}
+
+var _ Reader = &Cache{}
+
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend: backend,
+		txs:     make(map[txKey]struct{}),
+	}
+}
+
+func (cache *Cache) HasTx(expiryHeight uint64, txHash []byte) (bool, error) {
+	cache.RLock()
+	_, ok := cache.txs[newTxKey(expiryHeight, txHash)]
+	cache.RUnlock()
+	if ok {
+		return true, nil
+	}
+	return cache.backend.HasTx(expiryHeight, txHash)
+}
+
+func (cache *Cache) AddTx(expiryHeight uint64, txHash []byte) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.txs[newTxKey(expiryHeight, txHash)] = struct{}{}
+	return nil
+}
+
+// Writes the transactions in the cache to the output Writer in order of expiry height and hash. Does not reset the
+// cache, to do that call Reset() after Sync or use Flush if you wish to use the output state as your next backend
+func (cache *Cache) Sync(state Writer) error {
+	cache.Lock()
+	defer cache.Unlock()
+	keys := make([]txKey, 0, len(cache.txs))
+	for key := range cache.txs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].expiryHeight != keys[j].expiryHeight {
+			return keys[i].expiryHeight < keys[j].expiryHeight
+		}
+		return bytes.Compare(keys[i].txHash[:], keys[j].txHash[:]) < 0
+	})
+	for _, key := range keys {
+		err := state.AddTx(key.expiryHeight, key.txHash[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.txs = make(map[txKey]struct{})
+}
+
+// Syncs the Cache and Resets it to use backend as its Reader
+func (cache *Cache) Flush(output Writer, backend Reader) error {
+	err := cache.Sync(output)
+	if err != nil {
+		return err
+	}
+	cache.Reset(backend)
+	return nil
+}
+
+func newTxKey(expiryHeight uint64, txHash []byte) txKey {
+	key := txKey{expiryHeight: expiryHeight}
+	copy(key.txHash[:], txHash)
+	return key
+}
@@ -0,0 +1,20 @@
+package expiry
+
+// Hashes of executed transactions whose inputs are protected from replay until an expiry height rather than by
+// sequence numbers. Hashes are keyed by their expiry height so those that can no longer be replayed can be pruned.
+type Reader interface {
+	// Whether a transaction with txHash and expiryHeight has been executed
+	HasTx(expiryHeight uint64, txHash []byte) (bool, error)
+}
+
+type Writer interface {
+	// Record the execution of a transaction whose hash must be kept until expiryHeight
+	AddTx(expiryHeight uint64, txHash []byte) error
+	// Forget the transactions with an expiry height below height
+	PruneTxs(height uint64) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
@@ -0,0 +1,38 @@
+package execution
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	txDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "tx_duration_seconds",
+		Help:      "Time taken to execute a transaction by executor and payload type",
+		Buckets:   []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5},
+	}, []string{"executor", "payload"})
+	txErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "tx_errors_total",
+		Help:      "Number of transactions that failed to execute by executor and payload type",
+	}, []string{"executor", "payload"})
+	gasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "gas_used_total",
+		Help:      "Gas used by executed transactions",
+	}, []string{"executor"})
+	blockTxs = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "block_txs",
+		Help:      "Number of transactions in each committed block",
+		Buckets:   []float64{0, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(txDuration, txErrors, gasUsed, blockTxs)
+}
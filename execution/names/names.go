@@ -16,7 +16,9 @@ package names
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event/query"
 	amino "github.com/tendermint/go-amino"
 )
@@ -88,8 +90,14 @@ type Iterable interface {
 	IterateNames(consumer func(*Entry) error) (err error)
 }
 
+// Iterate over names by the address that owns them
+type OwnerIterable interface {
+	IterateNamesByOwner(owner crypto.Address, consumer func(*Entry) error) (err error)
+}
+
 type IterableReader interface {
 	Iterable
+	OwnerIterable
 	Reader
 }
 
@@ -98,6 +106,16 @@ type IterableReaderWriter interface {
 	ReaderWriter
 }
 
+// Names are hierarchical with sub.name enclosed by name, the owner of which controls sub.name. Returns the name
+// immediately enclosing name or the empty string if it is top-level
+func ParentName(name string) string {
+	i := strings.Index(name, ".")
+	if i < 0 {
+		return ""
+	}
+	return name[i+1:]
+}
+
 // base cost is "effective" number of bytes
 func NameBaseCost(name, data string) uint64 {
 	return uint64(len(data) + 32)
@@ -37,3 +37,10 @@ func TestEncodeProtobuf(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, entry, entryOut)
 }
+
+func TestParentName(t *testing.T) {
+	assert.Equal(t, "", ParentName("name"))
+	assert.Equal(t, "name", ParentName("sub.name"))
+	assert.Equal(t, "sub.name", ParentName("subsub.sub.name"))
+	assert.Equal(t, "", ParentName(ParentName("sub.name")))
+}
@@ -0,0 +1,152 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/state"
+)
+
+func (exe *executor) storageRentEnabled() bool {
+	return exe.params.StorageRentPerWord > 0
+}
+
+// Register the StorageRent SNative with the executor's natives unless already registered in a shared registry
+func (exe *executor) registerStorageRentContract() {
+	if exe.natives == nil {
+		exe.natives = evm.DefaultNatives()
+	}
+	contract := evm.StorageRentContract(exe.params.StorageRentPerWord)
+	if exe.natives.IsRegistered(contract.Address()) {
+		return
+	}
+	err := exe.natives.RegisterSNative(contract)
+	if err != nil {
+		// Addresses are derived from names so we only get here if a different contract has been given its name
+		panic(err)
+	}
+}
+
+// Each block charges storage rent to at most this many accounts, with each word of storage evicted counting as an
+// account, so that the work done per block does not grow with state
+const StorageRentBatchSize = 1000
+
+// Stops iteration once a batch is complete
+var errStorageRentBatchComplete = fmt.Errorf("storage rent batch complete")
+
+// Collection of storage rent begins in the block at a height that is a multiple of StorageRentPeriod and proceeds in
+// batches of StorageRentBatchSize through the accounts in ascending order of address, one batch per block, returning
+// the cursor at which the next batch starts or nil once every account has been charged. Each account is charged once
+// per collection so if there are too many accounts to charge within StorageRentPeriod blocks the next collection
+// begins at the first multiple of StorageRentPeriod after this one completes. Accounts created during a collection
+// with addresses already passed are first charged in the next.
+//
+// An account that cannot pay becomes dormant immediately, so that its storage can no longer change, and its storage is
+// evicted in the following blocks a batch at a time before the collection moves on.
+func (exe *executor) collectStorageRent(height uint64) (*state.StorageRentCursor, error) {
+	cursor, err := exe.state.GetStorageRentCursor()
+	if err != nil {
+		return nil, err
+	}
+	if cursor == nil {
+		if height%exe.params.StorageRentPeriod != 0 {
+			return nil, nil
+		}
+		cursor = &state.StorageRentCursor{}
+	}
+	budget := uint64(StorageRentBatchSize)
+	if cursor.EvictFrom != nil {
+		evictFrom, err := exe.evictStorage(cursor.Address, *cursor.EvictFrom, &budget)
+		if err != nil {
+			return nil, err
+		}
+		if evictFrom != nil {
+			return &state.StorageRentCursor{Address: cursor.Address, EvictFrom: evictFrom}, nil
+		}
+	}
+	var next *state.StorageRentCursor
+	err = exe.state.IterateAccountsFrom(cursor.Address, func(acc *acm.Account) error {
+		if budget == 0 {
+			next = &state.StorageRentCursor{Address: acc.Address}
+			return errStorageRentBatchComplete
+		}
+		budget--
+		dormant, err := exe.chargeStorageRent(acc.Address)
+		if err != nil {
+			return err
+		}
+		if dormant {
+			next = &state.StorageRentCursor{Address: acc.Address, EvictFrom: &Zero256}
+			return errStorageRentBatchComplete
+		}
+		return nil
+	})
+	if err != nil && err != errStorageRentBatchComplete {
+		return nil, err
+	}
+	return next, nil
+}
+
+// Charge an account storage rent from its balance for every word of storage it held at the end of the previous block,
+// returning true if it cannot pay and so has become dormant. A dormant account has its storage evicted leaving only
+// its hash from which it may be restored via the StorageRent SNative.
+func (exe *executor) chargeStorageRent(address crypto.Address) (bool, error) {
+	acc, err := exe.stateCache.GetAccount(address)
+	if err != nil {
+		return false, err
+	}
+	if acc == nil || acc.Dormant() || acc.StorageWords == 0 {
+		return false, nil
+	}
+	rent := evm.StorageRent(acc.StorageWords, exe.params.StorageRentPerWord)
+	if acc.Balance >= rent {
+		acc.Balance -= rent
+		return false, exe.stateCache.UpdateAccount(acc)
+	}
+	exe.logger.InfoMsg("Evicting storage of account unable to pay storage rent",
+		"address", address,
+		"words", acc.StorageWords,
+		"rent", rent,
+		"balance", acc.Balance)
+	acc.EvictedStorageHash = evm.EmptyStorageHash
+	return true, exe.stateCache.UpdateAccount(acc)
+}
+
+// Evict the storage of a dormant account from the key evictFrom, extending its evicted storage hash, until budget is
+// spent returning the key from which eviction continues or nil once all its storage has been evicted
+func (exe *executor) evictStorage(address crypto.Address, evictFrom Word256, budget *uint64) (*Word256, error) {
+	var keys, values Words256
+	var next *Word256
+	err := exe.state.IterateStorageFrom(address, evictFrom, func(key, value Word256) error {
+		if *budget == 0 {
+			next = &key
+			return errStorageRentBatchComplete
+		}
+		*budget--
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	})
+	if err != nil && err != errStorageRentBatchComplete {
+		return nil, err
+	}
+	acc, err := exe.stateCache.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	acc.EvictedStorageHash = evm.StorageHash(acc.EvictedStorageHash, keys, values)
+	err = exe.stateCache.UpdateAccount(acc)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		err = exe.stateCache.SetStorage(address, key, Zero256)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next, nil
+}
@@ -1,11 +1,14 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
@@ -13,37 +16,29 @@ import (
 )
 
 // Run a contract's code on an isolated and unpersisted state
-// Cannot be used to create new contracts
-func CallSim(reader acmstate.Reader, tip bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+// Cannot be used to create new contracts. Execution is interrupted if ctx is done, or any SimulationTimeout passes,
+// before it completes.
+func CallSim(ctx context.Context, reader acmstate.Reader, params Params, tip bcm.BlockchainInfo,
+	fromAddress, address crypto.Address, data []byte,
+	logger *logging.Logger, options ...ExecutionOption) (*exec.TxExecution, error) {
 
-	cache := acmstate.NewCache(reader)
-	exe := contexts.CallContext{
-		RunCall:     true,
-		StateWriter: cache,
-		Blockchain:  tip,
-		Logger:      logger,
-	}
-
-	txe := exec.NewTxExecution(txs.Enclose(tip.ChainID(), &payload.CallTx{
+	ctx, cancel, _ := simulationOptions(ctx, options)
+	defer cancel()
+	return simulateCall(ctx, reader, params, tip, &payload.CallTx{
 		Input: &payload.TxInput{
 			Address: fromAddress,
 		},
 		Address:  &address,
 		Data:     data,
 		GasLimit: contexts.GasLimit,
-	}))
-	err := exe.Execute(txe, txe.Envelope.Tx.Payload)
-	if err != nil {
-		return nil, err
-	}
-	return txe, nil
+	}, logger, options...)
 }
 
 // Run the given code on an isolated and unpersisted state
 // Cannot be used to create new contracts.
-func CallCodeSim(reader acmstate.Reader, tip bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+func CallCodeSim(ctx context.Context, reader acmstate.Reader, params Params, tip bcm.BlockchainInfo,
+	fromAddress, address crypto.Address, code, data []byte,
+	logger *logging.Logger, options ...ExecutionOption) (*exec.TxExecution, error) {
 
 	// Attach code to target account (overwriting target)
 	cache := acmstate.NewCache(reader)
@@ -55,5 +50,103 @@ func CallCodeSim(reader acmstate.Reader, tip bcm.BlockchainInfo, fromAddress, ad
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, tip, fromAddress, address, data, logger)
+	return CallSim(ctx, cache, params, tip, fromAddress, address, data, logger, options...)
+}
+
+// Find the smallest GasLimit with which tx would execute without exception against an isolated and unpersisted state.
+// tx may create a contract. The gas used by a call is a lower bound on the gas it needs and tx.GasLimit (or
+// contexts.GasLimit when zero) the upper bound, we binary search in between. If tx fails at the upper bound then the
+// upper bound is returned along with the failing TxExecution so that its exception (and any revert reason) can be
+// reported. If ctx is done, or any SimulationTimeout passes, before the search completes its error is returned.
+// Otherwise the gas limit found is increased by any EstimateGasMargin given, up to the upper bound, so that tx still has
+// enough gas should the state it runs against change before it is executed.
+func EstimateGas(ctx context.Context, reader acmstate.Reader, params Params, tip bcm.BlockchainInfo, tx *payload.CallTx,
+	logger *logging.Logger, options ...ExecutionOption) (uint64, *exec.TxExecution, error) {
+
+	upper := tx.GasLimit
+	if upper == 0 {
+		upper = contexts.GasLimit
+	}
+	ctx, cancel, opts := simulationOptions(ctx, options)
+	defer cancel()
+	limit := upper
+	run := func(gasLimit uint64) (*exec.TxExecution, error) {
+		sim := *tx
+		sim.GasLimit = gasLimit
+		txe, err := simulateCall(ctx, reader, params, tip, &sim, logger, options...)
+		if err == nil {
+			// An interrupted call tells us nothing about the gas needed
+			err = ctx.Err()
+		}
+		return txe, err
+	}
+	txe, err := run(upper)
+	if err != nil || txe.Exception != nil {
+		return upper, txe, err
+	}
+	lower := txe.Result.GetGasUsed()
+	for lower < upper {
+		mid := lower + (upper-lower)/2
+		midTxe, err := run(mid)
+		if err != nil {
+			return 0, nil, err
+		}
+		if midTxe.Exception == nil {
+			upper, txe = mid, midTxe
+		} else {
+			lower = mid + 1
+		}
+	}
+	margin := upper/100*opts.estimateGasMarginPercent + upper%100*opts.estimateGasMarginPercent/100
+	if margin > limit-upper {
+		margin = limit - upper
+	}
+	return upper + margin, txe, nil
+}
+
+// Apply options to an executor that serves only to carry them, bounding ctx by any SimulationTimeout among them
+func simulationOptions(ctx context.Context, options []ExecutionOption) (context.Context, context.CancelFunc, *executor) {
+	opts := new(executor)
+	for _, option := range options {
+		option(opts)
+	}
+	if opts.simulationTimeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, opts.simulationTimeout)
+		return ctx, cancel, opts
+	}
+	return ctx, func() {}, opts
+}
+
+// Simulated calls take the VM options and native contracts of any options given and run with the chain's ChainID,
+// EVMVersion, and StorageRent SNative so they see the same opcodes and contracts as committed transactions
+func simulateCall(ctx context.Context, reader acmstate.Reader, params Params, tip bcm.BlockchainInfo, tx *payload.CallTx,
+	logger *logging.Logger, options ...ExecutionOption) (*exec.TxExecution, error) {
+
+	opts := &executor{params: params}
+	for _, option := range options {
+		option(opts)
+	}
+	if opts.storageRentEnabled() {
+		opts.registerStorageRentContract()
+	}
+	vmOptions := append(opts.vmOptions[:len(opts.vmOptions):len(opts.vmOptions)], evm.Interruptible(ctx))
+	cache := acmstate.NewCache(reader)
+	exe := contexts.CallContext{
+		RunCall:     true,
+		StateWriter: cache,
+		Blockchain:  tip,
+		ChainID:     tip.ChainID(),
+		EVMVersion:  params.EVMVersion,
+		WASM:        params.WASM,
+		VMOptions:   vmOptions,
+		Natives:     opts.natives,
+		Logger:      logger,
+	}
+
+	txe := exec.NewTxExecution(txs.Enclose(tip.ChainID(), tx))
+	err := exe.Execute(txe, txe.Envelope.Tx.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return txe, nil
 }
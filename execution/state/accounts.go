@@ -46,14 +46,26 @@ func (ws *writeState) UpdateAccount(account *acm.Account) error {
 	if account == nil {
 		return fmt.Errorf("UpdateAccount passed nil account in State")
 	}
-	encodedAccount, err := account.Encode()
-	if err != nil {
-		return fmt.Errorf("UpdateAccount could not encode account: %v", err)
-	}
 	tree, err := ws.forest.Writer(keys.Account.Prefix())
 	if err != nil {
 		return err
 	}
+	if ws.countStorageWords {
+		// The words of storage held are counted by SetStorage so any other count is ignored
+		current, err := decodeAccountOrNil(tree.GetWriteTree(keys.Account.KeyNoPrefix(account.Address)))
+		if err != nil {
+			return err
+		}
+		account = account.Copy()
+		account.StorageWords = 0
+		if current != nil {
+			account.StorageWords = current.StorageWords
+		}
+	}
+	encodedAccount, err := account.Encode()
+	if err != nil {
+		return fmt.Errorf("UpdateAccount could not encode account: %v", err)
+	}
 	updated := tree.Set(keys.Account.KeyNoPrefix(account.Address), encodedAccount)
 	if updated {
 		ws.statsAddAccount(account)
@@ -118,13 +130,49 @@ func (ws *writeState) SetStorage(address crypto.Address, key, value binary.Word2
 		return err
 	}
 	if value == binary.Zero256 {
-		tree.Delete(keyFormat.KeyNoPrefix(key))
+		_, removed := tree.Delete(keyFormat.KeyNoPrefix(key))
+		if removed && ws.countStorageWords {
+			return ws.addStorageWords(address, -1)
+		}
 	} else {
-		tree.Set(keyFormat.KeyNoPrefix(key), value.Bytes())
+		updated := tree.Set(keyFormat.KeyNoPrefix(key), value.Bytes())
+		if !updated && ws.countStorageWords {
+			return ws.addStorageWords(address, 1)
+		}
+	}
+	return nil
+}
+
+// Adjust the count of the words of storage held by an account
+func (ws *writeState) addStorageWords(address crypto.Address, delta int) error {
+	tree, err := ws.forest.Writer(keys.Account.Prefix())
+	if err != nil {
+		return err
 	}
+	key := keys.Account.KeyNoPrefix(address)
+	account, err := decodeAccountOrNil(tree.GetWriteTree(key))
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return fmt.Errorf("storage set for account %v that does not exist", address)
+	}
+	account.StorageWords = uint64(int64(account.StorageWords) + int64(delta))
+	encodedAccount, err := account.Encode()
+	if err != nil {
+		return fmt.Errorf("could not encode account: %v", err)
+	}
+	tree.Set(key, encodedAccount)
 	return nil
 }
 
+func decodeAccountOrNil(bs []byte) (*acm.Account, error) {
+	if bs == nil {
+		return nil, nil
+	}
+	return acm.Decode(bs)
+}
+
 func (s *ReadState) IterateStorage(address crypto.Address, consumer func(key, value binary.Word256) error) error {
 	keyFormat := keys.Storage.Fix(address)
 	tree, err := s.Forest.Reader(keyFormat.Prefix())
@@ -1,15 +1,19 @@
 package state
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"math/big"
 	"os"
 
 	amino "github.com/tendermint/go-amino"
 
-	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
@@ -61,77 +65,324 @@ func (f *FileDumpReader) Next() (*dump.Dump, error) {
 	return &row, err
 }
 
-func (s *State) LoadDump(reader DumpReader) error {
-	txs := make([]*exec.TxExecution, 0)
+// Load a dump file followed by any diff dump files to apply on top of it (see LoadDump)
+func (s *State) LoadDumpFiles(filename string, diffFilenames ...string) (*dump.Header, error) {
+	reader, err := NewFileDumpReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	diffs := make([]DumpReader, len(diffFilenames))
+	for i, diffFilename := range diffFilenames {
+		diffs[i], err = NewFileDumpReader(diffFilename)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.LoadDump(reader, diffs...)
+}
 
-	var tx *exec.TxExecution
+// Load the rows of a dump into state followed by the rows of any diff dumps, each of which must start at the height
+// of the dump before it and come from the same chain. Returns the header of the last dump loaded (nil for dumps that
+// predate the header). Any dump with a header must be complete and its checksum must match. Since such a dump carries
+// the complete validator set any validators already in state, such as those of the genesis state we are restoring
+// onto, that it does not include are removed.
+func (s *State) LoadDump(reader DumpReader, diffs ...DumpReader) (*dump.Header, error) {
+	loader := &dumpLoader{ws: &s.writeState, validators: make(map[crypto.Address]bool)}
+	header, height, err := loader.load(reader)
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		if header.FromHeight != 0 {
+			return nil, fmt.Errorf("base dump is a diff from height %d, a full dump is required", header.FromHeight)
+		}
+		err = loader.removeOtherValidators()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, diff := range diffs {
+		diffHeader, diffHeight, err := loader.load(diff)
+		if err != nil {
+			return nil, fmt.Errorf("could not load diff dump %d: %v", i, err)
+		}
+		if diffHeader == nil || diffHeader.FromHeight == 0 {
+			return nil, fmt.Errorf("dump %d is not a diff dump", i)
+		}
+		if diffHeader.FromHeight != height {
+			return nil, fmt.Errorf("diff dump %d is from height %d but the previous dump is at height %d",
+				i, diffHeader.FromHeight, height)
+		}
+		if header != nil && diffHeader.ChainID != header.ChainID {
+			return nil, fmt.Errorf("diff dump %d is from chain %s but the previous dump is from chain %s",
+				i, diffHeader.ChainID, header.ChainID)
+		}
+		header, height = diffHeader, diffHeight
+	}
 
-	for {
-		row, err := reader.Next()
+	return header, s.writeState.AddBlock(&exec.BlockExecution{
+		Height:       0,
+		TxExecutions: loader.txs(),
+	})
+}
+
+// ReadDumpHeader reads the header from the first row of the dump in filename, returns nil for dumps that predate the
+// header
+func ReadDumpHeader(filename string) (*dump.Header, error) {
+	reader, err := NewFileDumpReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.(*FileDumpReader).file.Close()
+	row, err := reader.Next()
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return row.Header, nil
+}
 
+// DumpChainParams gets the chain params of genesisDoc to record in the header of a dump
+func DumpChainParams(genesisDoc *genesis.GenesisDoc) *dump.ChainParams {
+	return &dump.ChainParams{
+		ProposalThreshold:  genesisDoc.Params.ProposalThreshold,
+		EVMVersion:         genesisDoc.Params.EVMVersion,
+		BlockGasLimit:      genesisDoc.Params.BlockGasLimit,
+		WASM:               genesisDoc.Params.WASM,
+		StorageRentPerWord: genesisDoc.Params.StorageRentPerWord,
+		StorageRentPeriod:  genesisDoc.Params.StorageRentPeriod,
+		MaxTxExpiryBlocks:  genesisDoc.Params.MaxTxExpiryBlocks,
+		NameOwnership:      genesisDoc.Params.NameOwnership,
+	}
+}
+
+// SetDumpGenesis sets the chain params of genesisDoc to those recorded in the header of a dump to be restored onto it
+// (see CheckDumpGenesis)
+func SetDumpGenesis(header *dump.Header, genesisDoc *genesis.GenesisDoc) {
+	if header == nil || header.Params == nil {
+		return
+	}
+	genesisDoc.Params.ProposalThreshold = header.Params.ProposalThreshold
+	genesisDoc.Params.EVMVersion = header.Params.EVMVersion
+	genesisDoc.Params.BlockGasLimit = header.Params.BlockGasLimit
+	genesisDoc.Params.WASM = header.Params.WASM
+	genesisDoc.Params.StorageRentPerWord = header.Params.StorageRentPerWord
+	genesisDoc.Params.StorageRentPeriod = header.Params.StorageRentPeriod
+	genesisDoc.Params.MaxTxExpiryBlocks = header.Params.MaxTxExpiryBlocks
+	genesisDoc.Params.NameOwnership = header.Params.NameOwnership
+}
+
+// CheckDumpGenesis checks that genesisDoc, onto which the dump with header is being restored, has the chain params
+// recorded in the header (as set by burrow configure --restore-dump)
+func CheckDumpGenesis(header *dump.Header, genesisDoc *genesis.GenesisDoc) error {
+	if header == nil || header.Params == nil {
+		return nil
+	}
+	params := DumpChainParams(genesisDoc)
+	for _, param := range []struct {
+		name          string
+		dump, genesis interface{}
+	}{
+		{"ProposalThreshold", header.Params.ProposalThreshold, params.ProposalThreshold},
+		{"EVMVersion", header.Params.EVMVersion, params.EVMVersion},
+		{"BlockGasLimit", header.Params.BlockGasLimit, params.BlockGasLimit},
+		{"WASM", header.Params.WASM, params.WASM},
+		{"StorageRentPerWord", header.Params.StorageRentPerWord, params.StorageRentPerWord},
+		{"StorageRentPeriod", header.Params.StorageRentPeriod, params.StorageRentPeriod},
+		{"MaxTxExpiryBlocks", header.Params.MaxTxExpiryBlocks, params.MaxTxExpiryBlocks},
+		{"NameOwnership", header.Params.NameOwnership, params.NameOwnership},
+	} {
+		if param.dump != param.genesis {
+			return fmt.Errorf("dump from chain %s has %s %v but GenesisDoc has %v", header.ChainID, param.name,
+				param.dump, param.genesis)
+		}
+	}
+	return nil
+}
+
+// Accumulates restored events across dumps so they can be added as a single block
+type dumpLoader struct {
+	ws       *writeState
+	finished []*exec.TxExecution
+	tx       *exec.TxExecution
+	// Validators loaded from the dumps
+	validators map[crypto.Address]bool
+}
+
+// Remove validators in state that were not loaded from the dumps
+func (dl *dumpLoader) removeOtherValidators() error {
+	var others []crypto.PublicKey
+	err := dl.ws.ring.Head().Next.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		if power.Sign() != 0 && !dl.validators[id.GetAddress()] {
+			others = append(others, id.GetPublicKey())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range others {
+		err = dl.ws.SetPower(id, new(big.Int))
 		if err != nil {
 			return err
 		}
+	}
+	return nil
+}
+
+// Load a single dump returning its header and height
+func (dl *dumpLoader) load(reader DumpReader) (*dump.Header, uint64, error) {
+	var header *dump.Header
+	var height uint64
+	var trailer *dump.Trailer
+	digest := dump.NewDigest()
+
+	for rows := 0; ; rows++ {
+		row, err := reader.Next()
+
+		if err != nil {
+			return nil, 0, err
+		}
 
 		if row == nil {
 			break
 		}
 
-		if row.Account != nil {
-			if row.Account.Address != acm.GlobalPermissionsAddress {
-				s.writeState.UpdateAccount(row.Account)
+		if trailer != nil {
+			return nil, 0, fmt.Errorf("dump contains rows after its trailer")
+		}
+		if row.Trailer != nil {
+			if header == nil {
+				return nil, 0, fmt.Errorf("dump has a trailer but no header")
 			}
+			if !bytes.Equal(row.Trailer.Checksum, digest.Sum()) {
+				return nil, 0, fmt.Errorf("dump checksum mismatch: trailer has %v but rows give %X",
+					row.Trailer.Checksum, digest.Sum())
+			}
+			trailer = row.Trailer
+			continue
+		}
+		err = digest.Write(row)
+		if err != nil {
+			return nil, 0, err
 		}
-		if row.AccountStorage != nil {
-			for _, storage := range row.AccountStorage.Storage {
-				err := s.writeState.SetStorage(row.AccountStorage.Address, storage.Key, storage.Value)
-				if err != nil {
-					return err
-				}
+
+		if row.Header != nil {
+			if rows > 0 {
+				return nil, 0, fmt.Errorf("dump header must be the first row but found it at row %d", rows)
+			}
+			if row.Header.Version > dump.Version {
+				return nil, 0, fmt.Errorf("dump has version %d but we only understand versions up to %d",
+					row.Header.Version, dump.Version)
 			}
+			header = row.Header
+			height = row.Height
 		}
-		if row.Name != nil {
-			s.writeState.UpdateName(row.Name)
+		err = dl.loadRow(row)
+		if err != nil {
+			return nil, 0, err
 		}
-		if row.EVMEvent != nil {
+	}
+
+	if header != nil && trailer == nil {
+		return nil, 0, fmt.Errorf("dump has no trailer so may be truncated")
+	}
+	return header, height, nil
+}
 
-			if tx != nil && row.Height != tx.Height {
-				txs = append(txs, tx)
-				tx = nil
+func (dl *dumpLoader) loadRow(row *dump.Dump) error {
+	ws := dl.ws
+	if row.Removed != nil {
+		if row.Removed.Account != nil {
+			err := ws.RemoveAccount(*row.Removed.Account)
+			if err != nil {
+				return err
 			}
-			if tx == nil {
-				tx = &exec.TxExecution{
-					TxHeader: &exec.TxHeader{
-						TxType: payload.TypeCall,
-						TxHash: make([]byte, 32),
-						Height: row.Height,
-						Origin: &exec.Origin{
-							ChainID: row.EVMEvent.ChainID,
-							Time:    row.EVMEvent.Time,
-						},
-					},
-				}
+		}
+		if row.Removed.Name != "" {
+			err := ws.RemoveName(row.Removed.Name)
+			if err != nil {
+				return err
+			}
+		}
+		if len(row.Removed.ProposalHash) > 0 {
+			err := ws.RemoveProposal(row.Removed.ProposalHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if row.Account != nil {
+		err := ws.UpdateAccount(row.Account)
+		if err != nil {
+			return err
+		}
+	}
+	if row.AccountStorage != nil {
+		for _, storage := range row.AccountStorage.Storage {
+			err := ws.SetStorage(row.AccountStorage.Address, storage.Key, storage.Value)
+			if err != nil {
+				return err
 			}
+		}
+	}
+	if row.Name != nil {
+		err := ws.UpdateName(row.Name)
+		if err != nil {
+			return err
+		}
+	}
+	if row.Validator != nil {
+		err := ws.SetPower(row.Validator.PublicKey, row.Validator.BigPower())
+		if err != nil {
+			return err
+		}
+		dl.validators[row.Validator.GetAddress()] = true
+	}
+	if row.Proposal != nil {
+		err := ws.UpdateProposal(row.Proposal.ProposalHash, row.Proposal.Ballot)
+		if err != nil {
+			return err
+		}
+	}
+	if row.EVMEvent != nil {
 
-			tx.Events = append(tx.Events, &exec.Event{
-				Header: &exec.Header{
-					TxType:    payload.TypeCall,
-					EventType: exec.TypeLog,
-					Height:    row.Height,
+		if dl.tx != nil && row.Height != dl.tx.Height {
+			dl.finished = append(dl.finished, dl.tx)
+			dl.tx = nil
+		}
+		if dl.tx == nil {
+			dl.tx = &exec.TxExecution{
+				TxHeader: &exec.TxHeader{
+					TxType: payload.TypeCall,
+					TxHash: make([]byte, 32),
+					Height: row.Height,
+					Origin: &exec.Origin{
+						ChainID: row.EVMEvent.ChainID,
+						Time:    row.EVMEvent.Time,
+					},
 				},
-				Log: row.EVMEvent.Event,
-			})
+			}
 		}
-	}
 
-	if tx != nil {
-		txs = append(txs, tx)
+		dl.tx.Events = append(dl.tx.Events, &exec.Event{
+			Header: &exec.Header{
+				TxType:    payload.TypeCall,
+				EventType: exec.TypeLog,
+				Height:    row.Height,
+			},
+			Log: row.EVMEvent.Event,
+		})
 	}
+	return nil
+}
 
-	return s.writeState.AddBlock(&exec.BlockExecution{
-		Height:       0,
-		TxExecutions: txs,
-	})
+func (dl *dumpLoader) txs() []*exec.TxExecution {
+	txs := make([]*exec.TxExecution, 0, len(dl.finished)+1)
+	txs = append(txs, dl.finished...)
+	if dl.tx != nil {
+		txs = append(txs, dl.tx)
+	}
+	return txs
 }
 
 func (s *State) Dump() string {
@@ -0,0 +1,157 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Produce the dump rows for accounts, storage, names, validators, and proposals that changed between fromHeight and
+// toHeight. Removals are recorded with Removed rows, storage slots that were cleared with zero values, and validators
+// that were removed with zero power. Subtrees whose hash is unchanged between the two heights are skipped without being
+// read, so the cost is proportional to the number of changes rather than the size of the state.
+func (s *State) DumpDiff(fromHeight, toHeight uint64, fn func(row *dump.Dump) error) error {
+	if fromHeight >= toHeight {
+		return fmt.Errorf("cannot diff from height %d to height %d, from height must be lower", fromHeight, toHeight)
+	}
+	from, err := s.writeState.forest.GetImmutable(VersionAtHeight(fromHeight))
+	if err != nil {
+		return err
+	}
+	to, err := s.writeState.forest.GetImmutable(VersionAtHeight(toHeight))
+	if err != nil {
+		return err
+	}
+	dd := &dumpDiffer{from: from, to: to, height: toHeight, fn: fn}
+	for _, diff := range []func() error{dd.accounts, dd.storage, dd.names, dd.validators, dd.proposals} {
+		err = diff()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type dumpDiffer struct {
+	from   *storage.ImmutableForest
+	to     *storage.ImmutableForest
+	height uint64
+	fn     func(row *dump.Dump) error
+}
+
+// Diff the keys of the tree at prefix
+func (dd *dumpDiffer) diffTree(prefix storage.Prefix, fn func(key, fromValue, toValue []byte) error) error {
+	return dd.diffTrees(prefix, func(_ []byte, fromTree, toTree storage.KVCallbackIterableReader) error {
+		return storage.DiffTrees(fromTree, toTree, nil, nil, fn)
+	})
+}
+
+// Diff the trees whose prefix begins with prefix
+func (dd *dumpDiffer) diffTrees(prefix storage.Prefix,
+	fn func(prefix []byte, fromTree, toTree storage.KVCallbackIterableReader) error) error {
+	return storage.DiffForests(dd.from, dd.to, prefix, prefix.Above(), fn)
+}
+
+func (dd *dumpDiffer) accounts() error {
+	return dd.diffTree(keys.Account.Prefix(), func(key, _, toValue []byte) error {
+		if toValue == nil {
+			address, err := crypto.AddressFromBytes(key)
+			if err != nil {
+				return err
+			}
+			return dd.fn(&dump.Dump{Height: dd.height, Removed: &dump.Removed{Account: &address}})
+		}
+		acc, err := acm.Decode(toValue)
+		if err != nil {
+			return err
+		}
+		return dd.fn(&dump.Dump{Height: dd.height, Account: acc})
+	})
+}
+
+func (dd *dumpDiffer) storage() error {
+	accounts, err := dd.to.Reader(keys.Account.Prefix())
+	if err != nil {
+		return err
+	}
+	return dd.diffTrees(keys.Storage.Prefix(), func(prefix []byte, fromTree, toTree storage.KVCallbackIterableReader) error {
+		address, err := crypto.AddressFromBytes(keys.Storage.Prefix().Suffix(prefix))
+		if err != nil {
+			return err
+		}
+		if !accounts.Has(keys.Account.KeyNoPrefix(address)) {
+			// Storage of removed accounts is removed along with the account
+			return nil
+		}
+		accountStorage := &dump.AccountStorage{Address: address}
+		err = storage.DiffTrees(fromTree, toTree, nil, nil, func(key, _, toValue []byte) error {
+			accountStorage.Storage = append(accountStorage.Storage, &dump.Storage{
+				Key:   binary.LeftPadWord256(key),
+				Value: binary.LeftPadWord256(toValue),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(accountStorage.Storage) == 0 {
+			return nil
+		}
+		return dd.fn(&dump.Dump{Height: dd.height, AccountStorage: accountStorage})
+	})
+}
+
+func (dd *dumpDiffer) names() error {
+	return dd.diffTree(keys.Name.Prefix(), func(_, fromValue, toValue []byte) error {
+		if toValue == nil {
+			entry, err := names.DecodeEntry(fromValue)
+			if err != nil {
+				return err
+			}
+			return dd.fn(&dump.Dump{Height: dd.height, Removed: &dump.Removed{Name: entry.Name}})
+		}
+		entry, err := names.DecodeEntry(toValue)
+		if err != nil {
+			return err
+		}
+		return dd.fn(&dump.Dump{Height: dd.height, Name: entry})
+	})
+}
+
+func (dd *dumpDiffer) validators() error {
+	return dd.diffTree(keys.Validator.Prefix(), func(_, fromValue, toValue []byte) error {
+		if toValue == nil {
+			v, err := validator.Decode(fromValue)
+			if err != nil {
+				return err
+			}
+			v.Power = 0
+			return dd.fn(&dump.Dump{Height: dd.height, Validator: v})
+		}
+		v, err := validator.Decode(toValue)
+		if err != nil {
+			return err
+		}
+		return dd.fn(&dump.Dump{Height: dd.height, Validator: v})
+	})
+}
+
+func (dd *dumpDiffer) proposals() error {
+	return dd.diffTree(keys.Proposal.Prefix(), func(key, _, toValue []byte) error {
+		if toValue == nil {
+			return dd.fn(&dump.Dump{Height: dd.height, Removed: &dump.Removed{ProposalHash: key}})
+		}
+		ballot, err := payload.DecodeBallot(toValue)
+		if err != nil {
+			return err
+		}
+		return dd.fn(&dump.Dump{Height: dd.height, Proposal: &dump.Proposal{ProposalHash: key, Ballot: ballot}})
+	})
+}
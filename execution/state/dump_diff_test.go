@@ -0,0 +1,171 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestState_DumpDiff(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+
+	addressA := crypto.Address{1}
+	addressB := crypto.Address{2}
+	proposalHash := make([]byte, 32)
+	proposalHash[0] = 1
+
+	_, version, err := st.Update(func(up Updatable) error {
+		for _, address := range []crypto.Address{addressA, addressB} {
+			err := up.UpdateAccount(&acm.Account{Address: address, Balance: 1})
+			if err != nil {
+				return err
+			}
+			err = up.SetStorage(address, word(1), word(1))
+			if err != nil {
+				return err
+			}
+		}
+		err := up.UpdateName(&names.Entry{Name: "going", Owner: addressA})
+		if err != nil {
+			return err
+		}
+		err = up.SetPower(pub(1), pow(1))
+		if err != nil {
+			return err
+		}
+		return up.UpdateProposal(proposalHash, &payload.Ballot{Proposal: &payload.Proposal{Name: "Frogs"}})
+	})
+	require.NoError(t, err)
+	fromHeight := HeightAtVersion(version)
+
+	_, version, err = st.Update(func(up Updatable) error {
+		err := up.UpdateAccount(&acm.Account{Address: addressA, Balance: 2})
+		if err != nil {
+			return err
+		}
+		err = up.SetStorage(addressA, word(1), binary.Zero256)
+		if err != nil {
+			return err
+		}
+		err = up.SetStorage(addressA, word(2), word(2))
+		if err != nil {
+			return err
+		}
+		err = up.RemoveAccount(addressB)
+		if err != nil {
+			return err
+		}
+		err = up.RemoveName("going")
+		if err != nil {
+			return err
+		}
+		err = up.UpdateName(&names.Entry{Name: "coming", Owner: addressA})
+		if err != nil {
+			return err
+		}
+		err = up.SetPower(pub(1), new(big.Int))
+		if err != nil {
+			return err
+		}
+		err = up.SetPower(pub(2), pow(2))
+		if err != nil {
+			return err
+		}
+		return up.RemoveProposal(proposalHash)
+	})
+	require.NoError(t, err)
+	toHeight := HeightAtVersion(version)
+
+	from, err := st.LoadHeight(fromHeight)
+	require.NoError(t, err)
+	base := dumpRows(t, &dump.Dump{Height: fromHeight, Header: &dump.Header{Version: dump.Version}}, fullDump(t, from))
+
+	var diffRows []*dump.Dump
+	err = st.DumpDiff(fromHeight, toHeight, func(row *dump.Dump) error {
+		diffRows = append(diffRows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	diff := dumpRows(t, &dump.Dump{Height: toHeight, Header: &dump.Header{Version: dump.Version, FromHeight: fromHeight}},
+		diffRows)
+
+	restored, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	_, err = restored.LoadDump(&rowsDumpReader{rows: base}, &rowsDumpReader{rows: diff})
+	require.NoError(t, err)
+	require.NoError(t, restored.InitialCommit())
+
+	to, err := st.LoadHeight(toHeight)
+	require.NoError(t, err)
+	assert.Equal(t, fullDump(t, to), fullDump(t, &restored.ReadState))
+
+	// Diffs must chain from the height of the dump before them
+	diff[0].Header.FromHeight = fromHeight + 1
+	_, err = NewState(dbm.NewMemDB()).LoadDump(&rowsDumpReader{rows: dumpRows(t, base[0], base[1:len(base)-1])},
+		&rowsDumpReader{rows: dumpRows(t, diff[0], diff[1:len(diff)-1])})
+	require.Error(t, err)
+
+	// And from the same chain
+	diff[0].Header.FromHeight = fromHeight
+	diff[0].Header.ChainID = "Other"
+	_, err = NewState(dbm.NewMemDB()).LoadDump(&rowsDumpReader{rows: dumpRows(t, base[0], base[1:len(base)-1])},
+		&rowsDumpReader{rows: dumpRows(t, diff[0], diff[1:len(diff)-1])})
+	require.Error(t, err)
+}
+
+// Frame rows with header and checksum trailer
+func dumpRows(t *testing.T, header *dump.Dump, rows []*dump.Dump) []*dump.Dump {
+	digest := dump.NewDigest()
+	rows = append([]*dump.Dump{header}, rows...)
+	for _, row := range rows {
+		require.NoError(t, digest.Write(row))
+	}
+	return append(rows, &dump.Dump{Height: header.Height, Trailer: &dump.Trailer{Checksum: digest.Sum()}})
+}
+
+// Everything but events
+func fullDump(t *testing.T, st *ReadState) []*dump.Dump {
+	var rows []*dump.Dump
+	require.NoError(t, st.IterateAccounts(func(acc *acm.Account) error {
+		rows = append(rows, &dump.Dump{Account: acc})
+		accountStorage := &dump.AccountStorage{Address: acc.Address}
+		err := st.IterateStorage(acc.Address, func(key, value binary.Word256) error {
+			accountStorage.Storage = append(accountStorage.Storage, &dump.Storage{Key: key, Value: value})
+			return nil
+		})
+		if len(accountStorage.Storage) > 0 {
+			rows = append(rows, &dump.Dump{AccountStorage: accountStorage})
+		}
+		return err
+	}))
+	require.NoError(t, st.IterateNames(func(entry *names.Entry) error {
+		rows = append(rows, &dump.Dump{Name: entry})
+		return nil
+	}))
+	require.NoError(t, st.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		rows = append(rows, &dump.Dump{Validator: validator.New(id.GetPublicKey(), power)})
+		return nil
+	}))
+	require.NoError(t, st.IterateProposals(func(proposalHash []byte, ballot *payload.Ballot) error {
+		rows = append(rows, &dump.Dump{Proposal: &dump.Proposal{ProposalHash: proposalHash, Ballot: ballot}})
+		return nil
+	}))
+	return rows
+}
+
+func word(i int64) binary.Word256 {
+	return binary.Int64ToWord256(i)
+}
@@ -1,20 +1,26 @@
 package state
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
+	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type MockDumpReader struct {
@@ -97,9 +103,117 @@ func BenchmarkLoadDump(b *testing.B) {
 		}
 		st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
 		require.NoError(b, err)
-		err = st.LoadDump(&mock)
+		_, err = st.LoadDump(&mock)
 		require.NoError(b, err)
 		err = st.InitialCommit()
 		require.NoError(b, err)
 	}
 }
+
+type rowsDumpReader struct {
+	rows []*dump.Dump
+}
+
+func (r *rowsDumpReader) Next() (*dump.Dump, error) {
+	if len(r.rows) == 0 {
+		return nil, nil
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func TestLoadDump(t *testing.T) {
+	val := validator.New(pub(1), pow(2))
+	proposalHash := make([]byte, 32)
+	proposalHash[0] = 1
+	ballot := &payload.Ballot{
+		Proposal:      &payload.Proposal{Name: "Frogs"},
+		ProposalState: payload.Ballot_PROPOSED,
+	}
+	rows := []*dump.Dump{
+		{Header: &dump.Header{Version: dump.Version, ChainID: "Mocky", Params: &dump.ChainParams{ProposalThreshold: 4}}},
+		{Account: &acm.Account{Address: crypto.Address{1}, Balance: 3}},
+		{Account: &acm.Account{Address: acm.GlobalPermissionsAddress, Balance: 4}},
+		{Validator: val},
+		{Proposal: &dump.Proposal{ProposalHash: proposalHash, Ballot: ballot}},
+	}
+	digest := dump.NewDigest()
+	for _, row := range rows {
+		require.NoError(t, digest.Write(row))
+	}
+	rows = append(rows, &dump.Dump{Trailer: &dump.Trailer{Checksum: digest.Sum()}})
+
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	header, err := st.LoadDump(&rowsDumpReader{rows: rows})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	assert.Equal(t, uint64(4), header.Params.ProposalThreshold)
+
+	acc, err := st.GetAccount(acm.GlobalPermissionsAddress)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), acc.Balance)
+	power, err := st.Power(val.GetAddress())
+	require.NoError(t, err)
+	assert.Equal(t, pow(2), power)
+	restoredBallot, err := st.GetProposal(proposalHash)
+	require.NoError(t, err)
+	assert.Equal(t, "Frogs", restoredBallot.Proposal.Name)
+
+	// Tampering with any row should be detected
+	rows[1].Account.Balance = 5
+	_, err = NewState(dbm.NewMemDB()).LoadDump(&rowsDumpReader{rows: rows})
+	require.Error(t, err)
+
+	// As should truncation
+	_, err = NewState(dbm.NewMemDB()).LoadDump(&rowsDumpReader{rows: rows[:3]})
+	require.Error(t, err)
+}
+
+func TestCheckDumpGenesis(t *testing.T) {
+	header := &dump.Header{
+		Version: dump.Version,
+		ChainID: "Mocky",
+		Params: &dump.ChainParams{
+			ProposalThreshold:  4,
+			EVMVersion:         "istanbul",
+			BlockGasLimit:      1 << 30,
+			WASM:               true,
+			StorageRentPerWord: 2,
+			StorageRentPeriod:  100,
+			MaxTxExpiryBlocks:  genesis.DefaultMaxTxExpiryBlocks,
+			NameOwnership:      true,
+		},
+	}
+	f, err := ioutil.TempFile("", "dump")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	require.NoError(t, json.NewEncoder(f).Encode(&dump.Dump{Header: header}))
+	require.NoError(t, f.Close())
+	readHeader, err := ReadDumpHeader(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, header.Params, readHeader.Params)
+
+	genesisDoc := &genesis.GenesisDoc{}
+	require.Error(t, CheckDumpGenesis(readHeader, genesisDoc))
+	SetDumpGenesis(readHeader, genesisDoc)
+	require.NoError(t, CheckDumpGenesis(header, genesisDoc))
+	assert.Equal(t, header.Params, DumpChainParams(genesisDoc))
+
+	// Every param must match
+	for _, alter := range []func(){
+		func() { genesisDoc.Params.ProposalThreshold++ },
+		func() { genesisDoc.Params.EVMVersion = "byzantium" },
+		func() { genesisDoc.Params.BlockGasLimit++ },
+		func() { genesisDoc.Params.WASM = false },
+		func() { genesisDoc.Params.StorageRentPerWord++ },
+		func() { genesisDoc.Params.StorageRentPeriod++ },
+		func() { genesisDoc.Params.MaxTxExpiryBlocks++ },
+		func() { genesisDoc.Params.NameOwnership = false },
+	} {
+		SetDumpGenesis(header, genesisDoc)
+		alter()
+		require.Error(t, CheckDumpGenesis(header, genesisDoc))
+	}
+}
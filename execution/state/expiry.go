@@ -0,0 +1,38 @@
+package state
+
+func (s *ReadState) HasTx(expiryHeight uint64, txHash []byte) (bool, error) {
+	tree, err := s.Forest.Reader(keys.TxExpiry.Prefix())
+	if err != nil {
+		return false, err
+	}
+	return tree.Get(keys.TxExpiry.KeyNoPrefix(expiryHeight, txHash)) != nil, nil
+}
+
+func (ws *writeState) AddTx(expiryHeight uint64, txHash []byte) error {
+	tree, err := ws.forest.Writer(keys.TxExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.TxExpiry.KeyNoPrefix(expiryHeight, txHash), txHash)
+	return nil
+}
+
+func (ws *writeState) PruneTxs(height uint64) error {
+	tree, err := ws.forest.Writer(keys.TxExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	// Only delete keys that exist so that the tree is not saved when there is nothing to prune
+	var expired [][]byte
+	err = tree.IterateWriteTree(nil, keys.TxExpiry.KeyNoPrefix(height), true, func(key, _ []byte) error {
+		expired = append(expired, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		tree.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package state
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	commitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "commit_duration_seconds",
+		Help:      "Time taken to save the state trees for a block",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	})
+	treeSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "tree_size",
+		Help:      "Number of keys in each top-level IAVL tree of state",
+	}, []string{"tree"})
+	forestTrees = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "trees",
+		Help:      "Number of IAVL trees in state including one per account with storage",
+	})
+	accounts = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "accounts",
+		Help:      "Number of accounts in state by whether they have code",
+	}, []string{"code"})
+)
+
+// Trees with a single prefix whose sizes are reported
+var measuredTrees = map[string][]byte{
+	"account":    keys.Account.Prefix(),
+	"name":       keys.Name.Prefix(),
+	"name_owner": keys.NameOwner.Prefix(),
+	"proposal":   keys.Proposal.Prefix(),
+	"validator":  keys.Validator.Prefix(),
+	"event":      keys.Event.Prefix(),
+	"tx_hash":    keys.TxHash.Prefix(),
+}
+
+func init() {
+	prometheus.MustRegister(commitDuration, treeSize, forestTrees, accounts)
+}
+
+// Must be called with the state lock held after a save
+func (s *State) observeTrees() error {
+	for name, prefix := range measuredTrees {
+		size, err := s.writeState.forest.Size(prefix)
+		if err != nil {
+			return err
+		}
+		treeSize.WithLabelValues(name).Set(float64(size))
+	}
+	forestTrees.Set(float64(s.writeState.forest.Trees()))
+	accounts.WithLabelValues("true").Set(float64(s.writeState.accountStats.AccountsWithCode))
+	accounts.WithLabelValues("false").Set(float64(s.writeState.accountStats.AccountsWithoutCode))
+	return nil
+}
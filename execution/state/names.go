@@ -3,7 +3,9 @@ package state
 import (
 	"fmt"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
 )
 
 var _ names.IterableReader = &State{}
@@ -26,11 +28,28 @@ func (ws *writeState) UpdateName(entry *names.Entry) error {
 	if err != nil {
 		return err
 	}
+	key := keys.Name.KeyNoPrefix(entry.Name)
+	previous, err := decodeEntryOrNil(tree.Get(key))
+	if err != nil {
+		return err
+	}
 	bs, err := entry.Encode()
 	if err != nil {
 		return err
 	}
-	tree.Set(keys.Name.KeyNoPrefix(entry.Name), bs)
+	tree.Set(key, bs)
+	// Maintain the reverse index from owner to names
+	if !ws.nameOwnership || previous != nil && previous.Owner == entry.Owner {
+		return nil
+	}
+	ownerTree, err := ws.forest.Writer(keys.NameOwner.Prefix())
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		ownerTree.Delete(keys.NameOwner.KeyNoPrefix(previous.Owner, previous.Name))
+	}
+	ownerTree.Set(keys.NameOwner.KeyNoPrefix(entry.Owner, entry.Name), []byte(entry.Name))
 	return nil
 }
 
@@ -39,7 +58,20 @@ func (ws *writeState) RemoveName(name string) error {
 	if err != nil {
 		return err
 	}
-	tree.Delete(keys.Name.KeyNoPrefix(name))
+	key := keys.Name.KeyNoPrefix(name)
+	previous, err := decodeEntryOrNil(tree.Get(key))
+	if err != nil {
+		return err
+	}
+	tree.Delete(key)
+	if !ws.nameOwnership || previous == nil {
+		return nil
+	}
+	ownerTree, err := ws.forest.Writer(keys.NameOwner.Prefix())
+	if err != nil {
+		return err
+	}
+	ownerTree.Delete(keys.NameOwner.KeyNoPrefix(previous.Owner, name))
 	return nil
 }
 
@@ -56,3 +88,36 @@ func (s *ReadState) IterateNames(consumer func(*names.Entry) error) error {
 		return consumer(entry)
 	})
 }
+
+func (s *State) IterateNamesByOwner(owner crypto.Address, consumer func(*names.Entry) error) error {
+	if !s.writeState.nameOwnership {
+		return fmt.Errorf("names are not indexed by owner since NameOwnership is not enabled on this chain")
+	}
+	return s.ReadState.IterateNamesByOwner(owner, consumer)
+}
+
+func (s *ReadState) IterateNamesByOwner(owner crypto.Address, consumer func(*names.Entry) error) error {
+	tree, err := s.Forest.Reader(keys.NameOwner.Prefix())
+	if err != nil {
+		return err
+	}
+	start := storage.Prefix(owner.Bytes())
+	return tree.Iterate(start, start.Above(), true, func(_ []byte, name []byte) error {
+		entry, err := s.GetName(string(name))
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return fmt.Errorf("State.IterateNamesByOwner() found name %s indexed for owner %v but no entry for it",
+				name, owner)
+		}
+		return consumer(entry)
+	})
+}
+
+func decodeEntryOrNil(bs []byte) (*names.Entry, error) {
+	if bs == nil {
+		return nil, nil
+	}
+	return names.DecodeEntry(bs)
+}
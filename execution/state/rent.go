@@ -0,0 +1,113 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// StorageRentCursor marks where the collection of storage rent in progress continues
+type StorageRentCursor struct {
+	// The next account to be charged, or the account whose storage is being evicted
+	Address crypto.Address
+	// When non-nil the storage of Address is being evicted and eviction continues from this key
+	EvictFrom *binary.Word256
+}
+
+func (cursor *StorageRentCursor) bytes() []byte {
+	if cursor.EvictFrom == nil {
+		return cursor.Address.Bytes()
+	}
+	return append(cursor.Address.Bytes(), cursor.EvictFrom.Bytes()...)
+}
+
+// GetStorageRentCursor returns where the collection of storage rent in progress continues, or nil if no collection is
+// in progress
+func (s *ReadState) GetStorageRentCursor() (*StorageRentCursor, error) {
+	tree, err := s.Forest.Reader(keys.RentCursor.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	var cursor *StorageRentCursor
+	err = tree.Iterate(nil, nil, true, func(key, value []byte) error {
+		if len(value) != crypto.AddressLength && len(value) != crypto.AddressLength+binary.Word256Length {
+			return fmt.Errorf("GetStorageRentCursor could not decode cursor %X", value)
+		}
+		address, err := crypto.AddressFromBytes(value[:crypto.AddressLength])
+		if err != nil {
+			return fmt.Errorf("GetStorageRentCursor could not decode address: %v", err)
+		}
+		cursor = &StorageRentCursor{Address: address}
+		if len(value) > crypto.AddressLength {
+			evictFrom := binary.LeftPadWord256(value[crypto.AddressLength:])
+			cursor.EvictFrom = &evictFrom
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+func (ws *writeState) SetStorageRentCursor(cursor *StorageRentCursor) error {
+	tree, err := ws.forest.Writer(keys.RentCursor.Prefix())
+	if err != nil {
+		return err
+	}
+	// Only write when the cursor moves so that the tree is not saved when no collection is in progress
+	current := make(map[string][]byte)
+	err = tree.IterateWriteTree(nil, nil, true, func(key, value []byte) error {
+		current[string(key)] = value
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var next, value []byte
+	if cursor != nil {
+		next = keys.RentCursor.KeyNoPrefix(&cursor.Address)
+		value = cursor.bytes()
+	}
+	for key := range current {
+		if key != string(next) {
+			tree.Delete([]byte(key))
+		}
+	}
+	if cursor != nil && !bytes.Equal(current[string(next)], value) {
+		tree.Set(next, value)
+	}
+	return nil
+}
+
+// IterateStorageFrom iterates over the storage of an account in ascending order of key starting with start
+func (s *ReadState) IterateStorageFrom(address crypto.Address, start binary.Word256,
+	consumer func(key, value binary.Word256) error) error {
+
+	keyFormat := keys.Storage.Fix(address)
+	tree, err := s.Forest.Reader(keyFormat.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(keyFormat.KeyNoPrefix(start), nil, true, func(key []byte, value []byte) error {
+		return consumer(binary.LeftPadWord256(key), binary.LeftPadWord256(value))
+	})
+}
+
+// IterateAccountsFrom iterates over the accounts in ascending order of address starting with start
+func (s *ReadState) IterateAccountsFrom(start crypto.Address, consumer func(*acm.Account) error) error {
+	tree, err := s.Forest.Reader(keys.Account.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(keys.Account.KeyNoPrefix(start), nil, true, func(key []byte, value []byte) error {
+		account, err := acm.Decode(value)
+		if err != nil {
+			return fmt.Errorf("IterateAccountsFrom could not decode account: %v", err)
+		}
+		return consumer(account)
+	})
+}
@@ -0,0 +1,148 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/snapshot"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Take a snapshot of the state as it was after the block at height was committed. Each chunk is passed to writeChunk
+// as soon as it is filled. As well as the state at height we include the previous versions needed to reconstruct the
+// validator ring.
+func (s *State) Snapshot(chainID string, height uint64, chunkSize uint64,
+	writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+
+	version := VersionAtHeight(height)
+	manifest := &snapshot.Manifest{
+		ChainID:     chainID,
+		Height:      height,
+		Version:     version,
+		FromVersion: validatorRingStartVersion(version, DefaultValidatorsWindowSize),
+		Format:      snapshot.FormatIAVLForestEntries,
+	}
+	chunker := snapshot.NewChunker(height, chunkSize, func(chunk *snapshot.Chunk) error {
+		hash, err := snapshot.ChunkHash(chunk)
+		if err != nil {
+			return err
+		}
+		manifest.ChunkHashes = append(manifest.ChunkHashes, hash)
+		return writeChunk(chunk)
+	})
+	exporter := storage.NewForestExporter(storage.NewPrefixDB(s.db, forestPrefix))
+	for v := manifest.FromVersion; v <= version; v++ {
+		hash, err := exporter.Export(v, chunker.Add)
+		if err != nil {
+			return nil, fmt.Errorf("could not export state at version %d for snapshot: %v", v, err)
+		}
+		manifest.AppHash = hash
+	}
+	err := chunker.Flush()
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Restore state into db from the snapshot described by manifest, which must match the trusted appHash. Each chunk is
+// checked against the manifest as it is read and written to scratchDB, which should be an empty on-disk database that
+// the caller discards afterwards since it must hold the entire snapshot. We copy only the entries reachable from the
+// versions we need, so a peer cannot slip in entries that verification never looks at. The latest version is verified
+// against appHash and each earlier version (needed for the validator ring) against the app hash recorded in the header
+// of the block that follows it in the verified latest state, or against genesisHash for the genesis state. No version
+// is written to db until it has been verified.
+func RestoreSnapshot(db, scratchDB dbm.DB, source snapshot.Source, manifest *snapshot.Manifest,
+	appHash, genesisHash []byte) (*State, error) {
+
+	if manifest.Format != snapshot.FormatIAVLForestEntries {
+		return nil, fmt.Errorf("cannot restore snapshot with unknown format %d", manifest.Format)
+	}
+	if !bytes.Equal(manifest.AppHash, appHash) {
+		return nil, fmt.Errorf("snapshot at height %d has app hash %v but expected trusted app hash %X",
+			manifest.Height, manifest.AppHash, appHash)
+	}
+	if manifest.Version != VersionAtHeight(manifest.Height) {
+		return nil, fmt.Errorf("snapshot claims version %d at height %d but expected version %d",
+			manifest.Version, manifest.Height, VersionAtHeight(manifest.Height))
+	}
+	if manifest.FromVersion < VersionOffset || manifest.FromVersion > manifest.Version {
+		return nil, fmt.Errorf("snapshot claims to start from version %d but has version %d",
+			manifest.FromVersion, manifest.Version)
+	}
+	err := snapshot.ReadChunks(source, manifest, func(chunk *snapshot.Chunk) error {
+		batch := scratchDB.NewBatch()
+		for _, entry := range chunk.Entries {
+			batch.Set(entry.Key, entry.Value)
+		}
+		return writeBatch(batch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	forestDB := storage.NewPrefixDB(db, forestPrefix)
+	exporter := storage.NewForestExporter(scratchDB)
+	err = restoreVersion(exporter, forestDB, manifest.Version, appHash)
+	if err != nil {
+		return nil, err
+	}
+	err = storage.VerifyForest(forestDB, manifest.Version, appHash)
+	if err != nil {
+		return nil, err
+	}
+	forest, err := NewState(db).writeState.forest.GetImmutable(manifest.Version)
+	if err != nil {
+		return nil, err
+	}
+	trusted := &ReadState{Forest: forest}
+	for v := manifest.FromVersion; v < manifest.Version; v++ {
+		expectedHash := genesisHash
+		if v > VersionOffset {
+			// The header of the block that follows the one at version v commits to the app hash of version v
+			height := HeightAtVersion(v) + 1
+			ev, err := trusted.StreamEvent(height, 0)
+			if err != nil {
+				return nil, err
+			}
+			if ev == nil || ev.BeginBlock == nil || ev.BeginBlock.Header == nil {
+				return nil, fmt.Errorf("could not find header of block %d in snapshot state to verify version %d",
+					height, v)
+			}
+			expectedHash = ev.BeginBlock.Header.AppHash
+		}
+		err = restoreVersion(exporter, forestDB, v, expectedHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return LoadState(db, manifest.Version)
+}
+
+// Copy the entries of version from the exporter into forestDB provided they hash to expectedHash
+func restoreVersion(exporter *storage.ForestExporter, forestDB dbm.DB, version int64, expectedHash []byte) error {
+	batch := forestDB.NewBatch()
+	hash, err := exporter.Export(version, func(key, value []byte) error {
+		batch.Set(key, value)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("snapshot does not contain a valid state at version %d: %v", version, err)
+	}
+	if !bytes.Equal(hash, expectedHash) {
+		return fmt.Errorf("snapshot state at version %d has hash %X but expected trusted hash %X",
+			version, hash, expectedHash)
+	}
+	return writeBatch(batch)
+}
+
+// Tendermint's batches panic rather than return an error when they fail to write
+func writeBatch(batch dbm.Batch) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not write restored snapshot entries: %v", r)
+		}
+	}()
+	batch.Write()
+	return nil
+}
@@ -0,0 +1,286 @@
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestState_Snapshot(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+
+	genesisHash := st.Hash()
+	var height uint64
+	for i := 1; i <= DefaultValidatorsWindowSize+5; i++ {
+		height = commitBlock(t, st, func(up Updatable) error {
+			err := up.UpdateAccount(acm.NewAccountFromSecret(string(rune('a' + i))))
+			if err != nil {
+				return err
+			}
+			return up.SetPower(pub(i%3), pow(i))
+		})
+	}
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := snapshot.NewStore(dir)
+
+	// Use a small chunk size to get plenty of chunks
+	manifest, err := store.Save(height, func(writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+		return st.Snapshot("ChainyMcChainFace", height, 256, writeChunk)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, st.Hash(), manifest.AppHash.Bytes())
+	assert.True(t, len(manifest.ChunkHashes) > 1)
+
+	_, err = RestoreSnapshot(dbm.NewMemDB(), dbm.NewMemDB(), store, manifest, []byte("not the app hash"), genesisHash)
+	require.Error(t, err)
+
+	restored, err := RestoreSnapshot(dbm.NewMemDB(), dbm.NewMemDB(), store, manifest, st.Hash(), genesisHash)
+	require.NoError(t, err)
+	assert.Equal(t, st.Hash(), restored.Hash())
+	assert.Equal(t, st.Version(), restored.Version())
+	require.NoError(t, st.writeState.ring.Equal(restored.writeState.ring))
+
+	var accounts, restoredAccounts []*acm.Account
+	require.NoError(t, st.IterateAccounts(func(acc *acm.Account) error {
+		accounts = append(accounts, acc)
+		return nil
+	}))
+	require.NoError(t, restored.IterateAccounts(func(acc *acm.Account) error {
+		restoredAccounts = append(restoredAccounts, acc)
+		return nil
+	}))
+	assert.Equal(t, accounts, restoredAccounts)
+
+	// Both states should continue identically
+	for _, s := range []*State{st, restored} {
+		_, _, err = s.Update(func(up Updatable) error {
+			return up.SetPower(pub("new"), pow(1))
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, st.Hash(), restored.Hash())
+}
+
+func TestRestoreSnapshot_CorruptChunk(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := snapshot.NewStore(dir)
+	manifest, err := store.Save(0, func(writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+		return st.Snapshot("ChainyMcChainFace", 0, snapshot.DefaultChunkSize, func(chunk *snapshot.Chunk) error {
+			// Corrupt the chunk after its hash has been recorded in the manifest
+			chunk.Entries[0].Value = []byte("corrupt")
+			return writeChunk(chunk)
+		})
+	})
+	require.NoError(t, err)
+	_, err = RestoreSnapshot(dbm.NewMemDB(), dbm.NewMemDB(), store, manifest, st.Hash(), st.Hash())
+	require.Error(t, err)
+}
+
+func TestRestoreSnapshot_UnreachableEntries(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	genesisHash := st.Hash()
+	height := commitBlock(t, st, func(up Updatable) error {
+		return up.UpdateAccount(acm.NewAccountFromSecret("a"))
+	})
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := snapshot.NewStore(dir)
+	manifest, err := store.Save(height, func(writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+		return st.Snapshot("ChainyMcChainFace", height, snapshot.DefaultChunkSize, writeChunk)
+	})
+	require.NoError(t, err)
+
+	// A peer can serve whatever chunks it likes so long as they match its own manifest
+	injected := []byte("r\x00\x00\x00\x00\x00\x00\x01\x00")
+	source := &injectingSource{Store: store, entry: &snapshot.Entry{Key: injected, Value: []byte("bogus root")}}
+	chunk, err := source.GetChunk(manifest.Height, 0)
+	require.NoError(t, err)
+	manifest.ChunkHashes[0], err = snapshot.ChunkHash(chunk)
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	restored, err := RestoreSnapshot(db, dbm.NewMemDB(), source, manifest, st.Hash(), genesisHash)
+	require.NoError(t, err)
+	assert.Equal(t, st.Hash(), restored.Hash())
+	assert.Nil(t, db.Get(append([]byte(forestPrefix), injected...)))
+}
+
+func TestRestoreSnapshot_ForgedEarlierVersion(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	genesisHash := st.Hash()
+	var height uint64
+	for i := 1; i <= 3; i++ {
+		height = commitBlock(t, st, func(up Updatable) error {
+			return up.SetPower(pub(i), pow(i))
+		})
+	}
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := snapshot.NewStore(dir)
+	manifest, err := store.Save(height, func(writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+		return st.Snapshot("ChainyMcChainFace", height, snapshot.DefaultChunkSize, writeChunk)
+	})
+	require.NoError(t, err)
+	require.Equal(t, VersionOffset, manifest.FromVersion)
+
+	// Leave the latest version intact but point the root of an earlier version at a different (complete) state
+	source := &forgingSource{Store: store, entry: forgedRoot(st, 2, 3)}
+	for i := range manifest.ChunkHashes {
+		chunk, err := source.GetChunk(manifest.Height, uint64(i))
+		require.NoError(t, err)
+		manifest.ChunkHashes[i], err = snapshot.ChunkHash(chunk)
+		require.NoError(t, err)
+	}
+	_, err = RestoreSnapshot(dbm.NewMemDB(), dbm.NewMemDB(), source, manifest, st.Hash(), genesisHash)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "version 2")
+
+	// Likewise the genesis state
+	source = &forgingSource{Store: store, entry: forgedRoot(st, 1, 2)}
+	for i := range manifest.ChunkHashes {
+		chunk, err := source.GetChunk(manifest.Height, uint64(i))
+		require.NoError(t, err)
+		manifest.ChunkHashes[i], err = snapshot.ChunkHash(chunk)
+		require.NoError(t, err)
+	}
+	_, err = RestoreSnapshot(dbm.NewMemDB(), dbm.NewMemDB(), source, manifest, st.Hash(), genesisHash)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "version 1")
+}
+
+// Commit a block to st with a header recording the previous app hash as the executor does, returns its height
+func commitBlock(t *testing.T, st *State, update func(up Updatable) error) uint64 {
+	height := HeightAtVersion(st.Version()) + 1
+	appHash := st.Hash()
+	_, version, err := st.Update(func(up Updatable) error {
+		err := update(up)
+		if err != nil {
+			return err
+		}
+		return up.AddBlock(&exec.BlockExecution{
+			Height: height,
+			Header: &abciTypes.Header{Height: int64(height), AppHash: appHash},
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, height, HeightAtVersion(version))
+	return height
+}
+
+// An entry that points the root of the forest at version to the root of the forest at otherVersion
+func forgedRoot(st *State, version, otherVersion int64) *snapshot.Entry {
+	return &snapshot.Entry{
+		Key:   versionRootKey(version),
+		Value: st.db.Get(append([]byte(forestPrefix), versionRootKey(otherVersion)...)),
+	}
+}
+
+// The root of the forest's commits tree as IAVL stores it
+func versionRootKey(version int64) []byte {
+	key := make([]byte, 10)
+	copy(key, "cr")
+	binary.BigEndian.PutUint64(key[2:], uint64(version))
+	return key
+}
+
+// Serves a different value for an entry
+type forgingSource struct {
+	*snapshot.Store
+	entry *snapshot.Entry
+}
+
+func (source *forgingSource) GetChunk(height, index uint64) (*snapshot.Chunk, error) {
+	chunk, err := source.Store.GetChunk(height, index)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range chunk.Entries {
+		if bytes.Equal(entry.Key, source.entry.Key) {
+			entry.Value = source.entry.Value
+		}
+	}
+	return chunk, nil
+}
+
+type injectingSource struct {
+	*snapshot.Store
+	entry *snapshot.Entry
+}
+
+func (source *injectingSource) GetChunk(height, index uint64) (*snapshot.Chunk, error) {
+	chunk, err := source.Store.GetChunk(height, index)
+	if err != nil || index != 0 {
+		return chunk, err
+	}
+	chunk.Entries = append(chunk.Entries, source.entry)
+	return chunk, nil
+}
+
+func TestRestoreSnapshot_WriteFails(t *testing.T) {
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genesis.GenesisDoc{})
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := snapshot.NewStore(dir)
+	manifest, err := store.Save(0, func(writeChunk func(chunk *snapshot.Chunk) error) (*snapshot.Manifest, error) {
+		return st.Snapshot("ChainyMcChainFace", 0, snapshot.DefaultChunkSize, writeChunk)
+	})
+	require.NoError(t, err)
+	_, err = RestoreSnapshot(failingDB{dbm.NewMemDB()}, dbm.NewMemDB(), store, manifest, st.Hash(), st.Hash())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disk full")
+}
+
+type failingDB struct {
+	dbm.DB
+}
+
+func (db failingDB) NewBatch() dbm.Batch {
+	return failingBatch{db.DB.NewBatch()}
+}
+
+type failingBatch struct {
+	dbm.Batch
+}
+
+func (batch failingBatch) Write() {
+	panic(fmt.Errorf("disk full"))
+}
+
+func (batch failingBatch) WriteSync() {
+	panic(fmt.Errorf("disk full"))
+}
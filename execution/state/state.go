@@ -18,6 +18,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -25,6 +26,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/expiry"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/genesis"
@@ -46,16 +48,20 @@ const (
 // Implements account and blockchain state
 var _ acmstate.IterableReader = &State{}
 var _ names.IterableReader = &State{}
+var _ expiry.Reader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
+	Account    *storage.MustKeyFormat
+	Storage    *storage.MustKeyFormat
+	Name       *storage.MustKeyFormat
+	NameOwner  *storage.MustKeyFormat
+	Proposal   *storage.MustKeyFormat
+	Validator  *storage.MustKeyFormat
+	Event      *storage.MustKeyFormat
+	TxHash     *storage.MustKeyFormat
+	TxExpiry   *storage.MustKeyFormat
+	RentCursor *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -65,6 +71,8 @@ var keys = KeyFormatStore{
 	Storage: storage.NewMustKeyFormat("s", crypto.AddressLength, binary.Word256Length),
 	// Name -> Entry
 	Name: storage.NewMustKeyFormat("n", storage.VariadicSegmentLength),
+	// OwnerAddress, Name -> Name
+	NameOwner: storage.NewMustKeyFormat("o", crypto.AddressLength, storage.VariadicSegmentLength),
 	// ProposalHash -> Proposal
 	Proposal: storage.NewMustKeyFormat("p", sha256.Size),
 	// ValidatorAddress -> Power
@@ -73,6 +81,10 @@ var keys = KeyFormatStore{
 	Event: storage.NewMustKeyFormat("e", uint64Length, uint64Length),
 	// TxHash -> TxHeight, TxIndex
 	TxHash: storage.NewMustKeyFormat("th", txs.HashLength),
+	// ExpiryHeight, TxHash -> TxHash
+	TxExpiry: storage.NewMustKeyFormat("x", uint64Length, txs.HashLength),
+	// AccountAddress -> AccountAddress, held only while storage rent is being collected
+	RentCursor: storage.NewMustKeyFormat("r", crypto.AddressLength),
 }
 
 func init() {
@@ -87,6 +99,9 @@ type Updatable interface {
 	names.Writer
 	proposal.Writer
 	validator.Writer
+	expiry.Writer
+	// Set where the collection of storage rent in progress continues, nil when no collection is in progress
+	SetStorageRentCursor(cursor *StorageRentCursor) error
 	AddBlock(blockExecution *exec.BlockExecution) error
 }
 
@@ -95,6 +110,10 @@ type writeState struct {
 	forest       *storage.MutableForest
 	accountStats acmstate.AccountStats
 	ring         *validator.Ring
+	// Whether to maintain the index of names by owner
+	nameOwnership bool
+	// Whether to count the words of storage held by each account for storage rent
+	countStorageWords bool
 }
 
 type ReadState struct {
@@ -132,6 +151,8 @@ func NewState(db dbm.DB) *State {
 // Make genesis state from GenesisDoc and save to DB
 func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error) {
 	s := NewState(db)
+	s.SetNameOwnership(genesisDoc.Params.NameOwnership)
+	s.SetCountStorageWords(genesisDoc.Params.StorageRentPerWord > 0)
 
 	const errHeader = "MakeGenesisState():"
 	// Make accounts state tree
@@ -185,6 +206,23 @@ func (s *State) InitialCommit() error {
 	return nil
 }
 
+// Returns the hash of the state at genesis. A genesis with an AppHash was restored from a dump that must match it,
+// otherwise the state is built from genesisDoc alone.
+func GenesisStateHash(genesisDoc *genesis.GenesisDoc) ([]byte, error) {
+	if len(genesisDoc.AppHash) > 0 {
+		return genesisDoc.AppHash, nil
+	}
+	s, err := MakeGenesisState(dbm.NewMemDB(), genesisDoc)
+	if err != nil {
+		return nil, err
+	}
+	err = s.InitialCommit()
+	if err != nil {
+		return nil, err
+	}
+	return s.Hash(), nil
+}
+
 // Tries to load the execution state from DB, returns nil with no error if no state found
 func LoadState(db dbm.DB, version int64) (*State, error) {
 	s := NewState(db)
@@ -215,6 +253,18 @@ func LoadState(db dbm.DB, version int64) (*State, error) {
 	return s, nil
 }
 
+// Chains with NameOwnership enabled in their genesis maintain an index of names by owner, which must be set for a
+// State loaded from the database before any names are written to it
+func (s *State) SetNameOwnership(nameOwnership bool) {
+	s.writeState.nameOwnership = nameOwnership
+}
+
+// Chains with storage rent enabled in their genesis count the words of storage held by each account, which must be set
+// for a State loaded from the database before any storage is written to it
+func (s *State) SetCountStorageWords(countStorageWords bool) {
+	s.writeState.countStorageWords = countStorageWords
+}
+
 func (s *State) Version() int64 {
 	return s.writeState.forest.Version()
 }
@@ -239,6 +289,25 @@ func (s *State) LoadHeight(height uint64) (*ReadState, error) {
 	}, nil
 }
 
+// Call fn with each key in the forest whose value differs between s and other as they were at height, the prefix is
+// that of the tree in which the key lives. A nil value means the key is absent.
+func (s *State) DiffForest(other *State, height uint64, fn func(prefix, key, value, otherValue []byte) error) error {
+	forest, err := s.writeState.forest.GetImmutable(VersionAtHeight(height))
+	if err != nil {
+		return err
+	}
+	otherForest, err := other.writeState.forest.GetImmutable(VersionAtHeight(height))
+	if err != nil {
+		return err
+	}
+	return storage.DiffForests(forest, otherForest, nil, nil,
+		func(prefix []byte, tree, otherTree storage.KVCallbackIterableReader) error {
+			return storage.DiffTrees(tree, otherTree, nil, nil, func(key, value, otherValue []byte) error {
+				return fn(prefix, key, value, otherValue)
+			})
+		})
+}
+
 // Perform updates to state whilst holding the write lock, allows a commit to hold the write lock across multiple
 // operations while preventing interlaced reads and writes
 func (s *State) Update(updater func(up Updatable) error) ([]byte, int64, error) {
@@ -252,11 +321,17 @@ func (s *State) Update(updater func(up Updatable) error) ([]byte, int64, error)
 }
 
 func (s *State) commit() ([]byte, int64, error) {
+	start := time.Now()
 	// save state at a new version may still be orphaned before we save the version against the hash
 	hash, version, err := s.writeState.forest.Save()
 	if err != nil {
 		return nil, 0, err
 	}
+	commitDuration.Observe(time.Since(start).Seconds())
+	err = s.observeTrees()
+	if err != nil {
+		return nil, 0, err
+	}
 	totalPowerChange, totalFlow, err := s.writeState.ring.Rotate()
 	if err != nil {
 		return nil, 0, err
@@ -271,6 +346,8 @@ func (s *State) commit() ([]byte, int64, error) {
 // Creates a copy of the database to the supplied db
 func (s *State) Copy(db dbm.DB) (*State, error) {
 	stateCopy := NewState(db)
+	stateCopy.SetNameOwnership(s.writeState.nameOwnership)
+	stateCopy.SetCountStorageWords(s.writeState.countStorageWords)
 	err := s.writeState.forest.IterateRWTree(nil, nil, true,
 		func(prefix []byte, tree *storage.RWTree) error {
 			treeCopy, err := stateCopy.writeState.forest.Writer(prefix)
@@ -38,3 +38,34 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, account, accountOut)
 }
+
+func TestState_DiffForest(t *testing.T) {
+	st := NewState(dbm.NewMemDB())
+	other := NewState(dbm.NewMemDB())
+	account := acm.NewAccountFromSecret("Foo")
+	for _, s := range []*State{st, other} {
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateAccount(account)
+		})
+		require.NoError(t, err)
+	}
+	_, version, err := other.Update(func(ws Updatable) error {
+		return ws.UpdateAccount(acm.NewAccountFromSecret("Bar"))
+	})
+	require.NoError(t, err)
+	_, _, err = st.Update(func(ws Updatable) error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	var diffs int
+	err = st.DiffForest(other, HeightAtVersion(version), func(prefix, key, value, otherValue []byte) error {
+		diffs++
+		assert.Equal(t, keys.Account.Prefix().String(), string(prefix))
+		assert.Nil(t, value)
+		assert.NotNil(t, otherValue)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, diffs)
+}
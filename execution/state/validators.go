@@ -23,11 +23,7 @@ func LoadValidatorRing(version int64, ringSize int,
 	// a reindexing). If we are loading a chain whose height is less than the ring size we need to get the initial state
 	// correct
 
-	startVersion := version - int64(ringSize)
-	if startVersion < 1 {
-		// The ring will not be fully populated
-		startVersion = 1
-	}
+	startVersion := validatorRingStartVersion(version, ringSize)
 	var err error
 	// Read state to pull immutable forests from
 	rs := &ReadState{}
@@ -76,6 +72,16 @@ func LoadValidatorRing(version int64, ringSize int,
 	return ring, err
 }
 
+// The earliest version of the forest that LoadValidatorRing needs to read
+func validatorRingStartVersion(version int64, ringSize int) int64 {
+	startVersion := version - int64(ringSize)
+	if startVersion < 1 {
+		// The ring will not be fully populated
+		return 1
+	}
+	return startVersion
+}
+
 func (ws *writeState) MakeGenesisValidators(genesisDoc *genesis.GenesisDoc) error {
 	for _, gv := range genesisDoc.Validators {
 		err := ws.SetPower(gv.PublicKey, new(big.Int).SetUint64(gv.Amount))
@@ -16,6 +16,8 @@ package execution
 
 import (
 	"context"
+	cryptoRand "crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"time"
@@ -29,7 +31,9 @@ import (
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/tracing"
 	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 )
@@ -49,28 +53,33 @@ const (
 // concurrent within a new block window.
 type Transactor struct {
 	Tip             bcm.BlockchainInfo
+	Params          Params
 	Subscribable    event.Subscribable
 	MempoolAccounts *Accounts
 	checkTxAsync    func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error
 	txEncoder       txs.Encoder
 	logger          *logging.Logger
+	// Applied to simulated calls so they see the same VM as committed transactions
+	options []ExecutionOption
 }
 
-func NewTransactor(tip bcm.BlockchainInfo, subscribable event.Subscribable, mempoolAccounts *Accounts,
+func NewTransactor(tip bcm.BlockchainInfo, params Params, subscribable event.Subscribable, mempoolAccounts *Accounts,
 	checkTxAsync func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error, txEncoder txs.Encoder,
-	logger *logging.Logger) *Transactor {
+	logger *logging.Logger, options ...ExecutionOption) *Transactor {
 
 	return &Transactor{
 		Tip:             tip,
+		Params:          params,
 		Subscribable:    subscribable,
 		MempoolAccounts: mempoolAccounts,
 		checkTxAsync:    checkTxAsync,
 		txEncoder:       txEncoder,
 		logger:          logger.With(structure.ComponentKey, "Transactor"),
+		options:         options,
 	}
 }
 
-func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelope) (*exec.TxExecution, error) {
+func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelope) (txe *exec.TxExecution, err error) {
 	// Sign unless already signed - note we must attempt signing before subscribing so we get accurate final TxHash
 	unlock, err := trans.MaybeSignTxMempool(txEnv)
 	if err != nil {
@@ -80,6 +89,12 @@ func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelop
 	defer unlock()
 	// Subscribe before submitting to mempool
 	txHash := txEnv.Tx.Hash()
+	// Spans for CheckTx and DeliverTx of this tx will be children of this one
+	ctx, span := tracing.StartTx(ctx, txHash, "Transactor.BroadcastTxSync")
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
 	subID := event.GenSubID()
 	out, err := trans.Subscribable.Subscribe(ctx, subID, exec.QueryForTxExecution(txHash), SubscribeBufferSize)
 	if err != nil {
@@ -105,7 +120,7 @@ func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelop
 		return nil, fmt.Errorf("timed out waiting for transaction with hash %v timed out after %v",
 			checkTxReceipt.TxHash, BlockingTimeout)
 	case msg := <-out:
-		txe := msg.(*exec.TxExecution)
+		txe = msg.(*exec.TxExecution)
 		callError := txe.CallError()
 		if callError != nil && callError.ErrorCode() != errors.ErrorCodeExecutionReverted {
 			return nil, errors.Wrap(callError, "exception during transaction execution")
@@ -163,11 +178,29 @@ func (trans *Transactor) MaybeSignTxMempool(txEnv *txs.Envelope) (UnlockFunc, er
 	return func() {}, nil
 }
 
+// SignTxMempool sets the sequence numbers of inputs from the accounts in the mempool and signs them while holding
+// locks over those accounts that must be released with the returned UnlockFunc once the transaction is in the
+// mempool. Inputs with an ExpiryHeight do not need their accounts locking, so any number of transactions from one
+// account may be in flight at once, and are given a random nonce as their sequence number if it is zero.
 func (trans *Transactor) SignTxMempool(txEnv *txs.Envelope) (*txs.Envelope, UnlockFunc, error) {
 	inputs := txEnv.Tx.GetInputs()
 	signers := make([]acm.AddressableSigner, len(inputs))
-	unlockers := make([]UnlockFunc, len(inputs))
+	unlockers := make([]UnlockFunc, 0, len(inputs))
 	for i, input := range inputs {
+		if input.Expires() {
+			sa, err := trans.MempoolAccounts.SigningAccount(input.Address)
+			if err != nil {
+				return nil, nil, err
+			}
+			signers[i] = sa
+			if input.Sequence == 0 {
+				input.Sequence, err = randomNonce()
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			continue
+		}
 		ssa, err := trans.MempoolAccounts.SequentialSigningAccount(input.Address)
 		if err != nil {
 			return nil, nil, err
@@ -177,7 +210,7 @@ func (trans *Transactor) SignTxMempool(txEnv *txs.Envelope) (*txs.Envelope, Unlo
 			return nil, nil, err
 		}
 		// Hold lock until safely in mempool - important that this is held until after CheckTxSync returns
-		unlockers[i] = unlock
+		unlockers = append(unlockers, unlock)
 		signers[i] = sa
 		// Set sequence number consecutively from mempool
 		input.Sequence = sa.Sequence + 1
@@ -194,6 +227,20 @@ func (trans *Transactor) SignTxMempool(txEnv *txs.Envelope) (*txs.Envelope, Unlo
 	}), nil
 }
 
+// A non-zero nonce that makes the hash of a transaction protected from replay by its hash unique
+func randomNonce() (uint64, error) {
+	bs := make([]byte, 8)
+	for {
+		_, err := cryptoRand.Read(bs)
+		if err != nil {
+			return 0, fmt.Errorf("could not generate nonce: %v", err)
+		}
+		if nonce := binary.BigEndian.Uint64(bs); nonce != 0 {
+			return nonce, nil
+		}
+	}
+}
+
 func (trans *Transactor) SignTx(txEnv *txs.Envelope) (*txs.Envelope, error) {
 	var err error
 	inputs := txEnv.Tx.GetInputs()
@@ -258,10 +305,17 @@ func (trans *Transactor) CheckTxAsync(txEnv *txs.Envelope, callback func(res *ab
 	return trans.CheckTxAsyncRaw(txBytes, callback)
 }
 
-func (trans *Transactor) CallCodeSim(fromAddress crypto.Address, code, data []byte) (*exec.TxExecution, error) {
-	return CallCodeSim(trans.MempoolAccounts, trans.Tip, fromAddress, fromAddress, code, data, trans.logger)
+func (trans *Transactor) CallCodeSim(ctx context.Context, fromAddress crypto.Address,
+	code, data []byte) (*exec.TxExecution, error) {
+	return CallCodeSim(ctx, trans.MempoolAccounts, trans.Params, trans.Tip, fromAddress, fromAddress, code, data, trans.logger,
+		trans.options...)
+}
+
+func (trans *Transactor) CallSim(ctx context.Context, fromAddress, address crypto.Address,
+	data []byte) (*exec.TxExecution, error) {
+	return CallSim(ctx, trans.MempoolAccounts, trans.Params, trans.Tip, fromAddress, address, data, trans.logger, trans.options...)
 }
 
-func (trans *Transactor) CallSim(fromAddress, address crypto.Address, data []byte) (*exec.TxExecution, error) {
-	return CallSim(trans.MempoolAccounts, trans.Tip, fromAddress, address, data, trans.logger)
+func (trans *Transactor) EstimateGas(ctx context.Context, tx *payload.CallTx) (uint64, *exec.TxExecution, error) {
+	return EstimateGas(ctx, trans.MempoolAccounts, trans.Params, trans.Tip, tx, trans.logger, trans.options...)
 }
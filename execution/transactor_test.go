@@ -52,7 +52,7 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 	err := txEnv.Sign(privAccount)
 	require.NoError(t, err)
 	height := uint64(35)
-	trans := NewTransactor(bc, evc, NewAccounts(acmstate.NewMemoryState(), mock.NewKeyClient(privAccount), 100),
+	trans := NewTransactor(bc, Params{}, evc, NewAccounts(acmstate.NewMemoryState(), mock.NewKeyClient(privAccount), 100),
 		func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error {
 			txe := exec.NewTxExecution(txEnv)
 			txe.Height = height
@@ -74,3 +74,32 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, height, txe.Height)
 }
+
+func TestTransactor_SignTxMempoolExpiring(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	privAccount := acm.GeneratePrivateAccountFromSecret("frogs")
+	accounts := NewAccounts(acmstate.NewMemoryState(), mock.NewKeyClient(privAccount), 100)
+	trans := NewTransactor(&bcm.Blockchain{}, Params{}, event.NewEmitter(logger), accounts, nil, txs.NewAminoCodec(), logger)
+
+	// Hold the account's lock as if a transaction using sequence numbers were being signed
+	ssa, err := accounts.SequentialSigningAccount(privAccount.GetAddress())
+	require.NoError(t, err)
+	_, unlock, err := ssa.Lock()
+	require.NoError(t, err)
+	defer unlock()
+
+	sign := func() *payload.TxInput {
+		input := &payload.TxInput{Address: privAccount.GetAddress(), ExpiryHeight: 10}
+		txEnv, unlockTx, err := trans.SignTxMempool(txs.Enclose("TestChain", &payload.CallTx{
+			Input:   input,
+			Address: &crypto.Address{1, 2, 3},
+		}))
+		require.NoError(t, err)
+		unlockTx()
+		require.NoError(t, txEnv.Verify(nil, "TestChain"))
+		return input
+	}
+	first, second := sign(), sign()
+	assert.NotZero(t, first.Sequence)
+	assert.NotEqual(t, first.Sequence, second.Sequence)
+}
@@ -0,0 +1,483 @@
+package wasm
+
+import (
+	"fmt"
+)
+
+const (
+	opUnreachable  byte = 0x00
+	opNop          byte = 0x01
+	opBlock        byte = 0x02
+	opLoop         byte = 0x03
+	opIf           byte = 0x04
+	opElse         byte = 0x05
+	opEnd          byte = 0x0b
+	opBr           byte = 0x0c
+	opBrIf         byte = 0x0d
+	opBrTable      byte = 0x0e
+	opReturn       byte = 0x0f
+	opCall         byte = 0x10
+	opCallIndirect byte = 0x11
+	opDrop         byte = 0x1a
+	opSelect       byte = 0x1b
+	opLocalGet     byte = 0x20
+	opLocalSet     byte = 0x21
+	opLocalTee     byte = 0x22
+	opGlobalGet    byte = 0x23
+	opGlobalSet    byte = 0x24
+	opI32Load      byte = 0x28
+	opI64Load      byte = 0x29
+	opI32Load8S    byte = 0x2c
+	opI32Load8U    byte = 0x2d
+	opI32Load16S   byte = 0x2e
+	opI32Load16U   byte = 0x2f
+	opI64Load8S    byte = 0x30
+	opI64Load8U    byte = 0x31
+	opI64Load16S   byte = 0x32
+	opI64Load16U   byte = 0x33
+	opI64Load32S   byte = 0x34
+	opI64Load32U   byte = 0x35
+	opI32Store     byte = 0x36
+	opI64Store     byte = 0x37
+	opI32Store8    byte = 0x3a
+	opI32Store16   byte = 0x3b
+	opI64Store8    byte = 0x3c
+	opI64Store16   byte = 0x3d
+	opI64Store32   byte = 0x3e
+	opMemorySize   byte = 0x3f
+	opMemoryGrow   byte = 0x40
+	opI32Const     byte = 0x41
+	opI64Const     byte = 0x42
+	// i32 and i64 comparison and arithmetic operators are contiguous
+	opI32Eqz        byte = 0x45
+	opI32GeU        byte = 0x4f
+	opI64Eqz        byte = 0x50
+	opI64GeU        byte = 0x5a
+	opI32Clz        byte = 0x67
+	opI32Rotr       byte = 0x78
+	opI64Clz        byte = 0x79
+	opI64Rotr       byte = 0x8a
+	opI32WrapI64    byte = 0xa7
+	opI64ExtendI32S byte = 0xac
+	opI64ExtendI32U byte = 0xad
+	opI32Extend8S   byte = 0xc0
+	opI64Extend32S  byte = 0xc4
+	opPrefix        byte = 0xfc
+)
+
+// Sub-opcodes following opPrefix
+const (
+	opMemoryCopy uint32 = 10
+	opMemoryFill uint32 = 11
+)
+
+const blockTypeEmpty = 0x40
+
+type blockKind byte
+
+const (
+	kindBlock blockKind = iota
+	kindLoop
+	kindIf
+	kindFunction
+)
+
+// The static structure of a block, loop, or if
+type block struct {
+	kind blockKind
+	// Number of values the block consumes and produces
+	params  int
+	results int
+	// Position of the first instruction of the block
+	start int
+	// Position of the else opcode of an if, or -1
+	elsePos int
+	// Position of the end opcode
+	end int
+}
+
+// Branching to a loop continues it, otherwise branches exit the block
+func (b *block) arity() int {
+	if b.kind == kindLoop {
+		return b.params
+	}
+	return b.results
+}
+
+// compile validates the body of a function, checking its instructions are supported and well-formed and that they are
+// well-typed as per the validation algorithm of the WASM specification, and records the extent of each block so that
+// the interpreter can branch without rescanning. Since the interpreter does not check the types or number of operands
+// it relies on every function having been compiled.
+func (m *Module) compile(funcIndex uint32) (err error) {
+	code := &m.Codes[funcIndex-uint32(len(m.Imports))]
+	ft := m.FuncType(funcIndex)
+	r := &reader{buf: code.Body}
+	defer func() {
+		if rec := recover(); rec != nil {
+			if e, ok := rec.(decodeError); ok {
+				err = fmt.Errorf("invalid body for function %d at offset %d: %s", funcIndex, r.pos, string(e))
+				return
+			}
+			panic(rec)
+		}
+	}()
+	code.blocks = make(map[int]*block)
+	locals := append(append([]ValueType(nil), ft.Params...), code.Locals...)
+	v := &validator{r: r}
+	v.pushFrame(&block{kind: kindFunction, results: len(ft.Results), elsePos: -1}, nil, ft.Results)
+	for len(v.frames) > 0 {
+		pos := r.pos
+		op := r.byte()
+		switch op {
+		case opUnreachable:
+			v.setUnreachable()
+		case opNop:
+		case opReturn:
+			v.popAll(ft.Results)
+			v.setUnreachable()
+		case opDrop:
+			v.pop()
+		case opSelect:
+			v.popExpect(I32)
+			t1 := v.pop()
+			t2 := v.popExpect(t1)
+			if t1 == unknown {
+				t1 = t2
+			}
+			v.push(t1)
+		case opBlock, opLoop, opIf:
+			b := &block{kind: kindBlock, elsePos: -1}
+			if op == opLoop {
+				b.kind = kindLoop
+			} else if op == opIf {
+				b.kind = kindIf
+				v.popExpect(I32)
+			}
+			params, results := r.blockType(m)
+			b.params, b.results = len(params), len(results)
+			b.start = r.pos
+			code.blocks[pos] = b
+			v.popAll(params)
+			v.pushFrame(b, params, results)
+		case opElse:
+			f := v.frames[len(v.frames)-1]
+			if f.kind != kindIf || f.elsePos >= 0 {
+				r.fail("else without matching if")
+			}
+			f.elsePos = pos
+			v.endFrame()
+			f.unreachable = false
+			v.pushAll(f.paramTypes)
+		case opEnd:
+			f := v.endFrame()
+			if f.kind == kindIf && f.elsePos < 0 && !(FuncType{Results: f.paramTypes}).Equal(FuncType{Results: f.resultTypes}) {
+				r.fail("type mismatch: if without else must produce the values it consumes")
+			}
+			f.end = pos
+			v.frames = v.frames[:len(v.frames)-1]
+			v.pushAll(f.resultTypes)
+		case opBr:
+			v.popAll(v.label().labelTypes())
+			v.setUnreachable()
+		case opBrIf:
+			types := v.label().labelTypes()
+			v.popExpect(I32)
+			v.pushAll(v.popAll(types))
+		case opBrTable:
+			v.popExpect(I32)
+			var targets [][]ValueType
+			for n := r.u32(); n > 0; n-- {
+				targets = append(targets, v.label().labelTypes())
+			}
+			defaultTypes := v.label().labelTypes()
+			for _, types := range targets {
+				if len(types) != len(defaultTypes) {
+					r.fail("type mismatch: br_table targets have different arities")
+				}
+				v.pushAll(v.popAll(types))
+			}
+			v.popAll(defaultTypes)
+			v.setUnreachable()
+		case opCall:
+			index := r.u32()
+			if index >= m.numFuncs() {
+				r.fail("call to non-existent function")
+			}
+			callee := m.FuncType(index)
+			v.popAll(callee.Params)
+			v.pushAll(callee.Results)
+		case opCallIndirect:
+			callee := m.Types[r.typeIndex(m)]
+			if r.byte() != 0 || m.Table == nil {
+				r.fail("call_indirect requires table 0")
+			}
+			v.popExpect(I32)
+			v.popAll(callee.Params)
+			v.pushAll(callee.Results)
+		case opLocalGet, opLocalSet, opLocalTee:
+			index := r.u32()
+			if index >= uint32(len(locals)) {
+				r.fail("local index out of range")
+			}
+			if op != opLocalGet {
+				v.popExpect(locals[index])
+			}
+			if op != opLocalSet {
+				v.push(locals[index])
+			}
+		case opGlobalGet, opGlobalSet:
+			index := r.u32()
+			if index >= uint32(len(m.Globals)) {
+				r.fail("global index out of range")
+			}
+			if op == opGlobalGet {
+				v.push(m.Globals[index].Type)
+				break
+			}
+			if !m.Globals[index].Mutable {
+				r.fail("global %d is immutable", index)
+			}
+			v.popExpect(m.Globals[index].Type)
+		case opI32Load, opI64Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U, opI64Load8S, opI64Load8U,
+			opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+			m.requireMemory(r)
+			r.memArg(loadSize(op))
+			v.popExpect(I32)
+			v.push(loadType(op))
+		case opI32Store, opI64Store, opI32Store8, opI32Store16, opI64Store8, opI64Store16, opI64Store32:
+			m.requireMemory(r)
+			r.memArg(storeSize(op))
+			v.popExpect(storeType(op))
+			v.popExpect(I32)
+		case opMemorySize, opMemoryGrow:
+			m.requireMemory(r)
+			if r.byte() != 0 {
+				r.fail("expected memory index 0")
+			}
+			if op == opMemoryGrow {
+				v.popExpect(I32)
+			}
+			v.push(I32)
+		case opI32Const:
+			r.s32()
+			v.push(I32)
+		case opI64Const:
+			r.s64()
+			v.push(I64)
+		case opPrefix:
+			switch sub := r.u32(); sub {
+			case opMemoryCopy:
+				m.requireMemory(r)
+				if r.byte() != 0 || r.byte() != 0 {
+					r.fail("expected memory index 0")
+				}
+			case opMemoryFill:
+				m.requireMemory(r)
+				if r.byte() != 0 {
+					r.fail("expected memory index 0")
+				}
+			default:
+				r.fail("unsupported instruction 0xfc %d", sub)
+			}
+			// memory.copy takes destination, source, and length, memory.fill destination, value, and length
+			v.popAll([]ValueType{I32, I32, I32})
+		default:
+			params, result, ok := numericType(op)
+			if !ok {
+				r.fail("unsupported instruction 0x%x (floating point instructions are not supported)", op)
+			}
+			v.popAll(params)
+			v.push(result)
+		}
+	}
+	if !r.eof() {
+		r.fail("instructions after end of function")
+	}
+	return nil
+}
+
+// The operand types of integer operators taking no immediates
+func numericType(op byte) ([]ValueType, ValueType, bool) {
+	switch {
+	case op == opI32Eqz:
+		return []ValueType{I32}, I32, true
+	case op > opI32Eqz && op <= opI32GeU:
+		return []ValueType{I32, I32}, I32, true
+	case op == opI64Eqz:
+		return []ValueType{I64}, I32, true
+	case op > opI64Eqz && op <= opI64GeU:
+		return []ValueType{I64, I64}, I32, true
+	case op >= opI32Clz && op <= opI32Clz+2:
+		return []ValueType{I32}, I32, true
+	case op > opI32Clz+2 && op <= opI32Rotr:
+		return []ValueType{I32, I32}, I32, true
+	case op >= opI64Clz && op <= opI64Clz+2:
+		return []ValueType{I64}, I64, true
+	case op > opI64Clz+2 && op <= opI64Rotr:
+		return []ValueType{I64, I64}, I64, true
+	case op == opI32WrapI64:
+		return []ValueType{I64}, I32, true
+	case op == opI64ExtendI32S || op == opI64ExtendI32U:
+		return []ValueType{I32}, I64, true
+	case op == opI32Extend8S || op == opI32Extend8S+1:
+		return []ValueType{I32}, I32, true
+	case op >= opI32Extend8S+2 && op <= opI64Extend32S:
+		return []ValueType{I64}, I64, true
+	}
+	return nil, 0, false
+}
+
+func loadType(op byte) ValueType {
+	switch op {
+	case opI32Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U:
+		return I32
+	}
+	return I64
+}
+
+func storeType(op byte) ValueType {
+	switch op {
+	case opI32Store, opI32Store8, opI32Store16:
+		return I32
+	}
+	return I64
+}
+
+func (m *Module) requireMemory(r *reader) {
+	if m.Memory == nil {
+		r.fail("memory instruction used without memory")
+	}
+}
+
+// Reads the alignment hint and offset of a load or store of size bytes, the alignment may not exceed the natural
+// alignment
+func (r *reader) memArg(size int) {
+	if align := r.u32(); align >= 32 || 1<<align > size {
+		r.fail("alignment must not be larger than natural")
+	}
+	r.u32()
+}
+
+// Returns the types of the parameters and results of a block
+func (r *reader) blockType(m *Module) ([]ValueType, []ValueType) {
+	if r.eof() {
+		r.fail("missing block type")
+	}
+	switch ValueType(r.buf[r.pos]) {
+	case blockTypeEmpty:
+		r.pos++
+		return nil, nil
+	case I32, I64, F32, F64:
+		return nil, []ValueType{r.valueType()}
+	}
+	// A (positive) type index encoded as a signed 33 bit integer
+	index, n, err := readSLEB(r.buf[r.pos:], 33)
+	if err != nil {
+		r.fail("%v", err)
+	}
+	r.pos += n
+	if index < 0 || index >= int64(len(m.Types)) {
+		r.fail("invalid block type %d", index)
+	}
+	ft := m.Types[index]
+	return ft.Params, ft.Results
+}
+
+// The type of an operand pushed by unreachable code, which matches any type
+const unknown ValueType = 0
+
+// A block being validated
+type frame struct {
+	*block
+	paramTypes  []ValueType
+	resultTypes []ValueType
+	// Height of the operand stack beneath the block's parameters
+	height int
+	// Whether the rest of the block is unreachable, in which case operands may be popped beyond its height
+	unreachable bool
+}
+
+// The types of the values passed when branching to the block
+func (f *frame) labelTypes() []ValueType {
+	if f.kind == kindLoop {
+		return f.paramTypes
+	}
+	return f.resultTypes
+}
+
+// validator tracks the types of the operands and the enclosing blocks of a function body
+type validator struct {
+	r        *reader
+	operands []ValueType
+	frames   []*frame
+}
+
+func (v *validator) push(vt ValueType) {
+	v.operands = append(v.operands, vt)
+}
+
+func (v *validator) pushAll(vts []ValueType) {
+	for _, vt := range vts {
+		v.push(vt)
+	}
+}
+
+func (v *validator) pop() ValueType {
+	f := v.frames[len(v.frames)-1]
+	if len(v.operands) == f.height {
+		if f.unreachable {
+			return unknown
+		}
+		v.r.fail("type mismatch: operand stack underflow")
+	}
+	vt := v.operands[len(v.operands)-1]
+	v.operands = v.operands[:len(v.operands)-1]
+	return vt
+}
+
+func (v *validator) popExpect(expected ValueType) ValueType {
+	vt := v.pop()
+	if vt != expected && vt != unknown && expected != unknown {
+		v.r.fail("type mismatch: expected %v but got %v", expected, vt)
+	}
+	return vt
+}
+
+// Pops operands of types vts returning their actual types
+func (v *validator) popAll(vts []ValueType) []ValueType {
+	popped := make([]ValueType, len(vts))
+	for i := len(vts) - 1; i >= 0; i-- {
+		popped[i] = v.popExpect(vts[i])
+	}
+	return popped
+}
+
+func (v *validator) pushFrame(b *block, params, results []ValueType) {
+	v.frames = append(v.frames, &frame{block: b, paramTypes: params, resultTypes: results, height: len(v.operands)})
+	v.pushAll(params)
+}
+
+// Checks the innermost block leaves exactly its results on the operand stack and pops them
+func (v *validator) endFrame() *frame {
+	f := v.frames[len(v.frames)-1]
+	v.popAll(f.resultTypes)
+	if len(v.operands) != f.height {
+		v.r.fail("type mismatch: %d values left at end of block", len(v.operands)-f.height)
+	}
+	return f
+}
+
+// Reads a label index returning the block it refers to
+func (v *validator) label() *frame {
+	depth := v.r.u32()
+	if depth >= uint32(len(v.frames)) {
+		v.r.fail("branch to non-existent label")
+	}
+	return v.frames[len(v.frames)-1-int(depth)]
+}
+
+func (v *validator) setUnreachable() {
+	f := v.frames[len(v.frames)-1]
+	v.operands = v.operands[:f.height]
+	f.unreachable = true
+}
@@ -0,0 +1,176 @@
+package wasm
+
+// Encode returns the binary encoding of the module, the inverse of DecodeModule
+func (m *Module) Encode() []byte {
+	bs := append([]byte{}, Magic...)
+	bs = append(bs, byte(Version), byte(Version>>8), byte(Version>>16), byte(Version>>24))
+
+	if len(m.Types) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Types)))
+		for _, ft := range m.Types {
+			sec = append(sec, 0x60)
+			sec = appendValueTypes(sec, ft.Params)
+			sec = appendValueTypes(sec, ft.Results)
+		}
+		bs = appendSection(bs, 1, sec)
+	}
+	if len(m.Imports) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Imports)))
+		for _, imp := range m.Imports {
+			sec = appendName(sec, imp.Module)
+			sec = appendName(sec, imp.Name)
+			sec = append(sec, 0x00)
+			sec = AppendULEB(sec, uint64(imp.Type))
+		}
+		bs = appendSection(bs, 2, sec)
+	}
+	if len(m.Funcs) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Funcs)))
+		for _, typeIndex := range m.Funcs {
+			sec = AppendULEB(sec, uint64(typeIndex))
+		}
+		bs = appendSection(bs, 3, sec)
+	}
+	if m.Table != nil {
+		bs = appendSection(bs, 4, appendLimits([]byte{1, 0x70}, *m.Table))
+	}
+	if m.Memory != nil {
+		bs = appendSection(bs, 5, appendLimits([]byte{1}, *m.Memory))
+	}
+	if len(m.Globals) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Globals)))
+		for _, global := range m.Globals {
+			var mutable byte
+			if global.Mutable {
+				mutable = 1
+			}
+			sec = append(sec, byte(global.Type), mutable)
+			sec = appendConstExpr(sec, global.Type, global.Init)
+		}
+		bs = appendSection(bs, 6, sec)
+	}
+	if len(m.Exports) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Exports)))
+		for _, export := range m.Exports {
+			sec = appendName(sec, export.Name)
+			sec = append(sec, byte(export.Kind))
+			sec = AppendULEB(sec, uint64(export.Index))
+		}
+		bs = appendSection(bs, 7, sec)
+	}
+	if m.Start != nil {
+		bs = appendSection(bs, 8, AppendULEB(nil, uint64(*m.Start)))
+	}
+	if len(m.Elements) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Elements)))
+		for _, elem := range m.Elements {
+			sec = append(sec, 0)
+			sec = appendConstExpr(sec, I32, elem.Offset)
+			sec = AppendULEB(sec, uint64(len(elem.Funcs)))
+			for _, funcIndex := range elem.Funcs {
+				sec = AppendULEB(sec, uint64(funcIndex))
+			}
+		}
+		bs = appendSection(bs, 9, sec)
+	}
+	if len(m.Codes) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Codes)))
+		for _, code := range m.Codes {
+			var body []byte
+			// Run-length encode locals
+			var groups [][2]int
+			for _, vt := range code.Locals {
+				if len(groups) > 0 && groups[len(groups)-1][1] == int(vt) {
+					groups[len(groups)-1][0]++
+				} else {
+					groups = append(groups, [2]int{1, int(vt)})
+				}
+			}
+			body = AppendULEB(body, uint64(len(groups)))
+			for _, group := range groups {
+				body = AppendULEB(body, uint64(group[0]))
+				body = append(body, byte(group[1]))
+			}
+			body = append(body, code.Body...)
+			sec = AppendULEB(sec, uint64(len(body)))
+			sec = append(sec, body...)
+		}
+		bs = appendSection(bs, 10, sec)
+	}
+	if len(m.Data) > 0 {
+		sec := AppendULEB(nil, uint64(len(m.Data)))
+		for _, data := range m.Data {
+			sec = append(sec, 0)
+			sec = appendConstExpr(sec, I32, data.Offset)
+			sec = AppendULEB(sec, uint64(len(data.Init)))
+			sec = append(sec, data.Init...)
+		}
+		bs = appendSection(bs, 11, sec)
+	}
+	return bs
+}
+
+// AppendULEB appends the unsigned LEB128 encoding of v to bs
+func AppendULEB(bs []byte, v uint64) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(bs, b)
+		}
+		bs = append(bs, b|0x80)
+	}
+}
+
+// AppendSLEB appends the signed LEB128 encoding of v to bs
+func AppendSLEB(bs []byte, v int64) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(bs, b)
+		}
+		bs = append(bs, b|0x80)
+	}
+}
+
+func appendSection(bs []byte, id byte, contents []byte) []byte {
+	bs = append(bs, id)
+	bs = AppendULEB(bs, uint64(len(contents)))
+	return append(bs, contents...)
+}
+
+func appendName(bs []byte, name string) []byte {
+	bs = AppendULEB(bs, uint64(len(name)))
+	return append(bs, name...)
+}
+
+func appendValueTypes(bs []byte, vts []ValueType) []byte {
+	bs = AppendULEB(bs, uint64(len(vts)))
+	return append(bs, valueTypeBytes(vts)...)
+}
+
+func appendLimits(bs []byte, limits Limits) []byte {
+	if limits.HasMax {
+		bs = append(bs, 0x01)
+		bs = AppendULEB(bs, uint64(limits.Min))
+		return AppendULEB(bs, uint64(limits.Max))
+	}
+	bs = append(bs, 0x00)
+	return AppendULEB(bs, uint64(limits.Min))
+}
+
+func appendConstExpr(bs []byte, vt ValueType, expr ConstExpr) []byte {
+	switch {
+	case expr.GlobalRef != nil:
+		bs = append(bs, opGlobalGet)
+		bs = AppendULEB(bs, uint64(*expr.GlobalRef))
+	case vt == I64:
+		bs = append(bs, opI64Const)
+		bs = AppendSLEB(bs, int64(expr.Value))
+	default:
+		bs = append(bs, opI32Const)
+		bs = AppendSLEB(bs, int64(int32(expr.Value)))
+	}
+	return append(bs, opEnd)
+}
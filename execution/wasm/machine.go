@@ -0,0 +1,805 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"runtime/debug"
+
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+const (
+	// Gas charged for executing each instruction
+	GasInstruction uint64 = 1
+	// Gas charged for each page of memory allocated at instantiation or by memory.grow, the 3 gas per 32-byte word that
+	// Ethereum charges for memory
+	GasMemoryPage uint64 = 3 * PageSize / 32
+	// Gas charged for each element of a table allocated at instantiation
+	GasTableElement uint64 = 1
+	// Gas charged for each local variable (other than parameters) allocated when calling a function
+	GasLocal uint64 = 1
+	// Maximum depth of nested WASM function calls within an instance
+	MaxCallDepth = 1024
+)
+
+// HostFunc implements a function imported by a module. It may access the memory of the calling instance. Returning an
+// error stops execution of the instance and is returned from Invoke unchanged.
+type HostFunc func(instance *Instance, args []uint64) ([]uint64, error)
+
+// Imports provides host functions by module name then function name
+type Imports map[string]map[string]HostFunc
+
+// Instance is an instantiated module with its own memory, globals, and table
+type Instance struct {
+	Module    *Module
+	Memory    []byte
+	globals   []uint64
+	table     []int64
+	hostFuncs []HostFunc
+	gas       *uint64
+	pages     *uint64
+	depth     int
+	interrupt func() error
+}
+
+const panicReason = "invalid module"
+
+// Panic is the error returned when executing a module panics, which only a module that has not been validated by
+// DecodeModule can cause. Since the error may be recorded as the exception of a transaction its message is fixed, the value recovered
+// and the stack at the panic are for the caller to log.
+type Panic struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (p *Panic) Error() string {
+	return trap(panicReason).Error()
+}
+
+func (p *Panic) String() string {
+	return trap(panicReason).String()
+}
+
+func (p *Panic) ErrorCode() errors.Code {
+	return errors.ErrorCodeExecutionAborted
+}
+
+// Interrupt has the instance call interrupt before executing each instruction, stopping with any error it returns
+func Interrupt(interrupt func() error) func(*Instance) {
+	return func(inst *Instance) {
+		inst.interrupt = interrupt
+	}
+}
+
+// Instantiate resolves the module's imports, allocates and initialises its memory, table, and globals, and runs its
+// start function if it has one. gas is charged for all execution within the instance and pages is the number of pages
+// of memory the instance may allocate, both may be shared with other instances.
+func Instantiate(module *Module, imports Imports, gas, pages *uint64, options ...func(*Instance)) (*Instance, error) {
+	inst := &Instance{
+		Module: module,
+		gas:    gas,
+		pages:  pages,
+	}
+	for _, option := range options {
+		option(inst)
+	}
+	for _, imp := range module.Imports {
+		hostFunc := imports[imp.Module][imp.Name]
+		if hostFunc == nil {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidContract, "unknown WASM import %s.%s",
+				imp.Module, imp.Name)
+		}
+		inst.hostFuncs = append(inst.hostFuncs, hostFunc)
+	}
+	for _, global := range module.Globals {
+		inst.globals = append(inst.globals, inst.constExpr(global.Init))
+	}
+	if module.Memory != nil {
+		if !inst.allocatePages(module.Memory.Min) {
+			return nil, trap("memory limit exceeded")
+		}
+		err := inst.UseGas(uint64(module.Memory.Min) * GasMemoryPage)
+		if err != nil {
+			return nil, err
+		}
+		inst.Memory = make([]byte, int(module.Memory.Min)*PageSize)
+	}
+	if module.Table != nil {
+		err := inst.UseGas(uint64(module.Table.Min) * GasTableElement)
+		if err != nil {
+			return nil, err
+		}
+		inst.table = make([]int64, module.Table.Min)
+		for i := range inst.table {
+			inst.table[i] = -1
+		}
+	}
+	for _, elem := range module.Elements {
+		offset := inst.constExpr(elem.Offset)
+		if offset+uint64(len(elem.Funcs)) > uint64(len(inst.table)) {
+			return nil, trap("element segment out of bounds of table")
+		}
+		for i, funcIndex := range elem.Funcs {
+			inst.table[offset+uint64(i)] = int64(funcIndex)
+		}
+	}
+	for _, data := range module.Data {
+		offset := inst.constExpr(data.Offset)
+		if offset+uint64(len(data.Init)) > uint64(len(inst.Memory)) {
+			return nil, trap("data segment out of bounds of memory")
+		}
+		copy(inst.Memory[offset:], data.Init)
+	}
+	if module.Start != nil {
+		_, err := inst.invoke(*module.Start, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return inst, nil
+}
+
+// Invoke calls the exported function name with args (i32 arguments in the low 32 bits)
+func (inst *Instance) Invoke(name string, args ...uint64) ([]uint64, error) {
+	funcIndex, ok := inst.Module.Export(name, ExportFunc)
+	if !ok {
+		return nil, errors.ErrorCodef(errors.ErrorCodeInvalidContract, "WASM module does not export function %s",
+			name)
+	}
+	if len(args) != len(inst.Module.FuncType(funcIndex).Params) {
+		return nil, fmt.Errorf("function %s expects %d arguments but got %d", name,
+			len(inst.Module.FuncType(funcIndex).Params), len(args))
+	}
+	return inst.invoke(funcIndex, args)
+}
+
+func (inst *Instance) invoke(funcIndex uint32, args []uint64) (results []uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// Only a module that has not been validated can cause a panic (e.g. on value stack underflow), such a
+			// module is treated as trapping
+			results, err = nil, &Panic{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return inst.call(funcIndex, args)
+}
+
+// UseGas charges amount against the gas available to the instance
+func (inst *Instance) UseGas(amount uint64) error {
+	if *inst.gas < amount {
+		*inst.gas = 0
+		return errors.ErrorCodeInsufficientGas
+	}
+	*inst.gas -= amount
+	return nil
+}
+
+// Take pages from those the instance may allocate returning false if there are not enough
+func (inst *Instance) allocatePages(pages uint32) bool {
+	if *inst.pages < uint64(pages) {
+		return false
+	}
+	*inst.pages -= uint64(pages)
+	return true
+}
+
+// Gas returns the gas remaining
+func (inst *Instance) Gas() uint64 {
+	return *inst.gas
+}
+
+// Read returns a copy of length bytes of memory from offset
+func (inst *Instance) Read(offset, length uint32) ([]byte, error) {
+	if uint64(offset)+uint64(length) > uint64(len(inst.Memory)) {
+		return nil, trap("memory access out of bounds")
+	}
+	bs := make([]byte, length)
+	copy(bs, inst.Memory[offset:])
+	return bs, nil
+}
+
+// Write copies bs into memory at offset
+func (inst *Instance) Write(offset uint32, bs []byte) error {
+	if uint64(offset)+uint64(len(bs)) > uint64(len(inst.Memory)) {
+		return trap("memory access out of bounds")
+	}
+	copy(inst.Memory[offset:], bs)
+	return nil
+}
+
+func (inst *Instance) constExpr(expr ConstExpr) uint64 {
+	if expr.GlobalRef != nil {
+		return inst.globals[*expr.GlobalRef]
+	}
+	return expr.Value
+}
+
+func (inst *Instance) call(funcIndex uint32, args []uint64) ([]uint64, error) {
+	ft := inst.Module.FuncType(funcIndex)
+	numImports := uint32(len(inst.Module.Imports))
+	if funcIndex < numImports {
+		results, err := inst.hostFuncs[funcIndex](inst, args)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) != len(ft.Results) {
+			imp := inst.Module.Imports[funcIndex]
+			return nil, fmt.Errorf("host function %s.%s returned %d results but its type is %v", imp.Module,
+				imp.Name, len(results), ft)
+		}
+		return results, nil
+	}
+	if inst.depth >= MaxCallDepth {
+		return nil, errors.ErrorCodeCallStackOverflow
+	}
+	inst.depth++
+	defer func() { inst.depth-- }()
+	code := &inst.Module.Codes[funcIndex-numImports]
+	err := inst.UseGas(uint64(len(code.Locals)) * GasLocal)
+	if err != nil {
+		return nil, err
+	}
+	locals := make([]uint64, len(ft.Params)+len(code.Locals))
+	copy(locals, args)
+	return inst.execute(code, locals, len(ft.Results))
+}
+
+type label struct {
+	block *block
+	// Height of the value stack beneath the block's parameters
+	height int
+}
+
+func (inst *Instance) execute(code *Code, locals []uint64, numResults int) ([]uint64, error) {
+	body := code.Body
+	var stack []uint64
+	labels := []label{{block: &block{kind: kindFunction, results: numResults}}}
+	pc := 0
+
+	push := func(v uint64) {
+		stack = append(stack, v)
+	}
+	pop := func() uint64 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v
+	}
+	readU32 := func() uint32 {
+		v, n, _ := readULEB(body[pc:], 32)
+		pc += n
+		return uint32(v)
+	}
+	// Branch to the label depth levels out, returning true if the branch returns from the function
+	branch := func(depth uint32) bool {
+		l := labels[len(labels)-1-int(depth)]
+		arity := l.block.arity()
+		copy(stack[l.height:], stack[len(stack)-arity:])
+		stack = stack[:l.height+arity]
+		switch l.block.kind {
+		case kindFunction:
+			return true
+		case kindLoop:
+			labels = labels[:len(labels)-int(depth)]
+			pc = l.block.start
+		default:
+			labels = labels[:len(labels)-1-int(depth)]
+			pc = l.block.end + 1
+		}
+		return false
+	}
+	results := func() []uint64 {
+		return stack[len(stack)-numResults:]
+	}
+
+	for {
+		err := inst.UseGas(GasInstruction)
+		if err != nil {
+			return nil, err
+		}
+		if inst.interrupt != nil {
+			err = inst.interrupt()
+			if err != nil {
+				return nil, err
+			}
+		}
+		pos := pc
+		op := body[pc]
+		pc++
+		switch op {
+		case opUnreachable:
+			return nil, trap("unreachable executed")
+
+		case opNop:
+
+		case opBlock, opLoop:
+			b := code.blocks[pos]
+			pc = b.start
+			labels = append(labels, label{block: b, height: len(stack) - b.params})
+
+		case opIf:
+			b := code.blocks[pos]
+			cond := uint32(pop())
+			pc = b.start
+			labels = append(labels, label{block: b, height: len(stack) - b.params})
+			if cond == 0 {
+				if b.elsePos >= 0 {
+					pc = b.elsePos + 1
+				} else {
+					// Execute end to leave the block
+					pc = b.end
+				}
+			}
+
+		case opElse:
+			// End of the then branch
+			pc = labels[len(labels)-1].block.end
+
+		case opEnd:
+			labels = labels[:len(labels)-1]
+			if len(labels) == 0 {
+				return results(), nil
+			}
+
+		case opBr:
+			if branch(readU32()) {
+				return results(), nil
+			}
+
+		case opBrIf:
+			depth := readU32()
+			if uint32(pop()) != 0 && branch(depth) {
+				return results(), nil
+			}
+
+		case opBrTable:
+			n := readU32()
+			targets := make([]uint32, n+1)
+			for i := range targets {
+				targets[i] = readU32()
+			}
+			index := uint32(pop())
+			if index > n {
+				index = n
+			}
+			if branch(targets[index]) {
+				return results(), nil
+			}
+
+		case opReturn:
+			return results(), nil
+
+		case opCall:
+			funcIndex := readU32()
+			numParams := len(inst.Module.FuncType(funcIndex).Params)
+			args := append([]uint64(nil), stack[len(stack)-numParams:]...)
+			stack = stack[:len(stack)-numParams]
+			rets, err := inst.call(funcIndex, args)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, rets...)
+
+		case opCallIndirect:
+			ft := inst.Module.Types[readU32()]
+			pc++
+			index := uint32(pop())
+			if index >= uint32(len(inst.table)) || inst.table[index] < 0 {
+				return nil, trap("undefined element in table")
+			}
+			funcIndex := uint32(inst.table[index])
+			if !inst.Module.FuncType(funcIndex).Equal(ft) {
+				return nil, trap("indirect call type mismatch")
+			}
+			args := append([]uint64(nil), stack[len(stack)-len(ft.Params):]...)
+			stack = stack[:len(stack)-len(ft.Params)]
+			rets, err := inst.call(funcIndex, args)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, rets...)
+
+		case opDrop:
+			pop()
+
+		case opSelect:
+			cond, b, a := uint32(pop()), pop(), pop()
+			if cond != 0 {
+				push(a)
+			} else {
+				push(b)
+			}
+
+		case opLocalGet:
+			push(locals[readU32()])
+
+		case opLocalSet:
+			locals[readU32()] = pop()
+
+		case opLocalTee:
+			locals[readU32()] = stack[len(stack)-1]
+
+		case opGlobalGet:
+			push(inst.globals[readU32()])
+
+		case opGlobalSet:
+			inst.globals[readU32()] = pop()
+
+		case opI32Load, opI64Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U, opI64Load8S, opI64Load8U,
+			opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+			readU32()
+			offset := readU32()
+			mem, err := inst.access(pop(), offset, loadSize(op))
+			if err != nil {
+				return nil, err
+			}
+			push(load(op, mem))
+
+		case opI32Store, opI64Store, opI32Store8, opI32Store16, opI64Store8, opI64Store16, opI64Store32:
+			readU32()
+			offset := readU32()
+			value := pop()
+			size := storeSize(op)
+			mem, err := inst.access(pop(), offset, size)
+			if err != nil {
+				return nil, err
+			}
+			var buf [8]byte
+			binary.LittleEndian.PutUint64(buf[:], value)
+			copy(mem, buf[:size])
+
+		case opMemorySize:
+			pc++
+			push(uint64(len(inst.Memory) / PageSize))
+
+		case opMemoryGrow:
+			pc++
+			pages := uint32(pop())
+			current := uint32(len(inst.Memory) / PageSize)
+			max := uint32(MaxPages)
+			if inst.Module.Memory.HasMax && inst.Module.Memory.Max < max {
+				max = inst.Module.Memory.Max
+			}
+			if uint64(current)+uint64(pages) > uint64(max) || !inst.allocatePages(pages) {
+				push(uint64(uint32(0xffffffff)))
+				break
+			}
+			err := inst.UseGas(uint64(pages) * GasMemoryPage)
+			if err != nil {
+				return nil, err
+			}
+			inst.Memory = append(inst.Memory, make([]byte, int(pages)*PageSize)...)
+			push(uint64(current))
+
+		case opI32Const:
+			v, n, _ := readSLEB(body[pc:], 32)
+			pc += n
+			push(uint64(uint32(v)))
+
+		case opI64Const:
+			v, n, _ := readSLEB(body[pc:], 64)
+			pc += n
+			push(uint64(v))
+
+		case opPrefix:
+			switch readU32() {
+			case opMemoryCopy:
+				pc += 2
+				n, src, dst := uint32(pop()), uint32(pop()), uint32(pop())
+				if uint64(src)+uint64(n) > uint64(len(inst.Memory)) || uint64(dst)+uint64(n) > uint64(len(inst.Memory)) {
+					return nil, trap("memory access out of bounds")
+				}
+				err := inst.UseGas(uint64(n) / 32)
+				if err != nil {
+					return nil, err
+				}
+				copy(inst.Memory[dst:dst+n], inst.Memory[src:src+n])
+			case opMemoryFill:
+				pc++
+				n, value, dst := uint32(pop()), byte(pop()), uint32(pop())
+				if uint64(dst)+uint64(n) > uint64(len(inst.Memory)) {
+					return nil, trap("memory access out of bounds")
+				}
+				err := inst.UseGas(uint64(n) / 32)
+				if err != nil {
+					return nil, err
+				}
+				for i := dst; i < dst+n; i++ {
+					inst.Memory[i] = value
+				}
+			}
+
+		case opI32Eqz:
+			push(boolToUint64(uint32(pop()) == 0))
+
+		case opI64Eqz:
+			push(boolToUint64(pop() == 0))
+
+		case opI32Clz:
+			push(uint64(bits.LeadingZeros32(uint32(pop()))))
+
+		case opI32Clz + 1: // ctz
+			push(uint64(bits.TrailingZeros32(uint32(pop()))))
+
+		case opI32Clz + 2: // popcnt
+			push(uint64(bits.OnesCount32(uint32(pop()))))
+
+		case opI64Clz:
+			push(uint64(bits.LeadingZeros64(pop())))
+
+		case opI64Clz + 1: // ctz
+			push(uint64(bits.TrailingZeros64(pop())))
+
+		case opI64Clz + 2: // popcnt
+			push(uint64(bits.OnesCount64(pop())))
+
+		case opI32WrapI64:
+			push(uint64(uint32(pop())))
+
+		case opI64ExtendI32S:
+			push(uint64(int64(int32(pop()))))
+
+		case opI64ExtendI32U:
+			push(uint64(uint32(pop())))
+
+		case opI32Extend8S:
+			push(uint64(uint32(int32(int8(pop())))))
+
+		case opI32Extend8S + 1: // i32.extend16_s
+			push(uint64(uint32(int32(int16(pop())))))
+
+		case opI32Extend8S + 2: // i64.extend8_s
+			push(uint64(int64(int8(pop()))))
+
+		case opI32Extend8S + 3: // i64.extend16_s
+			push(uint64(int64(int16(pop()))))
+
+		case opI64Extend32S:
+			push(uint64(int64(int32(pop()))))
+
+		default:
+			y, x := pop(), pop()
+			var result uint64
+			switch {
+			case op > opI32Eqz && op <= opI32GeU:
+				result = boolToUint64(compare32(op, uint32(x), uint32(y)))
+			case op > opI64Eqz && op <= opI64GeU:
+				result = boolToUint64(compare64(op, x, y))
+			case op > opI32Clz+2 && op <= opI32Rotr:
+				r, err := arithmetic32(op, uint32(x), uint32(y))
+				if err != nil {
+					return nil, err
+				}
+				result = uint64(r)
+			case op > opI64Clz+2 && op <= opI64Rotr:
+				result, err = arithmetic64(op, x, y)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, trap(fmt.Sprintf("unsupported instruction 0x%x", op))
+			}
+			push(result)
+		}
+	}
+}
+
+// Returns the slice of memory to be loaded or stored checking bounds
+func (inst *Instance) access(base uint64, offset uint32, size int) ([]byte, error) {
+	address := uint64(uint32(base)) + uint64(offset)
+	if address+uint64(size) > uint64(len(inst.Memory)) {
+		return nil, trap("memory access out of bounds")
+	}
+	return inst.Memory[address : address+uint64(size)], nil
+}
+
+func loadSize(op byte) int {
+	switch op {
+	case opI32Load8S, opI32Load8U, opI64Load8S, opI64Load8U:
+		return 1
+	case opI32Load16S, opI32Load16U, opI64Load16S, opI64Load16U:
+		return 2
+	case opI32Load, opI64Load32S, opI64Load32U:
+		return 4
+	}
+	return 8
+}
+
+func storeSize(op byte) int {
+	switch op {
+	case opI32Store8, opI64Store8:
+		return 1
+	case opI32Store16, opI64Store16:
+		return 2
+	case opI32Store, opI64Store32:
+		return 4
+	}
+	return 8
+}
+
+func load(op byte, mem []byte) uint64 {
+	switch op {
+	case opI32Load8S:
+		return uint64(uint32(int32(int8(mem[0]))))
+	case opI64Load8S:
+		return uint64(int64(int8(mem[0])))
+	case opI32Load8U, opI64Load8U:
+		return uint64(mem[0])
+	case opI32Load16S:
+		return uint64(uint32(int32(int16(binary.LittleEndian.Uint16(mem)))))
+	case opI64Load16S:
+		return uint64(int64(int16(binary.LittleEndian.Uint16(mem))))
+	case opI32Load16U, opI64Load16U:
+		return uint64(binary.LittleEndian.Uint16(mem))
+	case opI64Load32S:
+		return uint64(int64(int32(binary.LittleEndian.Uint32(mem))))
+	case opI32Load, opI64Load32U:
+		return uint64(binary.LittleEndian.Uint32(mem))
+	}
+	return binary.LittleEndian.Uint64(mem)
+}
+
+// Comparisons in the order eq, ne, lt_s, lt_u, gt_s, gt_u, le_s, le_u, ge_s, ge_u
+func compare32(op byte, x, y uint32) bool {
+	switch op - opI32Eqz {
+	case 1:
+		return x == y
+	case 2:
+		return x != y
+	case 3:
+		return int32(x) < int32(y)
+	case 4:
+		return x < y
+	case 5:
+		return int32(x) > int32(y)
+	case 6:
+		return x > y
+	case 7:
+		return int32(x) <= int32(y)
+	case 8:
+		return x <= y
+	case 9:
+		return int32(x) >= int32(y)
+	}
+	return x >= y
+}
+
+func compare64(op byte, x, y uint64) bool {
+	switch op - opI64Eqz {
+	case 1:
+		return x == y
+	case 2:
+		return x != y
+	case 3:
+		return int64(x) < int64(y)
+	case 4:
+		return x < y
+	case 5:
+		return int64(x) > int64(y)
+	case 6:
+		return x > y
+	case 7:
+		return int64(x) <= int64(y)
+	case 8:
+		return x <= y
+	case 9:
+		return int64(x) >= int64(y)
+	}
+	return x >= y
+}
+
+// Binary operators in the order add, sub, mul, div_s, div_u, rem_s, rem_u, and, or, xor, shl, shr_s, shr_u, rotl, rotr
+func arithmetic32(op byte, x, y uint32) (uint32, error) {
+	switch op - opI32Clz - 3 {
+	case 0:
+		return x + y, nil
+	case 1:
+		return x - y, nil
+	case 2:
+		return x * y, nil
+	case 3:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int32(x) == -1<<31 && int32(y) == -1 {
+			return 0, trap("integer overflow")
+		}
+		return uint32(int32(x) / int32(y)), nil
+	case 4:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return x / y, nil
+	case 5:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int32(y) == -1 {
+			return 0, nil
+		}
+		return uint32(int32(x) % int32(y)), nil
+	case 6:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return x % y, nil
+	case 7:
+		return x & y, nil
+	case 8:
+		return x | y, nil
+	case 9:
+		return x ^ y, nil
+	case 10:
+		return x << (y % 32), nil
+	case 11:
+		return uint32(int32(x) >> (y % 32)), nil
+	case 12:
+		return x >> (y % 32), nil
+	case 13:
+		return bits.RotateLeft32(x, int(y%32)), nil
+	}
+	return bits.RotateLeft32(x, -int(y%32)), nil
+}
+
+func arithmetic64(op byte, x, y uint64) (uint64, error) {
+	switch op - opI64Clz - 3 {
+	case 0:
+		return x + y, nil
+	case 1:
+		return x - y, nil
+	case 2:
+		return x * y, nil
+	case 3:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int64(x) == -1<<63 && int64(y) == -1 {
+			return 0, trap("integer overflow")
+		}
+		return uint64(int64(x) / int64(y)), nil
+	case 4:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return x / y, nil
+	case 5:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		if int64(y) == -1 {
+			return 0, nil
+		}
+		return uint64(int64(x) % int64(y)), nil
+	case 6:
+		if y == 0 {
+			return 0, trap("integer divide by zero")
+		}
+		return x % y, nil
+	case 7:
+		return x & y, nil
+	case 8:
+		return x | y, nil
+	case 9:
+		return x ^ y, nil
+	case 10:
+		return x << (y % 64), nil
+	case 11:
+		return uint64(int64(x) >> (y % 64)), nil
+	case 12:
+		return x >> (y % 64), nil
+	case 13:
+		return bits.RotateLeft64(x, int(y%64)), nil
+	}
+	return bits.RotateLeft64(x, -int(y%64)), nil
+}
+
+func boolToUint64(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func trap(reason string) errors.CodedError {
+	return errors.ErrorCodef(errors.ErrorCodeExecutionAborted, "WASM trap: %s", reason)
+}
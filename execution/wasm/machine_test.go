@@ -0,0 +1,351 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	i32i32_i32 = FuncType{Params: []ValueType{I32, I32}, Results: []ValueType{I32}}
+	i32_i32    = FuncType{Params: []ValueType{I32}, Results: []ValueType{I32}}
+	i64_i64    = FuncType{Params: []ValueType{I64}, Results: []ValueType{I64}}
+	void       = FuncType{}
+)
+
+// Build a module with a single exported function "f" of type ft, preceded by any imports
+func newModule(t *testing.T, ft FuncType, locals []ValueType, body []byte, imports ...Import) *Module {
+	m := &Module{
+		Types:   []FuncType{ft},
+		Imports: imports,
+		Funcs:   []uint32{0},
+		Memory:  &Limits{Min: 1},
+		Exports: []Export{{Name: "f", Kind: ExportFunc, Index: uint32(len(imports))}},
+		Codes:   []Code{{Locals: locals, Body: body}},
+	}
+	module, err := DecodeModule(m.Encode())
+	require.NoError(t, err)
+	return module
+}
+
+func invoke(t *testing.T, module *Module, imports Imports, gas uint64, args ...uint64) ([]uint64, error) {
+	pages := uint64(MaxPages)
+	inst, err := Instantiate(module, imports, &gas, &pages)
+	require.NoError(t, err)
+	return inst.Invoke("f", args...)
+}
+
+func TestIsWASM(t *testing.T) {
+	assert.True(t, IsWASM((&Module{}).Encode()))
+	assert.False(t, IsWASM([]byte{0x60, 0x80, 0x60, 0x40}))
+	assert.False(t, IsWASM(nil))
+}
+
+func TestEncodeDecode(t *testing.T) {
+	global := uint32(0)
+	start := uint32(1)
+	m := &Module{
+		Types:    []FuncType{i32i32_i32, void},
+		Imports:  []Import{{Module: "env", Name: "add", Type: 0}},
+		Funcs:    []uint32{1},
+		Table:    &Limits{Min: 1, Max: 2, HasMax: true},
+		Memory:   &Limits{Min: 1},
+		Globals:  []Global{{Type: I32, Init: ConstExpr{Value: 8}}, {Type: I64, Mutable: true, Init: ConstExpr{Value: 1 << 40}}},
+		Exports:  []Export{{Name: "memory", Kind: ExportMemory}, {Name: "start", Kind: ExportFunc, Index: 1}},
+		Start:    &start,
+		Elements: []Element{{Offset: ConstExpr{GlobalRef: &global}, Funcs: []uint32{0}}},
+		Codes:    []Code{{Locals: []ValueType{I32, I32, I64}, Body: []byte{opEnd}}},
+		Data:     []Data{{Offset: ConstExpr{Value: 16}, Init: []byte("hello")}},
+	}
+	bs := m.Encode()
+	decoded, err := DecodeModule(bs)
+	require.NoError(t, err)
+	assert.Equal(t, bs, decoded.Encode())
+	assert.Equal(t, m.Globals, decoded.Globals)
+	assert.Equal(t, m.Codes[0].Locals, decoded.Codes[0].Locals)
+}
+
+func TestDecodeModule_Invalid(t *testing.T) {
+	_, err := DecodeModule([]byte{0x00, 'a', 's', 'm', 2, 0, 0, 0})
+	assert.Error(t, err, "bad version")
+
+	_, err = DecodeModule((&Module{Types: []FuncType{{Params: []ValueType{F32}}}}).Encode())
+	assert.Error(t, err, "floating point types are not supported")
+
+	// f32.add
+	_, err = DecodeModule((&Module{
+		Types: []FuncType{void},
+		Funcs: []uint32{0},
+		Codes: []Code{{Body: []byte{0x92, opEnd}}},
+	}).Encode())
+	assert.Error(t, err, "floating point instructions are not supported")
+
+	_, err = DecodeModule((&Module{
+		Types: []FuncType{void},
+		Funcs: []uint32{0},
+		Codes: []Code{{Body: []byte{opBr, 1, opEnd}}},
+	}).Encode())
+	assert.Error(t, err, "branch to non-existent label")
+
+	_, err = DecodeModule((&Module{
+		Types:   []FuncType{void},
+		Funcs:   []uint32{0},
+		Exports: []Export{{Name: "f", Kind: ExportFunc, Index: 1}},
+		Codes:   []Code{{Body: []byte{opEnd}}},
+	}).Encode())
+	assert.Error(t, err, "export of non-existent function")
+
+	_, err = DecodeModule((&Module{
+		Types: []FuncType{void},
+		Funcs: []uint32{0},
+		Table: &Limits{Min: MaxTableElements + 1},
+		Codes: []Code{{Body: []byte{opEnd}}},
+	}).Encode())
+	assert.Error(t, err, "table too large")
+}
+
+func TestDecodeModule_TypeMismatch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ft   FuncType
+		body []byte
+	}{
+		"operand stack underflow": {void, []byte{opDrop, opEnd}},
+		"i64 operand to i32.add":  {void, []byte{opI32Const, 1, opI64Const, 1, 0x6a, opDrop, opEnd}},
+		"value left on stack":     {void, []byte{opI32Const, 1, opEnd}},
+		"missing result":          {i32_i32, []byte{opEnd}},
+		"wrong result type":       {i32_i32, []byte{opI64Const, 1, opEnd}},
+		"local of wrong type":     {i32_i32, []byte{opI64Const, 1, opLocalSet, 0, opLocalGet, 0, opEnd}},
+		"if condition missing":    {void, []byte{opIf, blockTypeEmpty, opEnd, opEnd}},
+		"if without else result":  {void, []byte{opI32Const, 1, opIf, byte(I32), opI32Const, 1, opEnd, opDrop, opEnd}},
+		"branch without value":    {i32_i32, []byte{opBlock, byte(I32), opBr, 0, opEnd, opEnd}},
+		"select of mixed types":   {void, []byte{opI32Const, 1, opI64Const, 1, opI32Const, 1, opSelect, opDrop, opEnd}},
+		"misaligned load":         {void, []byte{opI32Const, 0, opI32Load8U, 1, 0, opDrop, opEnd}},
+		"br_table mixed arities": {void, []byte{opBlock, byte(I32), opI32Const, 1, opI32Const, 0, opBrTable, 1, 0, 1,
+			opEnd, opDrop, opEnd}},
+	} {
+		_, err := DecodeModule((&Module{
+			Types:  []FuncType{tc.ft},
+			Funcs:  []uint32{0},
+			Memory: &Limits{Min: 1},
+			Codes:  []Code{{Body: tc.body}},
+		}).Encode())
+		assert.Error(t, err, name)
+	}
+
+	// After an unconditional branch the operand stack may be popped to any type
+	_, err := DecodeModule((&Module{
+		Types: []FuncType{i32_i32},
+		Funcs: []uint32{0},
+		Codes: []Code{{Body: []byte{opI32Const, 1, opReturn, opI64Const, 1, 0x6a, opEnd}}},
+	}).Encode())
+	assert.Error(t, err, "i64 operand after return")
+	_, err = DecodeModule((&Module{
+		Types: []FuncType{i32_i32},
+		Funcs: []uint32{0},
+		Codes: []Code{{Body: []byte{opUnreachable, 0x6a, opEnd}}},
+	}).Encode())
+	assert.NoError(t, err)
+}
+
+func TestInvoke_Arithmetic(t *testing.T) {
+	// i32.add
+	module := newModule(t, i32i32_i32, nil, []byte{opLocalGet, 0, opLocalGet, 1, 0x6a, opEnd})
+	results, err := invoke(t, module, nil, 100000, 3, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{7}, results)
+
+	// Wraps
+	results, err = invoke(t, module, nil, 100000, 0xffffffff, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, results)
+
+	// i32.div_s
+	module = newModule(t, i32i32_i32, nil, []byte{opLocalGet, 0, opLocalGet, 1, 0x6d, opEnd})
+	results, err = invoke(t, module, nil, 100000, uint64(uint32(-9&0xffffffff)), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{uint64(uint32(-4 & 0xffffffff))}, results)
+
+	_, err = invoke(t, module, nil, 100000, 1, 0)
+	assert.Equal(t, errors.ErrorCodeExecutionAborted, errors.AsException(err).ErrorCode())
+}
+
+func TestInvoke_Loop(t *testing.T) {
+	// Iterative factorial
+	module := newModule(t, i64_i64, []ValueType{I64}, []byte{
+		opI64Const, 1, opLocalSet, 1,
+		opBlock, blockTypeEmpty,
+		opLoop, blockTypeEmpty,
+		opLocalGet, 0, opI64Eqz, opBrIf, 1,
+		opLocalGet, 1, opLocalGet, 0, 0x7e, opLocalSet, 1,
+		opLocalGet, 0, opI64Const, 1, 0x7d, opLocalSet, 0,
+		opBr, 0,
+		opEnd,
+		opEnd,
+		opLocalGet, 1,
+		opEnd,
+	})
+	results, err := invoke(t, module, nil, 10000, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2432902008176640000}, results)
+}
+
+func TestInvoke_Recursion(t *testing.T) {
+	// Recursive factorial with if/else yielding a value
+	module := newModule(t, i64_i64, nil, []byte{
+		opLocalGet, 0, opI64Eqz,
+		opIf, byte(I64),
+		opI64Const, 1,
+		opElse,
+		opLocalGet, 0, opLocalGet, 0, opI64Const, 1, 0x7d, opCall, 0, 0x7e,
+		opEnd,
+		opEnd,
+	})
+	results, err := invoke(t, module, nil, 10000, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3628800}, results)
+
+	// Unbounded recursion
+	module = newModule(t, void, nil, []byte{opCall, 0, opEnd})
+	_, err = invoke(t, module, nil, 1000000)
+	assert.Equal(t, errors.ErrorCodeCallStackOverflow, errors.AsException(err).ErrorCode())
+}
+
+func TestInvoke_BrTable(t *testing.T) {
+	module := newModule(t, i32_i32, nil, []byte{
+		opBlock, blockTypeEmpty,
+		opBlock, blockTypeEmpty,
+		opBlock, blockTypeEmpty,
+		opLocalGet, 0,
+		opBrTable, 2, 0, 1, 2,
+		opEnd,
+		opI32Const, 10, opReturn,
+		opEnd,
+		opI32Const, 11, opReturn,
+		opEnd,
+		opI32Const, 12,
+		opEnd,
+	})
+	for arg, expected := range []uint64{10, 11, 12, 12} {
+		results, err := invoke(t, module, nil, 100000, uint64(arg))
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{expected}, results)
+	}
+}
+
+func TestInvoke_Memory(t *testing.T) {
+	// Store a word then load its least significant byte
+	module := newModule(t, i32i32_i32, nil, []byte{
+		opLocalGet, 0, opLocalGet, 1, opI32Store, 2, 0,
+		opLocalGet, 0, opI32Load8U, 0, 0,
+		opEnd,
+	})
+	results, err := invoke(t, module, nil, 100000, 100, 0x01020304)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4}, results)
+
+	_, err = invoke(t, module, nil, 100000, PageSize-2, 1)
+	assert.Equal(t, errors.ErrorCodeExecutionAborted, errors.AsException(err).ErrorCode())
+
+	// Grow memory and return its new size
+	module = newModule(t, FuncType{Results: []ValueType{I32}}, nil, []byte{
+		opI32Const, 1, opMemoryGrow, 0, opDrop,
+		opMemorySize, 0,
+		opEnd,
+	})
+	gas := uint64(100000)
+	pages := uint64(3)
+	inst, err := Instantiate(module, nil, &gas, &pages)
+	require.NoError(t, err)
+	results, err = inst.Invoke("f")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2}, results)
+	assert.Len(t, inst.Memory, 2*PageSize)
+	assert.Equal(t, 100000-2*GasMemoryPage-5*GasInstruction, gas)
+	assert.Equal(t, uint64(1), pages)
+
+	// Growing beyond the pages that may be allocated fails without trapping
+	results, err = inst.Invoke("f")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, results)
+	results, err = inst.Invoke("f")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, results)
+	pages = 0
+	_, err = Instantiate(module, nil, &gas, &pages)
+	assert.Equal(t, errors.ErrorCodeExecutionAborted, errors.AsException(err).ErrorCode())
+}
+
+func TestInvoke_Host(t *testing.T) {
+	module := newModule(t, i32_i32, nil, []byte{opLocalGet, 0, opCall, 0, opEnd},
+		Import{Module: "env", Name: "double", Type: 0})
+	imports := Imports{"env": {"double": func(inst *Instance, args []uint64) ([]uint64, error) {
+		return []uint64{args[0] * 2}, nil
+	}}}
+	results, err := invoke(t, module, imports, 100000, 21)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{42}, results)
+
+	_, err = Instantiate(module, nil, new(uint64), new(uint64))
+	assert.Error(t, err, "missing import")
+}
+
+func TestInvoke_Gas(t *testing.T) {
+	module := newModule(t, void, nil, []byte{opLoop, blockTypeEmpty, opBr, 0, opEnd, opEnd})
+	_, err := invoke(t, module, nil, 100000)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, errors.AsException(err).ErrorCode())
+}
+
+func TestInstantiate_TableGas(t *testing.T) {
+	module, err := DecodeModule((&Module{
+		Types:   []FuncType{void},
+		Funcs:   []uint32{0},
+		Table:   &Limits{Min: 100},
+		Exports: []Export{{Name: "f", Kind: ExportFunc}},
+		Codes:   []Code{{Body: []byte{opEnd}}},
+	}).Encode())
+	require.NoError(t, err)
+	gas := 100 * GasTableElement
+	inst, err := Instantiate(module, nil, &gas, new(uint64))
+	require.NoError(t, err)
+	assert.Len(t, inst.table, 100)
+	assert.Equal(t, uint64(0), gas)
+
+	gas = 99 * GasTableElement
+	_, err = Instantiate(module, nil, &gas, new(uint64))
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, errors.AsException(err).ErrorCode())
+}
+
+func TestInvoke_LocalsGas(t *testing.T) {
+	module := newModule(t, void, []ValueType{I32, I64, I64}, []byte{opEnd})
+	gas := uint64(100000)
+	pages := uint64(MaxPages)
+	inst, err := Instantiate(module, nil, &gas, &pages)
+	require.NoError(t, err)
+	gas = 100000
+	_, err = inst.Invoke("f")
+	require.NoError(t, err)
+	assert.Equal(t, 100000-3*GasLocal-GasInstruction, gas)
+}
+
+func TestInvoke_Unreachable(t *testing.T) {
+	module := newModule(t, void, nil, []byte{opUnreachable, opEnd})
+	_, err := invoke(t, module, nil, 100000)
+	assert.Equal(t, errors.ErrorCodeExecutionAborted, errors.AsException(err).ErrorCode())
+}
+
+func TestInvoke_Panic(t *testing.T) {
+	// A module that has not been decoded, and so not validated, can underflow the value stack
+	module := &Module{
+		Types:   []FuncType{void},
+		Funcs:   []uint32{0},
+		Exports: []Export{{Name: "f", Kind: ExportFunc}},
+		Codes:   []Code{{Body: []byte{opDrop, opEnd}}},
+	}
+	_, err := invoke(t, module, nil, 100000)
+	require.IsType(t, &Panic{}, err)
+	assert.NotEmpty(t, err.(*Panic).Stack)
+	// The exception is independent of where the panic happened
+	assert.Equal(t, trap("invalid module"), errors.AsException(err))
+}
@@ -0,0 +1,588 @@
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Every WASM module starts with this magic followed by a little-endian version. Since 0x00 is STOP in the EVM no useful
+// EVM bytecode starts with it so we can use the magic to tell WASM and EVM code apart
+var Magic = []byte{0x00, 'a', 's', 'm'}
+
+const Version uint32 = 1
+
+const (
+	// 64 KiB
+	PageSize = 1 << 16
+	// A module may not declare or grow its memory beyond this many pages (16 MiB)
+	MaxPages = 256
+	// A module may not declare a table with more elements than this
+	MaxTableElements = 1 << 16
+)
+
+// IsWASM returns true if code is (putatively) a WASM module rather than EVM bytecode
+func IsWASM(code []byte) bool {
+	return bytes.HasPrefix(code, Magic)
+}
+
+type ValueType byte
+
+const (
+	I32 ValueType = 0x7f
+	I64 ValueType = 0x7e
+	F32 ValueType = 0x7d
+	F64 ValueType = 0x7c
+)
+
+func (vt ValueType) String() string {
+	switch vt {
+	case I32:
+		return "i32"
+	case I64:
+		return "i64"
+	case F32:
+		return "f32"
+	case F64:
+		return "f64"
+	}
+	return fmt.Sprintf("ValueType(0x%x)", byte(vt))
+}
+
+type FuncType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+func (ft FuncType) Equal(other FuncType) bool {
+	return bytes.Equal(valueTypeBytes(ft.Params), valueTypeBytes(other.Params)) &&
+		bytes.Equal(valueTypeBytes(ft.Results), valueTypeBytes(other.Results))
+}
+
+func (ft FuncType) String() string {
+	return fmt.Sprintf("%v -> %v", ft.Params, ft.Results)
+}
+
+type Import struct {
+	Module string
+	Name   string
+	// Index into Module.Types - only function imports are supported
+	Type uint32
+}
+
+type ExportKind byte
+
+const (
+	ExportFunc   ExportKind = 0x00
+	ExportTable  ExportKind = 0x01
+	ExportMemory ExportKind = 0x02
+	ExportGlobal ExportKind = 0x03
+)
+
+type Export struct {
+	Name  string
+	Kind  ExportKind
+	Index uint32
+}
+
+type Limits struct {
+	Min    uint32
+	Max    uint32
+	HasMax bool
+}
+
+type Global struct {
+	Type    ValueType
+	Mutable bool
+	Init    ConstExpr
+}
+
+// ConstExpr is a constant initialiser - either a constant or the value of an (immutable, earlier) global
+type ConstExpr struct {
+	Value     uint64
+	GlobalRef *uint32
+}
+
+type Element struct {
+	Offset ConstExpr
+	Funcs  []uint32
+}
+
+type Data struct {
+	Offset ConstExpr
+	Init   []byte
+}
+
+type Code struct {
+	// Local variable types following the parameters
+	Locals []ValueType
+	// Function body expression terminated by end
+	Body []byte
+	// Control structure computed on decoding
+	blocks map[int]*block
+}
+
+// Module is a decoded (and structurally validated) WASM binary module
+type Module struct {
+	Types    []FuncType
+	Imports  []Import
+	Funcs    []uint32
+	Table    *Limits
+	Memory   *Limits
+	Globals  []Global
+	Exports  []Export
+	Start    *uint32
+	Elements []Element
+	Codes    []Code
+	Data     []Data
+}
+
+// Decode a WASM binary module
+func DecodeModule(bs []byte) (module *Module, err error) {
+	r := &reader{buf: bs}
+	defer func() {
+		if rec := recover(); rec != nil {
+			if e, ok := rec.(decodeError); ok {
+				module, err = nil, fmt.Errorf("could not decode WASM module at offset %d: %s", r.pos, string(e))
+				return
+			}
+			panic(rec)
+		}
+	}()
+	if !bytes.Equal(r.bytes(4), Magic) {
+		r.fail("missing WASM magic")
+	}
+	if version := r.u32le(); version != Version {
+		r.fail("unsupported WASM version %d", version)
+	}
+	module = new(Module)
+	var lastOrder int
+	for !r.eof() {
+		id := r.byte()
+		size := r.u32()
+		section := &reader{buf: r.bytes(int(size)), offset: r.pos - int(size)}
+		if id != 0 {
+			order := sectionOrder(id)
+			if order <= lastOrder {
+				r.fail("section %d out of order", id)
+			}
+			lastOrder = order
+		}
+		module.decodeSection(id, section)
+		if !section.eof() {
+			section.fail("%d unexpected trailing bytes in section %d", len(section.buf)-section.pos, id)
+		}
+	}
+	if len(module.Funcs) != len(module.Codes) {
+		r.fail("module declares %d functions but has %d function bodies", len(module.Funcs), len(module.Codes))
+	}
+	module.validate(r)
+	for i := range module.Codes {
+		err := module.compile(uint32(len(module.Imports) + i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return module, nil
+}
+
+// Export returns the index of the export with name and kind if there is one
+func (m *Module) Export(name string, kind ExportKind) (uint32, bool) {
+	for _, export := range m.Exports {
+		if export.Name == name && export.Kind == kind {
+			return export.Index, true
+		}
+	}
+	return 0, false
+}
+
+// The type of the function with index funcIndex in the function index space (imports first)
+func (m *Module) FuncType(funcIndex uint32) FuncType {
+	if funcIndex < uint32(len(m.Imports)) {
+		return m.Types[m.Imports[funcIndex].Type]
+	}
+	return m.Types[m.Funcs[funcIndex-uint32(len(m.Imports))]]
+}
+
+func (m *Module) numFuncs() uint32 {
+	return uint32(len(m.Imports) + len(m.Funcs))
+}
+
+func (m *Module) decodeSection(id byte, r *reader) {
+	switch id {
+	case 0:
+		// Custom section - ignored
+		r.pos = len(r.buf)
+	case 1:
+		for i := r.u32(); i > 0; i-- {
+			if r.byte() != 0x60 {
+				r.fail("expected function type")
+			}
+			m.Types = append(m.Types, FuncType{Params: r.valueTypes(), Results: r.valueTypes()})
+		}
+	case 2:
+		for i := r.u32(); i > 0; i-- {
+			imp := Import{Module: r.name(), Name: r.name()}
+			if kind := r.byte(); kind != 0x00 {
+				r.fail("import %s.%s is not a function, only function imports are supported", imp.Module, imp.Name)
+			}
+			imp.Type = r.typeIndex(m)
+			m.Imports = append(m.Imports, imp)
+		}
+	case 3:
+		for i := r.u32(); i > 0; i-- {
+			m.Funcs = append(m.Funcs, r.typeIndex(m))
+		}
+	case 4:
+		for i := r.u32(); i > 0; i-- {
+			if m.Table != nil {
+				r.fail("at most one table is allowed")
+			}
+			if r.byte() != 0x70 {
+				r.fail("only funcref tables are supported")
+			}
+			limits := r.limits()
+			if limits.Min > MaxTableElements {
+				r.fail("table of %d elements exceeds the maximum of %d", limits.Min, MaxTableElements)
+			}
+			m.Table = &limits
+		}
+	case 5:
+		for i := r.u32(); i > 0; i-- {
+			if m.Memory != nil {
+				r.fail("at most one memory is allowed")
+			}
+			limits := r.limits()
+			if limits.Min > MaxPages {
+				r.fail("memory of %d pages exceeds the maximum of %d", limits.Min, MaxPages)
+			}
+			m.Memory = &limits
+		}
+	case 6:
+		for i := r.u32(); i > 0; i-- {
+			global := Global{Type: r.valueType()}
+			switch r.byte() {
+			case 0:
+			case 1:
+				global.Mutable = true
+			default:
+				r.fail("invalid global mutability")
+			}
+			global.Init = r.constExpr(m, global.Type)
+			m.Globals = append(m.Globals, global)
+		}
+	case 7:
+		for i := r.u32(); i > 0; i-- {
+			export := Export{Name: r.name(), Kind: ExportKind(r.byte()), Index: r.u32()}
+			if export.Kind > ExportGlobal {
+				r.fail("invalid export kind %d", export.Kind)
+			}
+			m.Exports = append(m.Exports, export)
+		}
+	case 8:
+		start := r.u32()
+		m.Start = &start
+	case 9:
+		for i := r.u32(); i > 0; i-- {
+			if flags := r.u32(); flags != 0 {
+				r.fail("only active element segments for table 0 are supported")
+			}
+			elem := Element{Offset: r.constExpr(m, I32)}
+			for j := r.u32(); j > 0; j-- {
+				elem.Funcs = append(elem.Funcs, r.u32())
+			}
+			m.Elements = append(m.Elements, elem)
+		}
+	case 10:
+		for i := r.u32(); i > 0; i-- {
+			size := r.u32()
+			body := &reader{buf: r.bytes(int(size)), offset: r.offset + r.pos - int(size)}
+			var code Code
+			for j := body.u32(); j > 0; j-- {
+				n := body.u32()
+				vt := body.valueType()
+				if uint64(len(code.Locals))+uint64(n) > maxLocals {
+					body.fail("too many locals")
+				}
+				for ; n > 0; n-- {
+					code.Locals = append(code.Locals, vt)
+				}
+			}
+			code.Body = body.buf[body.pos:]
+			m.Codes = append(m.Codes, code)
+		}
+	case 11:
+		for i := r.u32(); i > 0; i-- {
+			if flags := r.u32(); flags != 0 {
+				r.fail("only active data segments for memory 0 are supported")
+			}
+			data := Data{Offset: r.constExpr(m, I32)}
+			data.Init = r.bytes(int(r.u32()))
+			m.Data = append(m.Data, data)
+		}
+	case 12:
+		// Data count section (for bulk memory) - we do not support passive segments so it carries no information
+		r.u32()
+	default:
+		r.fail("unknown section %d", id)
+	}
+}
+
+const maxLocals = 50000
+
+// Non-custom sections must appear in this order, the data count section was added after the others and so has a higher
+// ID than where it must appear
+func sectionOrder(id byte) int {
+	switch {
+	case id == 12:
+		return 10
+	case id >= 10:
+		return int(id) + 1
+	}
+	return int(id)
+}
+
+// Check indices that may refer to items in sections decoded after the one in which they appear
+func (m *Module) validate(r *reader) {
+	for _, export := range m.Exports {
+		var limit uint32
+		switch export.Kind {
+		case ExportFunc:
+			limit = m.numFuncs()
+		case ExportTable:
+			limit = boolToUint32(m.Table != nil)
+		case ExportMemory:
+			limit = boolToUint32(m.Memory != nil)
+		case ExportGlobal:
+			limit = uint32(len(m.Globals))
+		}
+		if export.Index >= limit {
+			r.fail("export %s refers to non-existent index %d", export.Name, export.Index)
+		}
+	}
+	if m.Start != nil {
+		if *m.Start >= m.numFuncs() {
+			r.fail("start function %d does not exist", *m.Start)
+		}
+		ft := m.FuncType(*m.Start)
+		if len(ft.Params) > 0 || len(ft.Results) > 0 {
+			r.fail("start function must have type [] -> []")
+		}
+	}
+	if len(m.Elements) > 0 && m.Table == nil {
+		r.fail("element segments require a table")
+	}
+	for _, elem := range m.Elements {
+		for _, funcIndex := range elem.Funcs {
+			if funcIndex >= m.numFuncs() {
+				r.fail("element segment refers to non-existent function %d", funcIndex)
+			}
+		}
+	}
+	if len(m.Data) > 0 && m.Memory == nil {
+		r.fail("data segments require a memory")
+	}
+}
+
+func boolToUint32(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type decodeError string
+
+type reader struct {
+	buf []byte
+	pos int
+	// Offset of buf in the module for error messages
+	offset int
+}
+
+func (r *reader) fail(format string, args ...interface{}) {
+	panic(decodeError(fmt.Sprintf(format, args...)))
+}
+
+func (r *reader) eof() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *reader) byte() byte {
+	if r.eof() {
+		r.fail("%v", io.ErrUnexpectedEOF)
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *reader) bytes(n int) []byte {
+	if n < 0 || r.pos+n > len(r.buf) {
+		r.fail("%v", io.ErrUnexpectedEOF)
+	}
+	bs := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return bs
+}
+
+func (r *reader) u32le() uint32 {
+	bs := r.bytes(4)
+	return uint32(bs[0]) | uint32(bs[1])<<8 | uint32(bs[2])<<16 | uint32(bs[3])<<24
+}
+
+func (r *reader) u32() uint32 {
+	v, n, err := readULEB(r.buf[r.pos:], 32)
+	if err != nil {
+		r.fail("%v", err)
+	}
+	r.pos += n
+	return uint32(v)
+}
+
+func (r *reader) s32() int32 {
+	v, n, err := readSLEB(r.buf[r.pos:], 32)
+	if err != nil {
+		r.fail("%v", err)
+	}
+	r.pos += n
+	return int32(v)
+}
+
+func (r *reader) s64() int64 {
+	v, n, err := readSLEB(r.buf[r.pos:], 64)
+	if err != nil {
+		r.fail("%v", err)
+	}
+	r.pos += n
+	return v
+}
+
+func (r *reader) name() string {
+	return string(r.bytes(int(r.u32())))
+}
+
+func (r *reader) valueType() ValueType {
+	vt := ValueType(r.byte())
+	switch vt {
+	case I32, I64:
+		return vt
+	case F32, F64:
+		r.fail("floating point type %v is not supported since it is not deterministic", vt)
+	}
+	r.fail("invalid value type 0x%x", byte(vt))
+	return 0
+}
+
+func (r *reader) valueTypes() []ValueType {
+	n := r.u32()
+	vts := make([]ValueType, n)
+	for i := range vts {
+		vts[i] = r.valueType()
+	}
+	return vts
+}
+
+func (r *reader) typeIndex(m *Module) uint32 {
+	index := r.u32()
+	if index >= uint32(len(m.Types)) {
+		r.fail("type index %d out of range", index)
+	}
+	return index
+}
+
+func (r *reader) limits() Limits {
+	var limits Limits
+	switch r.byte() {
+	case 0x00:
+		limits.Min = r.u32()
+	case 0x01:
+		limits.Min = r.u32()
+		limits.Max = r.u32()
+		limits.HasMax = true
+		if limits.Max < limits.Min {
+			r.fail("limits maximum %d is less than minimum %d", limits.Max, limits.Min)
+		}
+	default:
+		r.fail("invalid limits")
+	}
+	return limits
+}
+
+func (r *reader) constExpr(m *Module, vt ValueType) ConstExpr {
+	var expr ConstExpr
+	switch op := r.byte(); op {
+	case opI32Const:
+		if vt != I32 {
+			r.fail("constant expression has type i32 but %v expected", vt)
+		}
+		expr.Value = uint64(uint32(r.s32()))
+	case opI64Const:
+		if vt != I64 {
+			r.fail("constant expression has type i64 but %v expected", vt)
+		}
+		expr.Value = uint64(r.s64())
+	case opGlobalGet:
+		index := r.u32()
+		if index >= uint32(len(m.Globals)) || m.Globals[index].Mutable || m.Globals[index].Type != vt {
+			r.fail("constant expression refers to invalid global %d", index)
+		}
+		expr.GlobalRef = &index
+	default:
+		r.fail("unsupported constant expression opcode 0x%x", op)
+	}
+	if r.byte() != opEnd {
+		r.fail("constant expression must be a single instruction")
+	}
+	return expr
+}
+
+func readULEB(bs []byte, bits uint) (uint64, int, error) {
+	var result uint64
+	var shift uint
+	for i, b := range bs {
+		if shift >= bits {
+			return 0, 0, fmt.Errorf("LEB128 integer too long")
+		}
+		result |= uint64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if bits < 64 && result>>bits != 0 {
+				return 0, 0, fmt.Errorf("LEB128 integer overflows %d bits", bits)
+			}
+			return result, i + 1, nil
+		}
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
+
+func readSLEB(bs []byte, bits uint) (int64, int, error) {
+	var result int64
+	var shift uint
+	for i, b := range bs {
+		if shift >= bits {
+			return 0, 0, fmt.Errorf("LEB128 integer too long")
+		}
+		result |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				// Sign extend
+				result |= -1 << shift
+			}
+			if bits < 64 && (result < -(1<<(bits-1)) || result >= 1<<(bits-1)) {
+				return 0, 0, fmt.Errorf("LEB128 integer overflows %d bits", bits)
+			}
+			return result, i + 1, nil
+		}
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
+
+func valueTypeBytes(vts []ValueType) []byte {
+	bs := make([]byte, len(vts))
+	for i, vt := range vts {
+		bs[i] = byte(vt)
+	}
+	return bs
+}
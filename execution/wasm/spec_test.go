@@ -0,0 +1,1599 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Assertions ported from the WebAssembly specification testsuite (https://github.com/WebAssembly/testsuite). The
+// assert_return and assert_trap cases of i32.wast, i64.wast, int_exprs.wast, and conversions.wast for the integer
+// instructions are tabulated in specNumeric and run against a function applying the single instruction to its
+// parameters. The control, variable, memory, and table instructions are covered by the tests below, which rebuild the
+// relevant functions of block.wast, loop.wast, if.wast, br.wast, br_if.wast, br_table.wast, return.wast, call.wast,
+// call_indirect.wast, select.wast, local_tee.wast, global.wast, address.wast, memory_grow.wast, memory_fill.wast, and
+// memory_copy.wast in binary form. Floating point instructions are rejected by the decoder so have no cases.
+
+const (
+	returns = false
+	traps   = true
+)
+
+type specAssertion struct {
+	args   []uint64
+	result uint64
+	trap   bool
+}
+
+var specOpcodes = map[string]byte{
+	"i32.eqz": 0x45, "i32.eq": 0x46, "i32.ne": 0x47, "i32.lt_s": 0x48, "i32.lt_u": 0x49, "i32.gt_s": 0x4a,
+	"i32.gt_u": 0x4b, "i32.le_s": 0x4c, "i32.le_u": 0x4d, "i32.ge_s": 0x4e, "i32.ge_u": 0x4f,
+	"i64.eqz": 0x50, "i64.eq": 0x51, "i64.ne": 0x52, "i64.lt_s": 0x53, "i64.lt_u": 0x54, "i64.gt_s": 0x55,
+	"i64.gt_u": 0x56, "i64.le_s": 0x57, "i64.le_u": 0x58, "i64.ge_s": 0x59, "i64.ge_u": 0x5a,
+	"i32.clz": 0x67, "i32.ctz": 0x68, "i32.popcnt": 0x69, "i32.add": 0x6a, "i32.sub": 0x6b, "i32.mul": 0x6c,
+	"i32.div_s": 0x6d, "i32.div_u": 0x6e, "i32.rem_s": 0x6f, "i32.rem_u": 0x70, "i32.and": 0x71, "i32.or": 0x72,
+	"i32.xor": 0x73, "i32.shl": 0x74, "i32.shr_s": 0x75, "i32.shr_u": 0x76, "i32.rotl": 0x77, "i32.rotr": 0x78,
+	"i64.clz": 0x79, "i64.ctz": 0x7a, "i64.popcnt": 0x7b, "i64.add": 0x7c, "i64.sub": 0x7d, "i64.mul": 0x7e,
+	"i64.div_s": 0x7f, "i64.div_u": 0x80, "i64.rem_s": 0x81, "i64.rem_u": 0x82, "i64.and": 0x83, "i64.or": 0x84,
+	"i64.xor": 0x85, "i64.shl": 0x86, "i64.shr_s": 0x87, "i64.shr_u": 0x88, "i64.rotl": 0x89, "i64.rotr": 0x8a,
+	"i32.wrap_i64": 0xa7, "i64.extend_i32_s": 0xac, "i64.extend_i32_u": 0xad,
+	"i32.extend8_s": 0xc0, "i32.extend16_s": 0xc1,
+	"i64.extend8_s": 0xc2, "i64.extend16_s": 0xc3, "i64.extend32_s": 0xc4,
+}
+
+func TestSpec_Numeric(t *testing.T) {
+	for name, assertions := range specNumeric {
+		op, ok := specOpcodes[name]
+		require.True(t, ok, "no opcode for %s", name)
+		params, result, ok := numericType(op)
+		require.True(t, ok, "%s is not accepted", name)
+		var body []byte
+		for i := range params {
+			body = append(body, opLocalGet, byte(i))
+		}
+		body = append(body, op, opEnd)
+		module := newModule(t, FuncType{Params: params, Results: []ValueType{result}}, nil, body)
+		for _, a := range assertions {
+			results, err := invoke(t, module, nil, 100000, a.args...)
+			if a.trap {
+				assertTrapped(t, err, "%s %#x", name, a.args)
+			} else if assert.NoError(t, err, "%s %#x", name, a.args) {
+				assert.Equal(t, []uint64{a.result}, results, "%s %#x", name, a.args)
+			}
+		}
+	}
+}
+
+// Every numeric instruction the interpreter accepts must have spec assertions
+func TestSpec_NumericCoverage(t *testing.T) {
+	names := make(map[byte]string)
+	for name, op := range specOpcodes {
+		names[op] = name
+	}
+	for op := 0; op < 256; op++ {
+		if _, _, ok := numericType(byte(op)); !ok {
+			continue
+		}
+		name, ok := names[byte(op)]
+		if assert.True(t, ok, "no spec assertions for opcode %#x", op) {
+			assert.NotEmpty(t, specNumeric[name], "no spec assertions for %s", name)
+		}
+	}
+}
+
+// A trap rather than a panic, which also has ErrorCodeExecutionAborted
+func assertTrapped(t *testing.T, err error, msgAndArgs ...interface{}) {
+	if assert.Error(t, err, msgAndArgs...) {
+		assert.IsType(t, &errors.Exception{}, err, msgAndArgs...)
+		assert.Equal(t, errors.ErrorCodeExecutionAborted, errors.AsException(err).ErrorCode(), msgAndArgs...)
+	}
+}
+
+type specFunc struct {
+	name   string
+	ft     FuncType
+	locals []ValueType
+	body   []byte
+}
+
+// Build a module with memory exporting each of funcs under its name. The type of each function follows types so that
+// blocks can refer to types by index.
+func specModule(types []FuncType, funcs ...specFunc) *Module {
+	m := &Module{Types: types, Memory: &Limits{Min: 1}}
+	for i, f := range funcs {
+		m.Funcs = append(m.Funcs, uint32(len(m.Types)))
+		m.Types = append(m.Types, f.ft)
+		m.Exports = append(m.Exports, Export{Name: f.name, Kind: ExportFunc, Index: uint32(i)})
+		m.Codes = append(m.Codes, Code{Locals: f.locals, Body: f.body})
+	}
+	return m
+}
+
+func specInstance(t *testing.T, m *Module) *Instance {
+	module, err := DecodeModule(m.Encode())
+	require.NoError(t, err)
+	gas := uint64(100000000)
+	pages := uint64(MaxPages)
+	inst, err := Instantiate(module, nil, &gas, &pages)
+	require.NoError(t, err)
+	return inst
+}
+
+func assertReturn(t *testing.T, inst *Instance, name string, expected []uint64, args ...uint64) {
+	results, err := inst.Invoke(name, args...)
+	if assert.NoError(t, err, "%s %#x", name, args) {
+		if len(expected) == 0 {
+			assert.Empty(t, results, "%s %#x", name, args)
+		} else {
+			assert.Equal(t, expected, results, "%s %#x", name, args)
+		}
+	}
+}
+
+func assertTrap(t *testing.T, inst *Instance, name string, args ...uint64) {
+	_, err := inst.Invoke(name, args...)
+	assertTrapped(t, err, "%s %#x", name, args)
+}
+
+var (
+	void_i32      = FuncType{Results: []ValueType{I32}}
+	void_i64      = FuncType{Results: []ValueType{I64}}
+	i32_void      = FuncType{Params: []ValueType{I32}}
+	i64_void      = FuncType{Params: []ValueType{I64}}
+	i64_i32       = FuncType{Params: []ValueType{I64}, Results: []ValueType{I32}}
+	i32_i64       = FuncType{Params: []ValueType{I32}, Results: []ValueType{I64}}
+	i32i64_i64    = FuncType{Params: []ValueType{I32, I64}, Results: []ValueType{I64}}
+	i32i32i32_i32 = FuncType{Params: []ValueType{I32, I32, I32}, Results: []ValueType{I32}}
+	i64i64i32_i64 = FuncType{Params: []ValueType{I64, I64, I32}, Results: []ValueType{I64}}
+	i32i32i32     = FuncType{Params: []ValueType{I32, I32, I32}}
+)
+
+func TestSpec_Block(t *testing.T) {
+	inst := specInstance(t, specModule([]FuncType{i32_i32},
+		specFunc{name: "singular", ft: void_i32, body: []byte{
+			opBlock, blockTypeEmpty, opNop, opEnd,
+			opBlock, byte(I32), opI32Const, 7, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "nested", ft: void_i32, body: []byte{
+			opBlock, byte(I32),
+			opBlock, blockTypeEmpty, opNop, opBlock, blockTypeEmpty, opEnd, opNop, opEnd,
+			opBlock, byte(I32), opNop, opI32Const, 9, opEnd,
+			opEnd,
+			opEnd,
+		}},
+		specFunc{name: "effects", ft: void_i32, locals: []ValueType{I32}, body: []byte{
+			opBlock, blockTypeEmpty,
+			opI32Const, 1, opLocalSet, 0,
+			opLocalGet, 0, opI32Const, 3, 0x6c, opLocalSet, 0,
+			opLocalGet, 0, opI32Const, 5, 0x6b, opLocalSet, 0,
+			opLocalGet, 0, opI32Const, 7, 0x6c, opLocalSet, 0,
+			opBr, 0,
+			opLocalGet, 0, opI32Const, 0xe4, 0x00, 0x6c, opLocalSet, 0,
+			opEnd,
+			opLocalGet, 0, opI32Const, 0x72, 0x46,
+			opEnd,
+		}},
+		// The block takes its parameter from the stack
+		specFunc{name: "param", ft: void_i32, body: []byte{
+			opI32Const, 1,
+			opBlock, 0, opI32Const, 2, 0x6a, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "break-value", ft: void_i32, body: []byte{
+			opBlock, byte(I32), opI32Const, 18, opBr, 0, opI32Const, 19, opEnd,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "singular", []uint64{7})
+	assertReturn(t, inst, "nested", []uint64{9})
+	assertReturn(t, inst, "effects", []uint64{1})
+	assertReturn(t, inst, "param", []uint64{3})
+	assertReturn(t, inst, "break-value", []uint64{18})
+}
+
+func TestSpec_Loop(t *testing.T) {
+	inst := specInstance(t, specModule([]FuncType{i32_i32},
+		specFunc{name: "while", ft: i64_i64, locals: []ValueType{I64}, body: []byte{
+			opI64Const, 1, opLocalSet, 1,
+			opBlock, blockTypeEmpty,
+			opLoop, blockTypeEmpty,
+			opLocalGet, 0, 0x50, opBrIf, 1,
+			opLocalGet, 0, opLocalGet, 1, 0x7e, opLocalSet, 1,
+			opLocalGet, 0, opI64Const, 1, 0x7d, opLocalSet, 0,
+			opBr, 0,
+			opEnd,
+			opEnd,
+			opLocalGet, 1,
+			opEnd,
+		}},
+		specFunc{name: "break-value", ft: void_i32, body: []byte{
+			opBlock, byte(I32),
+			opLoop, byte(I32), opI32Const, 18, opBr, 1, opBr, 0, opI32Const, 19, opEnd,
+			opEnd,
+			opEnd,
+		}},
+		// A loop's label takes its parameters so the branch passes a value back round the loop
+		specFunc{name: "param-break", ft: void_i32, locals: []ValueType{I32}, body: []byte{
+			opI32Const, 1,
+			opLoop, 0,
+			opLocalGet, 0, opI32Const, 1, 0x6a, opLocalSet, 0,
+			opI32Const, 2, 0x6c,
+			opLocalGet, 0, opI32Const, 5, 0x49, opBrIf, 0,
+			opEnd,
+			opEnd,
+		}},
+	))
+	for arg, expected := range map[uint64]uint64{0: 1, 1: 1, 2: 2, 3: 6, 5: 120, 20: 2432902008176640000} {
+		assertReturn(t, inst, "while", []uint64{expected}, arg)
+	}
+	assertReturn(t, inst, "break-value", []uint64{18})
+	assertReturn(t, inst, "param-break", []uint64{32})
+}
+
+func TestSpec_If(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "singular", ft: i32_i32, body: []byte{
+			opLocalGet, 0, opIf, blockTypeEmpty, opNop, opEnd,
+			opLocalGet, 0, opIf, blockTypeEmpty, opNop, opElse, opNop, opEnd,
+			opLocalGet, 0, opIf, byte(I32), opI32Const, 7, opElse, opI32Const, 8, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "as-binary-operand", ft: i32i32_i32, body: []byte{
+			opLocalGet, 0, opIf, byte(I32), opI32Const, 3, opElse, opI32Const, 0x7d, opEnd,
+			opLocalGet, 1, opIf, byte(I32), opI32Const, 4, opElse, opI32Const, 0x7b, opEnd,
+			0x6c,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "singular", []uint64{8}, 0)
+	assertReturn(t, inst, "singular", []uint64{7}, 1)
+	assertReturn(t, inst, "singular", []uint64{7}, 10)
+	assertReturn(t, inst, "singular", []uint64{7}, 0xfffffff6)
+	assertReturn(t, inst, "as-binary-operand", []uint64{15}, 0, 0)
+	assertReturn(t, inst, "as-binary-operand", []uint64{0xfffffff4}, 0, 1)
+	assertReturn(t, inst, "as-binary-operand", []uint64{0xfffffff1}, 1, 0)
+	assertReturn(t, inst, "as-binary-operand", []uint64{12}, 1, 1)
+}
+
+func TestSpec_Br(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "as-if-then", ft: i32i32_i32, body: []byte{
+			opLocalGet, 0, opIf, byte(I32), opI32Const, 3, opBr, 1, opElse, opLocalGet, 1, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "as-binary-left", ft: void_i32, body: []byte{
+			opBlock, byte(I32), opI32Const, 3, opBr, 0, opI32Const, 10, 0x6b, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "as-br_if-value", ft: i32_i32, body: []byte{
+			opBlock, byte(I32), opI32Const, 8, opLocalGet, 0, opBrIf, 0, opDrop, opI32Const, 16, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "nested-block-value", ft: i32_i32, body: []byte{
+			opI32Const, 1,
+			opBlock, byte(I32),
+			opI32Const, 2, opDrop,
+			opI32Const, 4,
+			opBlock, byte(I32), opI32Const, 8, opLocalGet, 0, opBrIf, 1, opDrop, opI32Const, 16, opEnd,
+			0x6a,
+			opEnd,
+			0x6a,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "as-if-then", []uint64{6}, 0, 6)
+	assertReturn(t, inst, "as-if-then", []uint64{3}, 1, 6)
+	assertReturn(t, inst, "as-binary-left", []uint64{3})
+	assertReturn(t, inst, "as-br_if-value", []uint64{16}, 0)
+	assertReturn(t, inst, "as-br_if-value", []uint64{8}, 1)
+	assertReturn(t, inst, "nested-block-value", []uint64{21}, 0)
+	assertReturn(t, inst, "nested-block-value", []uint64{9}, 1)
+}
+
+func TestSpec_BrTable(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "singleton", ft: i32_i32, body: []byte{
+			opBlock, blockTypeEmpty,
+			opBlock, blockTypeEmpty,
+			opLocalGet, 0, opBrTable, 1, 1, 0,
+			opI32Const, 21, opReturn,
+			opEnd,
+			opI32Const, 20, opReturn,
+			opEnd,
+			opI32Const, 22,
+			opEnd,
+		}},
+		specFunc{name: "singleton-value", ft: i32_i32, body: []byte{
+			opBlock, byte(I32),
+			opBlock, byte(I32),
+			opI32Const, 33, opLocalGet, 0, opBrTable, 1, 0, 1,
+			opI32Const, 31, opReturn,
+			opEnd,
+			opDrop, opI32Const, 32,
+			opEnd,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "singleton", []uint64{22}, 0)
+	assertReturn(t, inst, "singleton", []uint64{20}, 1)
+	assertReturn(t, inst, "singleton", []uint64{20}, 11)
+	assertReturn(t, inst, "singleton", []uint64{20}, 0xffffffff)
+	assertReturn(t, inst, "singleton-value", []uint64{32}, 0)
+	assertReturn(t, inst, "singleton-value", []uint64{33}, 1)
+	assertReturn(t, inst, "singleton-value", []uint64{33}, 11)
+	assertReturn(t, inst, "singleton-value", []uint64{33}, 0x80000000)
+}
+
+func TestSpec_Return(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "nested", ft: i32_i32, body: []byte{
+			opBlock, blockTypeEmpty,
+			opLocalGet, 0, opIf, blockTypeEmpty, opI32Const, 5, opReturn, opEnd,
+			opEnd,
+			opI32Const, 6,
+			opEnd,
+		}},
+		specFunc{name: "as-loop-first", ft: void_i32, body: []byte{
+			opLoop, byte(I32), opI32Const, 3, opReturn, opI32Const, 2, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "as-binary-right", ft: void_i64, body: []byte{
+			opI64Const, 10, opI64Const, 7, opReturn, 0x7d,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "nested", []uint64{6}, 0)
+	assertReturn(t, inst, "nested", []uint64{5}, 1)
+	assertReturn(t, inst, "as-loop-first", []uint64{3})
+	assertReturn(t, inst, "as-binary-right", []uint64{7})
+}
+
+func TestSpec_Call(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "fib", ft: i64_i64, body: []byte{
+			opLocalGet, 0, opI64Const, 2, 0x54,
+			opIf, byte(I64),
+			opI64Const, 1,
+			opElse,
+			opLocalGet, 0, opI64Const, 2, 0x7d, opCall, 0,
+			opLocalGet, 0, opI64Const, 1, 0x7d, opCall, 0,
+			0x7c,
+			opEnd,
+			opEnd,
+		}},
+		specFunc{name: "even", ft: i64_i32, body: []byte{
+			opLocalGet, 0, 0x50,
+			opIf, byte(I32), opI32Const, 44, opElse, opLocalGet, 0, opI64Const, 1, 0x7d, opCall, 2, opEnd,
+			opEnd,
+		}},
+		specFunc{name: "odd", ft: i64_i32, body: []byte{
+			opLocalGet, 0, 0x50,
+			opIf, byte(I32), opI32Const, 0xe3, 0x00, opElse, opLocalGet, 0, opI64Const, 1, 0x7d, opCall, 1, opEnd,
+			opEnd,
+		}},
+	))
+	for arg, expected := range map[uint64]uint64{0: 1, 1: 1, 2: 2, 5: 8, 20: 10946} {
+		assertReturn(t, inst, "fib", []uint64{expected}, arg)
+	}
+	for arg, expected := range map[uint64]uint64{0: 44, 1: 99, 100: 44, 77: 99} {
+		assertReturn(t, inst, "even", []uint64{expected}, arg)
+	}
+	for arg, expected := range map[uint64]uint64{0: 99, 1: 44, 200: 99, 77: 44} {
+		assertReturn(t, inst, "odd", []uint64{expected}, arg)
+	}
+}
+
+func TestSpec_CallIndirect(t *testing.T) {
+	m := specModule(nil,
+		specFunc{name: "const-i32", ft: void_i32, body: []byte{opI32Const, 0xb2, 0x02, opEnd}},
+		specFunc{name: "id-i64", ft: i64_i64, body: []byte{opLocalGet, 0, opEnd}},
+		// call_indirect of the type of id-i64
+		specFunc{name: "dispatch", ft: i32i64_i64, body: []byte{
+			opLocalGet, 1, opLocalGet, 0, opCallIndirect, 1, 0,
+			opEnd,
+		}},
+		// call_indirect of the type of const-i32
+		specFunc{name: "dispatch-i32", ft: i32_i32, body: []byte{
+			opLocalGet, 0, opCallIndirect, 0, 0,
+			opEnd,
+		}},
+	)
+	m.Table = &Limits{Min: 3}
+	m.Elements = []Element{{Funcs: []uint32{0, 1}}}
+	inst := specInstance(t, m)
+	assertReturn(t, inst, "dispatch", []uint64{2}, 1, 2)
+	assertReturn(t, inst, "dispatch", []uint64{0xffffffffffffffff}, 1, 0xffffffffffffffff)
+	assertReturn(t, inst, "dispatch-i32", []uint64{306}, 0)
+	// Indirect call type mismatch
+	assertTrap(t, inst, "dispatch", 0, 2)
+	assertTrap(t, inst, "dispatch-i32", 1)
+	// Uninitialised element
+	assertTrap(t, inst, "dispatch", 2, 2)
+	// Undefined element
+	assertTrap(t, inst, "dispatch", 3, 2)
+	assertTrap(t, inst, "dispatch", 0xffffffff, 2)
+}
+
+func TestSpec_Select(t *testing.T) {
+	inst := specInstance(t, specModule(nil,
+		specFunc{name: "select-i32", ft: i32i32i32_i32, body: []byte{
+			opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opSelect, opEnd,
+		}},
+		specFunc{name: "select-i64", ft: i64i64i32_i64, body: []byte{
+			opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opSelect, opEnd,
+		}},
+	))
+	assertReturn(t, inst, "select-i32", []uint64{1}, 1, 2, 1)
+	assertReturn(t, inst, "select-i32", []uint64{2}, 1, 2, 0)
+	assertReturn(t, inst, "select-i32", []uint64{1}, 2, 1, 0)
+	assertReturn(t, inst, "select-i32", []uint64{2}, 2, 1, 0xffffffff)
+	assertReturn(t, inst, "select-i32", []uint64{2}, 2, 1, 0xf0f0f0f0)
+	assertReturn(t, inst, "select-i64", []uint64{2}, 2, 1, 1)
+	assertReturn(t, inst, "select-i64", []uint64{2}, 2, 1, 0xffffffff)
+	assertReturn(t, inst, "select-i64", []uint64{1}, 2, 1, 0)
+	assertReturn(t, inst, "select-i64", []uint64{2}, 2, 1, 0xf0f0f0f0)
+}
+
+func TestSpec_Variables(t *testing.T) {
+	m := specModule(nil,
+		specFunc{name: "tee", ft: i32_i32, locals: []ValueType{I32}, body: []byte{
+			opLocalGet, 0, opLocalTee, 1, opLocalGet, 1, 0x6a, opEnd,
+		}},
+		specFunc{name: "get-a", ft: void_i32, body: []byte{opGlobalGet, 0, opEnd}},
+		specFunc{name: "get-x", ft: void_i32, body: []byte{opGlobalGet, 1, opEnd}},
+		specFunc{name: "set-x", ft: i32_void, body: []byte{opLocalGet, 0, opGlobalSet, 1, opEnd}},
+		specFunc{name: "get-b", ft: void_i64, body: []byte{opGlobalGet, 2, opEnd}},
+		specFunc{name: "get-y", ft: void_i64, body: []byte{opGlobalGet, 3, opEnd}},
+		specFunc{name: "set-y", ft: i64_void, body: []byte{opLocalGet, 0, opGlobalSet, 3, opEnd}},
+	)
+	m.Globals = []Global{
+		{Type: I32, Init: ConstExpr{Value: 0xfffffffe}},
+		{Type: I32, Mutable: true, Init: ConstExpr{Value: 0xfffffff4}},
+		{Type: I64, Init: ConstExpr{Value: 0xfffffffffffffffb}},
+		{Type: I64, Mutable: true, Init: ConstExpr{Value: 0xfffffffffffffff1}},
+	}
+	inst := specInstance(t, m)
+	assertReturn(t, inst, "tee", []uint64{6}, 3)
+	assertReturn(t, inst, "tee", []uint64{0}, 0x80000000)
+	assertReturn(t, inst, "get-a", []uint64{0xfffffffe})
+	assertReturn(t, inst, "get-b", []uint64{0xfffffffffffffffb})
+	assertReturn(t, inst, "get-x", []uint64{0xfffffff4})
+	assertReturn(t, inst, "get-y", []uint64{0xfffffffffffffff1})
+	assertReturn(t, inst, "set-x", nil, 6)
+	assertReturn(t, inst, "set-y", nil, 7)
+	assertReturn(t, inst, "get-a", []uint64{0xfffffffe})
+	assertReturn(t, inst, "get-b", []uint64{0xfffffffffffffffb})
+	assertReturn(t, inst, "get-x", []uint64{6})
+	assertReturn(t, inst, "get-y", []uint64{7})
+}
+
+func TestSpec_Address(t *testing.T) {
+	load := func(name string, ft FuncType, op byte, offset uint64) specFunc {
+		body := AppendULEB([]byte{opLocalGet, 0, op, 0}, offset)
+		return specFunc{name: name, ft: ft, body: append(body, opEnd)}
+	}
+	m := specModule(nil,
+		load("8u_good1", i32_i32, opI32Load8U, 0),
+		load("8u_good3", i32_i32, opI32Load8U, 1),
+		load("8u_good5", i32_i32, opI32Load8U, 25),
+		load("8u_bad", i32_i32, opI32Load8U, 0xffffffff),
+		load("8s_good1", i32_i32, opI32Load8S, 0),
+		load("8s_good5", i32_i32, opI32Load8S, 25),
+		load("16u_good1", i32_i32, opI32Load16U, 0),
+		load("16u_good3", i32_i32, opI32Load16U, 1),
+		load("16s_good5", i32_i32, opI32Load16S, 25),
+		load("32_good1", i32_i32, opI32Load, 0),
+		load("32_good3", i32_i32, opI32Load, 1),
+		load("32_good5", i32_i32, opI32Load, 25),
+		load("32_bad", i32_i32, opI32Load, 0xffffffff),
+		load("i64.8u_good1", i32_i64, opI64Load8U, 0),
+		load("i64.8s_good5", i32_i64, opI64Load8S, 25),
+		load("i64.16u_good3", i32_i64, opI64Load16U, 1),
+		load("i64.16s_good1", i32_i64, opI64Load16S, 0),
+		load("i64.32u_good1", i32_i64, opI64Load32U, 0),
+		load("i64.32s_good3", i32_i64, opI64Load32S, 1),
+		load("i64_good1", i32_i64, opI64Load, 0),
+		load("i64_good3", i32_i64, opI64Load, 1),
+		load("i64_good5", i32_i64, opI64Load, 25),
+	)
+	m.Data = []Data{{Init: []byte("abcdefghijklmnopqrstuvwxyz")}}
+	inst := specInstance(t, m)
+
+	assertReturn(t, inst, "8u_good1", []uint64{97}, 0)
+	assertReturn(t, inst, "8u_good3", []uint64{98}, 0)
+	assertReturn(t, inst, "8u_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "8u_good1", []uint64{0}, 65503)
+	assertReturn(t, inst, "8u_good5", []uint64{0}, 65503)
+	assertReturn(t, inst, "8u_good1", []uint64{0}, 65535)
+	assertTrap(t, inst, "8u_good1", 65536)
+	assertTrap(t, inst, "8u_good5", 65511)
+	assertTrap(t, inst, "8u_bad", 0)
+	assertTrap(t, inst, "8u_bad", 1)
+	assertReturn(t, inst, "8s_good1", []uint64{97}, 0)
+	assertReturn(t, inst, "8s_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "16u_good1", []uint64{25185}, 0)
+	assertReturn(t, inst, "16u_good3", []uint64{25442}, 0)
+	assertReturn(t, inst, "16u_good1", []uint64{0}, 65534)
+	assertTrap(t, inst, "16u_good1", 65535)
+	assertReturn(t, inst, "16s_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "32_good1", []uint64{1684234849}, 0)
+	assertReturn(t, inst, "32_good3", []uint64{1701077858}, 0)
+	assertReturn(t, inst, "32_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "32_good1", []uint64{0}, 65532)
+	assertTrap(t, inst, "32_good1", 65533)
+	assertTrap(t, inst, "32_good5", 65508)
+	assertTrap(t, inst, "32_bad", 0)
+	assertReturn(t, inst, "i64.8u_good1", []uint64{97}, 0)
+	assertReturn(t, inst, "i64.8s_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "i64.16u_good3", []uint64{25442}, 0)
+	assertReturn(t, inst, "i64.16s_good1", []uint64{25185}, 0)
+	assertReturn(t, inst, "i64.32u_good1", []uint64{1684234849}, 0)
+	assertReturn(t, inst, "i64.32s_good3", []uint64{1701077858}, 0)
+	assertReturn(t, inst, "i64_good1", []uint64{7523094288207667809}, 0)
+	assertReturn(t, inst, "i64_good3", []uint64{7595434461045744482}, 0)
+	assertReturn(t, inst, "i64_good5", []uint64{122}, 0)
+	assertReturn(t, inst, "i64_good1", []uint64{0}, 65528)
+	assertTrap(t, inst, "i64_good1", 65529)
+	assertTrap(t, inst, "i64_good5", 65504)
+}
+
+func TestSpec_Store(t *testing.T) {
+	// Store the parameter at address 8 then load it back
+	storeLoad := func(name string, ft FuncType, store, load byte) specFunc {
+		return specFunc{name: name, ft: ft, body: []byte{
+			opI32Const, 8, opLocalGet, 0, store, 0, 0,
+			opI32Const, 8, load, 0, 0,
+			opEnd,
+		}}
+	}
+	inst := specInstance(t, specModule(nil,
+		storeLoad("i32.store8-load8_s", i32_i32, opI32Store8, opI32Load8S),
+		storeLoad("i32.store8-load8_u", i32_i32, opI32Store8, opI32Load8U),
+		storeLoad("i32.store16-load16_s", i32_i32, opI32Store16, opI32Load16S),
+		storeLoad("i32.store16-load16_u", i32_i32, opI32Store16, opI32Load16U),
+		storeLoad("i32.store-load", i32_i32, opI32Store, opI32Load),
+		storeLoad("i64.store8-load8_s", i64_i64, opI64Store8, opI64Load8S),
+		storeLoad("i64.store16-load16_u", i64_i64, opI64Store16, opI64Load16U),
+		storeLoad("i64.store32-load32_s", i64_i64, opI64Store32, opI64Load32S),
+		storeLoad("i64.store32-load32_u", i64_i64, opI64Store32, opI64Load32U),
+		storeLoad("i64.store-load", i64_i64, opI64Store, opI64Load),
+		// Little endian: the high word of an i64 is stored 4 bytes after its low word
+		specFunc{name: "i64.store-i32.load", ft: i64_i32, body: []byte{
+			opI32Const, 16, opLocalGet, 0, opI64Store, 0, 0,
+			opI32Const, 16, opI32Load, 0, 4,
+			opEnd,
+		}},
+		specFunc{name: "i32.store", ft: i32_void, body: []byte{
+			opLocalGet, 0, opI32Const, 1, opI32Store, 0, 0,
+			opEnd,
+		}},
+	))
+	assertReturn(t, inst, "i32.store8-load8_s", []uint64{0xffffff80}, 0x180)
+	assertReturn(t, inst, "i32.store8-load8_s", []uint64{0x7f}, 0x7f)
+	assertReturn(t, inst, "i32.store8-load8_u", []uint64{0x80}, 0x180)
+	assertReturn(t, inst, "i32.store16-load16_s", []uint64{0xffff8765}, 0x12348765)
+	assertReturn(t, inst, "i32.store16-load16_u", []uint64{0x8765}, 0x12348765)
+	assertReturn(t, inst, "i32.store-load", []uint64{0xdeadbeef}, 0xdeadbeef)
+	assertReturn(t, inst, "i64.store8-load8_s", []uint64{0xffffffffffffff80}, 0x80)
+	assertReturn(t, inst, "i64.store16-load16_u", []uint64{0xcdef}, 0x123456789abcdef)
+	assertReturn(t, inst, "i64.store32-load32_s", []uint64{0xffffffff80000000}, 0x80000000)
+	assertReturn(t, inst, "i64.store32-load32_s", []uint64{0x23456789}, 0x123456789)
+	assertReturn(t, inst, "i64.store32-load32_u", []uint64{0x80000000}, 0xffffffff80000000)
+	assertReturn(t, inst, "i64.store-load", []uint64{0x8000000000000001}, 0x8000000000000001)
+	assertReturn(t, inst, "i64.store-i32.load", []uint64{0x11223344}, 0x1122334455667788)
+	assertReturn(t, inst, "i32.store", nil, 65532)
+	assertTrap(t, inst, "i32.store", 65533)
+	assertTrap(t, inst, "i32.store", 0xffffffff)
+	// A trapping store writes nothing
+	assert.Equal(t, []byte{1, 0, 0, 0}, inst.Memory[65532:])
+}
+
+func TestSpec_MemoryGrow(t *testing.T) {
+	m := specModule(nil,
+		specFunc{name: "grow", ft: i32_i32, body: []byte{opLocalGet, 0, opMemoryGrow, 0, opEnd}},
+		specFunc{name: "size", ft: void_i32, body: []byte{opMemorySize, 0, opEnd}},
+		specFunc{name: "store_at_zero", ft: void, body: []byte{opI32Const, 0, opI32Const, 2, opI32Store, 2, 0, opEnd}},
+		specFunc{name: "load_at_zero", ft: void_i32, body: []byte{opI32Const, 0, opI32Load, 2, 0, opEnd}},
+		specFunc{name: "store_at_page_size", ft: void, body: []byte{
+			opI32Const, 0x80, 0x80, 0x04, opI32Const, 3, opI32Store, 2, 0,
+			opEnd,
+		}},
+		specFunc{name: "load_at_page_size", ft: void_i32, body: []byte{
+			opI32Const, 0x80, 0x80, 0x04, opI32Load, 2, 0,
+			opEnd,
+		}},
+	)
+	m.Memory = &Limits{Min: 0, Max: 2, HasMax: true}
+	inst := specInstance(t, m)
+	assertReturn(t, inst, "size", []uint64{0})
+	assertTrap(t, inst, "store_at_zero")
+	assertTrap(t, inst, "load_at_zero")
+	assertTrap(t, inst, "store_at_page_size")
+	assertTrap(t, inst, "load_at_page_size")
+	assertReturn(t, inst, "grow", []uint64{0}, 1)
+	assertReturn(t, inst, "size", []uint64{1})
+	assertReturn(t, inst, "load_at_zero", []uint64{0})
+	assertReturn(t, inst, "store_at_zero", nil)
+	assertReturn(t, inst, "load_at_zero", []uint64{2})
+	assertTrap(t, inst, "store_at_page_size")
+	assertTrap(t, inst, "load_at_page_size")
+	assertReturn(t, inst, "grow", []uint64{0xffffffff}, 4)
+	assertReturn(t, inst, "grow", []uint64{1}, 0)
+	assertReturn(t, inst, "grow", []uint64{1}, 1)
+	assertReturn(t, inst, "size", []uint64{2})
+	assertReturn(t, inst, "load_at_zero", []uint64{2})
+	assertReturn(t, inst, "store_at_page_size", nil)
+	assertReturn(t, inst, "load_at_page_size", []uint64{3})
+	assertReturn(t, inst, "grow", []uint64{0xffffffff}, 1)
+	assertReturn(t, inst, "grow", []uint64{0xffffffff}, 0x10000)
+	assertReturn(t, inst, "grow", []uint64{0xffffffff}, 0xffffffff)
+	assertReturn(t, inst, "size", []uint64{2})
+}
+
+func TestSpec_MemoryFillCopy(t *testing.T) {
+	m := specModule(nil,
+		specFunc{name: "fill", ft: i32i32i32, body: []byte{
+			opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefix, byte(opMemoryFill), 0,
+			opEnd,
+		}},
+		specFunc{name: "copy", ft: i32i32i32, body: []byte{
+			opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefix, byte(opMemoryCopy), 0, 0,
+			opEnd,
+		}},
+		specFunc{name: "load8_u", ft: i32_i32, body: []byte{opLocalGet, 0, opI32Load8U, 0, 0, opEnd}},
+	)
+	m.Data = []Data{{Offset: ConstExpr{Value: 0x100}, Init: []byte{0xaa, 0xbb, 0xcc, 0xdd}}}
+	inst := specInstance(t, m)
+	assertBytes := func(address uint64, expected ...uint64) {
+		for i, b := range expected {
+			assertReturn(t, inst, "load8_u", []uint64{b}, address+uint64(i))
+		}
+	}
+
+	assertReturn(t, inst, "fill", nil, 1, 0xff, 3)
+	assertBytes(0, 0, 0xff, 0xff, 0xff, 0)
+	// Only the low byte of the value is used
+	assertReturn(t, inst, "fill", nil, 0, 0xbbaa, 2)
+	assertBytes(0, 0xaa, 0xaa, 0xff)
+	assertReturn(t, inst, "fill", nil, 0x12, 0x55, 0)
+	assertBytes(0x12, 0)
+	assertReturn(t, inst, "fill", nil, 0x10000, 0, 0)
+	assertTrap(t, inst, "fill", 0x10001, 0, 0)
+	assertTrap(t, inst, "fill", 0xff00, 0x55, 0x101)
+	assertBytes(0xff00, 0)
+	assertTrap(t, inst, "fill", 0xffffffff, 0x55, 2)
+
+	assertReturn(t, inst, "copy", nil, 0x10a, 0x100, 4)
+	assertBytes(0x109, 0, 0xaa, 0xbb, 0xcc, 0xdd, 0)
+	// Overlapping copies forwards and backwards
+	assertReturn(t, inst, "copy", nil, 0x108, 0x10a, 4)
+	assertBytes(0x107, 0, 0xaa, 0xbb, 0xcc, 0xdd, 0xcc, 0xdd, 0)
+	assertReturn(t, inst, "copy", nil, 0x10a, 0x107, 6)
+	assertBytes(0x107, 0, 0xaa, 0xbb, 0, 0xaa, 0xbb, 0xcc, 0xdd, 0xcc, 0)
+	assertReturn(t, inst, "copy", nil, 0x10000, 0, 0)
+	assertReturn(t, inst, "copy", nil, 0, 0x10000, 0)
+	assertTrap(t, inst, "copy", 0x10001, 0, 0)
+	assertTrap(t, inst, "copy", 0, 0x10001, 0)
+	assertTrap(t, inst, "copy", 0xfffe, 0x100, 4)
+	assertBytes(0xfffe, 0, 0)
+	assertTrap(t, inst, "copy", 0x100, 0xfffe, 4)
+	assertTrap(t, inst, "copy", 0xffffffff, 0, 2)
+}
+
+var specNumeric = map[string][]specAssertion{
+	"i32.add": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0xfffffffe, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x7fffffff, 1}, 0x80000000, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0x7fffffff, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x3fffffff, 1}, 0x40000000, returns},
+	},
+	"i32.sub": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0xffffffff}, 0x80000000, returns},
+		{[]uint64{0x80000000, 1}, 0x7fffffff, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x3fffffff, 0xffffffff}, 0x40000000, returns},
+	},
+	"i32.mul": {
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{0x10000000, 4096}, 0, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0x80000000, returns},
+		{[]uint64{0x7fffffff, 0xffffffff}, 0x80000001, returns},
+		{[]uint64{0x1234567, 0x76543210}, 0x358e7470, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+	},
+	"i32.div_s": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{0x80000000, 0xffffffff}, 0, traps},
+		{[]uint64{0x80000000, 2}, 0xc0000000, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{0x80000001, 1000}, 0xffdf3b65, returns},
+		{[]uint64{5, 2}, 2, returns},
+		{[]uint64{0xfffffffb, 2}, 0xfffffffe, returns},
+		{[]uint64{5, 0xfffffffe}, 0xfffffffe, returns},
+		{[]uint64{0xfffffffb, 0xfffffffe}, 2, returns},
+		{[]uint64{7, 3}, 2, returns},
+		{[]uint64{0xfffffff9, 3}, 0xfffffffe, returns},
+		{[]uint64{7, 0xfffffffd}, 0xfffffffe, returns},
+		{[]uint64{0xfffffff9, 0xfffffffd}, 2, returns},
+		{[]uint64{11, 5}, 2, returns},
+		{[]uint64{17, 7}, 2, returns},
+	},
+	"i32.div_u": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0x80000000, 2}, 0x40000000, returns},
+		{[]uint64{0x8ff00ff0, 0x10001}, 36847, returns},
+		{[]uint64{0x80000001, 1000}, 0x20c49b, returns},
+		{[]uint64{5, 2}, 2, returns},
+		{[]uint64{0xfffffffb, 2}, 0x7ffffffd, returns},
+		{[]uint64{5, 0xfffffffe}, 0, returns},
+		{[]uint64{0xfffffffb, 0xfffffffe}, 0, returns},
+		{[]uint64{7, 3}, 2, returns},
+		{[]uint64{11, 5}, 2, returns},
+		{[]uint64{17, 7}, 2, returns},
+	},
+	"i32.rem_s": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{0x7fffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0x80000000, 2}, 0, returns},
+		{[]uint64{0x80000001, 1000}, 0xfffffd79, returns},
+		{[]uint64{5, 2}, 1, returns},
+		{[]uint64{0xfffffffb, 2}, 0xffffffff, returns},
+		{[]uint64{5, 0xfffffffe}, 1, returns},
+		{[]uint64{0xfffffffb, 0xfffffffe}, 0xffffffff, returns},
+		{[]uint64{7, 3}, 1, returns},
+		{[]uint64{0xfffffff9, 3}, 0xffffffff, returns},
+		{[]uint64{7, 0xfffffffd}, 1, returns},
+		{[]uint64{0xfffffff9, 0xfffffffd}, 0xffffffff, returns},
+		{[]uint64{11, 5}, 1, returns},
+		{[]uint64{17, 7}, 3, returns},
+	},
+	"i32.rem_u": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0x80000000, returns},
+		{[]uint64{0x80000000, 2}, 0, returns},
+		{[]uint64{0x8ff00ff0, 0x10001}, 32769, returns},
+		{[]uint64{0x80000001, 1000}, 649, returns},
+		{[]uint64{5, 2}, 1, returns},
+		{[]uint64{0xfffffffb, 2}, 1, returns},
+		{[]uint64{5, 0xfffffffe}, 5, returns},
+		{[]uint64{0xfffffffb, 0xfffffffe}, 0xfffffffb, returns},
+		{[]uint64{7, 3}, 1, returns},
+		{[]uint64{11, 5}, 1, returns},
+		{[]uint64{17, 7}, 3, returns},
+	},
+	"i32.and": {
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0xffffffff}, 0x7fffffff, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xf0f0f0f0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0xffffffff, returns},
+	},
+	"i32.or": {
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0xffffffff, returns},
+		{[]uint64{0x80000000, 0}, 0x80000000, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0xffffffff, returns},
+	},
+	"i32.xor": {
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0xffffffff, returns},
+		{[]uint64{0x80000000, 0}, 0x80000000, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0x7fffffff, returns},
+		{[]uint64{0xffffffff, 0x7fffffff}, 0x80000000, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xf0f0f0f, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+	},
+	"i32.shl": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0x7fffffff, 1}, 0xfffffffe, returns},
+		{[]uint64{0xffffffff, 1}, 0xfffffffe, returns},
+		{[]uint64{0x80000000, 1}, 0, returns},
+		{[]uint64{0x40000000, 1}, 0x80000000, returns},
+		{[]uint64{1, 31}, 0x80000000, returns},
+		{[]uint64{1, 32}, 1, returns},
+		{[]uint64{1, 33}, 2, returns},
+		{[]uint64{1, 0xffffffff}, 0x80000000, returns},
+		{[]uint64{1, 0x7fffffff}, 0x80000000, returns},
+	},
+	"i32.shr_s": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0xffffffff, returns},
+		{[]uint64{0x7fffffff, 1}, 0x3fffffff, returns},
+		{[]uint64{0x80000000, 1}, 0xc0000000, returns},
+		{[]uint64{0x40000000, 1}, 0x20000000, returns},
+		{[]uint64{1, 32}, 1, returns},
+		{[]uint64{1, 33}, 0, returns},
+		{[]uint64{1, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0x7fffffff}, 0, returns},
+		{[]uint64{1, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 31}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 32}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 33}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 0x7fffffff}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0xffffffff, returns},
+	},
+	"i32.shr_u": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0x7fffffff, returns},
+		{[]uint64{0x7fffffff, 1}, 0x3fffffff, returns},
+		{[]uint64{0x80000000, 1}, 0x40000000, returns},
+		{[]uint64{0x40000000, 1}, 0x20000000, returns},
+		{[]uint64{1, 32}, 1, returns},
+		{[]uint64{1, 33}, 0, returns},
+		{[]uint64{1, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0x7fffffff}, 0, returns},
+		{[]uint64{1, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 31}, 1, returns},
+		{[]uint64{0xffffffff, 32}, 0xffffffff, returns},
+		{[]uint64{0xffffffff, 33}, 0x7fffffff, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0xffffffff, returns},
+	},
+	"i32.rotl": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0xffffffff, returns},
+		{[]uint64{1, 32}, 1, returns},
+		{[]uint64{0xabcd9876, 1}, 0x579b30ed, returns},
+		{[]uint64{0xfe00dc00, 4}, 0xe00dc00f, returns},
+		{[]uint64{0xb0c1d2e3, 5}, 0x183a5c76, returns},
+		{[]uint64{32768, 37}, 0x100000, returns},
+		{[]uint64{0xb0c1d2e3, 65285}, 0x183a5c76, returns},
+		{[]uint64{0x769abcdf, 0xffffffed}, 0x579beed3, returns},
+		{[]uint64{0x769abcdf, 0x8000000d}, 0x579beed3, returns},
+		{[]uint64{1, 31}, 0x80000000, returns},
+		{[]uint64{0x80000000, 1}, 1, returns},
+	},
+	"i32.rotr": {
+		{[]uint64{1, 1}, 0x80000000, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0xffffffff, returns},
+		{[]uint64{1, 32}, 1, returns},
+		{[]uint64{0xff00cc00, 1}, 0x7f806600, returns},
+		{[]uint64{0x80000, 4}, 32768, returns},
+		{[]uint64{0xb0c1d2e3, 5}, 0x1d860e97, returns},
+		{[]uint64{32768, 37}, 1024, returns},
+		{[]uint64{0xb0c1d2e3, 65285}, 0x1d860e97, returns},
+		{[]uint64{0x769abcdf, 0xffffffed}, 0xe6fbb4d5, returns},
+		{[]uint64{0x769abcdf, 0x8000000d}, 0xe6fbb4d5, returns},
+		{[]uint64{1, 31}, 2, returns},
+		{[]uint64{0x80000000, 31}, 1, returns},
+	},
+	"i32.clz": {
+		{[]uint64{0xffffffff}, 0, returns},
+		{[]uint64{0}, 32, returns},
+		{[]uint64{32768}, 16, returns},
+		{[]uint64{255}, 24, returns},
+		{[]uint64{0x80000000}, 0, returns},
+		{[]uint64{1}, 31, returns},
+		{[]uint64{2}, 30, returns},
+		{[]uint64{0x7fffffff}, 1, returns},
+	},
+	"i32.ctz": {
+		{[]uint64{0xffffffff}, 0, returns},
+		{[]uint64{0}, 32, returns},
+		{[]uint64{32768}, 15, returns},
+		{[]uint64{0x10000}, 16, returns},
+		{[]uint64{0x80000000}, 31, returns},
+		{[]uint64{0x7fffffff}, 0, returns},
+	},
+	"i32.popcnt": {
+		{[]uint64{0xffffffff}, 32, returns},
+		{[]uint64{0}, 0, returns},
+		{[]uint64{32768}, 1, returns},
+		{[]uint64{0x80008000}, 2, returns},
+		{[]uint64{0x7fffffff}, 31, returns},
+		{[]uint64{0xaaaaaaaa}, 16, returns},
+		{[]uint64{0x55555555}, 16, returns},
+		{[]uint64{0xdeadbeef}, 24, returns},
+	},
+	"i32.extend8_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{127}, 127, returns},
+		{[]uint64{128}, 0xffffff80, returns},
+		{[]uint64{255}, 0xffffffff, returns},
+		{[]uint64{0x1234500}, 0, returns},
+		{[]uint64{0xfedcba80}, 0xffffff80, returns},
+		{[]uint64{0xffffffff}, 0xffffffff, returns},
+	},
+	"i32.extend16_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{32767}, 32767, returns},
+		{[]uint64{32768}, 0xffff8000, returns},
+		{[]uint64{65535}, 0xffffffff, returns},
+		{[]uint64{0x1230000}, 0, returns},
+		{[]uint64{0xfedc8000}, 0xffff8000, returns},
+		{[]uint64{0xffffffff}, 0xffffffff, returns},
+	},
+	"i32.eqz": {
+		{[]uint64{0}, 1, returns},
+		{[]uint64{1}, 0, returns},
+		{[]uint64{0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff}, 0, returns},
+	},
+	"i32.eq": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x80000000, 0x80000000}, 1, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+	},
+	"i32.ne": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffff, 1}, 1, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x80000000, 0}, 1, returns},
+		{[]uint64{0, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 1, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 1, returns},
+	},
+	"i32.lt_s": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffff, 1}, 1, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x80000000, 0}, 1, returns},
+		{[]uint64{0, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 1, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+	},
+	"i32.lt_u": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 1, returns},
+	},
+	"i32.le_s": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 1, returns},
+		{[]uint64{0x80000000, 0x80000000}, 1, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x80000000, 0}, 1, returns},
+		{[]uint64{0, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 1, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+	},
+	"i32.le_u": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x80000000, 0x80000000}, 1, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 1, returns},
+	},
+	"i32.gt_s": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 1, returns},
+	},
+	"i32.gt_u": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffff, 1}, 1, returns},
+		{[]uint64{0x80000000, 0x80000000}, 0, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x80000000, 0}, 1, returns},
+		{[]uint64{0, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 1, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+	},
+	"i32.ge_s": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 0, returns},
+		{[]uint64{0x80000000, 0x80000000}, 1, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x80000000, 0}, 0, returns},
+		{[]uint64{0, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 0, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 1, returns},
+	},
+	"i32.ge_u": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffff, 1}, 1, returns},
+		{[]uint64{0x80000000, 0x80000000}, 1, returns},
+		{[]uint64{0x7fffffff, 0x7fffffff}, 1, returns},
+		{[]uint64{0xffffffff, 0xffffffff}, 1, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x80000000, 0}, 1, returns},
+		{[]uint64{0, 0x80000000}, 0, returns},
+		{[]uint64{0x80000000, 0xffffffff}, 0, returns},
+		{[]uint64{0xffffffff, 0x80000000}, 1, returns},
+		{[]uint64{0x80000000, 0x7fffffff}, 1, returns},
+		{[]uint64{0x7fffffff, 0x80000000}, 0, returns},
+	},
+	"i64.add": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0xfffffffffffffffe, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 1}, 0x8000000000000000, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0x7fffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x3fffffff, 1}, 0x40000000, returns},
+	},
+	"i64.sub": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0xffffffffffffffff}, 0x8000000000000000, returns},
+		{[]uint64{0x8000000000000000, 1}, 0x7fffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x3fffffff, 0xffffffffffffffff}, 0x40000000, returns},
+	},
+	"i64.mul": {
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0x1000000000000000, 4096}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0x8000000000000000, returns},
+		{[]uint64{0x7fffffffffffffff, 0xffffffffffffffff}, 0x8000000000000001, returns},
+		{[]uint64{0x123456789abcdef, 0xfedcba9876543210}, 0x2236d88fe5618cf0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+	},
+	"i64.div_s": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, traps},
+		{[]uint64{0x8000000000000000, 2}, 0xc000000000000000, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0x8000000000000001, 1000}, 0xffdf3b645a1cac09, returns},
+		{[]uint64{5, 2}, 2, returns},
+		{[]uint64{0xfffffffffffffffb, 2}, 0xfffffffffffffffe, returns},
+		{[]uint64{5, 0xfffffffffffffffe}, 0xfffffffffffffffe, returns},
+		{[]uint64{0xfffffffffffffffb, 0xfffffffffffffffe}, 2, returns},
+		{[]uint64{7, 3}, 2, returns},
+		{[]uint64{0xfffffffffffffff9, 3}, 0xfffffffffffffffe, returns},
+		{[]uint64{7, 0xfffffffffffffffd}, 0xfffffffffffffffe, returns},
+		{[]uint64{0xfffffffffffffff9, 0xfffffffffffffffd}, 2, returns},
+		{[]uint64{11, 5}, 2, returns},
+		{[]uint64{17, 7}, 2, returns},
+	},
+	"i64.div_u": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0x8000000000000000, 2}, 0x4000000000000000, returns},
+		{[]uint64{0x8ff00ff00ff00ff0, 0x100000001}, 0x8ff00fef, returns},
+		{[]uint64{0x8000000000000001, 1000}, 0x20c49ba5e353f7, returns},
+		{[]uint64{5, 2}, 2, returns},
+		{[]uint64{0xfffffffffffffffb, 2}, 0x7ffffffffffffffd, returns},
+		{[]uint64{5, 0xfffffffffffffffe}, 0, returns},
+		{[]uint64{0xfffffffffffffffb, 0xfffffffffffffffe}, 0, returns},
+		{[]uint64{7, 3}, 2, returns},
+		{[]uint64{11, 5}, 2, returns},
+		{[]uint64{17, 7}, 2, returns},
+	},
+	"i64.rem_s": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{0x7fffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0x8000000000000000, 2}, 0, returns},
+		{[]uint64{0x8000000000000001, 1000}, 0xfffffffffffffcd9, returns},
+		{[]uint64{5, 2}, 1, returns},
+		{[]uint64{0xfffffffffffffffb, 2}, 0xffffffffffffffff, returns},
+		{[]uint64{5, 0xfffffffffffffffe}, 1, returns},
+		{[]uint64{0xfffffffffffffffb, 0xfffffffffffffffe}, 0xffffffffffffffff, returns},
+		{[]uint64{7, 3}, 1, returns},
+		{[]uint64{0xfffffffffffffff9, 3}, 0xffffffffffffffff, returns},
+		{[]uint64{7, 0xfffffffffffffffd}, 1, returns},
+		{[]uint64{0xfffffffffffffff9, 0xfffffffffffffffd}, 0xffffffffffffffff, returns},
+		{[]uint64{11, 5}, 1, returns},
+		{[]uint64{17, 7}, 3, returns},
+	},
+	"i64.rem_u": {
+		{[]uint64{1, 0}, 0, traps},
+		{[]uint64{0, 0}, 0, traps},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0x8000000000000000, returns},
+		{[]uint64{0x8000000000000000, 2}, 0, returns},
+		{[]uint64{0x8ff00ff00ff00ff0, 0x100000001}, 0x80000001, returns},
+		{[]uint64{0x8000000000000001, 1000}, 809, returns},
+		{[]uint64{5, 2}, 1, returns},
+		{[]uint64{0xfffffffffffffffb, 2}, 1, returns},
+		{[]uint64{5, 0xfffffffffffffffe}, 5, returns},
+		{[]uint64{0xfffffffffffffffb, 0xfffffffffffffffe}, 0xfffffffffffffffb, returns},
+		{[]uint64{7, 3}, 1, returns},
+		{[]uint64{11, 5}, 1, returns},
+		{[]uint64{17, 7}, 3, returns},
+	},
+	"i64.and": {
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0xffffffffffffffff}, 0x7fffffffffffffff, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xf0f0f0f0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0xffffffffffffffff, returns},
+	},
+	"i64.or": {
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0xffffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 0}, 0x8000000000000000, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0xffffffffffffffff, returns},
+	},
+	"i64.xor": {
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0xffffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 0}, 0x8000000000000000, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0x7fffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0x7fffffffffffffff}, 0x8000000000000000, returns},
+		{[]uint64{0xf0f0ffff, 0xfffff0f0}, 0xf0f0f0f, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+	},
+	"i64.shl": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 1}, 0xfffffffffffffffe, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0xfffffffffffffffe, returns},
+		{[]uint64{0x8000000000000000, 1}, 0, returns},
+		{[]uint64{0x4000000000000000, 1}, 0x8000000000000000, returns},
+		{[]uint64{1, 63}, 0x8000000000000000, returns},
+		{[]uint64{1, 64}, 1, returns},
+		{[]uint64{1, 65}, 2, returns},
+		{[]uint64{1, 0xffffffffffffffff}, 0x8000000000000000, returns},
+		{[]uint64{1, 0x7fffffffffffffff}, 0x8000000000000000, returns},
+	},
+	"i64.shr_s": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0xffffffffffffffff, returns},
+		{[]uint64{0x7fffffffffffffff, 1}, 0x3fffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 1}, 0xc000000000000000, returns},
+		{[]uint64{0x4000000000000000, 1}, 0x2000000000000000, returns},
+		{[]uint64{1, 64}, 1, returns},
+		{[]uint64{1, 65}, 0, returns},
+		{[]uint64{1, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{1, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 63}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 64}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 65}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0x7fffffffffffffff}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0xffffffffffffffff, returns},
+	},
+	"i64.shr_u": {
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0x7fffffffffffffff, returns},
+		{[]uint64{0x7fffffffffffffff, 1}, 0x3fffffffffffffff, returns},
+		{[]uint64{0x8000000000000000, 1}, 0x4000000000000000, returns},
+		{[]uint64{0x4000000000000000, 1}, 0x2000000000000000, returns},
+		{[]uint64{1, 64}, 1, returns},
+		{[]uint64{1, 65}, 0, returns},
+		{[]uint64{1, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{1, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 63}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 64}, 0xffffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 65}, 0x7fffffffffffffff, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0xffffffffffffffff, returns},
+	},
+	"i64.rotl": {
+		{[]uint64{1, 1}, 2, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0xffffffffffffffff, returns},
+		{[]uint64{1, 64}, 1, returns},
+		{[]uint64{0xabcd987602468ace, 1}, 0x579b30ec048d159d, returns},
+		{[]uint64{0xfe000000dc000000, 4}, 0xe000000dc000000f, returns},
+		{[]uint64{0xabcd1234ef567809, 53}, 0x13579a2469deacf, returns},
+		{[]uint64{0xabd1234ef567809c, 63}, 0x55e891a77ab3c04e, returns},
+		{[]uint64{0xabcd1234ef567809, 245}, 0x13579a2469deacf, returns},
+		{[]uint64{0xabcd7294ef567809, 0xffffffffffffffed}, 0xcf013579ae529dea, returns},
+		{[]uint64{0xabd1234ef567809c, 0x800000000000003f}, 0x55e891a77ab3c04e, returns},
+		{[]uint64{1, 63}, 0x8000000000000000, returns},
+		{[]uint64{0x8000000000000000, 1}, 1, returns},
+	},
+	"i64.rotr": {
+		{[]uint64{1, 1}, 0x8000000000000000, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0xffffffffffffffff, returns},
+		{[]uint64{1, 64}, 1, returns},
+		{[]uint64{0xabcd987602468ace, 1}, 0x55e6cc3b01234567, returns},
+		{[]uint64{0xfe000000dc000000, 4}, 0xfe000000dc00000, returns},
+		{[]uint64{0xabcd1234ef567809, 53}, 0x6891a77ab3c04d5e, returns},
+		{[]uint64{0xabd1234ef567809c, 63}, 0x57a2469deacf0139, returns},
+		{[]uint64{0xabcd1234ef567809, 245}, 0x6891a77ab3c04d5e, returns},
+		{[]uint64{0xabcd7294ef567809, 0xffffffffffffffed}, 0x94a77ab3c04d5e6b, returns},
+		{[]uint64{0xabd1234ef567809c, 0x800000000000003f}, 0x57a2469deacf0139, returns},
+		{[]uint64{1, 63}, 2, returns},
+		{[]uint64{0x8000000000000000, 63}, 1, returns},
+	},
+	"i64.clz": {
+		{[]uint64{0xffffffffffffffff}, 0, returns},
+		{[]uint64{0}, 64, returns},
+		{[]uint64{32768}, 48, returns},
+		{[]uint64{255}, 56, returns},
+		{[]uint64{0x8000000000000000}, 0, returns},
+		{[]uint64{1}, 63, returns},
+		{[]uint64{2}, 62, returns},
+		{[]uint64{0x7fffffffffffffff}, 1, returns},
+	},
+	"i64.ctz": {
+		{[]uint64{0xffffffffffffffff}, 0, returns},
+		{[]uint64{0}, 64, returns},
+		{[]uint64{32768}, 15, returns},
+		{[]uint64{0x10000}, 16, returns},
+		{[]uint64{0x8000000000000000}, 63, returns},
+		{[]uint64{0x7fffffffffffffff}, 0, returns},
+	},
+	"i64.popcnt": {
+		{[]uint64{0xffffffffffffffff}, 64, returns},
+		{[]uint64{0}, 0, returns},
+		{[]uint64{32768}, 1, returns},
+		{[]uint64{0x8000800080008000}, 4, returns},
+		{[]uint64{0x7fffffffffffffff}, 63, returns},
+		{[]uint64{0xaaaaaaaa55555555}, 32, returns},
+		{[]uint64{0x99999999aaaaaaaa}, 32, returns},
+		{[]uint64{0xdeadbeefdeadbeef}, 48, returns},
+	},
+	"i64.extend8_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{127}, 127, returns},
+		{[]uint64{128}, 0xffffffffffffff80, returns},
+		{[]uint64{255}, 0xffffffffffffffff, returns},
+		{[]uint64{0x123456789abcd00}, 0, returns},
+		{[]uint64{0xfedcba9876543280}, 0xffffffffffffff80, returns},
+		{[]uint64{0xffffffffffffffff}, 0xffffffffffffffff, returns},
+	},
+	"i64.extend16_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{32767}, 32767, returns},
+		{[]uint64{32768}, 0xffffffffffff8000, returns},
+		{[]uint64{65535}, 0xffffffffffffffff, returns},
+		{[]uint64{0x123456789abc0000}, 0, returns},
+		{[]uint64{0xfedcba9876548000}, 0xffffffffffff8000, returns},
+		{[]uint64{0xffffffffffffffff}, 0xffffffffffffffff, returns},
+	},
+	"i64.extend32_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{32767}, 32767, returns},
+		{[]uint64{32768}, 32768, returns},
+		{[]uint64{65535}, 65535, returns},
+		{[]uint64{0x7fffffff}, 0x7fffffff, returns},
+		{[]uint64{0x80000000}, 0xffffffff80000000, returns},
+		{[]uint64{0xffffffff}, 0xffffffffffffffff, returns},
+		{[]uint64{0x123456700000000}, 0, returns},
+		{[]uint64{0xfedcba9880000000}, 0xffffffff80000000, returns},
+		{[]uint64{0xffffffffffffffff}, 0xffffffffffffffff, returns},
+	},
+	"i64.eqz": {
+		{[]uint64{0}, 1, returns},
+		{[]uint64{1}, 0, returns},
+		{[]uint64{0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff}, 0, returns},
+	},
+	"i64.eq": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+	},
+	"i64.ne": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0}, 1, returns},
+		{[]uint64{0, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 1, returns},
+	},
+	"i64.lt_s": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0}, 1, returns},
+		{[]uint64{0, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+	},
+	"i64.lt_u": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 1, returns},
+	},
+	"i64.le_s": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0}, 1, returns},
+		{[]uint64{0, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+	},
+	"i64.le_u": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{1, 0}, 0, returns},
+		{[]uint64{0, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 1, returns},
+	},
+	"i64.gt_s": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 1, returns},
+	},
+	"i64.gt_u": {
+		{[]uint64{0, 0}, 0, returns},
+		{[]uint64{1, 1}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 1, returns},
+		{[]uint64{0, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+	},
+	"i64.ge_s": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 0, returns},
+		{[]uint64{0, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 0, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 1, returns},
+	},
+	"i64.ge_u": {
+		{[]uint64{0, 0}, 1, returns},
+		{[]uint64{1, 1}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 1}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0xffffffffffffffff, 0xffffffffffffffff}, 1, returns},
+		{[]uint64{1, 0}, 1, returns},
+		{[]uint64{0, 1}, 0, returns},
+		{[]uint64{0x8000000000000000, 0}, 1, returns},
+		{[]uint64{0, 0x8000000000000000}, 0, returns},
+		{[]uint64{0x8000000000000000, 0xffffffffffffffff}, 0, returns},
+		{[]uint64{0xffffffffffffffff, 0x8000000000000000}, 1, returns},
+		{[]uint64{0x8000000000000000, 0x7fffffffffffffff}, 1, returns},
+		{[]uint64{0x7fffffffffffffff, 0x8000000000000000}, 0, returns},
+	},
+	"i64.extend_i32_s": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{10000}, 10000, returns},
+		{[]uint64{0xffffd8f0}, 0xffffffffffffd8f0, returns},
+		{[]uint64{0xffffffff}, 0xffffffffffffffff, returns},
+		{[]uint64{0x7fffffff}, 0x7fffffff, returns},
+		{[]uint64{0x80000000}, 0xffffffff80000000, returns},
+	},
+	"i64.extend_i32_u": {
+		{[]uint64{0}, 0, returns},
+		{[]uint64{10000}, 10000, returns},
+		{[]uint64{0xffffd8f0}, 0xffffd8f0, returns},
+		{[]uint64{0xffffffff}, 0xffffffff, returns},
+		{[]uint64{0x7fffffff}, 0x7fffffff, returns},
+		{[]uint64{0x80000000}, 0x80000000, returns},
+	},
+	"i32.wrap_i64": {
+		{[]uint64{0xffffffffffffffff}, 0xffffffff, returns},
+		{[]uint64{0xfffffffffffe7960}, 0xfffe7960, returns},
+		{[]uint64{0x80000000}, 0x80000000, returns},
+		{[]uint64{0xffffffff7fffffff}, 0x7fffffff, returns},
+		{[]uint64{0xffffffff00000000}, 0, returns},
+		{[]uint64{0xfffffffeffffffff}, 0xffffffff, returns},
+		{[]uint64{0xffffffff00000001}, 1, returns},
+		{[]uint64{0}, 0, returns},
+		{[]uint64{0x123456789abcdef0}, 0x9abcdef0, returns},
+		{[]uint64{0xffffffff}, 0xffffffff, returns},
+		{[]uint64{0x100000000}, 0, returns},
+		{[]uint64{0x100000001}, 1, returns},
+	},
+}
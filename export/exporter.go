@@ -0,0 +1,304 @@
+// Export chain data from the databases of a stopped node into tables of flat files suitable for analytics
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/permission"
+)
+
+const DefaultPartitionSize = 10000
+
+type BlockSource interface {
+	Block(height int64) (*bcm.Block, error)
+}
+
+type Exporter struct {
+	blocks        BlockSource
+	state         *state.State
+	format        Format
+	dir           string
+	partitionSize uint64
+	// Used to decode log events when non-nil
+	abiSpec *abi.AbiSpec
+	logger  *logging.Logger
+}
+
+// Create an Exporter writing tables beneath dir in format, each partition covering at most partitionSize heights.
+// Blocks are read from blocks and transactions, events, and accounts from st, which must hold the state of every height
+// to be exported
+func NewExporter(blocks BlockSource, st *state.State, format Format, dir string, partitionSize uint64,
+	abiSpec *abi.AbiSpec, logger *logging.Logger) *Exporter {
+	if partitionSize == 0 {
+		partitionSize = DefaultPartitionSize
+	}
+	return &Exporter{
+		blocks:        blocks,
+		state:         st,
+		format:        format,
+		dir:           dir,
+		partitionSize: partitionSize,
+		abiSpec:       abiSpec,
+		logger:        logger,
+	}
+}
+
+// Export heights [start, end]. Partitions are aligned to multiples of the partition size so that exports of different
+// ranges of the same chain write the same partitions, the first and last of which are truncated to the range.
+// Each partition of the accounts table is a snapshot of all accounts at the last height of the partition.
+func (ex *Exporter) Export(start, end uint64) error {
+	if end < start {
+		return fmt.Errorf("end height %d is before start height %d", end, start)
+	}
+	for partitionStart := start; partitionStart <= end; {
+		partitionEnd := (partitionStart/ex.partitionSize+1)*ex.partitionSize - 1
+		if partitionEnd > end {
+			partitionEnd = end
+		}
+		ex.logger.InfoMsg("Exporting partition", "start_height", partitionStart, "end_height", partitionEnd)
+		err := ex.exportPartition(partitionStart, partitionEnd)
+		if err != nil {
+			return fmt.Errorf("could not export partition %d-%d: %v", partitionStart, partitionEnd, err)
+		}
+		partitionStart = partitionEnd + 1
+	}
+	return nil
+}
+
+type partition map[string]TableWriter
+
+func (p partition) write(table Table, row ...string) error {
+	return p[table.Name].WriteRow(row)
+}
+
+func (p partition) close() error {
+	var firstErr error
+	for _, table := range Tables {
+		if w, ok := p[table.Name]; ok {
+			err := w.Close()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (ex *Exporter) exportPartition(start, end uint64) (err error) {
+	p := make(partition, len(Tables))
+	defer func() {
+		closeErr := p.close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	for _, table := range Tables {
+		// Only hold writers that were opened so that close does not call Close on a nil TableWriter
+		w, err := ex.format(ex.dir, table, start, end)
+		if err != nil {
+			return err
+		}
+		p[table.Name] = w
+	}
+	for height := start; height <= end; height++ {
+		err = ex.exportHeight(p, height)
+		if err != nil {
+			return err
+		}
+	}
+	return ex.exportAccounts(p, end)
+}
+
+func (ex *Exporter) exportHeight(p partition, height uint64) error {
+	block, err := ex.blocks.Block(int64(height))
+	if err != nil {
+		return err
+	}
+	err = p.write(BlocksTable,
+		formatUint(height),
+		block.Time.UTC().Format(time.RFC3339Nano),
+		binary.HexBytes(block.Hash()).String(),
+		binary.HexBytes(block.AppHash).String(),
+		strconv.FormatInt(block.NumTxs, 10),
+		binary.HexBytes(block.ProposerAddress).String())
+	if err != nil {
+		return err
+	}
+	txes, err := ex.state.TxsAtHeight(height)
+	if err != nil {
+		return err
+	}
+	for _, txe := range txes {
+		err = ex.exportTx(p, txe)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ex *Exporter) exportTx(p partition, txe *exec.TxExecution) error {
+	var gasUsed uint64
+	if txe.Result != nil {
+		gasUsed = txe.Result.GasUsed
+	}
+	var contractAddress string
+	var created bool
+	if txe.Receipt != nil && txe.Receipt.ContractAddress != crypto.ZeroAddress {
+		contractAddress = txe.Receipt.ContractAddress.String()
+		created = txe.Receipt.CreatesContract
+	}
+	err := p.write(TxsTable,
+		formatUint(txe.Height),
+		formatUint(txe.Index),
+		txe.TxHash.String(),
+		txe.TxType.String(),
+		formatUint(gasUsed),
+		contractAddress,
+		strconv.FormatBool(created),
+		txe.Exception.String())
+	if err != nil {
+		return err
+	}
+	for _, ev := range txe.Events {
+		switch {
+		case ev.Call != nil:
+			err = ex.exportCall(p, ev.Header, ev.Call)
+		case ev.Log != nil:
+			err = ex.exportLog(p, ev.Header, ev.Log)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// Batched transactions
+	for _, child := range txe.TxExecutions {
+		err = ex.exportTx(p, child)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ex *Exporter) exportCall(p partition, header *exec.Header, call *exec.CallEvent) error {
+	var caller, callee, data string
+	var value, gas uint64
+	if call.CallData != nil {
+		caller = call.CallData.Caller.String()
+		callee = call.CallData.Callee.String()
+		data = call.CallData.Data.String()
+		value = call.CallData.Value
+		gas = call.CallData.Gas
+	}
+	return p.write(CallsTable,
+		formatUint(header.Height),
+		header.TxHash.String(),
+		formatUint(header.Index),
+		call.CallType.String(),
+		formatUint(call.StackDepth),
+		call.Origin.String(),
+		caller,
+		callee,
+		formatUint(value),
+		formatUint(gas),
+		data,
+		call.Return.String(),
+		header.Exception.String())
+}
+
+func (ex *Exporter) exportLog(p partition, header *exec.Header, log *exec.LogEvent) error {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = binary.HexBytes(topic.Bytes()).String()
+	}
+	eventName, args := ex.decodeLog(log)
+	return p.write(LogsTable,
+		formatUint(header.Height),
+		header.TxHash.String(),
+		formatUint(header.Index),
+		log.Address.String(),
+		strings.Join(topics, ";"),
+		log.Data.String(),
+		eventName,
+		args)
+}
+
+// Decode a log event against our ABI returning the event name and its arguments as a JSON object, or empty strings if
+// we have no matching event
+func (ex *Exporter) decodeLog(log *exec.LogEvent) (eventName, args string) {
+	if ex.abiSpec == nil || len(log.Topics) == 0 {
+		return "", ""
+	}
+	var eventID abi.EventID
+	copy(eventID[:], log.Topics[0].Bytes())
+	evAbi, ok := ex.abiSpec.EventsById[eventID]
+	if !ok {
+		return "", ""
+	}
+	values := abi.GetPackingTypes(evAbi.Inputs)
+	err := abi.UnpackEvent(&evAbi, log.Topics, log.Data, values...)
+	if err != nil {
+		ex.logger.InfoMsg("Could not decode log event against ABI", "event_name", evAbi.Name,
+			"address", log.Address, structure.ErrorKey, err)
+		return "", ""
+	}
+	decoded := make(map[string]interface{}, len(values))
+	for i, input := range evAbi.Inputs {
+		name := input.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		switch v := values[i].(type) {
+		case *crypto.Address:
+			decoded[name] = v.String()
+		case *big.Int:
+			decoded[name] = v.String()
+		case *string:
+			decoded[name] = *v
+		default:
+			decoded[name] = v
+		}
+	}
+	bs, err := json.Marshal(decoded)
+	if err != nil {
+		ex.logger.InfoMsg("Could not encode decoded log event", "event_name", evAbi.Name, structure.ErrorKey, err)
+		return evAbi.Name, ""
+	}
+	return evAbi.Name, string(bs)
+}
+
+func (ex *Exporter) exportAccounts(p partition, height uint64) error {
+	st, err := ex.state.LoadHeight(height)
+	if err != nil {
+		return err
+	}
+	return st.IterateAccounts(func(acc *acm.Account) error {
+		return p.write(AccountsTable,
+			formatUint(height),
+			acc.Address.String(),
+			formatUint(acc.Balance),
+			formatUint(acc.Sequence),
+			acc.Code.String(),
+			strings.Join(permission.BasePermissionsToStringList(acc.Permissions.Base), ";"),
+			strings.Join(acc.Permissions.Roles, ";"))
+	})
+}
+
+func formatUint(u uint64) string {
+	return strconv.FormatUint(u, 10)
+}
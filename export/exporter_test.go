@@ -0,0 +1,137 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+const fooABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"who","type":"address"}],"name":"Foo","type":"event"}]`
+
+type blockSource struct{}
+
+func (blockSource) Block(height int64) (*bcm.Block, error) {
+	return bcm.NewBlock(txs.NewAminoCodec(), types.MakeBlock(height, nil, nil, nil)), nil
+}
+
+func TestExporter_Export(t *testing.T) {
+	abiSpec, err := abi.ReadAbiSpec([]byte(fooABI))
+	require.NoError(t, err)
+	eventSpec := abiSpec.Events["Foo"]
+
+	account := acm.NewAccountFromSecret("Foo")
+	st := state.NewState(dbm.NewMemDB())
+	_, _, err = st.Update(func(ws state.Updatable) error {
+		return ws.UpdateAccount(account)
+	})
+	require.NoError(t, err)
+	for height := uint64(1); height <= 3; height++ {
+		txHash := binary.LeftPadWord256([]byte{byte(height)}).Bytes()
+		txe := &exec.TxExecution{
+			TxHeader: &exec.TxHeader{
+				TxType: payload.TypeCall,
+				TxHash: txHash,
+				Height: height,
+			},
+			Events: []*exec.Event{
+				{
+					Header: &exec.Header{EventType: exec.TypeCall, TxHash: txHash, Height: height},
+					Call: &exec.CallEvent{
+						CallData: &exec.CallData{Caller: account.Address, Value: height},
+					},
+				},
+				{
+					Header: &exec.Header{EventType: exec.TypeLog, TxHash: txHash, Height: height, Index: 1},
+					Log: &exec.LogEvent{
+						Address: account.Address,
+						Topics: []binary.Word256{
+							binary.RightPadWord256(eventSpec.EventID[:]),
+							binary.LeftPadWord256(account.Address.Bytes()),
+						},
+					},
+				},
+			},
+		}
+		_, _, err = st.Update(func(ws state.Updatable) error {
+			return ws.AddBlock(&exec.BlockExecution{
+				Height:       height,
+				TxExecutions: []*exec.TxExecution{txe},
+			})
+		})
+		require.NoError(t, err)
+	}
+
+	dir, err := ioutil.TempDir("", "burrow-export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ex := NewExporter(blockSource{}, st, CSV, dir, 2, abiSpec, logging.NewNoopLogger())
+	err = ex.Export(1, 3)
+	require.NoError(t, err)
+
+	// Partitions are aligned to multiples of the partition size
+	blocks := readCSV(t, PartitionPath(dir, BlocksTable, 1, 1, "csv"))
+	require.Len(t, blocks, 2)
+	assert.Equal(t, BlocksTable.Columns, blocks[0])
+	assert.Equal(t, "1", blocks[1][0])
+
+	calls := readCSV(t, PartitionPath(dir, CallsTable, 2, 3, "csv"))
+	require.Len(t, calls, 3)
+	assert.Equal(t, []string{"2", "3"}, []string{calls[1][0], calls[2][0]})
+	assert.Equal(t, account.Address.String(), calls[1][6])
+	assert.Equal(t, "2", calls[1][8])
+
+	logs := readCSV(t, PartitionPath(dir, LogsTable, 2, 3, "csv"))
+	require.Len(t, logs, 3)
+	assert.Equal(t, "Foo", logs[1][6])
+	assert.Equal(t, `{"who":"`+account.Address.String()+`"}`, logs[1][7])
+
+	accounts := readCSV(t, PartitionPath(dir, AccountsTable, 2, 3, "csv"))
+	require.Len(t, accounts, 2)
+	assert.Equal(t, []string{"3", account.Address.String()}, accounts[1][:2])
+}
+
+func TestExporter_ExportFormatError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Fails to open the second table after opening the first
+	opened := 0
+	format := func(dir string, table Table, start, end uint64) (TableWriter, error) {
+		if opened > 0 {
+			return nil, fmt.Errorf("could not open %s", table.Name)
+		}
+		opened++
+		return CSV(dir, table, start, end)
+	}
+	ex := NewExporter(blockSource{}, state.NewState(dbm.NewMemDB()), format, dir, 2, nil, logging.NewNoopLogger())
+	err = ex.Export(1, 1)
+	assert.Error(t, err)
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	file, err := os.Open(filepath.Clean(path))
+	require.NoError(t, err)
+	defer file.Close()
+	rows, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	return rows
+}
@@ -0,0 +1,328 @@
+package export
+
+import (
+	"bufio"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+)
+
+// We write the subset of Parquet (https://github.com/apache/parquet-format) needed for our tables: every column is a
+// required UTF8 string stored as a PLAIN encoded BYTE_ARRAY in a single uncompressed data page per row group. Required
+// columns have no repetition or definition levels so a page holds only its values. The page headers and footer are
+// Thrift structs in the compact protocol, which we encode by hand with the field IDs from parquet.thrift.
+const (
+	parquetMagic = "PAR1"
+	// Rows (or bytes of values) buffered before they are written as a row group, which also keeps each page well
+	// within the int32 sizes of its header
+	parquetRowGroupRows  = 1 << 14
+	parquetRowGroupBytes = 64 << 20
+	parquetCreatedBy     = "burrow"
+)
+
+// Values of the Parquet enums we use
+const (
+	parquetTypeByteArray           = 6
+	parquetConvertedTypeUTF8       = 0
+	parquetRepetitionRequired      = 0
+	parquetEncodingPlain           = 0
+	parquetEncodingRLE             = 3
+	parquetCompressionUncompressed = 0
+	parquetPageTypeDataPage        = 0
+)
+
+type parquetWriter struct {
+	file    *os.File
+	writer  *bufio.Writer
+	columns []string
+	// Offset in the file of the next byte we write
+	offset int64
+	// Rows buffered by column
+	values        [][]string
+	bufferedBytes int
+	rowGroups     []parquetRowGroup
+	numRows       int64
+	maxRows       int
+}
+
+type parquetRowGroup struct {
+	numRows int64
+	chunks  []parquetColumnChunk
+}
+
+type parquetColumnChunk struct {
+	offset int64
+	// Including the page header
+	size      int64
+	numValues int64
+}
+
+// Parquet writes each partition as a Parquet file with a string column per column of the table
+func Parquet(dir string, table Table, start, end uint64) (TableWriter, error) {
+	path := PartitionPath(dir, table, start, end, "parquet")
+	err := os.MkdirAll(filepath.Dir(path), 0777)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	pw := &parquetWriter{
+		file:    file,
+		writer:  bufio.NewWriter(file),
+		columns: table.Columns,
+		values:  make([][]string, len(table.Columns)),
+		maxRows: parquetRowGroupRows,
+	}
+	err = pw.write([]byte(parquetMagic))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return pw, nil
+}
+
+func (pw *parquetWriter) WriteRow(row []string) error {
+	for i := range pw.values {
+		var value string
+		if i < len(row) {
+			value = row[i]
+		}
+		pw.values[i] = append(pw.values[i], value)
+		pw.bufferedBytes += len(value)
+	}
+	if len(pw.values[0]) >= pw.maxRows || pw.bufferedBytes >= parquetRowGroupBytes {
+		return pw.writeRowGroup()
+	}
+	return nil
+}
+
+func (pw *parquetWriter) Close() error {
+	err := pw.writeRowGroup()
+	if err == nil {
+		err = pw.writeFooter()
+	}
+	if err == nil {
+		err = pw.writer.Flush()
+	}
+	if err != nil {
+		pw.file.Close()
+		return err
+	}
+	return pw.file.Close()
+}
+
+func (pw *parquetWriter) writeRowGroup() error {
+	numRows := len(pw.values[0])
+	if numRows == 0 {
+		return nil
+	}
+	rowGroup := parquetRowGroup{numRows: int64(numRows)}
+	for i, values := range pw.values {
+		var data []byte
+		for _, value := range values {
+			data = appendUint32(data, uint32(len(value)))
+			data = append(data, value...)
+		}
+		header := new(thriftWriter)
+		header.beginStruct()
+		header.i32Field(1, parquetPageTypeDataPage)
+		header.i32Field(2, int32(len(data)))
+		header.i32Field(3, int32(len(data)))
+		header.structField(5)
+		header.i32Field(1, int32(numRows))
+		header.i32Field(2, parquetEncodingPlain)
+		header.i32Field(3, parquetEncodingRLE)
+		header.i32Field(4, parquetEncodingRLE)
+		header.endStruct()
+		header.endStruct()
+		chunk := parquetColumnChunk{
+			offset:    pw.offset,
+			size:      int64(len(header.bytes) + len(data)),
+			numValues: int64(numRows),
+		}
+		err := pw.write(header.bytes)
+		if err != nil {
+			return err
+		}
+		err = pw.write(data)
+		if err != nil {
+			return err
+		}
+		rowGroup.chunks = append(rowGroup.chunks, chunk)
+		pw.values[i] = values[:0]
+	}
+	pw.rowGroups = append(pw.rowGroups, rowGroup)
+	pw.numRows += rowGroup.numRows
+	pw.bufferedBytes = 0
+	return nil
+}
+
+// Writes the FileMetaData followed by its length and the closing magic
+func (pw *parquetWriter) writeFooter() error {
+	footer := new(thriftWriter)
+	footer.beginStruct()
+	footer.i32Field(1, 1)
+	footer.listField(2, thriftStruct, len(pw.columns)+1)
+	// The root of the schema is a group with the columns as its children
+	footer.beginStruct()
+	footer.stringField(4, "schema")
+	footer.i32Field(5, int32(len(pw.columns)))
+	footer.endStruct()
+	for _, column := range pw.columns {
+		footer.beginStruct()
+		footer.i32Field(1, parquetTypeByteArray)
+		footer.i32Field(3, parquetRepetitionRequired)
+		footer.stringField(4, column)
+		footer.i32Field(6, parquetConvertedTypeUTF8)
+		footer.endStruct()
+	}
+	footer.i64Field(3, pw.numRows)
+	footer.listField(4, thriftStruct, len(pw.rowGroups))
+	for _, rowGroup := range pw.rowGroups {
+		var totalSize int64
+		footer.beginStruct()
+		footer.listField(1, thriftStruct, len(rowGroup.chunks))
+		for i, chunk := range rowGroup.chunks {
+			totalSize += chunk.size
+			footer.beginStruct()
+			footer.i64Field(2, chunk.offset)
+			footer.structField(3)
+			footer.i32Field(1, parquetTypeByteArray)
+			footer.listField(2, thriftI32, 2)
+			footer.i32(parquetEncodingPlain)
+			footer.i32(parquetEncodingRLE)
+			footer.listField(3, thriftBinary, 1)
+			footer.string(pw.columns[i])
+			footer.i32Field(4, parquetCompressionUncompressed)
+			footer.i64Field(5, chunk.numValues)
+			footer.i64Field(6, chunk.size)
+			footer.i64Field(7, chunk.size)
+			footer.i64Field(9, chunk.offset)
+			footer.endStruct()
+			footer.endStruct()
+		}
+		footer.i64Field(2, totalSize)
+		footer.i64Field(3, rowGroup.numRows)
+		footer.endStruct()
+	}
+	footer.stringField(6, parquetCreatedBy)
+	footer.endStruct()
+
+	err := pw.write(footer.bytes)
+	if err != nil {
+		return err
+	}
+	err = pw.write(appendUint32(nil, uint32(len(footer.bytes))))
+	if err != nil {
+		return err
+	}
+	return pw.write([]byte(parquetMagic))
+}
+
+func (pw *parquetWriter) write(bs []byte) error {
+	n, err := pw.writer.Write(bs)
+	pw.offset += int64(n)
+	return err
+}
+
+func appendUint32(bs []byte, n uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], n)
+	return append(bs, buf[:]...)
+}
+
+// Thrift compact protocol type IDs
+const (
+	thriftStop   = 0
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// Encodes Thrift structs in the compact protocol. Fields are written in order of their IDs. Each struct, including the
+// outermost, is opened with beginStruct (or structField for a struct field) and closed with endStruct. The elements of a
+// list follow its listField.
+type thriftWriter struct {
+	bytes []byte
+	// The ID of the last field written in each open struct
+	lastFieldIDs []int16
+	lastFieldID  int16
+}
+
+func (tw *thriftWriter) fieldHeader(id int16, fieldType byte) {
+	delta := id - tw.lastFieldID
+	if delta > 0 && delta <= 15 {
+		tw.bytes = append(tw.bytes, byte(delta)<<4|fieldType)
+	} else {
+		tw.bytes = append(tw.bytes, fieldType)
+		tw.varint(int64(id))
+	}
+	tw.lastFieldID = id
+}
+
+func (tw *thriftWriter) i32Field(id int16, value int32) {
+	tw.fieldHeader(id, thriftI32)
+	tw.i32(value)
+}
+
+func (tw *thriftWriter) i64Field(id int16, value int64) {
+	tw.fieldHeader(id, thriftI64)
+	tw.varint(value)
+}
+
+func (tw *thriftWriter) stringField(id int16, value string) {
+	tw.fieldHeader(id, thriftBinary)
+	tw.string(value)
+}
+
+// Opens a struct field whose fields follow
+func (tw *thriftWriter) structField(id int16) {
+	tw.fieldHeader(id, thriftStruct)
+	tw.beginStruct()
+}
+
+// Writes the header of a list field whose size elements follow
+func (tw *thriftWriter) listField(id int16, elementType byte, size int) {
+	tw.fieldHeader(id, thriftList)
+	if size < 15 {
+		tw.bytes = append(tw.bytes, byte(size)<<4|elementType)
+	} else {
+		tw.bytes = append(tw.bytes, 0xF0|elementType)
+		tw.uvarint(uint64(size))
+	}
+}
+
+func (tw *thriftWriter) beginStruct() {
+	tw.lastFieldIDs = append(tw.lastFieldIDs, tw.lastFieldID)
+	tw.lastFieldID = 0
+}
+
+func (tw *thriftWriter) endStruct() {
+	tw.bytes = append(tw.bytes, thriftStop)
+	tw.lastFieldID = tw.lastFieldIDs[len(tw.lastFieldIDs)-1]
+	tw.lastFieldIDs = tw.lastFieldIDs[:len(tw.lastFieldIDs)-1]
+}
+
+func (tw *thriftWriter) i32(value int32) {
+	tw.varint(int64(value))
+}
+
+func (tw *thriftWriter) string(value string) {
+	tw.uvarint(uint64(len(value)))
+	tw.bytes = append(tw.bytes, value...)
+}
+
+// Zigzag encoded
+func (tw *thriftWriter) varint(value int64) {
+	tw.uvarint(uint64(value<<1) ^ uint64(value>>63))
+}
+
+func (tw *thriftWriter) uvarint(value uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], value)
+	tw.bytes = append(tw.bytes, buf[:n]...)
+}
@@ -0,0 +1,193 @@
+package export
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParquet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Enough columns to need the long form of a Thrift list header
+	table := Table{Name: "wide"}
+	for i := 0; i < 16; i++ {
+		table.Columns = append(table.Columns, fmt.Sprintf("column_%d", i))
+	}
+	var rows [][]string
+	for i := 0; i < 7; i++ {
+		row := make([]string, len(table.Columns))
+		for j := range row {
+			row[j] = fmt.Sprintf("%d.%d", i, j)
+		}
+		rows = append(rows, row)
+	}
+	rows[1][0] = ""
+	rows[2][1] = "ünïcødé"
+
+	tw, err := Parquet(dir, table, 1, 2)
+	require.NoError(t, err)
+	// Split the rows over several row groups
+	tw.(*parquetWriter).maxRows = 3
+	for _, row := range rows {
+		require.NoError(t, tw.WriteRow(row))
+	}
+	require.NoError(t, tw.Close())
+
+	columns, readRows := readParquet(t, PartitionPath(dir, table, 1, 2, "parquet"))
+	assert.Equal(t, table.Columns, columns)
+	assert.Equal(t, rows, readRows)
+
+	// An empty partition is still a valid file
+	tw, err = Parquet(dir, BlocksTable, 3, 4)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	columns, readRows = readParquet(t, PartitionPath(dir, BlocksTable, 3, 4, "parquet"))
+	assert.Equal(t, BlocksTable.Columns, columns)
+	assert.Len(t, readRows, 0)
+}
+
+// Reads a Parquet file of required PLAIN encoded BYTE_ARRAY columns following the FileMetaData rather than anything we
+// know about how it was written
+func readParquet(t *testing.T, path string) ([]string, [][]string) {
+	bs, err := ioutil.ReadFile(filepath.Clean(path))
+	require.NoError(t, err)
+	require.True(t, len(bs) >= 12)
+	require.Equal(t, parquetMagic, string(bs[:4]))
+	require.Equal(t, parquetMagic, string(bs[len(bs)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(bs[len(bs)-8:]))
+	footerStart := len(bs) - 8 - footerLength
+	tr := &thriftReader{bytes: bs[footerStart : len(bs)-8]}
+	metadata := tr.readStruct()
+	require.Empty(t, tr.bytes)
+
+	require.Equal(t, int32(1), metadata[1])
+	schema := metadata[2].([]interface{})
+	root := schema[0].(map[int16]interface{})
+	require.Equal(t, int32(len(schema)-1), root[5])
+	var columns []string
+	for _, element := range schema[1:] {
+		column := element.(map[int16]interface{})
+		require.Equal(t, int32(parquetTypeByteArray), column[1])
+		require.Equal(t, int32(parquetRepetitionRequired), column[3])
+		require.Equal(t, int32(parquetConvertedTypeUTF8), column[6])
+		columns = append(columns, string(column[4].([]byte)))
+	}
+
+	var rows [][]string
+	for _, rg := range metadata[4].([]interface{}) {
+		rowGroup := rg.(map[int16]interface{})
+		numRows := int(rowGroup[3].(int64))
+		groupRows := make([][]string, numRows)
+		for i := range groupRows {
+			groupRows[i] = make([]string, len(columns))
+		}
+		for i, cc := range rowGroup[1].([]interface{}) {
+			meta := cc.(map[int16]interface{})[3].(map[int16]interface{})
+			require.Equal(t, []interface{}{[]byte(columns[i])}, meta[3])
+			require.Equal(t, int32(parquetCompressionUncompressed), meta[4])
+			require.Equal(t, int64(numRows), meta[5])
+			offset := int(meta[9].(int64))
+			size := int(meta[7].(int64))
+			require.True(t, offset >= 4 && offset+size <= footerStart)
+
+			tr := &thriftReader{bytes: bs[offset : offset+size]}
+			pageHeader := tr.readStruct()
+			require.Equal(t, int32(parquetPageTypeDataPage), pageHeader[1])
+			require.Equal(t, int32(len(tr.bytes)), pageHeader[3])
+			dataPageHeader := pageHeader[5].(map[int16]interface{})
+			require.Equal(t, int32(numRows), dataPageHeader[1])
+			require.Equal(t, int32(parquetEncodingPlain), dataPageHeader[2])
+			data := tr.bytes
+			for row := 0; row < numRows; row++ {
+				length := int(binary.LittleEndian.Uint32(data))
+				groupRows[row][i] = string(data[4 : 4+length])
+				data = data[4+length:]
+			}
+			require.Empty(t, data)
+		}
+		rows = append(rows, groupRows...)
+	}
+	require.Equal(t, int64(len(rows)), metadata[3])
+	return columns, rows
+}
+
+// Decodes Thrift compact protocol structs into maps of field ID to value
+type thriftReader struct {
+	bytes []byte
+}
+
+func (tr *thriftReader) readStruct() map[int16]interface{} {
+	fields := make(map[int16]interface{})
+	var lastFieldID int16
+	for {
+		header := tr.readByte()
+		fieldType := header & 0x0F
+		if fieldType == thriftStop {
+			return fields
+		}
+		if delta := int16(header >> 4); delta != 0 {
+			lastFieldID += delta
+		} else {
+			lastFieldID = int16(tr.readVarint())
+		}
+		fields[lastFieldID] = tr.readValue(fieldType)
+	}
+}
+
+func (tr *thriftReader) readValue(valueType byte) interface{} {
+	switch valueType {
+	case thriftI32:
+		return int32(tr.readVarint())
+	case thriftI64:
+		return tr.readVarint()
+	case thriftBinary:
+		length := int(tr.readUvarint())
+		value := tr.bytes[:length]
+		tr.bytes = tr.bytes[length:]
+		return value
+	case thriftList:
+		header := tr.readByte()
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(tr.readUvarint())
+		}
+		values := make([]interface{}, size)
+		for i := range values {
+			values[i] = tr.readValue(header & 0x0F)
+		}
+		return values
+	case thriftStruct:
+		return tr.readStruct()
+	default:
+		panic(fmt.Errorf("unexpected Thrift type %d", valueType))
+	}
+}
+
+func (tr *thriftReader) readByte() byte {
+	b := tr.bytes[0]
+	tr.bytes = tr.bytes[1:]
+	return b
+}
+
+func (tr *thriftReader) readVarint() int64 {
+	n := tr.readUvarint()
+	return int64(n>>1) ^ -int64(n&1)
+}
+
+func (tr *thriftReader) readUvarint() uint64 {
+	n, length := binary.Uvarint(tr.bytes)
+	if length <= 0 {
+		panic(fmt.Errorf("invalid varint"))
+	}
+	tr.bytes = tr.bytes[length:]
+	return n
+}
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Table describes one of the tables we export, each row of which has a value for every column
+type Table struct {
+	Name    string
+	Columns []string
+}
+
+var (
+	BlocksTable = Table{
+		Name:    "blocks",
+		Columns: []string{"height", "time", "hash", "app_hash", "num_txs", "proposer"},
+	}
+	TxsTable = Table{
+		Name: "txs",
+		Columns: []string{"height", "index", "tx_hash", "tx_type", "gas_used", "contract_address", "created",
+			"exception"},
+	}
+	CallsTable = Table{
+		Name: "calls",
+		Columns: []string{"height", "tx_hash", "event_index", "call_type", "stack_depth", "origin", "caller",
+			"callee", "value", "gas", "data", "return", "exception"},
+	}
+	LogsTable = Table{
+		Name:    "logs",
+		Columns: []string{"height", "tx_hash", "event_index", "address", "topics", "data", "event_name", "args"},
+	}
+	AccountsTable = Table{
+		Name:    "accounts",
+		Columns: []string{"height", "address", "balance", "sequence", "code", "permissions", "roles"},
+	}
+)
+
+// Tables in the order we write them
+var Tables = []Table{BlocksTable, TxsTable, CallsTable, LogsTable, AccountsTable}
+
+// TableWriter receives the rows of a single partition of a table
+type TableWriter interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+// Format opens a TableWriter for the partition of table covering heights [start, end] beneath dir
+type Format func(dir string, table Table, start, end uint64) (TableWriter, error)
+
+// PartitionPath gives the file for the partition of table covering heights [start, end] beneath dir, partitions are
+// zero-padded so that they sort lexically in height order
+func PartitionPath(dir string, table Table, start, end uint64, extension string) string {
+	return filepath.Join(dir, table.Name, fmt.Sprintf("%012d-%012d.%s", start, end, extension))
+}
+
+type csvWriter struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+// CSV writes each partition as a CSV file with a header row of column names
+func CSV(dir string, table Table, start, end uint64) (TableWriter, error) {
+	path := PartitionPath(dir, table, start, end, "csv")
+	err := os.MkdirAll(filepath.Dir(path), 0777)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	cw := &csvWriter{
+		file:   file,
+		writer: csv.NewWriter(file),
+	}
+	err = cw.WriteRow(table.Columns)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) WriteRow(row []string) error {
+	return cw.writer.Write(row)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.writer.Flush()
+	err := cw.writer.Error()
+	if err != nil {
+		cw.file.Close()
+		return err
+	}
+	return cw.file.Close()
+}
+
+// Formats available by name
+var Formats = map[string]Format{
+	"csv":     CSV,
+	"parquet": Parquet,
+}
@@ -0,0 +1,135 @@
+package forensics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestReplay_Bisect(t *testing.T) {
+	const numBlocks = 10
+	const divergentHeight = 6
+	replay := newDivergentReplay(t, numBlocks, divergentHeight)
+
+	recaps, err := replay.Blocks(1, divergentHeight)
+	require.NoError(t, err)
+	require.Len(t, recaps, divergentHeight-1)
+	for _, recap := range recaps {
+		appHash, err := replay.AppHashAfter(recap.Height)
+		require.NoError(t, err)
+		assert.Equal(t, appHash, recap.AppHashAfter, "block %d should replay to its recorded app hash", recap.Height)
+	}
+
+	_, diverged, err := replay.diverged(divergentHeight - 1)
+	require.NoError(t, err)
+	assert.False(t, diverged)
+	recap, diverged, err := replay.diverged(divergentHeight)
+	require.NoError(t, err)
+	assert.True(t, diverged)
+	assert.Equal(t, uint64(divergentHeight), recap.Height)
+
+	recap, err = replay.Bisect(1, numBlocks)
+	require.NoError(t, err)
+	require.NotNil(t, recap)
+	assert.Equal(t, uint64(divergentHeight), recap.Height)
+
+	// A range that ends before the divergence finds nothing
+	recap, err = replay.Bisect(1, divergentHeight)
+	require.NoError(t, err)
+	assert.Nil(t, recap)
+
+	// We need the block after the range for its app hash
+	_, err = replay.Bisect(1, numBlocks+2)
+	require.Error(t, err)
+}
+
+// Build a chain of numBlocks blocks (plus one more recording the final app hash) where from divergentHeight onwards the
+// stored state includes a transaction that is absent from the blocks, as if the node had executed something else
+func newDivergentReplay(t *testing.T, numBlocks, divergentHeight uint64) *Replay {
+	genesisDoc, accounts, _ := genesis.NewDeterministicGenesis(1).GenesisDoc(2, false, 1000, 1, false, 1000)
+	db := dbm.NewMemDB()
+	st, err := state.MakeGenesisState(db, genesisDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	blockchain := bcm.NewBlockchain(db, genesisDoc)
+	params, err := execution.ParamsFromGenesis(genesisDoc)
+	require.NoError(t, err)
+	logger := logging.NewNoopLogger()
+
+	blocks := new(memoryBlockStore)
+	appHash := st.Hash()
+	for height := uint64(1); height <= numBlocks+1; height++ {
+		block := types.MakeBlock(int64(height), nil, nil, nil)
+		block.ChainID = genesisDoc.ChainID()
+		block.Time = genesisDoc.GenesisTime.Add(time.Duration(height) * time.Second)
+		block.AppHash = appHash
+		blocks.blocks = append(blocks.blocks, block)
+		if height > numBlocks {
+			break
+		}
+		committer := execution.NewBatchCommitter(st, params, blockchain, event.NewNoOpPublisher(), logger)
+		if height >= divergentHeight {
+			tx := payload.NewSendTx()
+			require.NoError(t, tx.AddInput(st, accounts[0].GetPublicKey(), 1))
+			require.NoError(t, tx.AddOutput(accounts[1].GetAddress(), 1))
+			txEnv := txs.Enclose(genesisDoc.ChainID(), tx)
+			require.NoError(t, txEnv.Sign(accounts[0]))
+			txe, err := committer.Execute(txEnv)
+			require.NoError(t, err)
+			require.Nil(t, txe.Exception)
+		}
+		header := types.TM2PB.Header(&block.Header)
+		appHash, err = committer.Commit(&header)
+		require.NoError(t, err)
+		require.NoError(t, blockchain.CommitBlockAtHeight(block.Time, block.Hash(), appHash, height))
+	}
+	return &Replay{
+		explorer:   bcm.NewBlockStore(blocks),
+		burrowDB:   db,
+		genesisDoc: genesisDoc,
+		logger:     logger,
+	}
+}
+
+type memoryBlockStore struct {
+	blocks []*types.Block
+}
+
+func (mbs *memoryBlockStore) Height() int64 {
+	return int64(len(mbs.blocks))
+}
+
+func (mbs *memoryBlockStore) LoadBlock(height int64) *types.Block {
+	if height < 1 || height > mbs.Height() {
+		return nil
+	}
+	return mbs.blocks[height-1]
+}
+
+func (mbs *memoryBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return nil
+}
+
+func (mbs *memoryBlockStore) LoadBlockPart(height int64, index int) *types.Part {
+	return nil
+}
+
+func (mbs *memoryBlockStore) LoadBlockCommit(height int64) *types.Commit {
+	return nil
+}
+
+func (mbs *memoryBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	return nil
+}
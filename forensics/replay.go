@@ -22,13 +22,13 @@ import (
 
 type Replay struct {
 	explorer   *bcm.BlockStore
-	burrowDB   *storage.CacheDB
-	blockchain *bcm.Blockchain
+	burrowDB   dbm.DB
 	genesisDoc *genesis.GenesisDoc
 	logger     *logging.Logger
 }
 
 type ReplayCapture struct {
+	Height        uint64
 	AppHashBefore binary.HexBytes
 	AppHashAfter  binary.HexBytes
 	TxExecutions  []*exec.TxExecution
@@ -39,78 +39,54 @@ func (recap *ReplayCapture) String() string {
 }
 
 func NewReplay(dbDir string, genesisDoc *genesis.GenesisDoc, logger *logging.Logger) *Replay {
-	// Avoid writing through to underlying DB
-	burrowDB := storage.NewCacheDB(core.NewBurrowDB(dbDir))
 	return &Replay{
 		explorer:   bcm.NewBlockExplorer(dbm.LevelDBBackend, dbDir),
-		burrowDB:   burrowDB,
-		blockchain: bcm.NewBlockchain(burrowDB, genesisDoc),
+		burrowDB:   core.NewBurrowDB(dbDir),
 		genesisDoc: genesisDoc,
 		logger:     logger,
 	}
 }
 
+// Height of the last block in the block store
+func (re *Replay) Height() uint64 {
+	return uint64(re.explorer.Height())
+}
+
+// Get the stored state after the block at height has been committed
 func (re *Replay) State(height uint64) (*state.State, error) {
-	return state.LoadState(re.burrowDB, int64(height))
+	// Avoid writing through to underlying DB
+	return state.LoadState(storage.NewCacheDB(re.burrowDB), state.VersionAtHeight(height))
 }
 
-func (re *Replay) Block(height uint64) (*ReplayCapture, error) {
-	recap := new(ReplayCapture)
-	// Load and commit previous block
-	block, err := re.explorer.Block(int64(height - 1))
+// Get the app hash recorded by consensus for the state after the block at height, which is stored in the header of the
+// following block
+func (re *Replay) AppHashAfter(height uint64) (binary.HexBytes, error) {
+	block, err := re.explorer.Block(int64(height + 1))
 	if err != nil {
 		return nil, err
 	}
-	err = re.blockchain.CommitBlockAtHeight(block.Time, block.Hash(), block.Header.AppHash, uint64(block.Height))
-	if err != nil {
-		return nil, err
-	}
-	// block.AppHash is hash after txs from previous block have been applied - it's the state we want to load on top
-	// of which we will reapply this block txs
-	st, err := re.State(height - 1)
-	if err != nil {
-		return nil, err
-	}
-	// Load block for replay
-	block, err = re.explorer.Block(int64(height))
-	if err != nil {
-		return nil, err
-	}
-	if !bytes.Equal(st.Hash(), block.AppHash) {
-		return nil, fmt.Errorf("state hash (%X) retrieved for block AppHash (%X) do not match",
-			st.Hash(), block.AppHash)
-	}
-	recap.AppHashBefore = binary.HexBytes(block.AppHash)
-
-	// Get our commit machinery
-	committer := execution.NewBatchCommitter(st, execution.ParamsFromGenesis(re.genesisDoc), re.blockchain,
-		event.NewNoOpPublisher(), re.logger)
+	return binary.HexBytes(block.AppHash), nil
+}
 
-	var txe *exec.TxExecution
-	var execErr error
-	_, err = block.Transactions(func(txEnv *txs.Envelope) (stop bool) {
-		txe, execErr = committer.Execute(txEnv)
-		if execErr != nil {
-			return true
-		}
-		recap.TxExecutions = append(recap.TxExecutions, txe)
-		return false
-	})
-	if err != nil {
-		return nil, err
-	}
-	if execErr != nil {
-		return nil, execErr
-	}
-	//abciHeader := types.TM2PB.Header(&block.Header)
-	recap.AppHashAfter, err = committer.Commit(nil)
+func (re *Replay) Block(height uint64) (*ReplayCapture, error) {
+	recaps, err := re.Blocks(height, height+1)
 	if err != nil {
 		return nil, err
 	}
-	return recap, nil
+	return recaps[0], nil
 }
 
+// Replay blocks in [startHeight, endHeight) on top of the stored state before startHeight
 func (re *Replay) Blocks(startHeight, endHeight uint64) ([]*ReplayCapture, error) {
+	if startHeight == 0 {
+		startHeight = 1
+	}
+	if endHeight <= startHeight {
+		return nil, fmt.Errorf("end height %d must be greater than start height %d", endHeight, startHeight)
+	}
+	// Each replay gets its own cache over the database so replays are independent and we never write to disk
+	burrowDB := storage.NewCacheDB(re.burrowDB)
+	blockchain := bcm.NewBlockchain(burrowDB, re.genesisDoc)
 	var err error
 	var st *state.State
 	if startHeight > 1 {
@@ -119,18 +95,20 @@ func (re *Replay) Blocks(startHeight, endHeight uint64) ([]*ReplayCapture, error
 		if err != nil {
 			return nil, err
 		}
-		err = re.blockchain.CommitBlockAtHeight(block.Time, block.Hash(), block.Header.AppHash, uint64(block.Height))
+		err = blockchain.CommitBlockAtHeight(block.Time, block.Hash(), block.Header.AppHash, uint64(block.Height))
 		if err != nil {
 			return nil, err
 		}
 		// block.AppHash is hash after txs from previous block have been applied - it's the state we want to load on top
 		// of which we will reapply this block txs
-		st, err = re.State(startHeight - 1)
+		st, err = state.LoadState(burrowDB, state.VersionAtHeight(startHeight-1))
 		if err != nil {
 			return nil, err
 		}
+		st.SetNameOwnership(re.genesisDoc.Params.NameOwnership)
+		st.SetCountStorageWords(re.genesisDoc.Params.StorageRentPerWord > 0)
 	} else {
-		st, err = state.MakeGenesisState(re.burrowDB, re.genesisDoc)
+		st, err = state.MakeGenesisState(burrowDB, re.genesisDoc)
 		if err != nil {
 			return nil, err
 		}
@@ -139,9 +117,13 @@ func (re *Replay) Blocks(startHeight, endHeight uint64) ([]*ReplayCapture, error
 			return nil, err
 		}
 	}
-	recaps := make([]*ReplayCapture, 0, endHeight-startHeight+1)
+	params, err := execution.ParamsFromGenesis(re.genesisDoc)
+	if err != nil {
+		return nil, err
+	}
+	recaps := make([]*ReplayCapture, 0, endHeight-startHeight)
 	for height := startHeight; height < endHeight; height++ {
-		recap := new(ReplayCapture)
+		recap := &ReplayCapture{Height: height}
 		// Load block for replay
 		block, err := re.explorer.Block(int64(height))
 		if err != nil {
@@ -154,7 +136,7 @@ func (re *Replay) Blocks(startHeight, endHeight uint64) ([]*ReplayCapture, error
 		recap.AppHashBefore = binary.HexBytes(block.AppHash)
 
 		// Get our commit machinery
-		committer := execution.NewBatchCommitter(st, execution.ParamsFromGenesis(re.genesisDoc), re.blockchain,
+		committer := execution.NewBatchCommitter(st, params, blockchain,
 			event.NewNoOpPublisher(), re.logger)
 
 		var txe *exec.TxExecution
@@ -178,7 +160,61 @@ func (re *Replay) Blocks(startHeight, endHeight uint64) ([]*ReplayCapture, error
 		if err != nil {
 			return nil, err
 		}
+		// Advance the chain as the ABCI app does so that the next block executes at the next height
+		err = blockchain.CommitBlockAtHeight(block.Time, block.Hash(), recap.AppHashAfter, height)
+		if err != nil {
+			return nil, err
+		}
 		recaps = append(recaps, recap)
 	}
 	return recaps, nil
 }
+
+// Binary search [startHeight, endHeight) for the first block whose replayed app hash differs from the app hash that
+// was recorded for it by consensus. Each block is replayed on top of the stored state before it and we assume that
+// once a block diverges the blocks after it do too. Returns nil if the last block in the range does not diverge.
+func (re *Replay) Bisect(startHeight, endHeight uint64) (*ReplayCapture, error) {
+	if startHeight == 0 {
+		startHeight = 1
+	}
+	if endHeight > re.Height() {
+		// We need the header of the block after the last block we replay
+		return nil, fmt.Errorf("can only bisect up to height %d since the app hash of the last block is recorded "+
+			"in the next block", re.Height()-1)
+	}
+	if endHeight <= startHeight {
+		return nil, fmt.Errorf("end height %d must be greater than start height %d", endHeight, startHeight)
+	}
+	var first *ReplayCapture
+	low, high := startHeight, endHeight-1
+	for low <= high {
+		mid := low + (high-low)/2
+		recap, diverged, err := re.diverged(mid)
+		if err != nil {
+			return nil, err
+		}
+		re.logger.InfoMsg("Bisecting for divergent block", "height", mid, "diverged", diverged)
+		if diverged {
+			first = recap
+			if mid == low {
+				break
+			}
+			high = mid - 1
+		} else {
+			low = mid + 1
+		}
+	}
+	return first, nil
+}
+
+func (re *Replay) diverged(height uint64) (*ReplayCapture, bool, error) {
+	recap, err := re.Block(height)
+	if err != nil {
+		return nil, false, err
+	}
+	appHash, err := re.AppHashAfter(height)
+	if err != nil {
+		return nil, false, err
+	}
+	return recap, !bytes.Equal(recap.AppHashAfter, appHash), nil
+}
@@ -0,0 +1,143 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Divergence describes how two accounts of the same block differ, either our replay of a block against what was
+// stored, or what was stored by two different nodes
+type Divergence struct {
+	Height           uint64
+	AppHash          binary.HexBytes
+	OtherAppHash     binary.HexBytes
+	TxExecutionDiffs []*TxExecutionDiff `json:",omitempty"`
+	StateDiffs       []*StateDiff       `json:",omitempty"`
+}
+
+type TxExecutionDiff struct {
+	Index            int
+	TxExecution      *exec.TxExecution
+	OtherTxExecution *exec.TxExecution
+}
+
+// A key in the state forest with differing values, a missing value means the key is absent
+type StateDiff struct {
+	Prefix     binary.HexBytes
+	Key        binary.HexBytes
+	Value      binary.HexBytes `json:",omitempty"`
+	OtherValue binary.HexBytes `json:",omitempty"`
+}
+
+func (div *Divergence) String() string {
+	return fmt.Sprintf("Divergence at height %d [%v != %v] with %d differing TxExecutions and %d differing keys",
+		div.Height, div.AppHash, div.OtherAppHash, len(div.TxExecutionDiffs), len(div.StateDiffs))
+}
+
+// Compare the state and TxExecutions stored at height by the nodes whose databases back replay and other
+func Compare(replay, other *Replay, height uint64) (*Divergence, error) {
+	st, err := replay.State(height)
+	if err != nil {
+		return nil, err
+	}
+	otherSt, err := other.State(height)
+	if err != nil {
+		return nil, err
+	}
+	txes, err := st.TxsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	otherTxes, err := otherSt.TxsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	div := &Divergence{
+		Height:       height,
+		AppHash:      st.Hash(),
+		OtherAppHash: otherSt.Hash(),
+	}
+	div.TxExecutionDiffs, err = DiffTxExecutions(txes, otherTxes)
+	if err != nil {
+		return nil, err
+	}
+	err = st.DiffForest(otherSt, height, func(prefix, key, value, otherValue []byte) error {
+		div.StateDiffs = append(div.StateDiffs, &StateDiff{
+			Prefix:     prefix,
+			Key:        key,
+			Value:      value,
+			OtherValue: otherValue,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return div, nil
+}
+
+// Compare our replay of a block with the TxExecutions that were stored when the block was originally executed
+func CompareReplay(replay *Replay, recap *ReplayCapture) (*Divergence, error) {
+	st, err := replay.State(recap.Height)
+	if err != nil {
+		return nil, err
+	}
+	txes, err := st.TxsAtHeight(recap.Height)
+	if err != nil {
+		return nil, err
+	}
+	appHash, err := replay.AppHashAfter(recap.Height)
+	if err != nil {
+		return nil, err
+	}
+	div := &Divergence{
+		Height:       recap.Height,
+		AppHash:      recap.AppHashAfter,
+		OtherAppHash: appHash,
+	}
+	div.TxExecutionDiffs, err = DiffTxExecutions(recap.TxExecutions, txes)
+	if err != nil {
+		return nil, err
+	}
+	return div, nil
+}
+
+// Pair up TxExecutions by index returning those that differ
+func DiffTxExecutions(txes, otherTxes []*exec.TxExecution) ([]*TxExecutionDiff, error) {
+	var diffs []*TxExecutionDiff
+	for i := 0; i < len(txes) || i < len(otherTxes); i++ {
+		diff := &TxExecutionDiff{Index: i}
+		if i < len(txes) {
+			diff.TxExecution = txes[i]
+		}
+		if i < len(otherTxes) {
+			diff.OtherTxExecution = otherTxes[i]
+		}
+		equal, err := equalTxExecutions(diff.TxExecution, diff.OtherTxExecution)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+func equalTxExecutions(txe, otherTxe *exec.TxExecution) (bool, error) {
+	if txe == nil || otherTxe == nil {
+		return txe == otherTxe, nil
+	}
+	bs, err := txe.Marshal()
+	if err != nil {
+		return false, err
+	}
+	otherBs, err := otherTxe.Marshal()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(bs, otherBs), nil
+}
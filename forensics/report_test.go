@@ -0,0 +1,32 @@
+package forensics
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTxExecutions(t *testing.T) {
+	txes := []*exec.TxExecution{
+		{TxHeader: &exec.TxHeader{TxHash: []byte{1}}},
+		{TxHeader: &exec.TxHeader{TxHash: []byte{2}}},
+	}
+	otherTxes := []*exec.TxExecution{
+		{TxHeader: &exec.TxHeader{TxHash: []byte{1}}},
+		{TxHeader: &exec.TxHeader{TxHash: []byte{2}}, Exception: &errors.Exception{Exception: "oops"}},
+		{TxHeader: &exec.TxHeader{TxHash: []byte{3}}},
+	}
+	diffs, err := DiffTxExecutions(txes, otherTxes)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, 1, diffs[0].Index)
+	assert.Equal(t, 2, diffs[1].Index)
+	assert.Nil(t, diffs[1].TxExecution)
+
+	diffs, err = DiffTxExecutions(txes, txes)
+	require.NoError(t, err)
+	assert.Len(t, diffs, 0)
+}
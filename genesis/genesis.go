@@ -62,8 +62,31 @@ type Validator struct {
 
 const DefaultProposalThreshold uint64 = 3
 
+const DefaultStorageRentPeriod uint64 = 1000
+
+// A suitable MaxTxExpiryBlocks for chains that allow expiring transactions
+const DefaultMaxTxExpiryBlocks uint64 = 1000
+
 type params struct {
 	ProposalThreshold uint64
+	// The EVM hard fork whose opcodes and precompiles are enabled (e.g. 'istanbul'), defaults to byzantium when empty
+	EVMVersion string `json:",omitempty" toml:",omitempty"`
+	// The maximum total gas that the CallTxs in a single block may reserve, unlimited when zero
+	BlockGasLimit uint64 `json:",omitempty" toml:",omitempty"`
+	// Rent charged per word of contract storage every StorageRentPeriod blocks, storage rent is disabled when zero
+	StorageRentPerWord uint64 `json:",omitempty" toml:",omitempty"`
+	// The number of blocks between the starts of collections of storage rent, defaults to DefaultStorageRentPeriod when
+	// zero. A collection charges a bounded batch of accounts per block so may take more than one block.
+	StorageRentPeriod uint64 `json:",omitempty" toml:",omitempty"`
+	// How far beyond the current height a transaction input's ExpiryHeight may be, which bounds how long the hashes of
+	// such transactions must be kept to prevent their replay. Inputs with an ExpiryHeight are rejected when zero.
+	MaxTxExpiryBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// Enables transferring names with NameTx.Owner, control of sub.name by the owner of name, and the index of names by
+	// owner. Since names registered before it would not be indexed it can only be enabled for a new chain.
+	NameOwnership bool `json:",omitempty" toml:",omitempty"`
+	// Enables running contract code that starts with the WASM magic as a WASM module, otherwise such code is EVM
+	// bytecode starting with STOP
+	WASM bool `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
@@ -34,6 +34,14 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	EVMVersion        string `json:",omitempty" toml:",omitempty"`
+	BlockGasLimit     uint64 `json:",omitempty" toml:",omitempty"`
+	// Storage rent is opt-in, see genesis.params
+	StorageRentPerWord uint64 `json:",omitempty" toml:",omitempty"`
+	StorageRentPeriod  uint64 `json:",omitempty" toml:",omitempty"`
+	MaxTxExpiryBlocks  uint64 `json:",omitempty" toml:",omitempty"`
+	NameOwnership      bool   `json:",omitempty" toml:",omitempty"`
+	WASM               bool   `json:",omitempty" toml:",omitempty"`
 }
 
 func (gs *GenesisSpec) RealiseKeys(keyClient keys.KeyClient) error {
@@ -64,6 +72,13 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, generateNodeKeys boo
 	if gs.Params.ProposalThreshold != 0 {
 		genesisDoc.Params.ProposalThreshold = DefaultProposalThreshold
 	}
+	genesisDoc.Params.EVMVersion = gs.Params.EVMVersion
+	genesisDoc.Params.BlockGasLimit = gs.Params.BlockGasLimit
+	genesisDoc.Params.StorageRentPerWord = gs.Params.StorageRentPerWord
+	genesisDoc.Params.StorageRentPeriod = gs.Params.StorageRentPeriod
+	genesisDoc.Params.MaxTxExpiryBlocks = gs.Params.MaxTxExpiryBlocks
+	genesisDoc.Params.NameOwnership = gs.Params.NameOwnership
+	genesisDoc.Params.WASM = gs.Params.WASM
 
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/snapshot"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -84,6 +85,43 @@ func TestBootShutdownResume(t *testing.T) {
 	assert.Error(t, bootWaitBlocksShutdown(t, privValidator, testConfig, logger, blockChecker))
 }
 
+func TestBootFromSnapshot(t *testing.T) {
+	cleanup := integration.EnterTestDirectory()
+	defer cleanup()
+	logger := logging.NewNoopLogger()
+
+	// Run a node taking snapshots until it is a couple of blocks past its first snapshot
+	testConfig := integration.NewTestConfig(genesisDoc)
+	testConfig.Snapshot = snapshot.DefaultSnapshotConfig()
+	testConfig.Snapshot.Enabled = true
+	testConfig.Snapshot.Interval = 3
+	require.NoError(t, bootWaitBlocksShutdown(t, tendermint.NewPrivValidatorMemory(privateValidators[0],
+		privateValidators[0]), testConfig, logger, func(block *exec.BlockExecution) bool {
+		return block.Height < testConfig.Snapshot.Interval+2
+	}))
+	store := snapshot.NewStore(core.SnapshotDirectory(testConfig.Tendermint.TendermintRoot, testConfig.Snapshot))
+	manifests, err := store.ListManifests()
+	require.NoError(t, err)
+	require.NotEmpty(t, manifests)
+	manifest := manifests[len(manifests)-1]
+	require.NotNil(t, manifest.Tendermint)
+
+	// Start a new node with empty stores from the snapshot, it is the only validator so must carry on the chain itself
+	restoreConfig := integration.NewTestConfig(genesisDoc)
+	restored, err := restoreConfig.RestoreSnapshot(store, manifest.AppHash)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.Height, restored.Height)
+	// Restoring over the stores we have just seeded should fail
+	_, err = restoreConfig.RestoreSnapshot(store, manifest.AppHash)
+	require.Error(t, err)
+
+	require.NoError(t, bootWaitBlocksShutdown(t, tendermint.NewPrivValidatorMemory(privateValidators[0],
+		privateValidators[0]), restoreConfig, logger, func(block *exec.BlockExecution) bool {
+		require.True(t, block.Height > manifest.Height)
+		return block.Height < manifest.Height+3
+	}))
+}
+
 func TestLoggingSignals(t *testing.T) {
 	//cleanup := integration.EnterTestDirectory()
 	//defer cleanup()
@@ -138,7 +176,9 @@ func bootWaitBlocksShutdown(t testing.TB, privValidator tmTypes.PrivValidator, t
 		testConfig.Tendermint.TendermintConfig(),
 		testConfig.RPC,
 		testConfig.Keys,
-		keyStore, nil, testConfig.Tendermint.DefaultAuthorizedPeersProvider(), "", logger)
+		testConfig.Snapshot,
+		testConfig.Tendermint.MempoolPriority,
+		keyStore, nil, testConfig.Tendermint.DefaultAuthorizedPeersProvider(), nil, logger)
 	if err != nil {
 		return err
 	}
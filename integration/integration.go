@@ -83,10 +83,12 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 		testConfig.Tendermint.TendermintConfig(),
 		testConfig.RPC,
 		testConfig.Keys,
+		testConfig.Snapshot,
+		testConfig.Tendermint.MempoolPriority,
 		nil,
 		[]execution.ExecutionOption{execution.VMOptions(evm.DebugOpcodes)},
 		testConfig.Tendermint.DefaultAuthorizedPeersProvider(),
-		"",
+		nil,
 		logger)
 	if err != nil {
 		panic(err)
@@ -121,10 +123,12 @@ func TestGenesisDoc(addressables []*acm.PrivateAccount) *genesis.GenesisDoc {
 	if err != nil {
 		panic("could not parse test genesis time")
 	}
-	return genesis.MakeGenesisDocFromAccounts(ChainName, nil, genesisTime, accounts,
+	genesisDoc := genesis.MakeGenesisDocFromAccounts(ChainName, nil, genesisTime, accounts,
 		map[string]*validator.Validator{
 			"genesis_validator": validator.FromAccount(accounts["user_0"], 1<<16),
 		})
+	genesisDoc.Params.MaxTxExpiryBlocks = genesis.DefaultMaxTxExpiryBlocks
+	return genesisDoc
 }
 
 // Deterministic account generation helper. Pass number of accounts to make
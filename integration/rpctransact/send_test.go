@@ -4,9 +4,11 @@ package rpctransact
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,3 +31,43 @@ func TestSendTxSync(t *testing.T) {
 		assert.False(t, txe.Receipt.CreatesContract)
 	}
 }
+
+func TestSendTxSyncExpiring(t *testing.T) {
+	cli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
+	qcli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: inputAddress})
+	require.NoError(t, err)
+
+	// Many transactions from one account in flight at once
+	expiryHeight := kern.Blockchain.LastBlockHeight() + 100
+	errs := make(chan error, 10)
+	wg := new(sync.WaitGroup)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cli.SendTxSync(context.Background(), &payload.SendTx{
+				Inputs: []*payload.TxInput{{
+					Address:      inputAddress,
+					Amount:       1,
+					ExpiryHeight: expiryHeight,
+				}},
+				Outputs: []*payload.TxOutput{{
+					Address: rpctest.PrivateAccounts[3].GetAddress(),
+					Amount:  1,
+				}},
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	after, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: inputAddress})
+	require.NoError(t, err)
+	assert.Equal(t, acc.Sequence, after.Sequence)
+	assert.Equal(t, acc.Balance-uint64(cap(errs)), after.Balance)
+}
@@ -0,0 +1,307 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const bearerPrefix = "Bearer "
+
+// Methods that only tokens with Admin set may call, in addition to those in AuthConfig.AdminMethods
+var adminMethods = []string{
+	"/rpcmempool.Mempool/EvictTx",
+}
+
+// Authorizer checks the bearer token presented for a method against an AuthConfig
+type Authorizer struct {
+	openMethods  []string
+	adminMethods []string
+	tokens       []*TokenConfig
+	jwtSecret    []byte
+	now          func() time.Time
+}
+
+// AuthError is returned when a call is not authorized
+type AuthError struct {
+	// True when no valid token was presented, false when the token does not grant access to the method
+	Unauthenticated bool
+	Method          string
+	Reason          string
+}
+
+func (err *AuthError) Error() string {
+	return fmt.Sprintf("not authorized to call %s: %s", err.Method, err.Reason)
+}
+
+// GRPCStatus allows the grpc package to return the appropriate status code for an AuthError
+func (err *AuthError) GRPCStatus() *status.Status {
+	if err.Unauthenticated {
+		return status.New(codes.Unauthenticated, err.Error())
+	}
+	return status.New(codes.PermissionDenied, err.Error())
+}
+
+func (err *AuthError) HTTPStatusCode() int {
+	if err.Unauthenticated {
+		return http.StatusUnauthorized
+	}
+	return http.StatusForbidden
+}
+
+// NewAuthorizer returns nil if conf is nil in which case all calls are permitted
+func NewAuthorizer(conf *AuthConfig) (*Authorizer, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	for _, tc := range conf.Tokens {
+		if tc.Token == "" {
+			return nil, fmt.Errorf("empty token in auth config")
+		}
+	}
+	return &Authorizer{
+		openMethods:  conf.OpenMethods,
+		adminMethods: append(adminMethods[:len(adminMethods):len(adminMethods)], conf.AdminMethods...),
+		tokens:       conf.Tokens,
+		jwtSecret:    []byte(conf.JWTSecret),
+		now:          time.Now,
+	}, nil
+}
+
+// Authorize returns an *AuthError if token does not allow method to be called, a nil Authorizer allows all calls. If
+// a valid token is presented the principal it identifies is returned. Admin methods are never open and may only be
+// called with an admin token.
+func (auth *Authorizer) Authorize(method, token string) (string, error) {
+	if auth == nil {
+		return "", nil
+	}
+	admin := matchesMethod(auth.adminMethods, method)
+	open := !admin && matchesMethod(auth.openMethods, method)
+	if token == "" {
+		if open {
+			return "", nil
+		}
+		return "", &AuthError{Unauthenticated: true, Method: method, Reason: "no bearer token provided"}
+	}
+	principal, methods, isAdmin, err := auth.verifyToken(token)
+	if err != nil {
+		if open {
+			return "", nil
+		}
+		return "", &AuthError{Unauthenticated: true, Method: method, Reason: err.Error()}
+	}
+	if admin && !isAdmin {
+		return "", &AuthError{Method: method, Reason: "method requires an admin token"}
+	}
+	if len(methods) > 0 && !matchesMethod(methods, method) && !matchesMethod(auth.openMethods, method) {
+		return "", &AuthError{Method: method, Reason: "token does not grant access to method"}
+	}
+	return principal, nil
+}
+
+// AuthorizeContext authorizes a gRPC call using the token in its 'authorization' metadata
+func (auth *Authorizer) AuthorizeContext(ctx context.Context, method string) (string, error) {
+	if auth == nil {
+		return "", nil
+	}
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = bearerToken(values[0])
+		}
+	}
+	return auth.Authorize(method, token)
+}
+
+// Handler wraps an HTTP handler authorizing each request using the token in its Authorization header, the principal
+// identified by the token is available to the wrapped handler via PrincipalFromContext
+func (auth *Authorizer) Handler(handler http.Handler) http.Handler {
+	if auth == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, r, err := httpMethod(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		principal, err := auth.Authorize(method, bearerToken(r.Header.Get("Authorization")))
+		if err != nil {
+			http.Error(w, err.Error(), err.(*AuthError).HTTPStatusCode())
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// CheckWebsocketRequests returns a function that, for a websocket connection upgraded from r, checks each JSON-RPC
+// request received on the connection. Requests are authorized with the token presented when the connection was opened
+// and rate limited per principal, or IP address for unauthenticated clients.
+func CheckWebsocketRequests(auth *Authorizer, limiter *RateLimiter) func(r *http.Request) func(method string) error {
+	if auth == nil && limiter == nil {
+		return nil
+	}
+	return func(r *http.Request) func(method string) error {
+		token := bearerToken(r.Header.Get("Authorization"))
+		host := hostOf(r.RemoteAddr)
+		return func(method string) error {
+			method = "/" + method
+			principal, err := auth.Authorize(method, token)
+			if err != nil {
+				return err
+			}
+			if principal == "" {
+				return limiter.Allow(host, method)
+			}
+			return limiter.Allow(principal, method)
+		}
+	}
+}
+
+type principalKey struct{}
+
+// WithPrincipal records the authenticated principal making a call
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	if principal == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal making a call or the empty string if there is none
+func PrincipalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
+
+// Returns the principal identified by token, the methods it may call (empty for any method), and whether it may call
+// admin methods or an error if the token is not valid
+func (auth *Authorizer) verifyToken(token string) (string, []string, bool, error) {
+	for i, tc := range auth.tokens {
+		if subtle.ConstantTimeCompare([]byte(tc.Token), []byte(token)) == 1 {
+			if tc.Principal != "" {
+				return tc.Principal, tc.Methods, tc.Admin, nil
+			}
+			return fmt.Sprintf("token#%d", i), tc.Methods, tc.Admin, nil
+		}
+	}
+	if len(auth.jwtSecret) > 0 && strings.Count(token, ".") == 2 {
+		claims, err := verifyJWT(token, auth.jwtSecret)
+		if err != nil {
+			return "", nil, false, err
+		}
+		if claims.ExpiresAt != 0 && auth.now().Unix() >= claims.ExpiresAt {
+			return "", nil, false, fmt.Errorf("JWT has expired")
+		}
+		if claims.Subject != "" {
+			return "jwt:" + claims.Subject, claims.Methods, claims.Admin, nil
+		}
+		return "jwt", claims.Methods, claims.Admin, nil
+	}
+	return "", nil, false, fmt.Errorf("invalid bearer token")
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	Methods   []string `json:"methods,omitempty"`
+	Admin     bool     `json:"admin,omitempty"`
+}
+
+// Verify an HS256 JWT returning its claims
+func verifyJWT(token string, secret []byte) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode JWT header: %v", err)
+	}
+	header := new(struct {
+		Alg string `json:"alg"`
+	})
+	err = json.Unmarshal(headerBytes, header)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JWT header: %v", err)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("JWT algorithm %s not supported, only HS256 is", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode JWT signature: %v", err)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("JWT signature is invalid")
+	}
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode JWT claims: %v", err)
+	}
+	claims := new(jwtClaims)
+	err = json.Unmarshal(claimsBytes, claims)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal JWT claims: %v", err)
+	}
+	return claims, nil
+}
+
+func bearerToken(authorization string) string {
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		return strings.TrimSpace(authorization[len(bearerPrefix):])
+	}
+	return ""
+}
+
+// JSON-RPC request bodies larger than this are rejected rather than read to find their method
+const maxHTTPRequestBytes = 4 << 20
+
+type httpMethodKey struct{}
+
+// The method an HTTP request calls: the JSON-RPC method prefixed with '/' for JSON-RPC requests, otherwise the URL path.
+// The body of a JSON-RPC request is read at most once, the returned request carries a copy of it along with the method
+// so that later handlers do not read it again. An error is returned if the body is larger than maxHTTPRequestBytes.
+func httpMethod(w http.ResponseWriter, r *http.Request) (string, *http.Request, error) {
+	if method, ok := r.Context().Value(httpMethodKey{}).(string); ok {
+		return method, r, nil
+	}
+	method := r.URL.Path
+	if r.Method == http.MethodPost && r.URL.Path == "/" && r.Body != nil {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxHTTPRequestBytes))
+		if err != nil {
+			return "", r, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		request := new(struct {
+			Method string `json:"method"`
+		})
+		if json.Unmarshal(body, request) == nil && request.Method != "" {
+			method = "/" + request.Method
+		}
+	}
+	return method, r.WithContext(context.WithValue(r.Context(), httpMethodKey{}, method)), nil
+}
+
+// Whether method is one of methods, where an entry ending in '/' such as "/rpcquery.Query/" matches every method of
+// that service
+func matchesMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == method || strings.HasSuffix(m, "/") && strings.HasPrefix(method, m) {
+			return true
+		}
+	}
+	return false
+}
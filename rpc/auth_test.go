@@ -0,0 +1,278 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	broadcastMethod = "/rpctransact.Transact/BroadcastTxSync"
+	signMethod      = "/rpctransact.Transact/SignTx"
+	queryMethod     = "/rpcquery.Query/GetAccount"
+)
+
+func TestAuthorizer(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{
+		OpenMethods: []string{"/rpcquery.Query/"},
+		Tokens: []*TokenConfig{
+			{Token: "admin"},
+			{Token: "broadcaster", Methods: []string{broadcastMethod}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, authorize(auth, queryMethod, ""))
+	assert.NoError(t, authorize(auth, signMethod, "admin"))
+	assert.NoError(t, authorize(auth, broadcastMethod, "broadcaster"))
+	assertAuthError(t, true, authorize(auth, signMethod, ""))
+	assertAuthError(t, true, authorize(auth, signMethod, "admin2"))
+	assertAuthError(t, false, authorize(auth, signMethod, "broadcaster"))
+	// Methods are matched exactly unless they name a whole service
+	assertAuthError(t, false, authorize(auth, broadcastMethod+"Async", "broadcaster"))
+	assert.NoError(t, authorize(auth, "/rpcquery.Query/ListAccounts", ""))
+	assertAuthError(t, true, authorize(auth, "/rpcquery.QueryAdmin/GetAccount", ""))
+
+	var noAuth *Authorizer
+	assert.NoError(t, authorize(noAuth, signMethod, ""))
+}
+
+func TestAuthorizerAdmin(t *testing.T) {
+	const evictMethod = "/rpcmempool.Mempool/EvictTx"
+	auth, err := NewAuthorizer(&AuthConfig{
+		OpenMethods:  []string{"/rpcmempool.Mempool/", "/rpcquery.Query/"},
+		AdminMethods: []string{"/rpcquery.Query/GetStats"},
+		Tokens: []*TokenConfig{
+			{Token: "any"},
+			{Token: "admin", Admin: true},
+		},
+		JWTSecret: "shh",
+	})
+	require.NoError(t, err)
+
+	// Admin methods are never open
+	assert.NoError(t, authorize(auth, "/rpcmempool.Mempool/ListTxs", ""))
+	assertAuthError(t, true, authorize(auth, evictMethod, ""))
+	assertAuthError(t, true, authorize(auth, "/rpcquery.Query/GetStats", ""))
+	// A token that may call any method still needs to be an admin token
+	assertAuthError(t, false, authorize(auth, evictMethod, "any"))
+	assertAuthError(t, false, authorize(auth, "/rpcquery.Query/GetStats", "any"))
+	assert.NoError(t, authorize(auth, evictMethod, "admin"))
+	assert.NoError(t, authorize(auth, "/rpcquery.Query/GetStats", "admin"))
+
+	assertAuthError(t, false, authorize(auth, evictMethod, makeJWT(t, "shh", `{}`)))
+	assert.NoError(t, authorize(auth, evictMethod, makeJWT(t, "shh", `{"admin":true}`)))
+}
+
+func TestAuthorizerJWT(t *testing.T) {
+	secret := "shh"
+	auth, err := NewAuthorizer(&AuthConfig{JWTSecret: secret})
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	auth.now = func() time.Time { return now }
+
+	assert.NoError(t, authorize(auth, signMethod, makeJWT(t, secret, `{}`)))
+	assert.NoError(t, authorize(auth, signMethod, makeJWT(t, secret, `{"exp":1001}`)))
+	assertAuthError(t, true, authorize(auth, signMethod, makeJWT(t, secret, `{"exp":1000}`)))
+	assertAuthError(t, true, authorize(auth, signMethod, makeJWT(t, "guess", `{}`)))
+
+	token := makeJWT(t, secret, `{"sub":"alice","methods":["/rpcquery.Query/"]}`)
+	principal, err := auth.Authorize(queryMethod, token)
+	require.NoError(t, err)
+	assert.Equal(t, "jwt:alice", principal)
+	assertAuthError(t, false, authorize(auth, signMethod, token))
+}
+
+func TestAuthorizeContext(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{Tokens: []*TokenConfig{{Token: "foo"}}})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer foo"))
+	principal, err := auth.AuthorizeContext(ctx, signMethod)
+	require.NoError(t, err)
+	assert.Equal(t, "token#0", principal)
+
+	_, err = auth.AuthorizeContext(context.Background(), signMethod)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorizerHandler(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{
+		OpenMethods: []string{"/status"},
+		Tokens:      []*TokenConfig{{Token: "foo", Methods: []string{"/account"}}},
+	})
+	require.NoError(t, err)
+	handler := auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	serve := func(path, authorization string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", path, nil)
+		if path == "/" {
+			// JSON-RPC
+			r = httptest.NewRequest("POST", path, strings.NewReader(`{"jsonrpc":"2.0","id":"1","method":"account"}`))
+		}
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, serve("/status", ""))
+	assert.Equal(t, http.StatusOK, serve("/account", "Bearer foo"))
+	assert.Equal(t, http.StatusOK, serve("/", "Bearer foo"))
+	assert.Equal(t, http.StatusUnauthorized, serve("/", ""))
+	assert.Equal(t, http.StatusUnauthorized, serve("/account", ""))
+	assert.Equal(t, http.StatusUnauthorized, serve("/account", "Basic foo"))
+	assert.Equal(t, http.StatusForbidden, serve("/validators", "Bearer foo"))
+	assert.Equal(t, http.StatusForbidden, serve("/accounts", "Bearer foo"))
+	assert.Equal(t, http.StatusUnauthorized, serve("/status_all", ""))
+}
+
+func TestHTTPMethod(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{OpenMethods: []string{"/account"}})
+	require.NoError(t, err)
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{RequestsPerSecond: 10})
+	require.NoError(t, err)
+	body := `{"jsonrpc":"2.0","id":"1","method":"account"}`
+	var received string
+	handler := limiter.Handler(auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = string(bs)
+	})))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, body, received)
+
+	w = httptest.NewRecorder()
+	large := `{"method":"account","params":"` + strings.Repeat("a", maxHTTPRequestBytes) + `"}`
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(large)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestCheckWebsocketRequests(t *testing.T) {
+	assert.Nil(t, CheckWebsocketRequests(nil, nil))
+
+	auth, err := NewAuthorizer(&AuthConfig{
+		OpenMethods: []string{"/status"},
+		Tokens:      []*TokenConfig{{Token: "foo", Principal: "alice", Methods: []string{"/account"}}},
+	})
+	require.NoError(t, err)
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{RequestsPerSecond: 1})
+	require.NoError(t, err)
+	check := CheckWebsocketRequests(auth, limiter)
+
+	r := httptest.NewRequest("GET", "/websocket", nil)
+	anonymous := check(r)
+	r.Header.Set("Authorization", "Bearer foo")
+	alice := check(r)
+
+	assert.NoError(t, anonymous("status"))
+	err = anonymous("account")
+	require.IsType(t, &AuthError{}, err)
+	assert.True(t, err.(*AuthError).Unauthenticated)
+	assert.IsType(t, &RateLimitError{}, anonymous("status"))
+
+	assert.NoError(t, alice("account"))
+	assert.IsType(t, &AuthError{}, alice("validators"))
+	assert.IsType(t, &RateLimitError{}, alice("account"))
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := &TLSConfig{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	}
+	writeSelfSignedCert(t, conf.CertFile, conf.KeyFile)
+
+	tlsConfig, err := conf.ServerTLSConfig()
+	require.NoError(t, err)
+	assert.Len(t, tlsConfig.Certificates, 1)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+
+	conf.ClientCAFile = conf.CertFile
+	tlsConfig, err = conf.ServerTLSConfig()
+	require.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+
+	conf.ClientCAFile = conf.KeyFile
+	_, err = conf.ServerTLSConfig()
+	assert.Error(t, err)
+
+	var noTLS *TLSConfig
+	tlsConfig, err = noTLS.ServerTLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+}
+
+func authorize(auth *Authorizer, method, token string) error {
+	_, err := auth.Authorize(method, token)
+	return err
+}
+
+func assertAuthError(t *testing.T, unauthenticated bool, err error) {
+	t.Helper()
+	require.IsType(t, &AuthError{}, err)
+	assert.Equal(t, unauthenticated, err.(*AuthError).Unauthenticated, err.Error())
+}
+
+func makeJWT(t *testing.T, secret, claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	token := encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encode([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(token))
+	return token + "." + encode(mac.Sum(nil))
+}
+
+func writeSelfSignedCert(t *testing.T, certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "burrow"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0600))
+}
@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcsnapshot"
+	"github.com/hyperledger/burrow/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := &TLSConfig{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	}
+	writeSelfSignedCert(t, conf.CertFile, conf.KeyFile)
+	tlsConfig, err := conf.ServerTLSConfig()
+	require.NoError(t, err)
+	auth, err := NewAuthorizer(&AuthConfig{Tokens: []*TokenConfig{{Token: "foo"}}})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewGRPCServer(tlsConfig, auth, nil, logging.NewNoopLogger())
+	rpcsnapshot.RegisterSnapshotServer(server, rpcsnapshot.NewSnapshotServer(snapshot.NewStore(dir)))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	listSnapshots := func(clientConfig *ClientConfig) error {
+		dialOptions, err := clientConfig.DialOptions()
+		require.NoError(t, err)
+		conn, err := grpc.Dial(listener.Addr().String(), dialOptions...)
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := rpcsnapshot.NewSnapshotClient(conn).ListSnapshots(ctx, &rpcsnapshot.ListSnapshotsParam{})
+		if err != nil {
+			return err
+		}
+		_, err = stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	assert.NoError(t, listSnapshots(&ClientConfig{CAFile: conf.CertFile, Token: "foo"}))
+
+	err = listSnapshots(&ClientConfig{CAFile: conf.CertFile})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The server's certificate is not signed by a system root
+	assert.Error(t, listSnapshots(&ClientConfig{Token: "foo"}))
+
+	// Only talks plaintext
+	assert.Error(t, listSnapshots(&ClientConfig{Token: "foo", Insecure: true}))
+
+	_, err = (&ClientConfig{CAFile: conf.KeyFile}).DialOptions()
+	assert.Error(t, err)
+}
@@ -1,21 +1,33 @@
 package rpc
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // 'localhost' gets interpreted as ipv6
 // TODO: revisit this
 const localhost = "127.0.0.1"
 
+// Event subscriptions allowed per websocket connection to the info server if not configured
+const DefaultMaxSubscriptions = 10
+
 type RPCConfig struct {
 	Info     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Profiler *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
+	Gateway  *GatewayConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
 	Enabled       bool
 	ListenAddress string
+	TLS           *TLSConfig       `json:",omitempty" toml:",omitempty"`
+	Auth          *AuthConfig      `json:",omitempty" toml:",omitempty"`
+	RateLimit     *RateLimitConfig `json:",omitempty" toml:",omitempty"`
+	// Event subscriptions allowed per websocket connection to the info server, 0 for DefaultMaxSubscriptions
+	MaxSubscriptions int `json:",omitempty" toml:",omitempty"`
 }
 
 type ProfilerConfig struct {
@@ -29,10 +41,88 @@ type GRPCConfig struct {
 }
 
 type MetricsConfig struct {
-	Enabled         bool
-	ListenAddress   string
-	MetricsPath     string
-	BlockSampleSize uint64
+	Enabled       bool
+	ListenAddress string
+	MetricsPath   string
+	// Deprecated: metrics are now maintained as blocks are committed rather than sampled
+	BlockSampleSize uint64           `json:",omitempty" toml:",omitempty"`
+	TLS             *TLSConfig       `json:",omitempty" toml:",omitempty"`
+	Auth            *AuthConfig      `json:",omitempty" toml:",omitempty"`
+	RateLimit       *RateLimitConfig `json:",omitempty" toml:",omitempty"`
+}
+
+// Serve the gRPC services to browsers and HTTP clients as gRPC-Web and REST/JSON
+type GatewayConfig struct {
+	Enabled       bool
+	ListenAddress string
+	// Prefix of REST routes, gRPC-Web is always served at /<service>/<method>
+	PathPrefix string
+	// Origins from which browsers may call the gateway, "*" allows any origin
+	AllowedOrigins []string         `json:",omitempty" toml:",omitempty"`
+	TLS            *TLSConfig       `json:",omitempty" toml:",omitempty"`
+	Auth           *AuthConfig      `json:",omitempty" toml:",omitempty"`
+	RateLimit      *RateLimitConfig `json:",omitempty" toml:",omitempty"`
+}
+
+// Serve over TLS rather than plaintext
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// If set clients must present a certificate signed by one of the CAs in this PEM file (mutual TLS)
+	ClientCAFile string `json:",omitempty" toml:",omitempty"`
+}
+
+// Require a bearer token for methods not listed in OpenMethods. Methods are matched exactly against the full gRPC
+// method name, such as "/rpctransact.Transact/BroadcastTxSync", or for HTTP servers the URL path or the JSON-RPC
+// method prefixed with '/', such as "/account". An entry ending in '/', such as "/rpcquery.Query/", matches every
+// method of that service. Admin methods, which include "/rpcmempool.Mempool/EvictTx", may only be called with a token
+// that has Admin set.
+type AuthConfig struct {
+	// Methods that may be called without a token, for example "/rpcquery.Query/"
+	OpenMethods []string `json:",omitempty" toml:",omitempty"`
+	// Further methods that may only be called with an admin token, they are never open
+	AdminMethods []string `json:",omitempty" toml:",omitempty"`
+	// Static bearer tokens
+	Tokens []*TokenConfig `json:",omitempty" toml:",omitempty"`
+	// Secret with which HS256 JWT bearer tokens are signed, a JWT's 'methods' claim may restrict the methods it can call,
+	// its 'admin' claim allows it to call admin methods, and its 'sub' claim identifies its principal
+	JWTSecret string `json:",omitempty" toml:",omitempty"`
+}
+
+// Limit calls per client. gRPC calls are authorized first and limited per authenticated principal, or per IP address
+// for calls without one, so authenticated clients behind a shared NAT or proxy are limited separately. gRPC calls that
+// fail authorization still count against their IP address. Websocket requests are likewise limited by the principal
+// authenticated when the connection was opened if there is one, plain HTTP requests are limited by IP address before
+// they are authorized. Request rates are limited with a token bucket per client for each group of methods.
+type RateLimitConfig struct {
+	// Requests per second allowed for methods not in any group, 0 for unlimited
+	RequestsPerSecond float64
+	// Requests that may be made at once after a period of inactivity, defaults to RequestsPerSecond
+	Burst int `json:",omitempty" toml:",omitempty"`
+	// Concurrent gRPC streams allowed, 0 for unlimited
+	MaxStreams int `json:",omitempty" toml:",omitempty"`
+	// Duration after which gRPC streams are terminated, 0 for unlimited
+	MaxStreamDuration time.Duration `json:",omitempty" toml:",omitempty"`
+	// Groups of methods with their own limits, a method belongs to the first group matching it
+	Groups []*RateLimitGroup `json:",omitempty" toml:",omitempty"`
+}
+
+type RateLimitGroup struct {
+	Name string
+	// Methods belonging to this group, matched in the same way as AuthConfig.OpenMethods
+	Methods           []string
+	RequestsPerSecond float64
+	Burst             int `json:",omitempty" toml:",omitempty"`
+}
+
+type TokenConfig struct {
+	Token string
+	// Identifies the client presenting this token, for example when applying rate limits
+	Principal string `json:",omitempty" toml:",omitempty"`
+	// Methods this token may call, matched in the same way as AuthConfig.OpenMethods, if empty it may call any method
+	Methods []string `json:",omitempty" toml:",omitempty"`
+	// Whether this token may call admin methods
+	Admin bool `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultRPCConfig() *RPCConfig {
@@ -41,13 +131,15 @@ func DefaultRPCConfig() *RPCConfig {
 		Profiler: DefaultProfilerConfig(),
 		GRPC:     DefaultGRPCConfig(),
 		Metrics:  DefaultMetricsConfig(),
+		Gateway:  DefaultGatewayConfig(),
 	}
 }
 
 func DefaultInfoConfig() *ServerConfig {
 	return &ServerConfig{
-		Enabled:       true,
-		ListenAddress: fmt.Sprintf("tcp://%s:26658", localhost),
+		Enabled:          true,
+		ListenAddress:    fmt.Sprintf("tcp://%s:26658", localhost),
+		MaxSubscriptions: DefaultMaxSubscriptions,
 	}
 }
 
@@ -67,9 +159,16 @@ func DefaultProfilerConfig() *ServerConfig {
 
 func DefaultMetricsConfig() *MetricsConfig {
 	return &MetricsConfig{
-		Enabled:         false,
-		ListenAddress:   fmt.Sprintf("tcp://%s:9102", localhost),
-		MetricsPath:     "/metrics",
-		BlockSampleSize: 100,
+		Enabled:       false,
+		ListenAddress: fmt.Sprintf("tcp://%s:9102", localhost),
+		MetricsPath:   "/metrics",
+	}
+}
+
+func DefaultGatewayConfig() *GatewayConfig {
+	return &GatewayConfig{
+		Enabled:       false,
+		ListenAddress: fmt.Sprintf("tcp://%s:10998", localhost),
+		PathPrefix:    DefaultGatewayPathPrefix,
 	}
 }
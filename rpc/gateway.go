@@ -0,0 +1,518 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultGatewayPathPrefix = "/api"
+
+// Matches the default maximum message size of a grpc.Server
+const maxGatewayRequestBytes = 4 << 20
+
+// Methods that only read state, which may be called over REST with GET as well as POST. Entries are matched in the same
+// way as AuthConfig.OpenMethods.
+var gatewayReadOnlyMethods = []string{
+	"/rpcquery.Query/",
+	"/rpcevents.ExecutionEvents/",
+	"/rpcdump.Dump/",
+	"/rpcsnapshot.Snapshot/",
+	"/rpcmempool.Mempool/ListTxs",
+	"/rpcmempool.Mempool/GetTx",
+	"/rpcmempool.Mempool/Stream",
+}
+
+// A gRPC message is framed by a compression flag byte followed by its big-endian length, gRPC-Web uses the same
+// framing for messages and sends trailers in a final frame with the high bit of the flag set
+const grpcFrameHeaderLength = 5
+
+// Gateway serves the services registered with a grpc.Server to HTTP clients as gRPC-Web and as REST/JSON, passing
+// each call through the server so that its interceptors apply as they do to gRPC clients.
+//
+// Routes are the full method names from the services' protobuf definitions: gRPC-Web requests, identified by their
+// application/grpc-web content type, are served at /<service>/<method> and REST requests at
+// <prefix>/<service>/<method>, for example POST /api/rpcquery.Query/GetAccount with the request message as JSON. REST
+// POSTs must have an application/json content type, which browsers cannot send cross-origin without a CORS preflight.
+// Methods that only read state may also be called with GET, which like an empty body calls the method with an empty
+// request. Server streaming methods respond over REST with a line of
+// JSON per message or, if the client accepts text/event-stream, with server-sent events. GET <prefix> lists the
+// routes.
+type Gateway struct {
+	server         *grpc.Server
+	pathPrefix     string
+	allowedOrigins []string
+	logger         *logging.Logger
+	// REST methods by full method name
+	methods map[string]*gatewayMethod
+}
+
+type gatewayMethod struct {
+	fullMethod      string
+	serverStreaming bool
+	// Pointer types of the request and response messages
+	requestType  reflect.Type
+	responseType reflect.Type
+}
+
+type gatewayRoute struct {
+	Method          string
+	Path            string
+	ServerStreaming bool
+}
+
+// NewGateway serves the services registered with server, they must be registered before calling NewGateway
+func NewGateway(server *grpc.Server, conf *GatewayConfig, logger *logging.Logger) *Gateway {
+	pathPrefix := strings.TrimSuffix(conf.PathPrefix, "/")
+	if pathPrefix == "" {
+		pathPrefix = DefaultGatewayPathPrefix
+	}
+	gw := &Gateway{
+		server:         server,
+		pathPrefix:     pathPrefix,
+		allowedOrigins: conf.AllowedOrigins,
+		logger:         logger.With(structure.ComponentKey, "Gateway"),
+		methods:        make(map[string]*gatewayMethod),
+	}
+	for serviceName, info := range server.GetServiceInfo() {
+		err := gw.addService(serviceName, info)
+		if err != nil {
+			gw.logger.InfoMsg("could not serve service over REST", structure.ErrorKey, err,
+				"service", serviceName)
+		}
+	}
+	return gw
+}
+
+// Derives the REST methods of a service from the protobuf file descriptor registered for it
+func (gw *Gateway) addService(serviceName string, info grpc.ServiceInfo) error {
+	filename, _ := info.Metadata.(string)
+	fd, err := fileDescriptor(filename)
+	if err != nil {
+		return err
+	}
+	for _, sd := range fd.GetService() {
+		if fd.GetPackage()+"."+sd.GetName() != serviceName {
+			continue
+		}
+		for _, md := range sd.GetMethod() {
+			if md.GetClientStreaming() {
+				// Neither gRPC-Web nor REST requests can stream
+				continue
+			}
+			method := &gatewayMethod{
+				fullMethod:      fmt.Sprintf("/%s/%s", serviceName, md.GetName()),
+				serverStreaming: md.GetServerStreaming(),
+				requestType:     proto.MessageType(strings.TrimPrefix(md.GetInputType(), ".")),
+				responseType:    proto.MessageType(strings.TrimPrefix(md.GetOutputType(), ".")),
+			}
+			if method.requestType == nil || method.responseType == nil {
+				gw.logger.InfoMsg("could not find message types for method", "method", method.fullMethod)
+				continue
+			}
+			gw.methods[method.fullMethod] = method
+		}
+		return nil
+	}
+	return fmt.Errorf("service %s not found in %s", serviceName, filename)
+}
+
+func fileDescriptor(filename string) (*descriptor.FileDescriptorProto, error) {
+	compressed := proto.FileDescriptor(filename)
+	if compressed == nil {
+		return nil, fmt.Errorf("no file descriptor registered for '%s'", filename)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	fd := new(descriptor.FileDescriptorProto)
+	err = proto.Unmarshal(bs, fd)
+	if err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gw.cors(w, r)
+	switch {
+	case r.Method == http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType):
+		gw.serveGRPCWeb(w, r)
+	default:
+		gw.serveREST(w, r)
+	}
+}
+
+func (gw *Gateway) serveREST(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, gw.pathPrefix)
+	if len(path) == len(r.URL.Path) {
+		http.NotFound(w, r)
+		return
+	}
+	if path == "" || path == "/" {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, r, http.MethodGet)
+			return
+		}
+		gw.serveRoutes(w)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "application/json" {
+			http.Error(w, "REST requests must have content type application/json", http.StatusUnsupportedMediaType)
+			return
+		}
+	case http.MethodGet:
+		if !matchesMethod(gatewayReadOnlyMethods, path) {
+			methodNotAllowed(w, r, http.MethodPost)
+			return
+		}
+	default:
+		if matchesMethod(gatewayReadOnlyMethods, path) {
+			methodNotAllowed(w, r, http.MethodGet, http.MethodPost)
+		} else {
+			methodNotAllowed(w, r, http.MethodPost)
+		}
+		return
+	}
+	method, ok := gw.methods[path]
+	rw := newRESTWriter(w, r, ok && method.serverStreaming)
+	if !ok {
+		rw.finish(status.New(codes.Unimplemented, fmt.Sprintf("unknown method %s", path)))
+		return
+	}
+	var body []byte
+	if r.Method == http.MethodPost {
+		var err error
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, maxGatewayRequestBytes))
+		if err != nil {
+			rw.finish(status.New(codes.InvalidArgument, fmt.Sprintf("could not read request: %v", err)))
+			return
+		}
+	}
+	request := reflect.New(method.requestType.Elem()).Interface().(proto.Message)
+	if len(bytes.TrimSpace(body)) > 0 {
+		err := json.Unmarshal(body, request)
+		if err != nil {
+			rw.finish(status.New(codes.InvalidArgument, fmt.Sprintf("could not decode request: %v", err)))
+			return
+		}
+	}
+	data, err := proto.Marshal(request)
+	if err != nil {
+		rw.finish(status.New(codes.InvalidArgument, fmt.Sprintf("could not encode request: %v", err)))
+		return
+	}
+	rw.finish(gw.invoke(r, method.fullMethod, bytes.NewReader(grpcFrame(0, data)), func(data []byte) error {
+		response := reflect.New(method.responseType.Elem()).Interface().(proto.Message)
+		err := proto.Unmarshal(data, response)
+		if err != nil {
+			return err
+		}
+		return rw.send(response)
+	}))
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+}
+
+// Lists the REST routes
+func (gw *Gateway) serveRoutes(w http.ResponseWriter) {
+	routes := make([]gatewayRoute, 0, len(gw.methods))
+	for fullMethod, method := range gw.methods {
+		routes = append(routes, gatewayRoute{
+			Method:          fullMethod,
+			Path:            gw.pathPrefix + fullMethod,
+			ServerStreaming: method.serverStreaming,
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Method < routes[j].Method
+	})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(routes)
+}
+
+// Calls fullMethod on the gRPC server with body holding the framed request message, passes each response message to
+// send, and returns the status of the call. The request's headers are passed as metadata and its remote address as
+// the peer so that calls are authorized, rate limited and traced as over gRPC.
+func (gw *Gateway) invoke(r *http.Request, fullMethod string, body io.Reader, send func([]byte) error) *status.Status {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	req, err := http.NewRequest(http.MethodPost, fullMethod, body)
+	if err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+	req = req.WithContext(ctx)
+	req.ProtoMajor, req.ProtoMinor = 2, 0
+	req.RemoteAddr = r.RemoteAddr
+	req.Host = r.Host
+	for key, values := range r.Header {
+		switch key {
+		case "Content-Type", "Content-Length", "Accept", "Accept-Encoding", "Connection":
+		default:
+			req.Header[key] = values
+		}
+	}
+	req.Header.Set("Content-Type", "application/grpc+proto")
+	rw := &grpcResponseWriter{
+		ctx:    ctx,
+		cancel: cancel,
+		header: make(http.Header),
+		send:   send,
+	}
+	gw.server.ServeHTTP(rw, req)
+	return rw.status()
+}
+
+func (gw *Gateway) cors(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" || !gw.allowedOrigin(origin) {
+		return
+	}
+	header := w.Header()
+	header.Set("Access-Control-Allow-Origin", origin)
+	header.Add("Vary", "Origin")
+	header.Set("Access-Control-Expose-Headers", "Grpc-Status, Grpc-Message")
+	if r.Method == http.MethodOptions {
+		header.Set("Access-Control-Allow-Methods", "GET, POST")
+		header.Set("Access-Control-Allow-Headers",
+			"Authorization, Content-Type, Traceparent, X-Grpc-Web, X-User-Agent, Grpc-Timeout")
+		header.Set("Access-Control-Max-Age", "600")
+	}
+}
+
+func (gw *Gateway) allowedOrigin(origin string) bool {
+	for _, allowed := range gw.allowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func grpcFrame(flag byte, data []byte) []byte {
+	frame := make([]byte, grpcFrameHeaderLength+len(data))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	copy(frame[grpcFrameHeaderLength:], data)
+	return frame
+}
+
+// Receives the response from grpc.Server.ServeHTTP, unframing messages as they are written
+type grpcResponseWriter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	header http.Header
+	send   func([]byte) error
+	buffer []byte
+	// Set if the server rejects the request before it reaches the gRPC transport
+	statusCode int
+	// Set if send fails, in which case the call is cancelled
+	err error
+}
+
+var _ http.Flusher = &grpcResponseWriter{}
+var _ http.CloseNotifier = &grpcResponseWriter{}
+
+func (rw *grpcResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *grpcResponseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+}
+
+func (rw *grpcResponseWriter) Write(bs []byte) (int, error) {
+	if rw.err != nil {
+		return 0, rw.err
+	}
+	rw.buffer = append(rw.buffer, bs...)
+	if rw.statusCode != 0 && rw.statusCode != http.StatusOK {
+		return len(bs), nil
+	}
+	for len(rw.buffer) >= grpcFrameHeaderLength {
+		length := int(binary.BigEndian.Uint32(rw.buffer[1:grpcFrameHeaderLength]))
+		if len(rw.buffer) < grpcFrameHeaderLength+length {
+			break
+		}
+		rw.err = rw.send(rw.buffer[grpcFrameHeaderLength : grpcFrameHeaderLength+length])
+		if rw.err != nil {
+			rw.cancel()
+			return 0, rw.err
+		}
+		rw.buffer = rw.buffer[grpcFrameHeaderLength+length:]
+	}
+	return len(bs), nil
+}
+
+func (rw *grpcResponseWriter) Flush() {
+}
+
+func (rw *grpcResponseWriter) CloseNotify() <-chan bool {
+	closed := make(chan bool, 1)
+	go func() {
+		<-rw.ctx.Done()
+		closed <- true
+	}()
+	return closed
+}
+
+func (rw *grpcResponseWriter) status() *status.Status {
+	switch {
+	case rw.err != nil:
+		return status.New(codes.Canceled, fmt.Sprintf("could not send response: %v", rw.err))
+	case rw.statusCode != 0 && rw.statusCode != http.StatusOK:
+		return status.New(codes.Internal, strings.TrimSpace(string(rw.buffer)))
+	}
+	code, err := strconv.Atoi(rw.header.Get("Grpc-Status"))
+	if err != nil {
+		return status.New(codes.Internal, "gRPC server did not return a status")
+	}
+	message, err := url.PathUnescape(rw.header.Get("Grpc-Message"))
+	if err != nil {
+		message = rw.header.Get("Grpc-Message")
+	}
+	return status.New(codes.Code(code), message)
+}
+
+// The body of REST error responses
+type gatewayError struct {
+	Code    uint32 `json:"code"`
+	Message string `json:"message"`
+}
+
+// Writes REST responses, a single JSON value for unary calls and newline-delimited JSON or server-sent events for
+// server streaming calls
+type restWriter struct {
+	w         http.ResponseWriter
+	flusher   http.Flusher
+	streaming bool
+	sse       bool
+	sent      int
+}
+
+func newRESTWriter(w http.ResponseWriter, r *http.Request, streaming bool) *restWriter {
+	flusher, _ := w.(http.Flusher)
+	return &restWriter{
+		w:         w,
+		flusher:   flusher,
+		streaming: streaming,
+		sse:       streaming && strings.Contains(r.Header.Get("Accept"), "text/event-stream"),
+	}
+}
+
+func (rw *restWriter) send(msg interface{}) error {
+	bs, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	rw.writeHeader()
+	rw.sent++
+	if rw.sse {
+		_, err = fmt.Fprintf(rw.w, "data: %s\n\n", bs)
+	} else {
+		_, err = rw.w.Write(append(bs, '\n'))
+	}
+	if rw.flusher != nil {
+		rw.flusher.Flush()
+	}
+	return err
+}
+
+func (rw *restWriter) finish(st *status.Status) {
+	if st.Code() == codes.OK {
+		rw.writeHeader()
+		return
+	}
+	bs, _ := json.Marshal(&gatewayError{Code: uint32(st.Code()), Message: st.Message()})
+	switch {
+	case rw.sent == 0:
+		rw.w.Header().Set("Content-Type", "application/json")
+		rw.w.WriteHeader(httpStatusFromCode(st.Code()))
+		rw.w.Write(append(bs, '\n'))
+	case !rw.streaming:
+		// Writing the response failed so there is no way to report the error
+	case rw.sse:
+		fmt.Fprintf(rw.w, "event: error\ndata: %s\n\n", bs)
+	default:
+		// The status has been sent so the error is the last line of the stream
+		fmt.Fprintf(rw.w, "{\"error\":%s}\n", bs)
+	}
+}
+
+func (rw *restWriter) writeHeader() {
+	if rw.sent > 0 {
+		return
+	}
+	contentType := "application/json"
+	switch {
+	case rw.sse:
+		contentType = "text/event-stream"
+	case rw.streaming:
+		contentType = "application/x-ndjson"
+	}
+	rw.w.Header().Set("Content-Type", contentType)
+	rw.w.WriteHeader(http.StatusOK)
+}
+
+// Maps gRPC status codes to HTTP status codes in the same way as grpc-gateway
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
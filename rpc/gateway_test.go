@@ -0,0 +1,255 @@
+package rpc
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGatewayREST(t *testing.T) {
+	server := newTestGateway(t, nil)
+	defer server.Close()
+
+	resp := post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", `{"TxHash":"ABCD"}`, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	txe := new(exec.TxExecution)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(txe))
+	assert.Equal(t, "ABCD", txe.TxHash.String())
+
+	resp = post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	gwErr := new(gatewayError)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(gwErr))
+	assert.Equal(t, uint32(codes.NotFound), gwErr.Code)
+	assert.Equal(t, "no such tx", gwErr.Message)
+
+	resp = post(t, server.URL+"/api/rpcevents.ExecutionEvents/Nope", "", nil)
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	resp, err := http.Get(server.URL + "/api")
+	require.NoError(t, err)
+	var routes []gatewayRoute
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&routes))
+	assert.Contains(t, routes, gatewayRoute{
+		Method:          "/rpcevents.ExecutionEvents/Stream",
+		Path:            "/api/rpcevents.ExecutionEvents/Stream",
+		ServerStreaming: true,
+	})
+}
+
+func TestGatewayRESTMethods(t *testing.T) {
+	server := newTestGateway(t, nil)
+	defer server.Close()
+
+	for _, contentType := range []string{"", "text/plain", "application/x-www-form-urlencoded"} {
+		resp := post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", `{"TxHash":"ABCD"}`, http.Header{
+			"Content-Type": []string{contentType},
+		})
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode, contentType)
+	}
+	resp := post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", `{"TxHash":"ABCD"}`, http.Header{
+		"Content-Type": []string{"application/json; charset=utf-8"},
+	})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Read-only methods may be called with GET
+	resp, err := http.Get(server.URL + "/api/rpcevents.ExecutionEvents/Tx")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/api/rpctransact.Transact/BroadcastTxSync")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	assert.Equal(t, "POST", resp.Header.Get("Allow"))
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/rpcevents.ExecutionEvents/Tx", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	assert.Equal(t, "GET, POST", resp.Header.Get("Allow"))
+}
+
+func TestGatewayRESTStream(t *testing.T) {
+	server := newTestGateway(t, nil)
+	defer server.Close()
+
+	resp := post(t, server.URL+"/api/rpcevents.ExecutionEvents/Stream", `{"Query":"fail"}`, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	lines := readLines(t, resp)
+	require.Len(t, lines, 4)
+	for i, line := range lines[:3] {
+		ev := new(exec.StreamEvent)
+		require.NoError(t, json.Unmarshal([]byte(line), ev))
+		assert.Equal(t, uint64(i+1), ev.BeginBlock.Height)
+	}
+	assert.Equal(t, `{"error":{"code":10,"message":"stream failed"}}`, lines[3])
+
+	resp = post(t, server.URL+"/api/rpcevents.ExecutionEvents/Stream", "", http.Header{
+		"Accept": []string{"text/event-stream"},
+	})
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	lines = readLines(t, resp)
+	require.Len(t, lines, 6)
+	assert.Equal(t, `data: {"BeginBlock":{"Height":1}}`, lines[0])
+	assert.Equal(t, "", lines[1])
+}
+
+func TestGatewayGRPCWeb(t *testing.T) {
+	server := newTestGateway(t, nil)
+	defer server.Close()
+
+	request, err := proto.Marshal(&rpcevents.TxRequest{TxHash: []byte{0xab, 0xcd}})
+	require.NoError(t, err)
+	resp := post(t, server.URL+"/rpcevents.ExecutionEvents/Tx", string(grpcFrame(0, request)), http.Header{
+		"Content-Type": []string{grpcWebContentType},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/grpc-web+proto", resp.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	frames := readFrames(t, body)
+	require.Len(t, frames, 2)
+	txe := new(exec.TxExecution)
+	require.NoError(t, proto.Unmarshal(frames[0], txe))
+	assert.Equal(t, "ABCD", txe.TxHash.String())
+	assert.Equal(t, "grpc-status: 0\r\ngrpc-message: \r\n", string(frames[1]))
+
+	// Text encoding
+	resp = post(t, server.URL+"/rpcevents.ExecutionEvents/Stream",
+		base64.StdEncoding.EncodeToString(grpcFrame(0, nil)), http.Header{
+			"Content-Type": []string{grpcWebTextContentType},
+		})
+	assert.Equal(t, "application/grpc-web-text+proto", resp.Header.Get("Content-Type"))
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var decoded []byte
+	// Each frame is encoded separately so may be padded, but always in whole quanta of 4 characters
+	for i := 0; i < len(body); i += 4 {
+		bs, err := base64.StdEncoding.DecodeString(string(body[i : i+4]))
+		require.NoError(t, err)
+		decoded = append(decoded, bs...)
+	}
+	frames = readFrames(t, decoded)
+	require.Len(t, frames, 4)
+	assert.Equal(t, "grpc-status: 0\r\ngrpc-message: \r\n", string(frames[3]))
+}
+
+func TestGatewayAuthorization(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{
+		OpenMethods: []string{"/rpcevents.ExecutionEvents/Stream"},
+		Tokens:      []*TokenConfig{{Token: "secret"}},
+	})
+	require.NoError(t, err)
+	server := newTestGateway(t, auth)
+	defer server.Close()
+
+	resp := post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", `{"TxHash":"ABCD"}`, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = post(t, server.URL+"/api/rpcevents.ExecutionEvents/Tx", `{"TxHash":"ABCD"}`, http.Header{
+		"Authorization": []string{"Bearer secret"},
+	})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = post(t, server.URL+"/api/rpcevents.ExecutionEvents/Stream", "", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// CORS preflight
+	req, err := http.NewRequest(http.MethodOptions, server.URL+"/rpcevents.ExecutionEvents/Tx", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://example.com")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "https://example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Headers"), "Authorization")
+}
+
+func newTestGateway(t *testing.T, auth *Authorizer) *httptest.Server {
+	grpcServer := NewGRPCServer(nil, auth, nil, logging.NewNoopLogger())
+	rpcevents.RegisterExecutionEventsServer(grpcServer, testEventsServer{})
+	gateway := NewGateway(grpcServer, &GatewayConfig{AllowedOrigins: []string{"https://example.com"}},
+		logging.NewNoopLogger())
+	return httptest.NewServer(gateway)
+}
+
+func post(t *testing.T, url, body string, header http.Header) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func readLines(t *testing.T, resp *http.Response) []string {
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func readFrames(t *testing.T, body []byte) [][]byte {
+	var frames [][]byte
+	for len(body) > 0 {
+		require.True(t, len(body) >= grpcFrameHeaderLength)
+		length := int(binary.BigEndian.Uint32(body[1:grpcFrameHeaderLength]))
+		require.True(t, len(body) >= grpcFrameHeaderLength+length)
+		frames = append(frames, body[grpcFrameHeaderLength:grpcFrameHeaderLength+length])
+		body = body[grpcFrameHeaderLength+length:]
+	}
+	return frames
+}
+
+type testEventsServer struct{}
+
+func (tes testEventsServer) Tx(ctx context.Context, request *rpcevents.TxRequest) (*exec.TxExecution, error) {
+	if len(request.TxHash) == 0 {
+		return nil, status.Error(codes.NotFound, "no such tx")
+	}
+	return &exec.TxExecution{TxHeader: &exec.TxHeader{TxHash: request.TxHash}}, nil
+}
+
+func (tes testEventsServer) Stream(request *rpcevents.BlocksRequest,
+	stream rpcevents.ExecutionEvents_StreamServer) error {
+
+	for height := uint64(1); height <= 3; height++ {
+		err := stream.Send(&exec.StreamEvent{BeginBlock: &exec.BeginBlock{Height: height}})
+		if err != nil {
+			return err
+		}
+	}
+	if request.Query == "fail" {
+		return status.Error(codes.Aborted, "stream failed")
+	}
+	return nil
+}
+
+func (tes testEventsServer) Events(request *rpcevents.BlocksRequest,
+	stream rpcevents.ExecutionEvents_EventsServer) error {
+	return nil
+}
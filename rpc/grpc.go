@@ -1,26 +1,43 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"runtime/debug"
+	"time"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/tracing"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
-func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))))
+// NewGRPCServer serves over TLS if tlsConfig is non-nil, authorizes calls with auth if it is non-nil and applies the
+// rate limits of limiter if it is non-nil
+func NewGRPCServer(tlsConfig *tls.Config, auth *Authorizer, limiter *RateLimiter,
+	logger *logging.Logger) *grpc.Server {
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(unaryInterceptor(auth, limiter, logger)),
+		grpc.StreamInterceptor(streamInterceptor(auth, limiter, logger.WithScope("NewGRPCServer"))),
+	}
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	return grpc.NewServer(options...)
 }
 
-func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
+func unaryInterceptor(auth *Authorizer, limiter *RateLimiter, logger *logging.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
 		logger = logger.With("method", info.FullMethod)
 
+		defer observeDuration(info.FullMethod, time.Now(), &err)
+		ctx, span := tracing.Start(ctx, info.FullMethod)
+		defer endSpan(span, &err)
 		defer func() {
 			if r := recover(); r != nil {
 				logger.InfoMsg("panic in GRPC unary call", structure.ErrorKey, fmt.Sprintf("%v", r))
@@ -28,17 +45,28 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC unary call")
-		return handler(ctx, req)
+		principal, client, err := authorizeGRPC(ctx, auth, limiter, info.FullMethod)
+		if err == nil {
+			err = limiter.Allow(client, info.FullMethod)
+		}
+		if err != nil {
+			logger.InfoMsg("GRPC unary call rejected", structure.ErrorKey, err)
+			return nil, err
+		}
+		return handler(WithPrincipal(ctx, principal), req)
 	}
 }
 
-func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
+func streamInterceptor(auth *Authorizer, limiter *RateLimiter, logger *logging.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		logger = logger.With("method", info.FullMethod,
 			"is_client_stream", info.IsClientStream,
 			"is_server_stream", info.IsServerStream)
 
+		defer observeDuration(info.FullMethod, time.Now(), &err)
+		ctx, span := tracing.Start(ss.Context(), info.FullMethod)
+		defer endSpan(span, &err)
 		defer func() {
 			if r := recover(); r != nil {
 				logger.InfoMsg("panic in GRPC stream", structure.ErrorKey, fmt.Sprintf("%v", r))
@@ -46,6 +74,59 @@ func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 			}
 		}()
 		logger.TraceMsg("GRPC stream call")
+		principal, client, err := authorizeGRPC(ctx, auth, limiter, info.FullMethod)
+		if err != nil {
+			logger.InfoMsg("GRPC stream rejected", structure.ErrorKey, err)
+			return err
+		}
+		release, err := limiter.StartStream(client, info.FullMethod)
+		if err != nil {
+			logger.InfoMsg("GRPC stream rejected", structure.ErrorKey, err)
+			return err
+		}
+		defer release()
+		ss = &contextStream{ServerStream: ss, ctx: WithPrincipal(ctx, principal)}
+		ss, cancel := limiter.limitStream(ss, client, info.FullMethod)
+		defer cancel()
 		return handler(srv, ss)
 	}
 }
+
+// Authorizes a gRPC call returning its principal and the client to rate limit it as, which is the principal if it
+// authenticated and its IP address otherwise so that authenticated clients sharing an address are limited separately.
+// Calls that fail authorization are still counted against their address so that tokens cannot be guessed at will.
+func authorizeGRPC(ctx context.Context, auth *Authorizer, limiter *RateLimiter, method string) (string, string, error) {
+	principal, err := auth.AuthorizeContext(ctx, method)
+	if err != nil {
+		limitErr := limiter.Allow(grpcClient(ctx), method)
+		if limitErr != nil {
+			return "", "", limitErr
+		}
+		return "", "", err
+	}
+	if principal != "" {
+		return principal, principal, nil
+	}
+	return "", grpcClient(ctx), nil
+}
+
+// Must be deferred before recovering from panics in order to see the final error
+func observeDuration(method string, start time.Time, err *error) {
+	grpcDuration.WithLabelValues(method, status.Code(*err).String()).Observe(time.Since(start).Seconds())
+}
+
+// Must be deferred before recovering from panics in order to see the final error
+func endSpan(span *tracing.Span, err *error) {
+	span.SetError(*err)
+	span.End()
+}
+
+// Replaces the context of a stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (cs *contextStream) Context() context.Context {
+	return cs.ctx
+}
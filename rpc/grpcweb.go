@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC-Web content types, the text variant base64 encodes the frames
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+)
+
+const grpcWebTrailerFlag = 0x80
+
+func (gw *Gateway) serveGRPCWeb(w http.ResponseWriter, r *http.Request) {
+	ww := newGRPCWebWriter(w, strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebTextContentType))
+	if r.Method != http.MethodPost {
+		ww.finish(status.New(codes.Unimplemented, "gRPC-Web requests must be POSTed"))
+		return
+	}
+	var body io.Reader = io.LimitReader(r.Body, maxGatewayRequestBytes+grpcFrameHeaderLength)
+	if ww.text {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	// Messages are framed in the same way as gRPC so the request and responses pass straight through
+	ww.finish(gw.invoke(r, r.URL.Path, body, func(data []byte) error {
+		return ww.writeFrame(0, data)
+	}))
+}
+
+// Writes gRPC-Web responses, the status is always sent in a trailer frame
+type grpcWebWriter struct {
+	w           http.ResponseWriter
+	flusher     http.Flusher
+	text        bool
+	wroteHeader bool
+}
+
+func newGRPCWebWriter(w http.ResponseWriter, text bool) *grpcWebWriter {
+	flusher, _ := w.(http.Flusher)
+	return &grpcWebWriter{
+		w:       w,
+		flusher: flusher,
+		text:    text,
+	}
+}
+
+func (ww *grpcWebWriter) finish(st *status.Status) {
+	trailer := fmt.Sprintf("grpc-status: %d\r\ngrpc-message: %s\r\n", st.Code(), url.PathEscape(st.Message()))
+	ww.writeFrame(grpcWebTrailerFlag, []byte(trailer))
+}
+
+func (ww *grpcWebWriter) writeFrame(flag byte, data []byte) error {
+	if !ww.wroteHeader {
+		contentType := grpcWebContentType + "+proto"
+		if ww.text {
+			contentType = grpcWebTextContentType + "+proto"
+		}
+		ww.w.Header().Set("Content-Type", contentType)
+		ww.w.WriteHeader(http.StatusOK)
+		ww.wroteHeader = true
+	}
+	frame := grpcFrame(flag, data)
+	var err error
+	if ww.text {
+		_, err = io.WriteString(ww.w, base64.StdEncoding.EncodeToString(frame))
+	} else {
+		_, err = ww.w.Write(frame)
+	}
+	if ww.flusher != nil {
+		ww.flusher.Flush()
+	}
+	return err
+}
@@ -117,7 +117,7 @@ func setup() {
 	wm := server.NewWebsocketManager(Routes, logger, server.ReadWait(5*time.Second), server.PingPeriod(1*time.Second))
 	mux.HandleFunc(websocketEndpoint, wm.WebsocketHandler)
 	go func() {
-		_, err := server.StartHTTPServer(tcpAddr, mux, tcpLogger)
+		_, err := server.StartHTTPServer(tcpAddr, mux, nil, tcpLogger)
 		if err != nil {
 			panic(err)
 		}
@@ -129,7 +129,7 @@ func setup() {
 	wm = server.NewWebsocketManager(Routes, logger)
 	mux2.HandleFunc(websocketEndpoint, wm.WebsocketHandler)
 	go func() {
-		_, err := server.StartHTTPServer(unixAddr, mux2, unixLogger)
+		_, err := server.StartHTTPServer(unixAddr, mux2, nil, unixLogger)
 		if err != nil {
 			panic(err)
 		}
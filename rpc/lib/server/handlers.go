@@ -388,6 +388,9 @@ type wsConnection struct {
 
 	// object that is used to subscribe / unsubscribe from events
 	eventSub types.EventSubscriber
+
+	// checks the method of each request before it is called
+	checkRequest func(method string) error
 }
 
 // NewWSConnection wraps websocket.Conn.
@@ -457,6 +460,14 @@ func PingPeriod(pingPeriod time.Duration) func(*wsConnection) {
 	}
 }
 
+// CheckRequest sets a function called with the method of each request received, the request is rejected with the
+// error it returns. It should only be used in the constructor - not Goroutine-safe.
+func CheckRequest(checkRequest func(method string) error) func(*wsConnection) {
+	return func(wsc *wsConnection) {
+		wsc.checkRequest = checkRequest
+	}
+}
+
 // OnStart implements cmn.Service by starting the read and write routines. It
 // blocks until the connection closes.
 func (wsc *wsConnection) OnStart() error {
@@ -568,6 +579,14 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
+			if wsc.checkRequest != nil {
+				err = wsc.checkRequest(request.Method)
+				if err != nil {
+					wsc.WriteRPCResponse(types.RPCServerError(request.ID, err))
+					continue
+				}
+			}
+
 			// Now, fetch the RPCFunc and execute it.
 
 			rpcFunc := wsc.funcMap[request.Method]
@@ -578,9 +597,12 @@ func (wsc *wsConnection) readRoutine() {
 			var args []reflect.Value
 			if rpcFunc.ws {
 				wsCtx := types.WSRPCContext{Request: request, WSRPCConnection: wsc}
-				if len(request.Params) > 0 {
-					args, err = jsonParamsToArgsWS(rpcFunc, request.Params, wsCtx)
+				params := request.Params
+				if len(params) == 0 {
+					// Websocket functions always take the context so call them with defaults for missing parameters
+					params = json.RawMessage("{}")
 				}
+				args, err = jsonParamsToArgsWS(rpcFunc, params, wsCtx)
 			} else {
 				if len(request.Params) > 0 {
 					args, err = jsonParamsToArgsRPC(rpcFunc, request.Params)
@@ -678,6 +700,8 @@ type WebsocketManager struct {
 	funcMap       map[string]*RPCFunc
 	logger        *logging.Logger
 	wsConnOptions []func(*wsConnection)
+	// If set returns the function with which to check requests on a connection upgraded from an HTTP request
+	CheckRequests func(r *http.Request) func(method string) error
 }
 
 // NewWebsocketManager returns a new WebsocketManager that routes according to
@@ -708,7 +732,11 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 	}
 
 	// register connection
-	con := NewWSConnection(wsConn, wm.funcMap, wm.logger, wm.wsConnOptions...)
+	options := wm.wsConnOptions
+	if wm.CheckRequests != nil {
+		options = append(options[:len(options):len(options)], CheckRequest(wm.CheckRequests(r)))
+	}
+	con := NewWSConnection(wsConn, wm.funcMap, wm.logger, options...)
 	wm.logger.InfoMsg("New websocket connection", "remote_address", con.remoteAddr)
 	err = con.Start() // Blocking
 	if err != nil {
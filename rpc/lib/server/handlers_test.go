@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/logging"
+	"github.com/gorilla/websocket"
 	"github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,3 +92,40 @@ func TestRPCNotification(t *testing.T) {
 	require.Nil(t, err, "reading from the body should not give back an error")
 	require.Equal(t, len(blob), 0, "a notification SHOULD NOT be responded to by the server")
 }
+
+func TestWebsocketCheckRequests(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func(s string, i int) (string, error) { return "foo", nil }, "s,i"),
+		"d": NewRPCFunc(func(s string, i int) (string, error) { return "bar", nil }, "s,i"),
+	}
+	wm := NewWebsocketManager(funcMap, logging.NewNoopLogger())
+	wm.CheckRequests = func(r *http.Request) func(method string) error {
+		user := r.Header.Get("User")
+		return func(method string) error {
+			if method == "d" && user != "admin" {
+				return fmt.Errorf("%s may not call %s", user, method)
+			}
+			return nil
+		}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(wm.WebsocketHandler))
+	defer srv.Close()
+
+	call := func(user, method string) *types.RPCResponse {
+		header := http.Header{}
+		header.Set("User", user)
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), header)
+		require.NoError(t, err)
+		defer conn.Close()
+		require.NoError(t, conn.WriteJSON(types.RPCRequest{JSONRPC: "2.0", ID: "0", Method: method,
+			Params: json.RawMessage(`["a", 1]`)}))
+		response := new(types.RPCResponse)
+		require.NoError(t, conn.ReadJSON(response))
+		return response
+	}
+	assert.Nil(t, call("bob", "c").Error)
+	response := call("bob", "d")
+	require.NotNil(t, response.Error)
+	assert.Contains(t, response.Error.Data, "bob may not call d")
+	assert.Nil(t, call("admin", "d").Error)
+}
@@ -3,6 +3,7 @@ package server
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -17,7 +18,9 @@ import (
 	"github.com/pkg/errors"
 )
 
-func StartHTTPServer(listenAddr string, handler http.Handler, logger *logging.Logger) (*http.Server, error) {
+// StartHTTPServer serves over TLS if tlsConfig is non-nil
+func StartHTTPServer(listenAddr string, handler http.Handler, tlsConfig *tls.Config,
+	logger *logging.Logger) (*http.Server, error) {
 	var proto, addr string
 	parts := strings.SplitN(listenAddr, "://", 2)
 	if len(parts) != 2 {
@@ -30,6 +33,9 @@ func StartHTTPServer(listenAddr string, handler http.Handler, logger *logging.Lo
 	if err != nil {
 		return nil, errors.Errorf("Failed to listen on %v: %v", listenAddr, err)
 	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
 
 	server := &http.Server{Handler: RecoverAndLogHandler(handler, logger)}
 
@@ -160,6 +160,8 @@ type WSRPCConnection interface {
 	WriteRPCResponse(resp RPCResponse)
 	TryWriteRPCResponse(resp RPCResponse) bool
 	GetEventSubscriber() EventSubscriber
+	// Closed when the connection is stopped
+	Quit() <-chan struct{}
 }
 
 // EventSubscriber mirros tendermint/tendermint/types.EventBusSubscriber
@@ -0,0 +1,25 @@
+package rpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	grpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "rpc",
+		Name:      "grpc_duration_seconds",
+		Help:      "Time taken to handle gRPC unary calls and streams by method and status code",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 30, 300},
+	}, []string{"method", "code"})
+	throttledCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "rpc",
+		Name:      "throttled_calls_total",
+		Help:      "Number of RPC calls rejected or terminated by rate limits",
+	}, []string{"server", "group", "reason"})
+)
+
+func init() {
+	prometheus.MustRegister(grpcDuration, throttledCalls)
+}
@@ -14,209 +14,75 @@
 package metrics
 
 import (
+	"crypto/tls"
 	"net/http"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/hyperledger/burrow/logging"
-	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-// Exporter is used to store Metrics data and embeds the config struct.
-// This is done so that the relevant functions have easy access to the
-// user defined runtime configuration when the Collect method is called.
-type Exporter struct {
-	burrowMetrics    map[string]*prometheus.Desc
-	service          *rpc.Service
-	logger           *logging.Logger
-	datum            *Datum
-	chainID          string
-	validatorMoniker string
-	blockSampleSize  uint64
+// Metrics are maintained by the components that own them and registered with the default prometheus registry, this
+// collector adds the node's view of its peers and mempool which are owned by Tendermint
+type nodeCollector struct {
+	service  *rpc.Service
+	peers    *prometheus.Desc
+	unconfTx *prometheus.Desc
 }
 
-// Datum is used to store data from all the relevant endpoints
-type Datum struct {
-	LatestBlockHeight   float64
-	UnconfirmedTxs      float64
-	TotalPeers          float64
-	InboundPeers        float64
-	OutboundPeers       float64
-	BlockSampleSize     uint64
-	TotalTxs            float64
-	TxPerBlockBuckets   map[float64]float64
-	TotalTime           float64
-	TimePerBlockBuckets map[float64]float64
-	AccountsWithCode    float64
-	AccountsWithoutCode float64
-}
-
-func StartServer(service *rpc.Service, pattern, listenAddress string, blockSampleSize uint64,
-	logger *logging.Logger) (*http.Server, error) {
+func StartServer(service *rpc.Service, pattern, listenAddress string, tlsConfig *tls.Config, auth *rpc.Authorizer,
+	limiter *rpc.RateLimiter, logger *logging.Logger) (*http.Server, error) {
 
-	// instantiate metrics and variables we do not expect to change during runtime
-	chainStatus, _ := service.Status()
-	exporter := Exporter{
-		burrowMetrics:    AddMetrics(),
-		datum:            &Datum{},
-		logger:           logger.With(structure.ComponentKey, "Metrics_Exporter"),
-		service:          service,
-		chainID:          chainStatus.NodeInfo.Network,
-		validatorMoniker: chainStatus.NodeInfo.Moniker,
-		blockSampleSize:  blockSampleSize,
+	err := prometheus.Register(newNodeCollector(service))
+	if err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			return nil, err
+		}
 	}
 
-	// Register Metrics from each of the endpoints
-	// This invokes the Collect method through the prometheus client libraries.
-	prometheus.MustRegister(&exporter)
-
 	mux := http.NewServeMux()
 	mux.Handle(pattern, server.RecoverAndLogHandler(prometheus.Handler(), logger))
 
-	srv, err := server.StartHTTPServer(listenAddress, mux, logger)
+	srv, err := server.StartHTTPServer(listenAddress, limiter.Handler(auth.Handler(mux)), tlsConfig, logger)
 	if err != nil {
 		return nil, err
 	}
 	return srv, nil
 }
 
-// AddMetrics - Add's all of the metrics to a map of strings, returns the map.
-func AddMetrics() map[string]*prometheus.Desc {
-	burrowMetrics := make(map[string]*prometheus.Desc)
-
-	burrowMetrics["Height"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "chain", "block_height"),
-		"Current block height",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Time Per Block"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "chain", "block_time"),
-		"Summary metric of nanoseconds per block",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Unconfirmed Transactions"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "transactions", "in_mempool"),
-		"Current depth of the mempool",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Tx Per Block"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "transactions", "per_block"),
-		"Summary metric of transactions per block",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Total Peers"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "peers", "total"),
-		"Current total peers",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Inbound Peers"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "peers", "inbound"),
-		"Current inbound peers",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Outbound Peers"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "peers", "outbound"),
-		"Current outbound peers",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Contracts"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "accounts", "contracts"),
-		"Current contracts on the chain",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	burrowMetrics["Users"] = prometheus.NewDesc(
-		prometheus.BuildFQName("burrow", "accounts", "users"),
-		"Current users on the chain",
-		[]string{"chain_id", "moniker"}, nil,
-	)
-	return burrowMetrics
-}
-
-// Describe - loops through the API metrics and passes them to prometheus.Describe
-func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
-	for _, m := range e.burrowMetrics {
-		ch <- m
+func newNodeCollector(service *rpc.Service) *nodeCollector {
+	return &nodeCollector{
+		service: service,
+		peers: prometheus.NewDesc(
+			prometheus.BuildFQName("burrow", "peers", "total"),
+			"Current peers by direction",
+			[]string{"direction"}, nil,
+		),
+		unconfTx: prometheus.NewDesc(
+			prometheus.BuildFQName("burrow", "transactions", "in_mempool"),
+			"Current depth of the mempool",
+			nil, nil,
+		),
 	}
 }
 
-// Collect function, called on by Prometheus Client library
-// This function is called when a scrape is peformed on the /metrics page
-func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+func (nc *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nc.peers
+	ch <- nc.unconfTx
+}
 
-	// Scrape the Data
-	var err = e.gatherData()
-	if err != nil {
-		return
+func (nc *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	var inbound, outbound float64
+	for _, peer := range nc.service.Peers() {
+		if peer.IsOutbound {
+			outbound++
+		} else {
+			inbound++
+		}
 	}
-
-	// Set prometheus gauge metrics using the data gathered
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Height"],
-		prometheus.CounterValue,
-		e.datum.LatestBlockHeight,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Unconfirmed Transactions"],
-		prometheus.GaugeValue,
-		e.datum.UnconfirmedTxs,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Total Peers"],
-		prometheus.GaugeValue,
-		e.datum.TotalPeers,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Inbound Peers"],
-		prometheus.GaugeValue,
-		e.datum.InboundPeers,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Outbound Peers"],
-		prometheus.GaugeValue,
-		e.datum.OutboundPeers,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstSummary(
-		e.burrowMetrics["Tx Per Block"],
-		e.datum.BlockSampleSize,
-		e.datum.TotalTxs,
-		e.datum.TxPerBlockBuckets,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstSummary(
-		e.burrowMetrics["Time Per Block"],
-		e.datum.BlockSampleSize,
-		e.datum.TotalTime,
-		e.datum.TimePerBlockBuckets,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Contracts"],
-		prometheus.GaugeValue,
-		e.datum.AccountsWithCode,
-		e.chainID,
-		e.validatorMoniker,
-	)
-	ch <- prometheus.MustNewConstMetric(
-		e.burrowMetrics["Users"],
-		prometheus.GaugeValue,
-		e.datum.AccountsWithoutCode,
-		e.chainID,
-		e.validatorMoniker,
-	)
-
-	e.logger.InfoMsg("All Metrics successfully collected")
+	ch <- prometheus.MustNewConstMetric(nc.peers, prometheus.GaugeValue, inbound, "inbound")
+	ch <- prometheus.MustNewConstMetric(nc.peers, prometheus.GaugeValue, outbound, "outbound")
+	ch <- prometheus.MustNewConstMetric(nc.unconfTx, prometheus.GaugeValue, float64(nc.service.MempoolSize()))
 }
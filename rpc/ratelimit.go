@@ -0,0 +1,300 @@
+package rpc
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRateLimitGroup = "default"
+	// Idle buckets are swept at most this often
+	rateLimitSweepInterval = time.Minute
+)
+
+// Reasons a call may be throttled
+const (
+	ThrottledRate           = "rate"
+	ThrottledStreams        = "streams"
+	ThrottledStreamDuration = "stream_duration"
+)
+
+// RateLimitError is returned when a client exceeds a rate limit
+type RateLimitError struct {
+	Client string
+	Method string
+	Reason string
+}
+
+func (err *RateLimitError) Error() string {
+	switch err.Reason {
+	case ThrottledStreams:
+		return fmt.Sprintf("client %s has too many concurrent streams to call %s", err.Client, err.Method)
+	case ThrottledStreamDuration:
+		return fmt.Sprintf("stream %s for client %s exceeded its maximum duration", err.Method, err.Client)
+	default:
+		return fmt.Sprintf("client %s exceeded its request rate limit for %s", err.Client, err.Method)
+	}
+}
+
+func (err *RateLimitError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, err.Error())
+}
+
+// RateLimiter applies token bucket rate limits per client and method group along with limits on streams
+type RateLimiter struct {
+	server            string
+	groups            []*RateLimitGroup
+	defaultGroup      *RateLimitGroup
+	maxStreams        int
+	maxStreamDuration time.Duration
+	now               func() time.Time
+	mtx               sync.Mutex
+	buckets           map[bucketKey]*tokenBucket
+	streams           map[string]int
+	lastSweep         time.Time
+}
+
+type bucketKey struct {
+	client string
+	group  string
+}
+
+type tokenBucket struct {
+	tokens   float64
+	capacity float64
+	rate     float64
+	updated  time.Time
+}
+
+// NewRateLimiter returns nil if conf is nil in which case no limits are applied, server labels its metrics
+func NewRateLimiter(server string, conf *RateLimitConfig) (*RateLimiter, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	defaultGroup := &RateLimitGroup{
+		Name:              defaultRateLimitGroup,
+		RequestsPerSecond: conf.RequestsPerSecond,
+		Burst:             conf.Burst,
+	}
+	names := map[string]bool{defaultRateLimitGroup: true}
+	for _, group := range append([]*RateLimitGroup{defaultGroup}, conf.Groups...) {
+		if group.RequestsPerSecond < 0 || group.Burst < 0 {
+			return nil, fmt.Errorf("rate limit group %s has negative limit", group.Name)
+		}
+		if group != defaultGroup {
+			if names[group.Name] {
+				return nil, fmt.Errorf("rate limit groups must have distinct names other than '%s' but got %s",
+					defaultRateLimitGroup, group.Name)
+			}
+			names[group.Name] = true
+		}
+	}
+	return &RateLimiter{
+		server:            server,
+		groups:            conf.Groups,
+		defaultGroup:      defaultGroup,
+		maxStreams:        conf.MaxStreams,
+		maxStreamDuration: conf.MaxStreamDuration,
+		now:               time.Now,
+		buckets:           make(map[bucketKey]*tokenBucket),
+		streams:           make(map[string]int),
+	}, nil
+}
+
+// Allow returns a *RateLimitError if client has exceeded the request rate for the group method belongs to, a nil
+// RateLimiter allows all calls
+func (rl *RateLimiter) Allow(client, method string) error {
+	if rl == nil {
+		return nil
+	}
+	group := rl.group(method)
+	if group.RequestsPerSecond == 0 {
+		return nil
+	}
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	now := rl.now()
+	rl.sweep(now)
+	key := bucketKey{client: client, group: group.Name}
+	bucket, ok := rl.buckets[key]
+	if !ok {
+		capacity := float64(group.Burst)
+		if capacity == 0 {
+			capacity = math.Ceil(group.RequestsPerSecond)
+		}
+		bucket = &tokenBucket{tokens: capacity, capacity: capacity, rate: group.RequestsPerSecond, updated: now}
+		rl.buckets[key] = bucket
+	}
+	if !bucket.take(now) {
+		return rl.throttled(client, method, group.Name, ThrottledRate)
+	}
+	return nil
+}
+
+// StartStream checks client's request rate and concurrent streams, if allowed the returned function must be called
+// when the stream ends
+func (rl *RateLimiter) StartStream(client, method string) (func(), error) {
+	if rl == nil {
+		return func() {}, nil
+	}
+	err := rl.Allow(client, method)
+	if err != nil {
+		return nil, err
+	}
+	if rl.maxStreams == 0 {
+		return func() {}, nil
+	}
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	if rl.streams[client] >= rl.maxStreams {
+		return nil, rl.throttled(client, method, rl.group(method).Name, ThrottledStreams)
+	}
+	rl.streams[client]++
+	return func() {
+		rl.mtx.Lock()
+		defer rl.mtx.Unlock()
+		rl.streams[client]--
+		if rl.streams[client] == 0 {
+			delete(rl.streams, client)
+		}
+	}, nil
+}
+
+// Handler wraps an HTTP handler applying request rate limits per IP address with a 429 status when exceeded. It should
+// wrap the Authorizer's handler so that requests are throttled before any work is done to authorize them.
+func (rl *RateLimiter) Handler(handler http.Handler) http.Handler {
+	if rl == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, r, err := httpMethod(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		err = rl.Allow(hostOf(r.RemoteAddr), method)
+		if err != nil {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (rl *RateLimiter) group(method string) *RateLimitGroup {
+	for _, group := range rl.groups {
+		if matchesMethod(group.Methods, method) {
+			return group
+		}
+	}
+	return rl.defaultGroup
+}
+
+func (rl *RateLimiter) throttled(client, method, group, reason string) error {
+	throttledCalls.WithLabelValues(rl.server, group, reason).Inc()
+	return &RateLimitError{Client: client, Method: method, Reason: reason}
+}
+
+// Drop buckets that have refilled so that the number of buckets is bounded by the number of active clients, must be
+// called with lock held
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	rl.lastSweep = now
+	for key, bucket := range rl.buckets {
+		if bucket.refill(now) >= bucket.capacity {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) float64 {
+	tb.tokens = math.Min(tb.capacity, tb.tokens+now.Sub(tb.updated).Seconds()*tb.rate)
+	tb.updated = now
+	return tb.tokens
+}
+
+func (tb *tokenBucket) take(now time.Time) bool {
+	if tb.refill(now) < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// The address of the client making a gRPC call, by which calls without an authenticated principal are limited
+func grpcClient(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostOf(p.Addr.String())
+	}
+	return ""
+}
+
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
+
+// Terminates a stream once its maximum duration has elapsed
+type limitedStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	expired func() error
+}
+
+// Wraps a stream so that it is terminated after the maximum stream duration, the returned function must be called
+// when the stream ends
+func (rl *RateLimiter) limitStream(ss grpc.ServerStream, client, method string) (grpc.ServerStream, func()) {
+	if rl == nil || rl.maxStreamDuration == 0 {
+		return ss, func() {}
+	}
+	ctx, cancel := context.WithTimeout(ss.Context(), rl.maxStreamDuration)
+	var once sync.Once
+	var err error
+	return &limitedStream{
+		ServerStream: ss,
+		ctx:          ctx,
+		expired: func() error {
+			if ctx.Err() != context.DeadlineExceeded {
+				return nil
+			}
+			once.Do(func() {
+				err = rl.throttled(client, method, rl.group(method).Name, ThrottledStreamDuration)
+			})
+			return err
+		},
+	}, cancel
+}
+
+func (ls *limitedStream) Context() context.Context {
+	return ls.ctx
+}
+
+func (ls *limitedStream) SendMsg(m interface{}) error {
+	if err := ls.expired(); err != nil {
+		return err
+	}
+	return ls.ServerStream.SendMsg(m)
+}
+
+func (ls *limitedStream) RecvMsg(m interface{}) error {
+	if err := ls.expired(); err != nil {
+		return err
+	}
+	return ls.ServerStream.RecvMsg(m)
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const listAccountsMethod = "/rpcquery.Query/ListAccounts"
+
+func TestRateLimiter(t *testing.T) {
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{
+		RequestsPerSecond: 2,
+		Groups: []*RateLimitGroup{
+			{Name: "transact", Methods: []string{"/rpctransact.Transact/"}, RequestsPerSecond: 1, Burst: 3},
+		},
+	})
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+	throttledBefore := throttledCount(t, "test", defaultRateLimitGroup, ThrottledRate)
+
+	// Default group has a burst of its rate
+	assert.NoError(t, limiter.Allow("alice", queryMethod))
+	assert.NoError(t, limiter.Allow("alice", queryMethod))
+	err = limiter.Allow("alice", queryMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, throttledBefore+1, throttledCount(t, "test", defaultRateLimitGroup, ThrottledRate))
+
+	// Separate buckets per client and group
+	assert.NoError(t, limiter.Allow("bob", queryMethod))
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, limiter.Allow("alice", signMethod))
+	}
+	assert.Error(t, limiter.Allow("alice", broadcastMethod))
+
+	// Refills at rate
+	now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, limiter.Allow("alice", queryMethod))
+	assert.Error(t, limiter.Allow("alice", queryMethod))
+	assert.Error(t, limiter.Allow("alice", signMethod))
+	now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, limiter.Allow("alice", signMethod))
+
+	// Idle buckets are swept
+	now = now.Add(rateLimitSweepInterval)
+	assert.NoError(t, limiter.Allow("carol", queryMethod))
+	assert.Len(t, limiter.buckets, 1)
+
+	var noLimiter *RateLimiter
+	assert.NoError(t, noLimiter.Allow("alice", queryMethod))
+}
+
+func TestRateLimiterStreams(t *testing.T) {
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{MaxStreams: 2})
+	require.NoError(t, err)
+
+	release1, err := limiter.StartStream("alice", listAccountsMethod)
+	require.NoError(t, err)
+	_, err = limiter.StartStream("alice", listAccountsMethod)
+	require.NoError(t, err)
+	_, err = limiter.StartStream("alice", listAccountsMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = limiter.StartStream("bob", listAccountsMethod)
+	require.NoError(t, err)
+
+	release1()
+	_, err = limiter.StartStream("alice", listAccountsMethod)
+	require.NoError(t, err)
+}
+
+func TestRateLimiterStreamDuration(t *testing.T) {
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{MaxStreamDuration: time.Millisecond})
+	require.NoError(t, err)
+
+	ss, cancel := limiter.limitStream(&testServerStream{ctx: context.Background()}, "alice", listAccountsMethod)
+	defer cancel()
+	require.NoError(t, ss.SendMsg(nil))
+	<-ss.Context().Done()
+	err = ss.SendMsg(nil)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRateLimiterHandler(t *testing.T) {
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{RequestsPerSecond: 1})
+	require.NoError(t, err)
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	serve := func(remoteAddr string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/status", nil)
+		r.RemoteAddr = remoteAddr
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, serve("10.0.0.1:1000"))
+	assert.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:1001"))
+	assert.Equal(t, http.StatusOK, serve("10.0.0.2:1000"))
+}
+
+func TestRateLimiterInterceptors(t *testing.T) {
+	auth, err := NewAuthorizer(&AuthConfig{Tokens: []*TokenConfig{{Token: "foo"}, {Token: "bar", Principal: "bar"}}})
+	require.NoError(t, err)
+	limiter, err := NewRateLimiter("test", &RateLimitConfig{RequestsPerSecond: 1})
+	require.NoError(t, err)
+	logger := logging.NewNoopLogger()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1000}})
+	fooCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer foo"))
+	barCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer bar"))
+
+	unary := unaryInterceptor(auth, limiter, logger)
+	info := &grpc.UnaryServerInfo{FullMethod: signMethod}
+	var principal string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal = PrincipalFromContext(ctx)
+		return nil, nil
+	}
+	// Failed authorization counts against the address
+	_, err = unary(ctx, nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = unary(ctx, nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// Authenticated clients at the same address are limited by principal
+	_, err = unary(fooCtx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "token#0", principal)
+	_, err = unary(fooCtx, nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = unary(barCtx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", principal)
+
+	limiter, err = NewRateLimiter("test", &RateLimitConfig{MaxStreams: 1})
+	require.NoError(t, err)
+	stream := streamInterceptor(auth, limiter, logger)
+	streamInfo := &grpc.StreamServerInfo{FullMethod: listAccountsMethod, IsServerStream: true}
+	started := make(chan string)
+	done := make(chan struct{})
+	go stream(nil, &testServerStream{ctx: fooCtx}, streamInfo, func(srv interface{}, ss grpc.ServerStream) error {
+		started <- PrincipalFromContext(ss.Context())
+		<-done
+		return nil
+	})
+	// Stream handlers see the principal
+	assert.Equal(t, "token#0", <-started)
+	defer close(done)
+	err = stream(nil, &testServerStream{ctx: fooCtx}, streamInfo, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	err = stream(nil, &testServerStream{ctx: barCtx}, streamInfo, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter, err := NewRateLimiter("test", nil)
+	require.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	_, err = NewRateLimiter("test", &RateLimitConfig{RequestsPerSecond: -1})
+	assert.Error(t, err)
+
+	_, err = NewRateLimiter("test", &RateLimitConfig{Groups: []*RateLimitGroup{{Name: defaultRateLimitGroup}}})
+	assert.Error(t, err)
+}
+
+func throttledCount(t *testing.T, labels ...string) float64 {
+	metric := new(dto.Metric)
+	require.NoError(t, throttledCalls.WithLabelValues(labels...).Write(metric))
+	return metric.GetCounter().GetValue()
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *testServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (ss *testServerStream) SendMsg(m interface{}) error {
+	return nil
+}
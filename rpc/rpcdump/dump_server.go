@@ -1,17 +1,22 @@
 package rpcdump
 
 import (
+	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/crypto"
 	dump "github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type dumpServer struct {
@@ -37,13 +42,60 @@ func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) er
 	if height <= 0 {
 		height = ds.blockchain.LastBlockHeight()
 	}
+	if param.FromHeight >= height {
+		return fmt.Errorf("cannot dump changes from height %d to height %d", param.FromHeight, height)
+	}
+
+	// Every row is included in the checksum sent in the trailer
+	digest := dump.NewDigest()
+	send := func(row *dump.Dump) error {
+		err := digest.Write(row)
+		if err != nil {
+			return err
+		}
+		return stream.Send(row)
+	}
+
+	genesisDoc := ds.blockchain.GenesisDoc()
+	err := send(&dump.Dump{
+		Height: height,
+		Header: &dump.Header{
+			Version:    dump.Version,
+			ChainID:    ds.blockchain.ChainID(),
+			Params:     state.DumpChainParams(&genesisDoc),
+			FromHeight: param.FromHeight,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if param.FromHeight > 0 {
+		err = ds.state.DumpDiff(param.FromHeight, height, send)
+	} else {
+		err = ds.dumpState(height, send)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = ds.dumpEvents(param.FromHeight, height, send)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&dump.Dump{Height: height, Trailer: &dump.Trailer{Checksum: digest.Sum()}})
+}
+
+// Send the complete state at height
+func (ds *dumpServer) dumpState(height uint64, send func(row *dump.Dump) error) error {
 	st, err := ds.state.LoadHeight(height)
 	if err != nil {
 		return err
 	}
 
 	err = st.IterateAccounts(func(acc *acm.Account) error {
-		err = stream.Send(&dump.Dump{Height: height, Account: acc})
+		err = send(&dump.Dump{Height: height, Account: acc})
 		if err != nil {
 			return err
 		}
@@ -63,7 +115,7 @@ func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) er
 		}
 
 		if len(storage.Storage) > 0 {
-			return stream.Send(&dump.Dump{
+			return send(&dump.Dump{
 				Height:         height,
 				AccountStorage: &storage,
 			})
@@ -77,17 +129,33 @@ func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) er
 	}
 
 	err = st.IterateNames(func(entry *names.Entry) error {
-		return stream.Send(&dump.Dump{Height: height, Name: entry})
+		return send(&dump.Dump{Height: height, Name: entry})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = st.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		return send(&dump.Dump{Height: height, Validator: validator.New(id.GetPublicKey(), power)})
 	})
 
 	if err != nil {
 		return err
 	}
 
+	return st.IterateProposals(func(proposalHash []byte, ballot *payload.Ballot) error {
+		return send(&dump.Dump{Height: height, Proposal: &dump.Proposal{ProposalHash: proposalHash, Ballot: ballot}})
+	})
+}
+
+// Send the EVM events from blocks in [fromHeight, height)
+func (ds *dumpServer) dumpEvents(fromHeight, height uint64, send func(row *dump.Dump) error) error {
+
 	var blockTime time.Time
 	var origin *exec.Origin
 
-	return ds.state.IterateStreamEvents(exec.StreamKey{}, exec.StreamKey{Height: height},
+	return ds.state.IterateStreamEvents(exec.StreamKey{Height: fromHeight}, exec.StreamKey{Height: height},
 		func(ev *exec.StreamEvent) error {
 			switch {
 			case ev.BeginBlock != nil:
@@ -105,7 +173,7 @@ func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) er
 					evmevent.ChainID = ds.blockchain.ChainID()
 					evmevent.Time = blockTime
 				}
-				err := stream.Send(&dump.Dump{Height: ev.Event.Header.Height, EVMEvent: &evmevent})
+				err := send(&dump.Dump{Height: ev.Event.Header.Height, EVMEvent: &evmevent})
 				if err != nil {
 					return err
 				}
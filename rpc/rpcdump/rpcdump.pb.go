@@ -1,22 +1,20 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: rpcdump.proto
 
-package rpcdump // import "github.com/hyperledger/burrow/rpc/rpcdump"
-
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import dump "github.com/hyperledger/burrow/dump"
+package rpcdump
 
 import (
-	context "golang.org/x/net/context"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	dump "github.com/hyperledger/burrow/dump"
 	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
 )
 
-import io "io"
-
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = golang_proto.Marshal
@@ -30,7 +28,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type GetDumpParam struct {
-	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// If set dump only the changes since fromHeight
+	FromHeight           uint64   `protobuf:"varint,2,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -40,7 +40,7 @@ func (m *GetDumpParam) Reset()         { *m = GetDumpParam{} }
 func (m *GetDumpParam) String() string { return proto.CompactTextString(m) }
 func (*GetDumpParam) ProtoMessage()    {}
 func (*GetDumpParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcdump_f7581cfaf7945463, []int{0}
+	return fileDescriptor_80c0fd6a8168e015, []int{0}
 }
 func (m *GetDumpParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -57,8 +57,8 @@ func (m *GetDumpParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *GetDumpParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDumpParam.Merge(dst, src)
+func (m *GetDumpParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDumpParam.Merge(m, src)
 }
 func (m *GetDumpParam) XXX_Size() int {
 	return m.Size()
@@ -76,6 +76,13 @@ func (m *GetDumpParam) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetDumpParam) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
 func (*GetDumpParam) XXX_MessageName() string {
 	return "rpcdump.GetDumpParam"
 }
@@ -84,6 +91,27 @@ func init() {
 	golang_proto.RegisterType((*GetDumpParam)(nil), "rpcdump.GetDumpParam")
 }
 
+func init() { proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e015) }
+func init() { golang_proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e015) }
+
+var fileDescriptor_80c0fd6a8168e015 = []byte{
+	// 211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2a, 0x48, 0x4e,
+	0x29, 0xcd, 0x2d, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x74, 0xd3,
+	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xd3, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1,
+	0xf2, 0x49, 0xa5, 0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0xf4, 0x49, 0x71, 0x21, 0xcc, 0x50,
+	0x72, 0xe3, 0xe2, 0x71, 0x4f, 0x2d, 0x71, 0x29, 0xcd, 0x2d, 0x08, 0x48, 0x2c, 0x4a, 0xcc, 0x15,
+	0x12, 0xe3, 0x62, 0xcb, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
+	0x82, 0xf2, 0x84, 0xe4, 0xb8, 0xb8, 0xd2, 0x8a, 0xf2, 0x73, 0x3d, 0x20, 0x72, 0x4c, 0x60, 0x39,
+	0x24, 0x11, 0x23, 0x33, 0x2e, 0x16, 0x90, 0x21, 0x42, 0x7a, 0x5c, 0xec, 0x50, 0xf3, 0x84, 0x44,
+	0xf5, 0x60, 0xce, 0x45, 0xb6, 0x41, 0x8a, 0x4b, 0x0f, 0x2c, 0x06, 0x12, 0x30, 0x60, 0x74, 0xb2,
+	0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x0f, 0x3c, 0x96,
+	0x63, 0x3c, 0xf1, 0x58, 0x8e, 0x31, 0x4a, 0x13, 0xc9, 0x43, 0x19, 0x95, 0x05, 0xa9, 0x45, 0x39,
+	0xa9, 0x29, 0xe9, 0xa9, 0x45, 0xfa, 0x49, 0xa5, 0x45, 0x45, 0xf9, 0xe5, 0xfa, 0x45, 0x05, 0xc9,
+	0xfa, 0x50, 0xb3, 0x93, 0xd8, 0xc0, 0xfe, 0x30, 0x06, 0x0c, 0x00, 0x6e, 0xcc, 0xee, 0xab, 0x1c,
+	0x01, 0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -203,6 +231,11 @@ func (m *GetDumpParam) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRpcdump(dAtA, i, uint64(m.Height))
 	}
+	if m.FromHeight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcdump(dAtA, i, uint64(m.FromHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -227,6 +260,9 @@ func (m *GetDumpParam) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovRpcdump(uint64(m.Height))
 	}
+	if m.FromHeight != 0 {
+		n += 1 + sovRpcdump(uint64(m.FromHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -261,7 +297,7 @@ func (m *GetDumpParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -289,7 +325,26 @@ func (m *GetDumpParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcdump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -303,6 +358,9 @@ func (m *GetDumpParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcdump
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcdump
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -370,10 +428,13 @@ func skipRpcdump(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthRpcdump
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthRpcdump
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -402,6 +463,9 @@ func skipRpcdump(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthRpcdump
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -420,23 +484,3 @@ var (
 	ErrInvalidLengthRpcdump = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowRpcdump   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("rpcdump.proto", fileDescriptor_rpcdump_f7581cfaf7945463) }
-func init() { golang_proto.RegisterFile("rpcdump.proto", fileDescriptor_rpcdump_f7581cfaf7945463) }
-
-var fileDescriptor_rpcdump_f7581cfaf7945463 = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2a, 0x48, 0x4e,
-	0x29, 0xcd, 0x2d, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x74, 0xd3,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xd3, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1,
-	0xf2, 0x49, 0xa5, 0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0xf4, 0x49, 0x71, 0x21, 0xcc, 0x50,
-	0x52, 0xe3, 0xe2, 0x71, 0x4f, 0x2d, 0x71, 0x29, 0xcd, 0x2d, 0x08, 0x48, 0x2c, 0x4a, 0xcc, 0x15,
-	0x12, 0xe3, 0x62, 0xcb, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
-	0x82, 0xf2, 0x8c, 0xcc, 0xb8, 0x58, 0x40, 0x8a, 0x84, 0xf4, 0xb8, 0xd8, 0xa1, 0xea, 0x85, 0x44,
-	0xf5, 0x60, 0xce, 0x41, 0x36, 0x41, 0x8a, 0x4b, 0x0f, 0x2c, 0x06, 0x12, 0x30, 0x60, 0x74, 0xb2,
-	0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x0f, 0x3c, 0x96,
-	0x63, 0x3c, 0xf1, 0x58, 0x8e, 0x31, 0x4a, 0x13, 0xc9, 0xc1, 0x19, 0x95, 0x05, 0xa9, 0x45, 0x39,
-	0xa9, 0x29, 0xe9, 0xa9, 0x45, 0xfa, 0x49, 0xa5, 0x45, 0x45, 0xf9, 0xe5, 0xfa, 0x45, 0x05, 0xc9,
-	0xfa, 0x50, 0xb3, 0x93, 0xd8, 0xc0, 0xee, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xba, 0x2f,
-	0xc9, 0x63, 0xfc, 0x00, 0x00, 0x00,
-}
@@ -39,6 +39,21 @@ func NewExecutionEventsServer(eventsProvider Provider, subscribable event.Subscr
 	}
 }
 
+// StreamEvents sends the StreamEvents for blockRange to consumer, catching up from state on any blocks that were
+// dropped by the subscription while consumer was blocked, until the range is exhausted, ctx is done, or consumer
+// returns an error
+func StreamEvents(ctx context.Context, eventsProvider Provider, subscribable event.Subscribable, tip bcm.BlockchainInfo,
+	blockRange *BlockRange, logger *logging.Logger, consumer func(*exec.StreamEvent) error) error {
+
+	ees := &executionEventsServer{
+		eventsProvider: eventsProvider,
+		subscribable:   subscribable,
+		tip:            tip,
+		logger:         logger,
+	}
+	return ees.streamEvents(ctx, blockRange, consumer)
+}
+
 func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*exec.TxExecution, error) {
 	txe, err := ees.eventsProvider.TxByHash(request.TxHash)
 	if err != nil {
@@ -182,18 +197,20 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 		}
 	}()
 
-	for msg := range out {
+	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		default:
+		case msg, ok := <-out:
+			if !ok {
+				return nil
+			}
 			err = consumer(msg.(*exec.BlockExecution))
 			if err != nil {
 				return err
 			}
 		}
 	}
-	return nil
 }
 
 func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64,
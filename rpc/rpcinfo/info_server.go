@@ -15,6 +15,7 @@
 package rpcinfo
 
 import (
+	"crypto/tls"
 	"net/http"
 
 	"github.com/hyperledger/burrow/logging"
@@ -23,14 +24,16 @@ import (
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-func StartServer(service *rpc.Service, pattern, listenAddress string, logger *logging.Logger) (*http.Server, error) {
+func StartServer(service *rpc.Service, subs *Subscriptions, pattern, listenAddress string, tlsConfig *tls.Config,
+	auth *rpc.Authorizer, limiter *rpc.RateLimiter, logger *logging.Logger) (*http.Server, error) {
 	logger = logger.With(structure.ComponentKey, "RPC_Info")
-	routes := GetRoutes(service, logger)
+	routes := GetRoutes(service, subs, logger)
 	mux := http.NewServeMux()
 	wm := server.NewWebsocketManager(routes, logger)
+	wm.CheckRequests = rpc.CheckWebsocketRequests(auth, limiter)
 	mux.HandleFunc(pattern, wm.WebsocketHandler)
 	server.RegisterRPCFuncs(mux, routes, logger)
-	srv, err := server.StartHTTPServer(listenAddress, mux, logger)
+	srv, err := server.StartHTTPServer(listenAddress, limiter.Handler(auth.Handler(mux)), tlsConfig, logger)
 	if err != nil {
 		return nil, err
 	}
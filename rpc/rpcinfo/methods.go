@@ -39,11 +39,16 @@ const (
 	UnconfirmedTxs = "unconfirmed_txs"
 	Validators     = "validators"
 	Consensus      = "consensus"
+
+	// Execution event subscriptions, over websocket only
+	Subscribe   = "subscribe"
+	Unsubscribe = "unsubscribe"
 )
 
-func GetRoutes(service *rpc.Service, logger *logging.Logger) map[string]*server.RPCFunc {
+// GetRoutes returns the info server's methods, subscribe and unsubscribe are included if subs is not nil
+func GetRoutes(service *rpc.Service, subs *Subscriptions, logger *logging.Logger) map[string]*server.RPCFunc {
 	logger = logger.WithScope("GetRoutes")
-	return map[string]*server.RPCFunc{
+	routes := map[string]*server.RPCFunc{
 		// Status
 		Status:  server.NewRPCFunc(service.StatusWithin, "block_time_within,block_seen_time_within"),
 		Network: server.NewRPCFunc(service.Network, ""),
@@ -76,4 +81,9 @@ func GetRoutes(service *rpc.Service, logger *logging.Logger) map[string]*server.
 		Name:  server.NewRPCFunc(service.Name, "name"),
 		Names: server.NewRPCFunc(service.Names, ""),
 	}
+	if subs != nil {
+		routes[Subscribe] = server.NewWSRPCFunc(subs.Subscribe, "query")
+		routes[Unsubscribe] = server.NewWSRPCFunc(subs.Unsubscribe, "subscription_id")
+	}
+	return routes
 }
@@ -0,0 +1,165 @@
+package rpcinfo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/types"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+)
+
+// Suffix added to the ID of the subscribe request to form the ID of the responses carrying its events
+const SubscriptionEventIDSuffix = "#event"
+
+type ResultSubscribe struct {
+	SubscriptionID string
+}
+
+type ResultUnsubscribe struct {
+	SubscriptionID string
+}
+
+// Pushed to the websocket for each StreamEvent matching a subscription's query
+type ResultSubscriptionEvent struct {
+	SubscriptionID string
+	Event          *exec.StreamEvent
+}
+
+// Subscriptions streams execution events to websocket connections from the block after that at which they subscribe.
+// A connection that reads slowly blocks its subscriptions, while blocked they miss blocks from the event subscription
+// and catch up on them from state so no events are dropped.
+type Subscriptions struct {
+	eventsProvider   rpcevents.Provider
+	subscribable     event.Subscribable
+	tip              bcm.BlockchainInfo
+	maxPerConnection int
+	logger           *logging.Logger
+	mtx              sync.Mutex
+	// Cancels for each subscription by connection remote address and subscription ID
+	connections map[string]map[string]context.CancelFunc
+}
+
+func NewSubscriptions(eventsProvider rpcevents.Provider, subscribable event.Subscribable, tip bcm.BlockchainInfo,
+	maxPerConnection int, logger *logging.Logger) *Subscriptions {
+
+	if maxPerConnection <= 0 {
+		maxPerConnection = rpc.DefaultMaxSubscriptions
+	}
+	return &Subscriptions{
+		eventsProvider:   eventsProvider,
+		subscribable:     subscribable,
+		tip:              tip,
+		maxPerConnection: maxPerConnection,
+		logger:           logger.With(structure.ComponentKey, "Subscriptions"),
+		connections:      make(map[string]map[string]context.CancelFunc),
+	}
+}
+
+// Subscribe to StreamEvents matching queryString, which takes the same syntax as the query of a BlocksRequest
+func (subs *Subscriptions) Subscribe(wsCtx types.WSRPCContext, queryString string) (*ResultSubscribe, error) {
+	qry, err := query.NewOrEmpty(queryString)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse StreamEvent query: %v", err)
+	}
+	subID := event.GenSubID()
+	ctx, cancel := context.WithCancel(context.Background())
+	err = subs.add(wsCtx.GetRemoteAddr(), subID, cancel)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	blockRange := rpcevents.NewBlockRange(rpcevents.AbsoluteBound(subs.tip.LastBlockHeight()+1),
+		rpcevents.StreamBound())
+	go subs.stream(ctx, wsCtx, subID, qry, blockRange)
+	return &ResultSubscribe{SubscriptionID: subID}, nil
+}
+
+func (subs *Subscriptions) Unsubscribe(wsCtx types.WSRPCContext, subscriptionID string) (*ResultUnsubscribe, error) {
+	if !subs.remove(wsCtx.GetRemoteAddr(), subscriptionID) {
+		return nil, fmt.Errorf("no subscription with ID %s on this connection", subscriptionID)
+	}
+	return &ResultUnsubscribe{SubscriptionID: subscriptionID}, nil
+}
+
+func (subs *Subscriptions) stream(ctx context.Context, wsCtx types.WSRPCContext, subID string, qry query.Query,
+	blockRange *rpcevents.BlockRange) {
+
+	remoteAddr := wsCtx.GetRemoteAddr()
+	defer subs.remove(remoteAddr, subID)
+	go func() {
+		select {
+		case <-wsCtx.Quit():
+			subs.remove(remoteAddr, subID)
+		case <-ctx.Done():
+		}
+	}()
+
+	id := wsCtx.Request.ID + SubscriptionEventIDSuffix
+	err := rpcevents.StreamEvents(ctx, subs.eventsProvider, subs.subscribable, subs.tip, blockRange, subs.logger,
+		func(ev *exec.StreamEvent) error {
+			if !qry.Matches(ev.Tagged()) {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Blocks until the connection accepts the event or is stopped
+			wsCtx.WriteRPCResponse(types.NewRPCSuccessResponse(id, &ResultSubscriptionEvent{
+				SubscriptionID: subID,
+				Event:          ev,
+			}))
+			return nil
+		})
+	if err != nil && ctx.Err() == nil {
+		subs.logger.InfoMsg("subscription ended with error", structure.ErrorKey, err,
+			"remote_address", remoteAddr, "subscription_id", subID)
+		wsCtx.WriteRPCResponse(types.RPCServerError(id, err))
+	}
+}
+
+func (subs *Subscriptions) add(remoteAddr, subID string, cancel context.CancelFunc) error {
+	subs.mtx.Lock()
+	defer subs.mtx.Unlock()
+	cancels, ok := subs.connections[remoteAddr]
+	if !ok {
+		cancels = make(map[string]context.CancelFunc)
+		subs.connections[remoteAddr] = cancels
+	}
+	if len(cancels) >= subs.maxPerConnection {
+		return fmt.Errorf("connection already has the maximum of %d subscriptions", subs.maxPerConnection)
+	}
+	cancels[subID] = cancel
+	return nil
+}
+
+// Cancel the subscription returning whether it existed
+func (subs *Subscriptions) remove(remoteAddr, subID string) bool {
+	subs.mtx.Lock()
+	defer subs.mtx.Unlock()
+	cancels := subs.connections[remoteAddr]
+	cancel, ok := cancels[subID]
+	if !ok {
+		return false
+	}
+	cancel()
+	delete(cancels, subID)
+	if len(cancels) == 0 {
+		delete(subs.connections, remoteAddr)
+	}
+	return true
+}
+
+// Number of subscriptions open on the connection with remoteAddr
+func (subs *Subscriptions) count(remoteAddr string) int {
+	subs.mtx.Lock()
+	defer subs.mtx.Unlock()
+	return len(subs.connections[remoteAddr])
+}
@@ -0,0 +1,162 @@
+package rpcinfo
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/lib/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptions(t *testing.T) {
+	emitter := event.NewEmitter(logging.NewNoopLogger())
+	chain := new(testChain)
+	chain.commit(t, emitter)
+	subs := NewSubscriptions(chain, emitter, chain, 2, logging.NewNoopLogger())
+	conn := newTestConnection()
+
+	_, err := subs.Subscribe(conn.context("bad"), "Height >")
+	require.Error(t, err)
+
+	fromThree, err := subs.Subscribe(conn.context("fromThree"), "Height >= 3")
+	require.NoError(t, err)
+	all, err := subs.Subscribe(conn.context("all"), "")
+	require.NoError(t, err)
+	_, err = subs.Subscribe(conn.context("tooMany"), "")
+	require.Error(t, err)
+
+	// Blocks 2 and 3
+	chain.commit(t, emitter)
+	chain.commit(t, emitter)
+
+	events := conn.receive(t, 6)
+	assert.Len(t, events[all.SubscriptionID], 4)
+	require.Len(t, events[fromThree.SubscriptionID], 2)
+	assert.Equal(t, uint64(3), events[fromThree.SubscriptionID][0].BeginBlock.Height)
+	assert.Equal(t, uint64(3), events[fromThree.SubscriptionID][1].EndBlock.Height)
+
+	_, err = subs.Unsubscribe(conn.context("unsubscribe"), fromThree.SubscriptionID)
+	require.NoError(t, err)
+	_, err = subs.Unsubscribe(conn.context("unsubscribe"), fromThree.SubscriptionID)
+	require.Error(t, err)
+	assert.Equal(t, 1, subs.count(conn.GetRemoteAddr()))
+
+	close(conn.quit)
+	for start := time.Now(); subs.count(conn.GetRemoteAddr()) > 0; time.Sleep(time.Millisecond) {
+		require.True(t, time.Since(start) < time.Second, "subscriptions should be removed when connection quits")
+	}
+}
+
+type testChain struct {
+	bcm.BlockchainInfo
+	mtx    sync.Mutex
+	blocks []*exec.BlockExecution
+}
+
+func (tc *testChain) LastBlockHeight() uint64 {
+	tc.mtx.Lock()
+	defer tc.mtx.Unlock()
+	return uint64(len(tc.blocks))
+}
+
+func (tc *testChain) IterateStreamEvents(start, end exec.StreamKey, consumer func(*exec.StreamEvent) error) error {
+	tc.mtx.Lock()
+	blocks := tc.blocks
+	tc.mtx.Unlock()
+	for _, be := range blocks {
+		if be.Height < start.Height || be.Height >= end.Height {
+			continue
+		}
+		for _, ev := range be.StreamEvents() {
+			err := consumer(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (tc *testChain) TxByHash(txHash []byte) (*exec.TxExecution, error) {
+	return nil, nil
+}
+
+// Store the next block then publish it in the same order as the committer
+func (tc *testChain) commit(t *testing.T, publisher event.Publisher) {
+	tc.mtx.Lock()
+	be := &exec.BlockExecution{Height: uint64(len(tc.blocks)) + 1}
+	tc.blocks = append(tc.blocks, be)
+	tc.mtx.Unlock()
+	require.NoError(t, publisher.Publish(context.Background(), be, be.Tagged()))
+}
+
+type testConnection struct {
+	responses chan types.RPCResponse
+	quit      chan struct{}
+}
+
+var _ types.WSRPCConnection = &testConnection{}
+
+func newTestConnection() *testConnection {
+	return &testConnection{
+		responses: make(chan types.RPCResponse),
+		quit:      make(chan struct{}),
+	}
+}
+
+func (tc *testConnection) context(id string) types.WSRPCContext {
+	return types.WSRPCContext{Request: types.RPCRequest{ID: id}, WSRPCConnection: tc}
+}
+
+// Receive n events keyed by subscription ID
+func (tc *testConnection) receive(t *testing.T, n int) map[string][]*exec.StreamEvent {
+	events := make(map[string][]*exec.StreamEvent)
+	for i := 0; i < n; i++ {
+		select {
+		case resp := <-tc.responses:
+			require.Nil(t, resp.Error)
+			result := new(ResultSubscriptionEvent)
+			require.NoError(t, json.Unmarshal(resp.Result, result))
+			events[result.SubscriptionID] = append(events[result.SubscriptionID], result.Event)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for event %d of %d", i+1, n)
+		}
+	}
+	return events
+}
+
+func (tc *testConnection) GetRemoteAddr() string {
+	return "127.0.0.1:26658"
+}
+
+func (tc *testConnection) WriteRPCResponse(resp types.RPCResponse) {
+	select {
+	case tc.responses <- resp:
+	case <-tc.quit:
+	}
+}
+
+func (tc *testConnection) TryWriteRPCResponse(resp types.RPCResponse) bool {
+	select {
+	case tc.responses <- resp:
+		return true
+	default:
+		return false
+	}
+}
+
+func (tc *testConnection) GetEventSubscriber() types.EventSubscriber {
+	return nil
+}
+
+func (tc *testConnection) Quit() <-chan struct{} {
+	return tc.quit
+}
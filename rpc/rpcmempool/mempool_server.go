@@ -0,0 +1,137 @@
+package rpcmempool
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/consensus/tendermint/abci"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Events are dropped for Stream clients that fall this far behind
+const SubscribeBufferSize = 100
+
+type mempoolServer struct {
+	mempool      *abci.Mempool
+	subscribable event.Subscribable
+	logger       *logging.Logger
+}
+
+var _ MempoolServer = &mempoolServer{}
+
+func NewMempoolServer(mempool *abci.Mempool, subscribable event.Subscribable,
+	logger *logging.Logger) *mempoolServer {
+
+	return &mempoolServer{
+		mempool:      mempool,
+		subscribable: subscribable,
+		logger:       logger.With(structure.ComponentKey, "MempoolServer"),
+	}
+}
+
+func (ms *mempoolServer) ListTxs(param *ListTxsParam, stream Mempool_ListTxsServer) error {
+	mtxs, err := ms.mempool.List()
+	if err != nil {
+		return err
+	}
+	for _, mtx := range mtxs {
+		if param.Address != nil && !hasInput(mtx, param) {
+			continue
+		}
+		err = stream.Send(NewMempoolTx(mtx))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ms *mempoolServer) GetTx(ctx context.Context, param *GetTxParam) (*MempoolTx, error) {
+	mtx, ok := ms.mempool.Get(param.TxHash)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no transaction with hash %v in the mempool", param.TxHash)
+	}
+	return NewMempoolTx(mtx), nil
+}
+
+func (ms *mempoolServer) Stream(param *StreamParam, stream Mempool_StreamServer) error {
+	ctx := stream.Context()
+	subID := event.GenSubID()
+	out, err := ms.subscribable.Subscribe(ctx, subID, abci.QueryForMempoolEvents(), SubscribeBufferSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = ms.subscribable.UnsubscribeAll(context.Background(), subID)
+		for range out {
+			// flush
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-out:
+			if !ok {
+				return nil
+			}
+			err = stream.Send(NewMempoolEvent(msg.(*abci.MempoolEvent)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// EvictTx is an admin method so is only available to callers presenting an admin token to the server's AuthConfig, it
+// is refused if the server has no AuthConfig
+func (ms *mempoolServer) EvictTx(ctx context.Context, param *EvictTxParam) (*MempoolTx, error) {
+	principal := rpc.PrincipalFromContext(ctx)
+	if principal == "" {
+		return nil, status.Error(codes.PermissionDenied, "EvictTx requires an authenticated caller")
+	}
+	if _, ok := ms.mempool.Get(param.TxHash); !ok {
+		return nil, status.Errorf(codes.NotFound, "no transaction with hash %v in the mempool", param.TxHash)
+	}
+	mtx, err := ms.mempool.Evict(param.TxHash, fmt.Sprintf("evicted by %s", principal))
+	if err != nil {
+		return nil, err
+	}
+	ms.logger.InfoMsg("Evicted transaction from mempool", "tx_hash", param.TxHash, "principal", principal)
+	return NewMempoolTx(mtx), nil
+}
+
+func NewMempoolTx(mtx *abci.MempoolTx) *MempoolTx {
+	tx := mtx.Envelope.Tx
+	return &MempoolTx{
+		TxHash:         tx.Hash(),
+		TxType:         tx.Type(),
+		Envelope:       mtx.Envelope,
+		Payload:        tx.Payload.Any(),
+		Inputs:         tx.GetInputs(),
+		CheckTxReceipt: mtx.Receipt,
+		AdmittedAt:     mtx.Admitted,
+	}
+}
+
+func NewMempoolEvent(ev *abci.MempoolEvent) *MempoolEvent {
+	if ev.Removed {
+		return &MempoolEvent{Removed: NewMempoolTx(ev.Tx), Reason: ev.Reason}
+	}
+	return &MempoolEvent{Admitted: NewMempoolTx(ev.Tx)}
+}
+
+func hasInput(mtx *abci.MempoolTx, param *ListTxsParam) bool {
+	for _, input := range mtx.Envelope.Tx.GetInputs() {
+		if input.Address == *param.Address {
+			return true
+		}
+	}
+	return false
+}
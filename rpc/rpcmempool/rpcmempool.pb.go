@@ -0,0 +1,1918 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcmempool.proto
+
+package rpcmempool
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	txs "github.com/hyperledger/burrow/txs"
+	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
+	payload "github.com/hyperledger/burrow/txs/payload"
+	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ListTxsParam struct {
+	// If set only list transactions with an input from this account
+	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *ListTxsParam) Reset()         { *m = ListTxsParam{} }
+func (m *ListTxsParam) String() string { return proto.CompactTextString(m) }
+func (*ListTxsParam) ProtoMessage()    {}
+func (*ListTxsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{0}
+}
+func (m *ListTxsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTxsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTxsParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListTxsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTxsParam.Merge(m, src)
+}
+func (m *ListTxsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTxsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTxsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTxsParam proto.InternalMessageInfo
+
+func (*ListTxsParam) XXX_MessageName() string {
+	return "rpcmempool.ListTxsParam"
+}
+
+type GetTxParam struct {
+	TxHash               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *GetTxParam) Reset()         { *m = GetTxParam{} }
+func (m *GetTxParam) String() string { return proto.CompactTextString(m) }
+func (*GetTxParam) ProtoMessage()    {}
+func (*GetTxParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{1}
+}
+func (m *GetTxParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxParam.Merge(m, src)
+}
+func (m *GetTxParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxParam proto.InternalMessageInfo
+
+func (*GetTxParam) XXX_MessageName() string {
+	return "rpcmempool.GetTxParam"
+}
+
+type StreamParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamParam) Reset()         { *m = StreamParam{} }
+func (m *StreamParam) String() string { return proto.CompactTextString(m) }
+func (*StreamParam) ProtoMessage()    {}
+func (*StreamParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{2}
+}
+func (m *StreamParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamParam.Merge(m, src)
+}
+func (m *StreamParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamParam proto.InternalMessageInfo
+
+func (*StreamParam) XXX_MessageName() string {
+	return "rpcmempool.StreamParam"
+}
+
+type EvictTxParam struct {
+	TxHash               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *EvictTxParam) Reset()         { *m = EvictTxParam{} }
+func (m *EvictTxParam) String() string { return proto.CompactTextString(m) }
+func (*EvictTxParam) ProtoMessage()    {}
+func (*EvictTxParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{3}
+}
+func (m *EvictTxParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictTxParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictTxParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictTxParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictTxParam.Merge(m, src)
+}
+func (m *EvictTxParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictTxParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictTxParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictTxParam proto.InternalMessageInfo
+
+func (*EvictTxParam) XXX_MessageName() string {
+	return "rpcmempool.EvictTxParam"
+}
+
+type MempoolTx struct {
+	TxHash   github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	TxType   github_com_hyperledger_burrow_txs_payload.Type `protobuf:"varint,2,opt,name=TxType,proto3,casttype=github.com/hyperledger/burrow/txs/payload.Type" json:"TxType,omitempty"`
+	Envelope *txs.Envelope                                  `protobuf:"bytes,3,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	// The decoded payload of the transaction
+	Payload *payload.Any `protobuf:"bytes,4,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	// The inputs of the transaction with the sequence numbers they use
+	Inputs []*payload.TxInput `protobuf:"bytes,5,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	// The receipt returned by CheckTx when the transaction was admitted, absent if the transaction was admitted
+	// before this node started tracking the mempool
+	CheckTxReceipt       *txs.Receipt `protobuf:"bytes,6,opt,name=CheckTxReceipt,proto3" json:"CheckTxReceipt,omitempty"`
+	AdmittedAt           time.Time    `protobuf:"bytes,7,opt,name=AdmittedAt,proto3,stdtime" json:"AdmittedAt"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MempoolTx) Reset()         { *m = MempoolTx{} }
+func (m *MempoolTx) String() string { return proto.CompactTextString(m) }
+func (*MempoolTx) ProtoMessage()    {}
+func (*MempoolTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{4}
+}
+func (m *MempoolTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTx.Merge(m, src)
+}
+func (m *MempoolTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTx proto.InternalMessageInfo
+
+func (m *MempoolTx) GetTxType() github_com_hyperledger_burrow_txs_payload.Type {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *MempoolTx) GetEnvelope() *txs.Envelope {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (m *MempoolTx) GetPayload() *payload.Any {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MempoolTx) GetInputs() []*payload.TxInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *MempoolTx) GetCheckTxReceipt() *txs.Receipt {
+	if m != nil {
+		return m.CheckTxReceipt
+	}
+	return nil
+}
+
+func (m *MempoolTx) GetAdmittedAt() time.Time {
+	if m != nil {
+		return m.AdmittedAt
+	}
+	return time.Time{}
+}
+
+func (*MempoolTx) XXX_MessageName() string {
+	return "rpcmempool.MempoolTx"
+}
+
+type MempoolEvent struct {
+	// Set when a transaction is admitted to the mempool
+	Admitted *MempoolTx `protobuf:"bytes,1,opt,name=Admitted,proto3" json:"Admitted,omitempty"`
+	// Set when a transaction leaves the mempool
+	Removed *MempoolTx `protobuf:"bytes,2,opt,name=Removed,proto3" json:"Removed,omitempty"`
+	// Why the transaction was removed
+	Reason               string   `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolEvent) Reset()         { *m = MempoolEvent{} }
+func (m *MempoolEvent) String() string { return proto.CompactTextString(m) }
+func (*MempoolEvent) ProtoMessage()    {}
+func (*MempoolEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{5}
+}
+func (m *MempoolEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolEvent.Merge(m, src)
+}
+func (m *MempoolEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolEvent proto.InternalMessageInfo
+
+func (m *MempoolEvent) GetAdmitted() *MempoolTx {
+	if m != nil {
+		return m.Admitted
+	}
+	return nil
+}
+
+func (m *MempoolEvent) GetRemoved() *MempoolTx {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *MempoolEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (*MempoolEvent) XXX_MessageName() string {
+	return "rpcmempool.MempoolEvent"
+}
+func init() {
+	proto.RegisterType((*ListTxsParam)(nil), "rpcmempool.ListTxsParam")
+	golang_proto.RegisterType((*ListTxsParam)(nil), "rpcmempool.ListTxsParam")
+	proto.RegisterType((*GetTxParam)(nil), "rpcmempool.GetTxParam")
+	golang_proto.RegisterType((*GetTxParam)(nil), "rpcmempool.GetTxParam")
+	proto.RegisterType((*StreamParam)(nil), "rpcmempool.StreamParam")
+	golang_proto.RegisterType((*StreamParam)(nil), "rpcmempool.StreamParam")
+	proto.RegisterType((*EvictTxParam)(nil), "rpcmempool.EvictTxParam")
+	golang_proto.RegisterType((*EvictTxParam)(nil), "rpcmempool.EvictTxParam")
+	proto.RegisterType((*MempoolTx)(nil), "rpcmempool.MempoolTx")
+	golang_proto.RegisterType((*MempoolTx)(nil), "rpcmempool.MempoolTx")
+	proto.RegisterType((*MempoolEvent)(nil), "rpcmempool.MempoolEvent")
+	golang_proto.RegisterType((*MempoolEvent)(nil), "rpcmempool.MempoolEvent")
+}
+
+func init() { proto.RegisterFile("rpcmempool.proto", fileDescriptor_5c68184ea82b89b2) }
+func init() { golang_proto.RegisterFile("rpcmempool.proto", fileDescriptor_5c68184ea82b89b2) }
+
+var fileDescriptor_5c68184ea82b89b2 = []byte{
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xf1, 0xc6, 0x9a, 0xed, 0x6d, 0x8b, 0x26, 0x4b, 0x8c, 0xa8, 0x87, 0xb6, 0xea, 0x01,
+	0x15, 0x89, 0x25, 0xa3, 0xfc, 0x39, 0x20, 0x38, 0xb4, 0x30, 0x31, 0x26, 0x26, 0x4d, 0x26, 0xa7,
+	0x21, 0x0e, 0x69, 0x62, 0xd2, 0x88, 0x26, 0xb6, 0x1c, 0xb7, 0x24, 0x5f, 0x81, 0x13, 0xdf, 0x80,
+	0xaf, 0xc2, 0x71, 0x47, 0xce, 0x3b, 0x14, 0xb4, 0x7d, 0x8b, 0x9d, 0x50, 0x1d, 0xa7, 0x0d, 0x68,
+	0x2b, 0x17, 0xb8, 0xe5, 0xf5, 0xfb, 0x3c, 0x3f, 0x5b, 0x7e, 0xfd, 0x04, 0xb6, 0x05, 0xf7, 0x22,
+	0x1a, 0x71, 0xc6, 0xc6, 0x16, 0x17, 0x4c, 0x32, 0x0c, 0xcb, 0x95, 0xc6, 0x6e, 0x10, 0xca, 0xd1,
+	0x64, 0x68, 0x79, 0x2c, 0xb2, 0x03, 0x16, 0x30, 0x5b, 0x49, 0x86, 0x93, 0x0f, 0xaa, 0x52, 0x85,
+	0xfa, 0xca, 0xad, 0x8d, 0x56, 0xc0, 0x58, 0x30, 0xa6, 0x4b, 0x95, 0x0c, 0x23, 0x9a, 0x48, 0x37,
+	0xe2, 0x5a, 0x50, 0xe7, 0x6e, 0x36, 0x66, 0xae, 0xaf, 0xcb, 0x2d, 0x99, 0x26, 0xf9, 0x67, 0xe7,
+	0x04, 0x6a, 0x6f, 0xc2, 0x44, 0x3a, 0x69, 0x72, 0xec, 0x0a, 0x37, 0xc2, 0x87, 0x60, 0xf4, 0x7d,
+	0x5f, 0xd0, 0x24, 0x31, 0x51, 0x1b, 0x75, 0x6b, 0x83, 0xbd, 0xb3, 0x59, 0xeb, 0x7e, 0xe9, 0x38,
+	0xa3, 0x8c, 0x53, 0x31, 0xa6, 0x7e, 0x40, 0x85, 0x3d, 0x9c, 0x08, 0xc1, 0x3e, 0xd9, 0x9e, 0xc8,
+	0xb8, 0x64, 0x96, 0xf6, 0x91, 0x02, 0xd0, 0x79, 0x07, 0xf0, 0x8a, 0x4a, 0x27, 0xcd, 0xc9, 0x47,
+	0x50, 0x71, 0xd2, 0x03, 0x37, 0x19, 0x69, 0xf0, 0xe3, 0xd3, 0x59, 0xeb, 0xc6, 0xd9, 0xac, 0xb5,
+	0xbb, 0x1a, 0x3e, 0x0c, 0x63, 0x57, 0x64, 0xd6, 0x01, 0x4d, 0x07, 0x99, 0xa4, 0x09, 0xd1, 0x90,
+	0x4e, 0x1d, 0xaa, 0x6f, 0xa5, 0xa0, 0x6e, 0xa4, 0xe8, 0x9d, 0xf7, 0x50, 0xdb, 0x9f, 0x86, 0xde,
+	0xff, 0xda, 0xed, 0xeb, 0x3a, 0x6c, 0x1d, 0xe5, 0xc3, 0x71, 0xd2, 0x7f, 0x0c, 0xc7, 0x87, 0x73,
+	0x9c, 0x93, 0x71, 0x6a, 0xae, 0xb5, 0x51, 0xb7, 0x3e, 0xe8, 0x5d, 0xce, 0x5a, 0xd6, 0x6a, 0x94,
+	0x4c, 0x13, 0xbb, 0x98, 0xe9, 0xdc, 0x49, 0x34, 0x01, 0xdf, 0x83, 0xcd, 0xfd, 0x78, 0x4a, 0xc7,
+	0x8c, 0x53, 0x73, 0xbd, 0x8d, 0xba, 0xd5, 0x5e, 0xdd, 0x9a, 0x4f, 0xbb, 0x58, 0x24, 0x8b, 0x36,
+	0xbe, 0x0b, 0xc6, 0x71, 0x8e, 0x30, 0x6f, 0x2a, 0x65, 0xcd, 0x2a, 0x90, 0xfd, 0x38, 0x23, 0x45,
+	0x13, 0x77, 0xa1, 0xf2, 0x3a, 0xe6, 0x13, 0x99, 0x98, 0x1b, 0xed, 0xf5, 0x6e, 0xb5, 0xb7, 0xbd,
+	0x90, 0x39, 0xa9, 0x6a, 0x10, 0xdd, 0xc7, 0x8f, 0xe0, 0xd6, 0x8b, 0x11, 0xf5, 0x3e, 0x3a, 0x29,
+	0xa1, 0x1e, 0x0d, 0xb9, 0x34, 0x2b, 0x1a, 0x3c, 0x3f, 0x82, 0x5e, 0x23, 0x7f, 0x68, 0xf0, 0x4b,
+	0x80, 0xbe, 0x1f, 0x85, 0x52, 0x52, 0xbf, 0x2f, 0x4d, 0x43, 0x39, 0x1a, 0x56, 0xfe, 0xa4, 0xad,
+	0xe2, 0x49, 0x5b, 0x4e, 0xf1, 0xa4, 0x07, 0x9b, 0xf3, 0xdb, 0xfe, 0xf2, 0xa3, 0x85, 0x48, 0xc9,
+	0xd7, 0xf9, 0x8c, 0xa0, 0xa6, 0x27, 0xb4, 0x3f, 0xa5, 0xb1, 0xc4, 0x0f, 0x60, 0xb3, 0x68, 0xab,
+	0x31, 0x55, 0x7b, 0xb7, 0xad, 0x52, 0xe8, 0x16, 0xd3, 0x24, 0x0b, 0x19, 0xb6, 0xc1, 0x20, 0x34,
+	0x62, 0x53, 0xea, 0x9b, 0x6b, 0xab, 0x1c, 0x85, 0x0a, 0xef, 0x40, 0x85, 0x50, 0x37, 0x61, 0xb1,
+	0xba, 0xeb, 0x2d, 0xa2, 0xab, 0xde, 0x25, 0x02, 0x43, 0xcb, 0xf1, 0x33, 0x30, 0x74, 0xc2, 0xb0,
+	0x59, 0xc6, 0x95, 0x63, 0xd7, 0xb8, 0x7a, 0xa3, 0x3d, 0x84, 0x9f, 0xc0, 0x86, 0xca, 0x10, 0xde,
+	0x29, 0x2b, 0x96, 0xb1, 0xba, 0xc6, 0x89, 0x9f, 0x43, 0x25, 0x8f, 0x07, 0xbe, 0x53, 0x16, 0x94,
+	0x22, 0xd3, 0x30, 0xaf, 0x70, 0xaa, 0xab, 0xdb, 0x43, 0xf8, 0x29, 0x18, 0x3a, 0x4e, 0xbf, 0x1f,
+	0xba, 0x9c, 0xb1, 0x6b, 0xb6, 0x1e, 0x0c, 0x4e, 0xcf, 0x9b, 0xe8, 0xfb, 0x79, 0x13, 0xfd, 0x3c,
+	0x6f, 0xa2, 0x6f, 0x17, 0x4d, 0x74, 0x7a, 0xd1, 0x44, 0x27, 0x7f, 0xf9, 0x87, 0x08, 0xee, 0xd9,
+	0x4b, 0xda, 0xb0, 0xa2, 0xe6, 0xfe, 0xf0, 0xd7, 0x00, 0xe4, 0x28, 0x07, 0x45, 0x27, 0x05, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolClient interface {
+	// List the transactions pending in the mempool in the order they will be proposed
+	ListTxs(ctx context.Context, in *ListTxsParam, opts ...grpc.CallOption) (Mempool_ListTxsClient, error)
+	// Get a pending transaction by hash
+	GetTx(ctx context.Context, in *GetTxParam, opts ...grpc.CallOption) (*MempoolTx, error)
+	// Stream the admission of transactions to the mempool and their removal from it
+	Stream(ctx context.Context, in *StreamParam, opts ...grpc.CallOption) (Mempool_StreamClient, error)
+	// Remove a pending transaction from the mempool along with any that depend on it. Requires an authenticated caller.
+	EvictTx(ctx context.Context, in *EvictTxParam, opts ...grpc.CallOption) (*MempoolTx, error)
+}
+
+type mempoolClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMempoolClient(cc *grpc.ClientConn) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) ListTxs(ctx context.Context, in *ListTxsParam, opts ...grpc.CallOption) (Mempool_ListTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Mempool_serviceDesc.Streams[0], "/rpcmempool.Mempool/ListTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolListTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mempool_ListTxsClient interface {
+	Recv() (*MempoolTx, error)
+	grpc.ClientStream
+}
+
+type mempoolListTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolListTxsClient) Recv() (*MempoolTx, error) {
+	m := new(MempoolTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mempoolClient) GetTx(ctx context.Context, in *GetTxParam, opts ...grpc.CallOption) (*MempoolTx, error) {
+	out := new(MempoolTx)
+	err := c.cc.Invoke(ctx, "/rpcmempool.Mempool/GetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolClient) Stream(ctx context.Context, in *StreamParam, opts ...grpc.CallOption) (Mempool_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Mempool_serviceDesc.Streams[1], "/rpcmempool.Mempool/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mempool_StreamClient interface {
+	Recv() (*MempoolEvent, error)
+	grpc.ClientStream
+}
+
+type mempoolStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolStreamClient) Recv() (*MempoolEvent, error) {
+	m := new(MempoolEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mempoolClient) EvictTx(ctx context.Context, in *EvictTxParam, opts ...grpc.CallOption) (*MempoolTx, error) {
+	out := new(MempoolTx)
+	err := c.cc.Invoke(ctx, "/rpcmempool.Mempool/EvictTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServer is the server API for Mempool service.
+type MempoolServer interface {
+	// List the transactions pending in the mempool in the order they will be proposed
+	ListTxs(*ListTxsParam, Mempool_ListTxsServer) error
+	// Get a pending transaction by hash
+	GetTx(context.Context, *GetTxParam) (*MempoolTx, error)
+	// Stream the admission of transactions to the mempool and their removal from it
+	Stream(*StreamParam, Mempool_StreamServer) error
+	// Remove a pending transaction from the mempool along with any that depend on it. Requires an authenticated caller.
+	EvictTx(context.Context, *EvictTxParam) (*MempoolTx, error)
+}
+
+func RegisterMempoolServer(s *grpc.Server, srv MempoolServer) {
+	s.RegisterService(&_Mempool_serviceDesc, srv)
+}
+
+func _Mempool_ListTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTxsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServer).ListTxs(m, &mempoolListTxsServer{stream})
+}
+
+type Mempool_ListTxsServer interface {
+	Send(*MempoolTx) error
+	grpc.ServerStream
+}
+
+type mempoolListTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolListTxsServer) Send(m *MempoolTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mempool_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).GetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcmempool.Mempool/GetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).GetTx(ctx, req.(*GetTxParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mempool_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServer).Stream(m, &mempoolStreamServer{stream})
+}
+
+type Mempool_StreamServer interface {
+	Send(*MempoolEvent) error
+	grpc.ServerStream
+}
+
+type mempoolStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolStreamServer) Send(m *MempoolEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mempool_EvictTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictTxParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).EvictTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcmempool.Mempool/EvictTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).EvictTx(ctx, req.(*EvictTxParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Mempool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcmempool.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTx",
+			Handler:    _Mempool_GetTx_Handler,
+		},
+		{
+			MethodName: "EvictTx",
+			Handler:    _Mempool_EvictTx_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTxs",
+			Handler:       _Mempool_ListTxs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Stream",
+			Handler:       _Mempool_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcmempool.proto",
+}
+
+func (m *ListTxsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTxsParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Address != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Address.Size()))
+		n1, err := m.Address.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetTxParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcmempool(dAtA, i, uint64(m.TxHash.Size()))
+	n2, err := m.TxHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StreamParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EvictTxParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictTxParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcmempool(dAtA, i, uint64(m.TxHash.Size()))
+	n3, err := m.TxHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MempoolTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcmempool(dAtA, i, uint64(m.TxHash.Size()))
+	n4, err := m.TxHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.TxType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.TxType))
+	}
+	if m.Envelope != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Envelope.Size()))
+		n5, err := m.Envelope.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Payload != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Payload.Size()))
+		n6, err := m.Payload.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintRpcmempool(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CheckTxReceipt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.CheckTxReceipt.Size()))
+		n7, err := m.CheckTxReceipt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintRpcmempool(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.AdmittedAt)))
+	n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AdmittedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MempoolEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Admitted != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Admitted.Size()))
+		n9, err := m.Admitted.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Removed != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Removed.Size()))
+		n10, err := m.Removed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcmempool(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRpcmempool(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListTxsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTxParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcmempool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvictTxParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcmempool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MempoolTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcmempool(uint64(l))
+	if m.TxType != 0 {
+		n += 1 + sovRpcmempool(uint64(m.TxType))
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovRpcmempool(uint64(l))
+		}
+	}
+	if m.CheckTxReceipt != nil {
+		l = m.CheckTxReceipt.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AdmittedAt)
+	n += 1 + l + sovRpcmempool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MempoolEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Admitted != nil {
+		l = m.Admitted.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcmempool(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRpcmempool(x uint64) (n int) {
+	return sovRpcmempool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListTxsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTxsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTxsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictTxParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictTxParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictTxParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &payload.Any{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &payload.TxInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTxReceipt == nil {
+				m.CheckTxReceipt = &txs.Receipt{}
+			}
+			if err := m.CheckTxReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmittedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AdmittedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Admitted == nil {
+				m.Admitted = &MempoolTx{}
+			}
+			if err := m.Admitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Removed == nil {
+				m.Removed = &MempoolTx{}
+			}
+			if err := m.Removed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcmempool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcmempool
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthRpcmempool
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowRpcmempool
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipRpcmempool(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthRpcmempool
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthRpcmempool = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcmempool   = fmt.Errorf("proto: integer overflow")
+)
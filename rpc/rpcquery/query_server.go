@@ -109,6 +109,27 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	return streamErr
 }
 
+func (qs *queryServer) ResolveName(ctx context.Context, param *ResolveNameParam) (*names.Entry, error) {
+	entry, err := qs.nameReg.GetName(param.Name)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || entry.Expires <= qs.blockchain.LastBlockHeight() {
+		return nil, fmt.Errorf("name %s not found", param.Name)
+	}
+	return entry, nil
+}
+
+func (qs *queryServer) ReverseLookup(param *ReverseLookupParam, stream Query_ReverseLookupServer) error {
+	height := qs.blockchain.LastBlockHeight()
+	return qs.nameReg.IterateNamesByOwner(param.Owner, func(entry *names.Entry) error {
+		if entry.Expires <= height {
+			return nil
+		}
+		return stream.Send(entry)
+	})
+}
+
 // Validators
 
 func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorSetParam) (*ValidatorSet, error) {
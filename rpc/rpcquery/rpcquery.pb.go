@@ -1,30 +1,27 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: rpcquery.proto
 
-package rpcquery // import "github.com/hyperledger/burrow/rpc/rpcquery"
-
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import acm "github.com/hyperledger/burrow/acm"
-import validator "github.com/hyperledger/burrow/acm/validator"
-import names "github.com/hyperledger/burrow/execution/names"
-import rpc "github.com/hyperledger/burrow/rpc"
-import payload "github.com/hyperledger/burrow/txs/payload"
-import types "github.com/tendermint/tendermint/abci/types"
-
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+package rpcquery
 
 import (
-	context "golang.org/x/net/context"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	acm "github.com/hyperledger/burrow/acm"
+	validator "github.com/hyperledger/burrow/acm/validator"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	names "github.com/hyperledger/burrow/execution/names"
+	rpc "github.com/hyperledger/burrow/rpc"
+	payload "github.com/hyperledger/burrow/txs/payload"
+	types "github.com/tendermint/tendermint/abci/types"
 	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
 )
 
-import io "io"
-
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = golang_proto.Marshal
@@ -49,7 +46,7 @@ func (m *StatusParam) Reset()         { *m = StatusParam{} }
 func (m *StatusParam) String() string { return proto.CompactTextString(m) }
 func (*StatusParam) ProtoMessage()    {}
 func (*StatusParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{0}
+	return fileDescriptor_88e25d9b99e39f02, []int{0}
 }
 func (m *StatusParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -66,8 +63,8 @@ func (m *StatusParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *StatusParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusParam.Merge(dst, src)
+func (m *StatusParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusParam.Merge(m, src)
 }
 func (m *StatusParam) XXX_Size() int {
 	return m.Size()
@@ -107,7 +104,7 @@ func (m *GetAccountParam) Reset()         { *m = GetAccountParam{} }
 func (m *GetAccountParam) String() string { return proto.CompactTextString(m) }
 func (*GetAccountParam) ProtoMessage()    {}
 func (*GetAccountParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{1}
+	return fileDescriptor_88e25d9b99e39f02, []int{1}
 }
 func (m *GetAccountParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,8 +121,8 @@ func (m *GetAccountParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (dst *GetAccountParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountParam.Merge(dst, src)
+func (m *GetAccountParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountParam.Merge(m, src)
 }
 func (m *GetAccountParam) XXX_Size() int {
 	return m.Size()
@@ -152,7 +149,7 @@ func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
 func (m *GetStorageParam) String() string { return proto.CompactTextString(m) }
 func (*GetStorageParam) ProtoMessage()    {}
 func (*GetStorageParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{2}
+	return fileDescriptor_88e25d9b99e39f02, []int{2}
 }
 func (m *GetStorageParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,8 +166,8 @@ func (m *GetStorageParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (dst *GetStorageParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStorageParam.Merge(dst, src)
+func (m *GetStorageParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageParam.Merge(m, src)
 }
 func (m *GetStorageParam) XXX_Size() int {
 	return m.Size()
@@ -196,7 +193,7 @@ func (m *StorageValue) Reset()         { *m = StorageValue{} }
 func (m *StorageValue) String() string { return proto.CompactTextString(m) }
 func (*StorageValue) ProtoMessage()    {}
 func (*StorageValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{3}
+	return fileDescriptor_88e25d9b99e39f02, []int{3}
 }
 func (m *StorageValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,8 +210,8 @@ func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *StorageValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StorageValue.Merge(dst, src)
+func (m *StorageValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageValue.Merge(m, src)
 }
 func (m *StorageValue) XXX_Size() int {
 	return m.Size()
@@ -240,7 +237,7 @@ func (m *ListAccountsParam) Reset()         { *m = ListAccountsParam{} }
 func (m *ListAccountsParam) String() string { return proto.CompactTextString(m) }
 func (*ListAccountsParam) ProtoMessage()    {}
 func (*ListAccountsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{4}
+	return fileDescriptor_88e25d9b99e39f02, []int{4}
 }
 func (m *ListAccountsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,8 +254,8 @@ func (m *ListAccountsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *ListAccountsParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListAccountsParam.Merge(dst, src)
+func (m *ListAccountsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsParam.Merge(m, src)
 }
 func (m *ListAccountsParam) XXX_Size() int {
 	return m.Size()
@@ -291,7 +288,7 @@ func (m *GetNameParam) Reset()         { *m = GetNameParam{} }
 func (m *GetNameParam) String() string { return proto.CompactTextString(m) }
 func (*GetNameParam) ProtoMessage()    {}
 func (*GetNameParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{5}
+	return fileDescriptor_88e25d9b99e39f02, []int{5}
 }
 func (m *GetNameParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,8 +305,8 @@ func (m *GetNameParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *GetNameParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNameParam.Merge(dst, src)
+func (m *GetNameParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNameParam.Merge(m, src)
 }
 func (m *GetNameParam) XXX_Size() int {
 	return m.Size()
@@ -342,7 +339,7 @@ func (m *ListNamesParam) Reset()         { *m = ListNamesParam{} }
 func (m *ListNamesParam) String() string { return proto.CompactTextString(m) }
 func (*ListNamesParam) ProtoMessage()    {}
 func (*ListNamesParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{6}
+	return fileDescriptor_88e25d9b99e39f02, []int{6}
 }
 func (m *ListNamesParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,8 +356,8 @@ func (m *ListNamesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *ListNamesParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNamesParam.Merge(dst, src)
+func (m *ListNamesParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamesParam.Merge(m, src)
 }
 func (m *ListNamesParam) XXX_Size() int {
 	return m.Size()
@@ -382,6 +379,103 @@ func (*ListNamesParam) XXX_MessageName() string {
 	return "rpcquery.ListNamesParam"
 }
 
+// Resolve a name to its entry provided it has not expired
+type ResolveNameParam struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveNameParam) Reset()         { *m = ResolveNameParam{} }
+func (m *ResolveNameParam) String() string { return proto.CompactTextString(m) }
+func (*ResolveNameParam) ProtoMessage()    {}
+func (*ResolveNameParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{7}
+}
+func (m *ResolveNameParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveNameParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveNameParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveNameParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveNameParam.Merge(m, src)
+}
+func (m *ResolveNameParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveNameParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveNameParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveNameParam proto.InternalMessageInfo
+
+func (m *ResolveNameParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (*ResolveNameParam) XXX_MessageName() string {
+	return "rpcquery.ResolveNameParam"
+}
+
+// List the unexpired names owned by an address
+type ReverseLookupParam struct {
+	Owner                github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Owner,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Owner"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *ReverseLookupParam) Reset()         { *m = ReverseLookupParam{} }
+func (m *ReverseLookupParam) String() string { return proto.CompactTextString(m) }
+func (*ReverseLookupParam) ProtoMessage()    {}
+func (*ReverseLookupParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{8}
+}
+func (m *ReverseLookupParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReverseLookupParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReverseLookupParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReverseLookupParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReverseLookupParam.Merge(m, src)
+}
+func (m *ReverseLookupParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReverseLookupParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReverseLookupParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReverseLookupParam proto.InternalMessageInfo
+
+func (*ReverseLookupParam) XXX_MessageName() string {
+	return "rpcquery.ReverseLookupParam"
+}
+
 type GetValidatorSetParam struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -392,7 +486,7 @@ func (m *GetValidatorSetParam) Reset()         { *m = GetValidatorSetParam{} }
 func (m *GetValidatorSetParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetParam) ProtoMessage()    {}
 func (*GetValidatorSetParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{7}
+	return fileDescriptor_88e25d9b99e39f02, []int{9}
 }
 func (m *GetValidatorSetParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,8 +503,8 @@ func (m *GetValidatorSetParam) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (dst *GetValidatorSetParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValidatorSetParam.Merge(dst, src)
+func (m *GetValidatorSetParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetParam.Merge(m, src)
 }
 func (m *GetValidatorSetParam) XXX_Size() int {
 	return m.Size()
@@ -437,7 +531,7 @@ func (m *GetValidatorSetHistoryParam) Reset()         { *m = GetValidatorSetHist
 func (m *GetValidatorSetHistoryParam) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorSetHistoryParam) ProtoMessage()    {}
 func (*GetValidatorSetHistoryParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{8}
+	return fileDescriptor_88e25d9b99e39f02, []int{10}
 }
 func (m *GetValidatorSetHistoryParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,8 +548,8 @@ func (m *GetValidatorSetHistoryParam) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *GetValidatorSetHistoryParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValidatorSetHistoryParam.Merge(dst, src)
+func (m *GetValidatorSetHistoryParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetHistoryParam.Merge(m, src)
 }
 func (m *GetValidatorSetHistoryParam) XXX_Size() int {
 	return m.Size()
@@ -478,7 +572,7 @@ func (*GetValidatorSetHistoryParam) XXX_MessageName() string {
 }
 
 type ValidatorSetHistory struct {
-	History              []*ValidatorSet `protobuf:"bytes,1,rep,name=History" json:"History,omitempty"`
+	History              []*ValidatorSet `protobuf:"bytes,1,rep,name=History,proto3" json:"History,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -488,7 +582,7 @@ func (m *ValidatorSetHistory) Reset()         { *m = ValidatorSetHistory{} }
 func (m *ValidatorSetHistory) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetHistory) ProtoMessage()    {}
 func (*ValidatorSetHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{9}
+	return fileDescriptor_88e25d9b99e39f02, []int{11}
 }
 func (m *ValidatorSetHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,8 +599,8 @@ func (m *ValidatorSetHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *ValidatorSetHistory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorSetHistory.Merge(dst, src)
+func (m *ValidatorSetHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetHistory.Merge(m, src)
 }
 func (m *ValidatorSetHistory) XXX_Size() int {
 	return m.Size()
@@ -530,7 +624,7 @@ func (*ValidatorSetHistory) XXX_MessageName() string {
 
 type ValidatorSet struct {
 	Height               uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Set                  []*validator.Validator `protobuf:"bytes,2,rep,name=Set" json:"Set,omitempty"`
+	Set                  []*validator.Validator `protobuf:"bytes,2,rep,name=Set,proto3" json:"Set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -540,7 +634,7 @@ func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()    {}
 func (*ValidatorSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{10}
+	return fileDescriptor_88e25d9b99e39f02, []int{12}
 }
 func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,8 +651,8 @@ func (m *ValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *ValidatorSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorSet.Merge(dst, src)
+func (m *ValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSet.Merge(m, src)
 }
 func (m *ValidatorSet) XXX_Size() int {
 	return m.Size()
@@ -598,7 +692,7 @@ func (m *GetProposalParam) Reset()         { *m = GetProposalParam{} }
 func (m *GetProposalParam) String() string { return proto.CompactTextString(m) }
 func (*GetProposalParam) ProtoMessage()    {}
 func (*GetProposalParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{11}
+	return fileDescriptor_88e25d9b99e39f02, []int{13}
 }
 func (m *GetProposalParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,8 +709,8 @@ func (m *GetProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (dst *GetProposalParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProposalParam.Merge(dst, src)
+func (m *GetProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalParam.Merge(m, src)
 }
 func (m *GetProposalParam) XXX_Size() int {
 	return m.Size()
@@ -649,7 +743,7 @@ func (m *ListProposalsParam) Reset()         { *m = ListProposalsParam{} }
 func (m *ListProposalsParam) String() string { return proto.CompactTextString(m) }
 func (*ListProposalsParam) ProtoMessage()    {}
 func (*ListProposalsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{12}
+	return fileDescriptor_88e25d9b99e39f02, []int{14}
 }
 func (m *ListProposalsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,8 +760,8 @@ func (m *ListProposalsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *ListProposalsParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProposalsParam.Merge(dst, src)
+func (m *ListProposalsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProposalsParam.Merge(m, src)
 }
 func (m *ListProposalsParam) XXX_Size() int {
 	return m.Size()
@@ -691,7 +785,7 @@ func (*ListProposalsParam) XXX_MessageName() string {
 
 type ProposalResult struct {
 	Hash                 []byte          `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
-	Ballot               *payload.Ballot `protobuf:"bytes,2,opt,name=Ballot" json:"Ballot,omitempty"`
+	Ballot               *payload.Ballot `protobuf:"bytes,2,opt,name=Ballot,proto3" json:"Ballot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -701,7 +795,7 @@ func (m *ProposalResult) Reset()         { *m = ProposalResult{} }
 func (m *ProposalResult) String() string { return proto.CompactTextString(m) }
 func (*ProposalResult) ProtoMessage()    {}
 func (*ProposalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{13}
+	return fileDescriptor_88e25d9b99e39f02, []int{15}
 }
 func (m *ProposalResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,8 +812,8 @@ func (m *ProposalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *ProposalResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposalResult.Merge(dst, src)
+func (m *ProposalResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalResult.Merge(m, src)
 }
 func (m *ProposalResult) XXX_Size() int {
 	return m.Size()
@@ -758,7 +852,7 @@ func (m *GetStatsParam) Reset()         { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()    {}
 func (*GetStatsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{14}
+	return fileDescriptor_88e25d9b99e39f02, []int{16}
 }
 func (m *GetStatsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,8 +869,8 @@ func (m *GetStatsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *GetStatsParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStatsParam.Merge(dst, src)
+func (m *GetStatsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatsParam.Merge(m, src)
 }
 func (m *GetStatsParam) XXX_Size() int {
 	return m.Size()
@@ -803,7 +897,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{15}
+	return fileDescriptor_88e25d9b99e39f02, []int{17}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,8 +914,8 @@ func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Stats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stats.Merge(dst, src)
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
 }
 func (m *Stats) XXX_Size() int {
 	return m.Size()
@@ -861,7 +955,7 @@ func (m *GetBlockParam) Reset()         { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()    {}
 func (*GetBlockParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpcquery_d450d608191f3562, []int{16}
+	return fileDescriptor_88e25d9b99e39f02, []int{18}
 }
 func (m *GetBlockParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,8 +972,8 @@ func (m *GetBlockParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *GetBlockParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockParam.Merge(dst, src)
+func (m *GetBlockParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockParam.Merge(m, src)
 }
 func (m *GetBlockParam) XXX_Size() int {
 	return m.Size()
@@ -915,6 +1009,10 @@ func init() {
 	golang_proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
 	proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
 	golang_proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
+	proto.RegisterType((*ResolveNameParam)(nil), "rpcquery.ResolveNameParam")
+	golang_proto.RegisterType((*ResolveNameParam)(nil), "rpcquery.ResolveNameParam")
+	proto.RegisterType((*ReverseLookupParam)(nil), "rpcquery.ReverseLookupParam")
+	golang_proto.RegisterType((*ReverseLookupParam)(nil), "rpcquery.ReverseLookupParam")
 	proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
 	golang_proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
 	proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
@@ -937,6 +1035,72 @@ func init() {
 	golang_proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
 }
 
+func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
+func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
+
+var fileDescriptor_88e25d9b99e39f02 = []byte{
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0xe6, 0x92, 0xc6, 0x49, 0xc6, 0x76, 0xdc, 0x6e, 0x83, 0x31, 0x57, 0x70, 0xab, 0x95, 0x48,
+	0x43, 0x05, 0x67, 0xcb, 0x34, 0x14, 0x81, 0x10, 0x34, 0x08, 0x9c, 0x94, 0x12, 0xc2, 0x19, 0xb5,
+	0x52, 0x1f, 0x10, 0xeb, 0xbb, 0xc5, 0x3e, 0xf5, 0x7c, 0x7b, 0xec, 0xed, 0xa5, 0xba, 0x9f, 0xc4,
+	0xbf, 0xe0, 0x31, 0x8f, 0x3c, 0xf3, 0x50, 0xa1, 0xf4, 0x1f, 0xf0, 0x0b, 0xd0, 0xed, 0xee, 0xd9,
+	0x7b, 0x67, 0x37, 0x12, 0x8a, 0xfa, 0x62, 0xcd, 0xcc, 0x7e, 0x33, 0xe3, 0x9b, 0xd9, 0xef, 0xbb,
+	0x83, 0x1d, 0x1e, 0x7b, 0xbf, 0xa7, 0x94, 0x67, 0x4e, 0xcc, 0x99, 0x60, 0x68, 0xab, 0xf0, 0xed,
+	0x8f, 0x27, 0x81, 0x98, 0xa6, 0x63, 0xc7, 0x63, 0xb3, 0xde, 0x84, 0x4d, 0x58, 0x4f, 0x02, 0xc6,
+	0xe9, 0x6f, 0xd2, 0x93, 0x8e, 0xb4, 0x54, 0xa2, 0xfd, 0xc0, 0x80, 0x0b, 0x1a, 0xf9, 0x94, 0xcf,
+	0x82, 0x48, 0x98, 0x26, 0x19, 0x7b, 0x41, 0x4f, 0x64, 0x31, 0x4d, 0xd4, 0xaf, 0x4e, 0xac, 0x47,
+	0x64, 0x36, 0x77, 0xb6, 0x89, 0x37, 0xd3, 0x66, 0xeb, 0x8c, 0x84, 0x81, 0x4f, 0x04, 0xe3, 0xc5,
+	0x19, 0x8f, 0x3d, 0x6d, 0x36, 0x63, 0x92, 0x85, 0x8c, 0xf8, 0xca, 0xc5, 0x01, 0xd4, 0x47, 0x82,
+	0x88, 0x34, 0x39, 0x25, 0x9c, 0xcc, 0xd0, 0x3e, 0xb4, 0x0e, 0x43, 0xe6, 0x3d, 0xff, 0x39, 0x98,
+	0xd1, 0xa7, 0x81, 0x98, 0x06, 0x51, 0xc7, 0xba, 0x63, 0xed, 0x6f, 0xbb, 0xd5, 0x30, 0xea, 0xc3,
+	0x4d, 0x19, 0x1a, 0x51, 0x1a, 0x19, 0xe8, 0x35, 0x89, 0x5e, 0x75, 0x84, 0x09, 0xb4, 0x86, 0x54,
+	0x3c, 0xf4, 0x3c, 0x96, 0x46, 0x42, 0xb5, 0x3b, 0x81, 0xcd, 0x87, 0xbe, 0xcf, 0x69, 0x92, 0xc8,
+	0x36, 0x8d, 0xc3, 0xfb, 0xe7, 0x2f, 0x6f, 0xbf, 0xf5, 0xf7, 0xcb, 0xdb, 0x1f, 0x19, 0x23, 0x99,
+	0x66, 0x31, 0xe5, 0x21, 0xf5, 0x27, 0x94, 0xf7, 0xc6, 0x29, 0xe7, 0xec, 0x45, 0xcf, 0xe3, 0x59,
+	0x2c, 0x98, 0xa3, 0x73, 0xdd, 0xa2, 0x08, 0xfe, 0xc3, 0x92, 0x3d, 0x46, 0x82, 0x71, 0x32, 0xa1,
+	0x6f, 0xa4, 0x07, 0xfa, 0x0e, 0xd6, 0xbf, 0xa7, 0x59, 0x67, 0xed, 0xff, 0xd4, 0x1a, 0x07, 0x11,
+	0xe1, 0x99, 0xf3, 0x94, 0x71, 0x7f, 0x70, 0xf0, 0xa9, 0x9b, 0x17, 0xc0, 0xcf, 0xa0, 0xa1, 0xff,
+	0xe7, 0x13, 0x12, 0xa6, 0x14, 0x3d, 0x82, 0x0d, 0x69, 0x74, 0xac, 0x2b, 0x54, 0x56, 0x25, 0xf0,
+	0x87, 0x70, 0xe3, 0x71, 0x90, 0x14, 0xb3, 0xd6, 0xbb, 0xdd, 0x85, 0x8d, 0x9f, 0xf2, 0xeb, 0xa9,
+	0x37, 0xaa, 0x1c, 0x8c, 0xa1, 0x31, 0xa4, 0xe2, 0x84, 0xcc, 0xf4, 0xb8, 0x10, 0x5c, 0xcb, 0x1d,
+	0x0d, 0x92, 0x36, 0xde, 0x83, 0x9d, 0xbc, 0x5c, 0x6e, 0x5f, 0x5a, 0x6b, 0x0f, 0xae, 0xbb, 0x34,
+	0x61, 0xe1, 0x19, 0xbd, 0xbc, 0xde, 0xaf, 0x80, 0x5c, 0x7a, 0x46, 0x79, 0x42, 0x1f, 0x33, 0xf6,
+	0x3c, 0x8d, 0x15, 0xf2, 0x11, 0x6c, 0xfc, 0xf8, 0x22, 0xa2, 0xfc, 0x4a, 0x6b, 0x52, 0x25, 0x70,
+	0x1b, 0x76, 0x87, 0x54, 0x3c, 0x29, 0x68, 0x30, 0xa2, 0xea, 0xc2, 0xe1, 0x21, 0xdc, 0xaa, 0xc4,
+	0x8f, 0x82, 0x44, 0x30, 0x9e, 0xcd, 0xaf, 0xff, 0x71, 0xe4, 0x85, 0xa9, 0x4f, 0x4f, 0x39, 0x3d,
+	0x0b, 0x58, 0xaa, 0xee, 0xcc, 0xba, 0x5b, 0x0d, 0xe3, 0x21, 0xdc, 0x5c, 0x51, 0x05, 0xf5, 0x61,
+	0x53, 0x9b, 0x1d, 0xeb, 0xce, 0xfa, 0x7e, 0x7d, 0xd0, 0x76, 0xe6, 0x2a, 0x61, 0xe2, 0xdd, 0x02,
+	0x86, 0x4f, 0xa0, 0x61, 0x1e, 0xa0, 0x36, 0xd4, 0xa6, 0x34, 0x98, 0x4c, 0x85, 0xec, 0x7c, 0xcd,
+	0xd5, 0x1e, 0xda, 0x83, 0xf5, 0x11, 0x15, 0x9d, 0x35, 0x59, 0x75, 0xd7, 0x59, 0x30, 0x7c, 0x9e,
+	0xed, 0xe6, 0x80, 0x7c, 0x07, 0x43, 0x2a, 0x4e, 0x39, 0x8b, 0x59, 0x42, 0xc2, 0xf9, 0x0e, 0x8e,
+	0x48, 0x32, 0x55, 0x83, 0x75, 0xa5, 0x8d, 0xfb, 0x80, 0xf2, 0x9d, 0x16, 0x40, 0xbd, 0x57, 0x1b,
+	0xb6, 0x54, 0x84, 0xfa, 0x12, 0xbd, 0xe5, 0xce, 0x7d, 0xfc, 0x03, 0xec, 0x14, 0x68, 0x97, 0x26,
+	0x69, 0x28, 0x56, 0xd5, 0x45, 0x77, 0xa1, 0x76, 0x48, 0xc2, 0x90, 0x09, 0xc9, 0x90, 0xfa, 0xa0,
+	0xe5, 0x14, 0x82, 0xa3, 0xc2, 0xae, 0x3e, 0xc6, 0x2d, 0x68, 0x4a, 0xaa, 0x12, 0x7d, 0x3f, 0x31,
+	0x85, 0x0d, 0xe9, 0xa1, 0x7b, 0x70, 0xbd, 0xb8, 0xb9, 0xb9, 0x74, 0x7c, 0xc3, 0x7c, 0xaa, 0x87,
+	0xb1, 0x14, 0xcf, 0x65, 0xc8, 0x8c, 0xb1, 0x54, 0x48, 0xf8, 0x9a, 0x84, 0xaf, 0x3a, 0xc2, 0x77,
+	0x65, 0x5f, 0x29, 0x50, 0xea, 0x99, 0xdb, 0x50, 0x3b, 0x2a, 0x4d, 0x5c, 0x79, 0x83, 0x7f, 0x6b,
+	0xfa, 0x92, 0xa3, 0x01, 0xd4, 0x94, 0x48, 0xa2, 0xb7, 0x17, 0xeb, 0x34, 0x64, 0xd3, 0xbe, 0x91,
+	0x87, 0x1d, 0x35, 0x15, 0x8d, 0x3c, 0x00, 0x58, 0xa8, 0x1d, 0x7a, 0x77, 0x91, 0x57, 0xd1, 0x40,
+	0xbb, 0xe1, 0xe4, 0xc2, 0x5d, 0x00, 0xbf, 0x92, 0x69, 0x5a, 0x18, 0x2a, 0x69, 0xa6, 0xac, 0xd9,
+	0x6d, 0xf3, 0x9f, 0x18, 0x32, 0xf2, 0x05, 0x34, 0x4c, 0xea, 0xa3, 0x5b, 0x0b, 0xdc, 0x92, 0x24,
+	0x94, 0x7b, 0xf7, 0x2d, 0xd4, 0x83, 0x4d, 0x2d, 0x06, 0xa8, 0x5d, 0x6a, 0x3d, 0xe7, 0xb3, 0xdd,
+	0x70, 0xd4, 0x4b, 0xe7, 0xdb, 0x48, 0xf0, 0x0c, 0x1d, 0xc0, 0xf6, 0x5c, 0x19, 0x50, 0xa7, 0xdc,
+	0x6a, 0x21, 0x17, 0xe5, 0xa4, 0xbe, 0x85, 0x1e, 0x40, 0xdd, 0x10, 0x0a, 0x64, 0x2f, 0x12, 0xab,
+	0xfa, 0x51, 0xe9, 0xf7, 0x25, 0x34, 0x4b, 0xca, 0x81, 0xde, 0x33, 0x53, 0xab, 0x92, 0xb2, 0xd4,
+	0xf7, 0x58, 0xbe, 0x1e, 0x4a, 0x7c, 0xeb, 0x96, 0x9e, 0x73, 0x49, 0x31, 0xec, 0xd7, 0x10, 0x18,
+	0xfd, 0x02, 0xed, 0xd5, 0x4a, 0x82, 0x3e, 0x78, 0x6d, 0x45, 0x53, 0x6b, 0xec, 0xf7, 0x57, 0x17,
+	0x2e, 0xaa, 0x7c, 0x0e, 0x75, 0x83, 0xc7, 0xe6, 0x88, 0xaa, 0xf4, 0xb6, 0xab, 0x14, 0x43, 0xc7,
+	0xd0, 0x2c, 0x71, 0xdb, 0x9c, 0xd2, 0x32, 0xe9, 0x6d, 0x63, 0x6f, 0x65, 0x82, 0xf7, 0x2d, 0x74,
+	0x1f, 0xb6, 0x0a, 0x96, 0xa2, 0x77, 0x2a, 0xb7, 0xb1, 0x60, 0xae, 0xdd, 0x2a, 0xb3, 0x22, 0x41,
+	0x9f, 0xc1, 0x4e, 0xc1, 0xb1, 0x23, 0x4a, 0x7c, 0xca, 0x2b, 0xb9, 0x0b, 0xf6, 0xd9, 0x4d, 0x47,
+	0x7d, 0xd1, 0x28, 0xdc, 0xe1, 0xd7, 0xe7, 0x17, 0x5d, 0xeb, 0xaf, 0x8b, 0xae, 0xf5, 0xcf, 0x45,
+	0xd7, 0xfa, 0xf3, 0x55, 0xd7, 0x3a, 0x7f, 0xd5, 0xb5, 0x9e, 0xdd, 0xbb, 0xfc, 0x1d, 0xc0, 0x63,
+	0xaf, 0x57, 0x94, 0x1f, 0xd7, 0xe4, 0x87, 0xcd, 0x27, 0xff, 0x0d, 0x00, 0xff, 0xc2, 0x0a, 0xed,
+	0x9f, 0x09, 0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -955,6 +1119,8 @@ type QueryClient interface {
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
+	ResolveName(ctx context.Context, in *ResolveNameParam, opts ...grpc.CallOption) (*names.Entry, error)
+	ReverseLookup(ctx context.Context, in *ReverseLookupParam, opts ...grpc.CallOption) (Query_ReverseLookupClient, error)
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error)
 	GetValidatorSetHistory(ctx context.Context, in *GetValidatorSetHistoryParam, opts ...grpc.CallOption) (*ValidatorSetHistory, error)
 	GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error)
@@ -1071,6 +1237,47 @@ func (x *queryListNamesClient) Recv() (*names.Entry, error) {
 	return m, nil
 }
 
+func (c *queryClient) ResolveName(ctx context.Context, in *ResolveNameParam, opts ...grpc.CallOption) (*names.Entry, error) {
+	out := new(names.Entry)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/ResolveName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverseLookup(ctx context.Context, in *ReverseLookupParam, opts ...grpc.CallOption) (Query_ReverseLookupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ReverseLookup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryReverseLookupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ReverseLookupClient interface {
+	Recv() (*names.Entry, error)
+	grpc.ClientStream
+}
+
+type queryReverseLookupClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryReverseLookupClient) Recv() (*names.Entry, error) {
+	m := new(names.Entry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error) {
 	out := new(ValidatorSet)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetValidatorSet", in, out, opts...)
@@ -1099,7 +1306,7 @@ func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opt
 }
 
 func (c *queryClient) ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ListProposals", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[3], "/rpcquery.Query/ListProposals", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1156,6 +1363,8 @@ type QueryServer interface {
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
+	ResolveName(context.Context, *ResolveNameParam) (*names.Entry, error)
+	ReverseLookup(*ReverseLookupParam, Query_ReverseLookupServer) error
 	GetValidatorSet(context.Context, *GetValidatorSetParam) (*ValidatorSet, error)
 	GetValidatorSetHistory(context.Context, *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error)
 	GetProposal(context.Context, *GetProposalParam) (*payload.Ballot, error)
@@ -1282,6 +1491,45 @@ func (x *queryListNamesServer) Send(m *names.Entry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_ResolveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveNameParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/ResolveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveName(ctx, req.(*ResolveNameParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverseLookup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReverseLookupParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ReverseLookup(m, &queryReverseLookupServer{stream})
+}
+
+type Query_ReverseLookupServer interface {
+	Send(*names.Entry) error
+	grpc.ServerStream
+}
+
+type queryReverseLookupServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryReverseLookupServer) Send(m *names.Entry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_GetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorSetParam)
 	if err := dec(in); err != nil {
@@ -1413,6 +1661,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetName",
 			Handler:    _Query_GetName_Handler,
 		},
+		{
+			MethodName: "ResolveName",
+			Handler:    _Query_ResolveName_Handler,
+		},
 		{
 			MethodName: "GetValidatorSet",
 			Handler:    _Query_GetValidatorSet_Handler,
@@ -1445,6 +1697,11 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListNames_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReverseLookup",
+			Handler:       _Query_ReverseLookup_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListProposals",
 			Handler:       _Query_ListProposals_Handler,
@@ -1663,6 +1920,62 @@ func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResolveNameParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveNameParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReverseLookupParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReverseLookupParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Owner.Size()))
+	n5, err := m.Owner.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1864,11 +2177,11 @@ func (m *ProposalResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpcquery(dAtA, i, uint64(m.Ballot.Size()))
-		n5, err := m.Ballot.MarshalTo(dAtA[i:])
+		n6, err := m.Ballot.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2075,6 +2388,36 @@ func (m *ListNamesParam) Size() (n int) {
 	return n
 }
 
+func (m *ResolveNameParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReverseLookupParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Owner.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetValidatorSetParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2265,7 +2608,7 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2293,7 +2636,7 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2303,6 +2646,9 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2322,7 +2668,7 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2332,6 +2678,9 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2346,6 +2695,9 @@ func (m *StatusParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2374,7 +2726,7 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2402,7 +2754,7 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2411,6 +2763,9 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2427,6 +2782,9 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2455,7 +2813,7 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2483,7 +2841,7 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2492,6 +2850,9 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2513,7 +2874,7 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2522,6 +2883,9 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2538,6 +2902,9 @@ func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2566,7 +2933,7 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2594,7 +2961,7 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2603,6 +2970,9 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2619,6 +2989,9 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2647,7 +3020,7 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2675,7 +3048,7 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2685,6 +3058,9 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2699,6 +3075,9 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2727,7 +3106,7 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2755,7 +3134,7 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2765,6 +3144,9 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2779,6 +3161,9 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2807,7 +3192,7 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2835,7 +3220,7 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2845,6 +3230,9 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2859,6 +3247,182 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveNameParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveNameParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReverseLookupParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReverseLookupParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReverseLookupParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2887,7 +3451,7 @@ func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2910,6 +3474,9 @@ func (m *GetValidatorSetParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2938,7 +3505,7 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2966,7 +3533,7 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IncludePrevious |= (int64(b) & 0x7F) << shift
+				m.IncludePrevious |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2980,6 +3547,9 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3008,7 +3578,7 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3036,7 +3606,7 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3045,6 +3615,9 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3062,6 +3635,9 @@ func (m *ValidatorSetHistory) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3090,7 +3666,7 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3118,7 +3694,7 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3137,7 +3713,7 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3146,6 +3722,9 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3163,6 +3742,9 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3191,7 +3773,7 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3219,7 +3801,7 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3228,6 +3810,9 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3245,6 +3830,9 @@ func (m *GetProposalParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3273,7 +3861,7 @@ func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3301,7 +3889,7 @@ func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3316,6 +3904,9 @@ func (m *ListProposalsParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3344,7 +3935,7 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3372,7 +3963,7 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3381,6 +3972,9 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3403,7 +3997,7 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3412,6 +4006,9 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpcquery
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3431,6 +4028,9 @@ func (m *ProposalResult) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3459,7 +4059,7 @@ func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3482,6 +4082,9 @@ func (m *GetStatsParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3510,7 +4113,7 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3538,7 +4141,7 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsWithCode |= (uint64(b) & 0x7F) << shift
+				m.AccountsWithCode |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3557,7 +4160,7 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountsWithoutCode |= (uint64(b) & 0x7F) << shift
+				m.AccountsWithoutCode |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3571,6 +4174,9 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3599,7 +4205,7 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3627,7 +4233,7 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3641,6 +4247,9 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpcquery
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3708,10 +4317,13 @@ func skipRpcquery(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthRpcquery
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthRpcquery
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -3740,6 +4352,9 @@ func skipRpcquery(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthRpcquery
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -3758,65 +4373,3 @@ var (
 	ErrInvalidLengthRpcquery = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowRpcquery   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_rpcquery_d450d608191f3562) }
-func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_rpcquery_d450d608191f3562) }
-
-var fileDescriptor_rpcquery_d450d608191f3562 = []byte{
-	// 872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xbb, 0xdd, 0xec, 0xee, 0xcb, 0xbf, 0x76, 0xba, 0x84, 0xe0, 0x42, 0x5a, 0x8d, 0xc4,
-	0x76, 0xa9, 0xc0, 0x89, 0x42, 0x17, 0x10, 0x1c, 0xa0, 0x41, 0x90, 0x2c, 0x7f, 0x56, 0x8b, 0x83,
-	0x5a, 0xa9, 0x07, 0xa4, 0x89, 0x3d, 0x24, 0x16, 0x8e, 0xc7, 0x8c, 0xc7, 0x45, 0xfe, 0x48, 0x7c,
-	0x0b, 0x8e, 0x7b, 0xe4, 0xcc, 0xa1, 0x42, 0xdb, 0x6f, 0xc1, 0x09, 0x79, 0xfe, 0x38, 0xb6, 0x37,
-	0xad, 0xe0, 0xc0, 0x25, 0x7a, 0xef, 0xcd, 0xef, 0xfd, 0x9e, 0xf3, 0xe6, 0xfd, 0xde, 0x40, 0x87,
-	0xc7, 0xde, 0x2f, 0x29, 0xe5, 0x99, 0x13, 0x73, 0x26, 0x18, 0x3a, 0x30, 0xbe, 0xfd, 0xfe, 0x32,
-	0x10, 0xab, 0x74, 0xe1, 0x78, 0x6c, 0x3d, 0x5c, 0xb2, 0x25, 0x1b, 0x4a, 0xc0, 0x22, 0xfd, 0x49,
-	0x7a, 0xd2, 0x91, 0x96, 0x4a, 0xb4, 0x3f, 0x2a, 0xc1, 0x05, 0x8d, 0x7c, 0xca, 0xd7, 0x41, 0x24,
-	0xca, 0x26, 0x59, 0x78, 0xc1, 0x50, 0x64, 0x31, 0x4d, 0xd4, 0xaf, 0x4e, 0x6c, 0x46, 0x64, 0x5d,
-	0x38, 0x87, 0xc4, 0x5b, 0x6b, 0xb3, 0xfb, 0x8c, 0x84, 0x81, 0x4f, 0x04, 0xe3, 0xe6, 0x8c, 0xc7,
-	0x9e, 0x36, 0xdb, 0x31, 0xc9, 0x42, 0x46, 0x7c, 0xe5, 0xe2, 0x00, 0x9a, 0x73, 0x41, 0x44, 0x9a,
-	0x5c, 0x10, 0x4e, 0xd6, 0xe8, 0x04, 0xba, 0x93, 0x90, 0x79, 0x3f, 0xff, 0x10, 0xac, 0xe9, 0x93,
-	0x40, 0xac, 0x82, 0xa8, 0x6f, 0xdd, 0xb3, 0x4e, 0x0e, 0xdd, 0x7a, 0x18, 0x8d, 0xe0, 0xb6, 0x0c,
-	0xcd, 0x29, 0x8d, 0x4a, 0xe8, 0x1d, 0x89, 0xde, 0x76, 0x84, 0x09, 0x74, 0xa7, 0x54, 0x3c, 0xf2,
-	0x3c, 0x96, 0x46, 0x42, 0x95, 0x3b, 0x87, 0xfd, 0x47, 0xbe, 0xcf, 0x69, 0x92, 0xc8, 0x32, 0xad,
-	0xc9, 0xc3, 0xcb, 0xe7, 0x77, 0x5f, 0xfb, 0xf3, 0xf9, 0xdd, 0xf7, 0x4a, 0x2d, 0x59, 0x65, 0x31,
-	0xe5, 0x21, 0xf5, 0x97, 0x94, 0x0f, 0x17, 0x29, 0xe7, 0xec, 0xd7, 0xa1, 0xc7, 0xb3, 0x58, 0x30,
-	0x47, 0xe7, 0xba, 0x86, 0x04, 0xff, 0x66, 0xc9, 0x1a, 0x73, 0xc1, 0x38, 0x59, 0xd2, 0xff, 0xa5,
-	0x06, 0xfa, 0x0a, 0x76, 0xbf, 0xa1, 0x99, 0xfc, 0xa3, 0xff, 0x9a, 0x6b, 0x11, 0x44, 0x84, 0x67,
-	0xce, 0x13, 0xc6, 0xfd, 0xf1, 0xe9, 0x87, 0x6e, 0x4e, 0x80, 0x9f, 0x42, 0x4b, 0x7f, 0xe7, 0x63,
-	0x12, 0xa6, 0x14, 0x7d, 0x0d, 0x7b, 0xd2, 0xf8, 0x6f, 0x5f, 0x59, 0x63, 0x56, 0x14, 0xf8, 0x5d,
-	0xb8, 0xf5, 0x6d, 0x90, 0x98, 0x5e, 0xeb, 0xbb, 0x3d, 0x82, 0xbd, 0xef, 0xf3, 0xf1, 0xd4, 0x37,
-	0xaa, 0x1c, 0x8c, 0xa1, 0x35, 0xa5, 0xe2, 0x9c, 0xac, 0x75, 0xbb, 0x10, 0xdc, 0xc8, 0x1d, 0x0d,
-	0x92, 0x36, 0x3e, 0x86, 0x4e, 0x4e, 0x97, 0xdb, 0xaf, 0xe4, 0xea, 0xc1, 0xd1, 0x94, 0x8a, 0xc7,
-	0x66, 0xf8, 0xe6, 0x54, 0x5d, 0x33, 0x9e, 0xc2, 0x9d, 0x5a, 0x7c, 0x16, 0x24, 0x82, 0xf1, 0xac,
-	0x18, 0xba, 0xb3, 0xc8, 0x0b, 0x53, 0x9f, 0x5e, 0x70, 0xfa, 0x2c, 0x60, 0xa9, 0xba, 0xa9, 0x5d,
-	0xb7, 0x1e, 0xc6, 0x53, 0xb8, 0xbd, 0x85, 0x05, 0x8d, 0x60, 0x5f, 0x9b, 0x7d, 0xeb, 0xde, 0xee,
-	0x49, 0x73, 0xdc, 0x73, 0x0a, 0x6d, 0x96, 0xf1, 0xae, 0x81, 0xe1, 0x73, 0x68, 0x95, 0x0f, 0x50,
-	0x0f, 0x1a, 0x2b, 0x1a, 0x2c, 0x57, 0x42, 0x56, 0xbe, 0xe1, 0x6a, 0x0f, 0x1d, 0xc3, 0xee, 0x9c,
-	0x8a, 0xfe, 0x8e, 0x64, 0x3d, 0x72, 0x36, 0xba, 0x2a, 0xb2, 0xdd, 0x1c, 0x80, 0x8f, 0xe1, 0xe6,
-	0x94, 0x8a, 0x0b, 0xce, 0x62, 0x96, 0x90, 0xb0, 0xe8, 0xe4, 0x8c, 0x24, 0x2b, 0x75, 0x9f, 0xae,
-	0xb4, 0xf1, 0x08, 0x50, 0xde, 0x49, 0x03, 0xd4, 0xdd, 0xb4, 0xe1, 0x40, 0x45, 0xa8, 0x2f, 0xd1,
-	0x07, 0x6e, 0xe1, 0xe3, 0xef, 0xa0, 0x63, 0xd0, 0x2e, 0x4d, 0xd2, 0x50, 0x6c, 0xe3, 0x45, 0xf7,
-	0xa1, 0x31, 0x21, 0x61, 0xc8, 0x84, 0x9c, 0xcb, 0xe6, 0xb8, 0xeb, 0x18, 0x99, 0xab, 0xb0, 0xab,
-	0x8f, 0x71, 0x17, 0xda, 0x52, 0x20, 0x44, 0x4f, 0x05, 0xa6, 0xb0, 0x27, 0x3d, 0xf4, 0x00, 0x6e,
-	0x9a, 0x79, 0xc9, 0x05, 0xfb, 0x05, 0xf3, 0xa9, 0x6e, 0xc6, 0xb5, 0x78, 0x2e, 0xfe, 0x72, 0x8c,
-	0xa5, 0x42, 0xc2, 0x77, 0x24, 0x7c, 0xdb, 0x11, 0xbe, 0x2f, 0xeb, 0xca, 0xb5, 0xa0, 0xfe, 0x73,
-	0x0f, 0x1a, 0xb3, 0x4a, 0xc7, 0x95, 0x37, 0xfe, 0x7b, 0x4f, 0x8f, 0x16, 0x1a, 0x43, 0x43, 0xad,
-	0x26, 0xf4, 0xfa, 0xe6, 0x3a, 0x4b, 0xcb, 0xca, 0xbe, 0x95, 0x87, 0x1d, 0xd5, 0x15, 0x8d, 0x3c,
-	0x05, 0xd8, 0xec, 0x18, 0xf4, 0xe6, 0x26, 0xaf, 0xb6, 0x79, 0xec, 0x96, 0x93, 0xaf, 0x4b, 0x03,
-	0xfc, 0x4c, 0xa6, 0x69, 0x39, 0xd6, 0xd2, 0xca, 0xcb, 0xc4, 0xee, 0x95, 0xbf, 0xa4, 0x24, 0xde,
-	0x4f, 0xa1, 0x55, 0x16, 0x1c, 0xba, 0xb3, 0xc1, 0x5d, 0x13, 0x62, 0xb5, 0xf6, 0xc8, 0x42, 0x43,
-	0xd8, 0xd7, 0x12, 0x44, 0xbd, 0x4a, 0xe9, 0x42, 0x95, 0x76, 0xcb, 0x51, 0xab, 0xfe, 0xcb, 0x48,
-	0xf0, 0x0c, 0x9d, 0xc2, 0x61, 0xa1, 0x47, 0xd4, 0xaf, 0x96, 0xda, 0x88, 0xb4, 0x9a, 0x34, 0xb2,
-	0xd0, 0x99, 0x5c, 0x8e, 0x95, 0xb9, 0x1f, 0x54, 0xea, 0x5d, 0x53, 0xae, 0xfd, 0x12, 0x21, 0xa1,
-	0x1f, 0xa1, 0xb7, 0x5d, 0xd1, 0xe8, 0x9d, 0x97, 0x32, 0x96, 0x35, 0x6f, 0xbf, 0xbd, 0x9d, 0xd8,
-	0xb0, 0x7c, 0x02, 0xcd, 0x92, 0x9e, 0x90, 0x5d, 0x21, 0xad, 0xc8, 0xcc, 0xae, 0x8f, 0x3a, 0x3a,
-	0x83, 0x76, 0x45, 0x63, 0xe8, 0xad, 0x6a, 0x87, 0xaa, 0xe2, 0xb3, 0x4b, 0xfd, 0xab, 0x0a, 0x6d,
-	0x64, 0xa1, 0x87, 0x70, 0x60, 0xd4, 0x82, 0xde, 0xa8, 0x4d, 0x85, 0x51, 0x90, 0xdd, 0xad, 0x4e,
-	0x67, 0x82, 0x3e, 0x86, 0x8e, 0x99, 0xf5, 0x19, 0x25, 0x3e, 0xe5, 0xb5, 0xdc, 0x8d, 0x0a, 0xec,
-	0xb6, 0xa3, 0xde, 0x73, 0x85, 0x9b, 0x7c, 0x7e, 0x79, 0x35, 0xb0, 0xfe, 0xb8, 0x1a, 0x58, 0x7f,
-	0x5d, 0x0d, 0xac, 0xdf, 0x5f, 0x0c, 0xac, 0xcb, 0x17, 0x03, 0xeb, 0xe9, 0x83, 0x57, 0x3f, 0x01,
-	0x3c, 0xf6, 0x86, 0x86, 0x7e, 0xd1, 0x90, 0xcf, 0xfa, 0x07, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x3d, 0x0c, 0x60, 0xec, 0x9d, 0x08, 0x00, 0x00,
-}
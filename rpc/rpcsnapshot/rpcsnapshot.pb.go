@@ -0,0 +1,657 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcsnapshot.proto
+
+package rpcsnapshot
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	snapshot "github.com/hyperledger/burrow/snapshot"
+	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ListSnapshotsParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSnapshotsParam) Reset()         { *m = ListSnapshotsParam{} }
+func (m *ListSnapshotsParam) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsParam) ProtoMessage()    {}
+func (*ListSnapshotsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25a4ca074ddf5dad, []int{0}
+}
+func (m *ListSnapshotsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSnapshotsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSnapshotsParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSnapshotsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsParam.Merge(m, src)
+}
+func (m *ListSnapshotsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSnapshotsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsParam proto.InternalMessageInfo
+
+func (*ListSnapshotsParam) XXX_MessageName() string {
+	return "rpcsnapshot.ListSnapshotsParam"
+}
+
+type GetChunkParam struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChunkParam) Reset()         { *m = GetChunkParam{} }
+func (m *GetChunkParam) String() string { return proto.CompactTextString(m) }
+func (*GetChunkParam) ProtoMessage()    {}
+func (*GetChunkParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25a4ca074ddf5dad, []int{1}
+}
+func (m *GetChunkParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetChunkParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetChunkParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetChunkParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChunkParam.Merge(m, src)
+}
+func (m *GetChunkParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetChunkParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChunkParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChunkParam proto.InternalMessageInfo
+
+func (m *GetChunkParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetChunkParam) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (*GetChunkParam) XXX_MessageName() string {
+	return "rpcsnapshot.GetChunkParam"
+}
+func init() {
+	proto.RegisterType((*ListSnapshotsParam)(nil), "rpcsnapshot.ListSnapshotsParam")
+	golang_proto.RegisterType((*ListSnapshotsParam)(nil), "rpcsnapshot.ListSnapshotsParam")
+	proto.RegisterType((*GetChunkParam)(nil), "rpcsnapshot.GetChunkParam")
+	golang_proto.RegisterType((*GetChunkParam)(nil), "rpcsnapshot.GetChunkParam")
+}
+
+func init() { proto.RegisterFile("rpcsnapshot.proto", fileDescriptor_25a4ca074ddf5dad) }
+func init() { golang_proto.RegisterFile("rpcsnapshot.proto", fileDescriptor_25a4ca074ddf5dad) }
+
+var fileDescriptor_25a4ca074ddf5dad = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0x2a, 0x48, 0x2e,
+	0xce, 0x4b, 0x2c, 0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x46,
+	0x12, 0x92, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf,
+	0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30, 0x0b, 0xa2, 0x57,
+	0x8a, 0x0f, 0xd5, 0x2c, 0x25, 0x11, 0x2e, 0x21, 0x9f, 0xcc, 0xe2, 0x92, 0x60, 0xa8, 0x68, 0x71,
+	0x40, 0x62, 0x51, 0x62, 0xae, 0x92, 0x2d, 0x17, 0xaf, 0x7b, 0x6a, 0x89, 0x73, 0x46, 0x69, 0x5e,
+	0x36, 0x58, 0x40, 0x48, 0x8c, 0x8b, 0xcd, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81,
+	0x51, 0x83, 0x25, 0x08, 0xca, 0x13, 0x12, 0xe1, 0x62, 0xf5, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60,
+	0x02, 0x0b, 0x43, 0x38, 0x46, 0xdd, 0x8c, 0x5c, 0x1c, 0x30, 0x13, 0x85, 0xdc, 0xb8, 0x78, 0x51,
+	0x6c, 0x10, 0x92, 0xd7, 0x43, 0xf6, 0x12, 0xa6, 0xed, 0x52, 0x42, 0x7a, 0x70, 0x59, 0xdf, 0xc4,
+	0xbc, 0xcc, 0xb4, 0xd4, 0xe2, 0x12, 0x03, 0x46, 0x21, 0x73, 0x2e, 0x0e, 0x98, 0x9b, 0x84, 0xa4,
+	0x50, 0x8c, 0x40, 0x71, 0xaa, 0x14, 0x3f, 0x42, 0x37, 0x58, 0xd4, 0xc9, 0xf9, 0xc4, 0x23, 0x39,
+	0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x3c, 0xf0, 0x58, 0x8e, 0xf1, 0xc4, 0x63,
+	0x39, 0xc6, 0x28, 0xe4, 0x70, 0xcb, 0xa8, 0x2c, 0x48, 0x2d, 0xca, 0x49, 0x4d, 0x49, 0x4f, 0x2d,
+	0xd2, 0x4f, 0x2a, 0x2d, 0x2a, 0xca, 0x2f, 0xd7, 0x2f, 0x2a, 0x48, 0xd6, 0x47, 0xb2, 0x23, 0x89,
+	0x0d, 0x1c, 0x5c, 0xc6, 0x80, 0x01, 0x00, 0xdf, 0x9f, 0x02, 0x4b, 0x8f, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SnapshotClient is the client API for Snapshot service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SnapshotClient interface {
+	// List the manifests of the snapshots held by this node in ascending order of height
+	ListSnapshots(ctx context.Context, in *ListSnapshotsParam, opts ...grpc.CallOption) (Snapshot_ListSnapshotsClient, error)
+	// Get a chunk of a snapshot
+	GetChunk(ctx context.Context, in *GetChunkParam, opts ...grpc.CallOption) (*snapshot.Chunk, error)
+}
+
+type snapshotClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSnapshotClient(cc *grpc.ClientConn) SnapshotClient {
+	return &snapshotClient{cc}
+}
+
+func (c *snapshotClient) ListSnapshots(ctx context.Context, in *ListSnapshotsParam, opts ...grpc.CallOption) (Snapshot_ListSnapshotsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Snapshot_serviceDesc.Streams[0], "/rpcsnapshot.Snapshot/ListSnapshots", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snapshotListSnapshotsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Snapshot_ListSnapshotsClient interface {
+	Recv() (*snapshot.Manifest, error)
+	grpc.ClientStream
+}
+
+type snapshotListSnapshotsClient struct {
+	grpc.ClientStream
+}
+
+func (x *snapshotListSnapshotsClient) Recv() (*snapshot.Manifest, error) {
+	m := new(snapshot.Manifest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *snapshotClient) GetChunk(ctx context.Context, in *GetChunkParam, opts ...grpc.CallOption) (*snapshot.Chunk, error) {
+	out := new(snapshot.Chunk)
+	err := c.cc.Invoke(ctx, "/rpcsnapshot.Snapshot/GetChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SnapshotServer is the server API for Snapshot service.
+type SnapshotServer interface {
+	// List the manifests of the snapshots held by this node in ascending order of height
+	ListSnapshots(*ListSnapshotsParam, Snapshot_ListSnapshotsServer) error
+	// Get a chunk of a snapshot
+	GetChunk(context.Context, *GetChunkParam) (*snapshot.Chunk, error)
+}
+
+func RegisterSnapshotServer(s *grpc.Server, srv SnapshotServer) {
+	s.RegisterService(&_Snapshot_serviceDesc, srv)
+}
+
+func _Snapshot_ListSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSnapshotsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnapshotServer).ListSnapshots(m, &snapshotListSnapshotsServer{stream})
+}
+
+type Snapshot_ListSnapshotsServer interface {
+	Send(*snapshot.Manifest) error
+	grpc.ServerStream
+}
+
+type snapshotListSnapshotsServer struct {
+	grpc.ServerStream
+}
+
+func (x *snapshotListSnapshotsServer) Send(m *snapshot.Manifest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Snapshot_GetChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServer).GetChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcsnapshot.Snapshot/GetChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServer).GetChunk(ctx, req.(*GetChunkParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Snapshot_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcsnapshot.Snapshot",
+	HandlerType: (*SnapshotServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChunk",
+			Handler:    _Snapshot_GetChunk_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListSnapshots",
+			Handler:       _Snapshot_ListSnapshots_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcsnapshot.proto",
+}
+
+func (m *ListSnapshotsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSnapshotsParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetChunkParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChunkParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcsnapshot(dAtA, i, uint64(m.Height))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcsnapshot(dAtA, i, uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRpcsnapshot(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListSnapshotsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetChunkParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcsnapshot(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcsnapshot(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcsnapshot(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRpcsnapshot(x uint64) (n int) {
+	return sovRpcsnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListSnapshotsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcsnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSnapshotsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSnapshotsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcsnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcsnapshot
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcsnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChunkParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcsnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChunkParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChunkParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcsnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcsnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcsnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcsnapshot
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcsnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcsnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcsnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcsnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcsnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcsnapshot
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthRpcsnapshot
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowRpcsnapshot
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipRpcsnapshot(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthRpcsnapshot
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthRpcsnapshot = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcsnapshot   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,36 @@
+package rpcsnapshot
+
+import (
+	"github.com/hyperledger/burrow/snapshot"
+	"golang.org/x/net/context"
+)
+
+type snapshotServer struct {
+	store *snapshot.Store
+}
+
+var _ SnapshotServer = &snapshotServer{}
+
+func NewSnapshotServer(store *snapshot.Store) *snapshotServer {
+	return &snapshotServer{
+		store: store,
+	}
+}
+
+func (ss *snapshotServer) ListSnapshots(param *ListSnapshotsParam, stream Snapshot_ListSnapshotsServer) error {
+	manifests, err := ss.store.ListManifests()
+	if err != nil {
+		return err
+	}
+	for _, manifest := range manifests {
+		err = stream.Send(manifest)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ss *snapshotServer) GetChunk(ctx context.Context, param *GetChunkParam) (*snapshot.Chunk, error) {
+	return ss.store.GetChunk(param.Height, param.Index)
+}
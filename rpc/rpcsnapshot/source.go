@@ -0,0 +1,45 @@
+package rpcsnapshot
+
+import (
+	"io"
+
+	"github.com/hyperledger/burrow/snapshot"
+	"golang.org/x/net/context"
+)
+
+// Restore snapshots from a peer's Snapshot service
+type clientSource struct {
+	ctx    context.Context
+	client SnapshotClient
+}
+
+var _ snapshot.Source = &clientSource{}
+
+func NewSource(ctx context.Context, client SnapshotClient) *clientSource {
+	return &clientSource{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+func (cs *clientSource) ListManifests() ([]*snapshot.Manifest, error) {
+	stream, err := cs.client.ListSnapshots(cs.ctx, &ListSnapshotsParam{})
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*snapshot.Manifest
+	for {
+		manifest, err := stream.Recv()
+		if err == io.EOF {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+}
+
+func (cs *clientSource) GetChunk(height, index uint64) (*snapshot.Chunk, error) {
+	return cs.client.GetChunk(cs.ctx, &GetChunkParam{Height: height, Index: index})
+}
@@ -1,27 +1,25 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: rpctransact.proto
 
-package rpctransact // import "github.com/hyperledger/burrow/rpc/rpctransact"
-
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import exec "github.com/hyperledger/burrow/execution/exec"
-import txs "github.com/hyperledger/burrow/txs"
-import payload "github.com/hyperledger/burrow/txs/payload"
-
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-import github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
+package rpctransact
 
 import (
-	context "golang.org/x/net/context"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	errors "github.com/hyperledger/burrow/execution/errors"
+	exec "github.com/hyperledger/burrow/execution/exec"
+	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
+	txs "github.com/hyperledger/burrow/txs"
+	payload "github.com/hyperledger/burrow/txs/payload"
 	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
 )
 
-import io "io"
-
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = golang_proto.Marshal
@@ -47,7 +45,7 @@ func (m *CallCodeParam) Reset()         { *m = CallCodeParam{} }
 func (m *CallCodeParam) String() string { return proto.CompactTextString(m) }
 func (*CallCodeParam) ProtoMessage()    {}
 func (*CallCodeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpctransact_0d4753fe41955324, []int{0}
+	return fileDescriptor_039da6ebb58a8dc9, []int{0}
 }
 func (m *CallCodeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -64,8 +62,8 @@ func (m *CallCodeParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *CallCodeParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallCodeParam.Merge(dst, src)
+func (m *CallCodeParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallCodeParam.Merge(m, src)
 }
 func (m *CallCodeParam) XXX_Size() int {
 	return m.Size()
@@ -94,8 +92,89 @@ func (*CallCodeParam) XXX_MessageName() string {
 	return "rpctransact.CallCodeParam"
 }
 
+type GasEstimate struct {
+	// The smallest GasLimit with which the CallTx succeeds increased by the margin configured on the node (but never
+	// beyond the CallTx's own GasLimit) to allow for the state changing before it runs, or the GasLimit tried if it
+	// always fails
+	GasLimit uint64 `protobuf:"varint,1,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	// The gas actually consumed when run with GasLimit
+	GasUsed uint64 `protobuf:"varint,2,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// Set if the CallTx fails even with the maximum GasLimit
+	Exception *errors.Exception `protobuf:"bytes,3,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// The reason passed to revert() or require() if the CallTx was reverted with one
+	RevertReason         string   `protobuf:"bytes,4,opt,name=RevertReason,proto3" json:"RevertReason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GasEstimate) Reset()         { *m = GasEstimate{} }
+func (m *GasEstimate) String() string { return proto.CompactTextString(m) }
+func (*GasEstimate) ProtoMessage()    {}
+func (*GasEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+}
+func (m *GasEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasEstimate.Merge(m, src)
+}
+func (m *GasEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasEstimate proto.InternalMessageInfo
+
+func (m *GasEstimate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetException() *errors.Exception {
+	if m != nil {
+		return m.Exception
+	}
+	return nil
+}
+
+func (m *GasEstimate) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (*GasEstimate) XXX_MessageName() string {
+	return "rpctransact.GasEstimate"
+}
+
 type TxEnvelope struct {
-	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
 	XXX_unrecognized     []byte                                      `json:"-"`
 	XXX_sizecache        int32                                       `json:"-"`
@@ -105,7 +184,7 @@ func (m *TxEnvelope) Reset()         { *m = TxEnvelope{} }
 func (m *TxEnvelope) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelope) ProtoMessage()    {}
 func (*TxEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpctransact_0d4753fe41955324, []int{1}
+	return fileDescriptor_039da6ebb58a8dc9, []int{2}
 }
 func (m *TxEnvelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,8 +201,8 @@ func (m *TxEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *TxEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxEnvelope.Merge(dst, src)
+func (m *TxEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEnvelope.Merge(m, src)
 }
 func (m *TxEnvelope) XXX_Size() int {
 	return m.Size()
@@ -140,9 +219,9 @@ func (*TxEnvelope) XXX_MessageName() string {
 
 type TxEnvelopeParam struct {
 	// An existing Envelope - either signed or unsigned - if the latter will be signed server-side
-	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	// If no Envelope provided then one will be generated from the provided payload and signed server-side
-	Payload              *payload.Any `protobuf:"bytes,2,opt,name=Payload" json:"Payload,omitempty"`
+	Payload              *payload.Any `protobuf:"bytes,2,opt,name=Payload,proto3" json:"Payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -152,7 +231,7 @@ func (m *TxEnvelopeParam) Reset()         { *m = TxEnvelopeParam{} }
 func (m *TxEnvelopeParam) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelopeParam) ProtoMessage()    {}
 func (*TxEnvelopeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpctransact_0d4753fe41955324, []int{2}
+	return fileDescriptor_039da6ebb58a8dc9, []int{3}
 }
 func (m *TxEnvelopeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,8 +248,8 @@ func (m *TxEnvelopeParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (dst *TxEnvelopeParam) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxEnvelopeParam.Merge(dst, src)
+func (m *TxEnvelopeParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEnvelopeParam.Merge(m, src)
 }
 func (m *TxEnvelopeParam) XXX_Size() int {
 	return m.Size()
@@ -194,12 +273,60 @@ func (*TxEnvelopeParam) XXX_MessageName() string {
 func init() {
 	proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
 	golang_proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
+	proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
+	golang_proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
 	proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	golang_proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 	golang_proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 }
 
+func init() { proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
+func init() { golang_proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
+
+var fileDescriptor_039da6ebb58a8dc9 = []byte{
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xfd, 0xf9, 0x47, 0x68, 0x9b, 0x71, 0xaa, 0xd2, 0xbd, 0x60, 0x45, 0x28, 0xa9, 0x72, 0x40,
+	0x15, 0x6a, 0xed, 0x28, 0xf4, 0xc0, 0x01, 0x81, 0x92, 0x90, 0xe6, 0x82, 0x50, 0xe5, 0x18, 0x24,
+	0xb8, 0x6d, 0xec, 0xc5, 0xb5, 0x64, 0x7b, 0xad, 0xdd, 0x4d, 0x71, 0x3e, 0x05, 0x17, 0x0e, 0x7c,
+	0x1c, 0x8e, 0x39, 0x21, 0xce, 0x3d, 0x44, 0x28, 0xfd, 0x22, 0xc8, 0x6b, 0x3b, 0xb5, 0xf3, 0xa7,
+	0xe5, 0xc2, 0x6d, 0xe6, 0xcd, 0xbe, 0xb7, 0x3b, 0xcf, 0x33, 0x86, 0x43, 0x16, 0xd9, 0x82, 0xe1,
+	0x90, 0x63, 0x5b, 0xe8, 0x11, 0xa3, 0x82, 0x22, 0xb5, 0x00, 0xd5, 0x4f, 0x5d, 0x4f, 0x5c, 0x4e,
+	0xc6, 0xba, 0x4d, 0x03, 0xc3, 0xa5, 0x2e, 0x35, 0xe4, 0x99, 0xf1, 0xe4, 0xb3, 0xcc, 0x64, 0x22,
+	0xa3, 0x94, 0x5b, 0xaf, 0x11, 0xc6, 0x28, 0xe3, 0x59, 0x06, 0x24, 0x26, 0x76, 0x16, 0xef, 0x47,
+	0x78, 0xea, 0x53, 0xec, 0x64, 0x69, 0x55, 0xc4, 0xd9, 0xa9, 0xd6, 0x57, 0x05, 0xf6, 0xfb, 0xd8,
+	0xf7, 0xfb, 0xd4, 0x21, 0x17, 0x98, 0xe1, 0x00, 0x7d, 0x00, 0xf5, 0x9c, 0xd1, 0xa0, 0xeb, 0x38,
+	0x8c, 0x70, 0xae, 0x29, 0x47, 0xca, 0x71, 0xad, 0x77, 0x36, 0x9b, 0x37, 0xff, 0xbb, 0x9e, 0x37,
+	0x4f, 0x0a, 0x2f, 0xba, 0x9c, 0x46, 0x84, 0xf9, 0xc4, 0x71, 0x09, 0x33, 0xc6, 0x13, 0xc6, 0xe8,
+	0x17, 0xc3, 0x66, 0xd3, 0x48, 0x50, 0x3d, 0xe3, 0x9a, 0x45, 0x21, 0x84, 0xa0, 0x92, 0x5c, 0xa2,
+	0xfd, 0x9f, 0x08, 0x9a, 0x32, 0x4e, 0xb0, 0x37, 0x58, 0x60, 0xed, 0x41, 0x8a, 0x25, 0x71, 0xeb,
+	0xbb, 0x02, 0xea, 0x10, 0xf3, 0x01, 0x17, 0x5e, 0x80, 0x05, 0x41, 0x75, 0xd8, 0x1b, 0x62, 0xfe,
+	0xd6, 0x0b, 0x3c, 0x21, 0x1f, 0x53, 0x31, 0x97, 0x39, 0xd2, 0x60, 0x77, 0x88, 0xf9, 0x7b, 0x4e,
+	0x1c, 0x29, 0x5b, 0x31, 0xf3, 0x14, 0x19, 0x50, 0x1d, 0xc4, 0x36, 0x89, 0x84, 0x47, 0x43, 0x29,
+	0xaf, 0x76, 0x0e, 0xf5, 0xcc, 0x9f, 0x65, 0xc1, 0xbc, 0x3d, 0x83, 0x5a, 0x50, 0x33, 0xc9, 0x15,
+	0x61, 0xc2, 0x24, 0x98, 0xd3, 0x50, 0xab, 0x1c, 0x29, 0xc7, 0x55, 0xb3, 0x84, 0xb5, 0x5c, 0x00,
+	0x2b, 0x1e, 0x84, 0x57, 0xc4, 0xa7, 0x11, 0x41, 0x1f, 0x61, 0x2f, 0x8f, 0xe5, 0xc3, 0xd4, 0xce,
+	0xbe, 0x9e, 0x18, 0x9b, 0x83, 0x3d, 0xfd, 0x7a, 0xde, 0x7c, 0x76, 0xb7, 0x61, 0xc5, 0xf3, 0xe6,
+	0x52, 0xae, 0xf5, 0x4d, 0x81, 0x83, 0xdb, 0x9b, 0xd2, 0xef, 0xf2, 0xef, 0xae, 0x43, 0x4f, 0x61,
+	0xf7, 0x22, 0x1d, 0x10, 0x69, 0xa3, 0xda, 0xa9, 0xe9, 0xf9, 0xc0, 0x74, 0xc3, 0xa9, 0x99, 0x17,
+	0x3b, 0x3f, 0x1f, 0xc2, 0x9e, 0x95, 0x0d, 0x27, 0xea, 0xc1, 0x41, 0x8f, 0x51, 0xec, 0xd8, 0x98,
+	0x0b, 0x2b, 0x1e, 0x4d, 0x43, 0x1b, 0x3d, 0xd1, 0x8b, 0x03, 0xbd, 0xd2, 0x40, 0xfd, 0x50, 0x97,
+	0x13, 0x69, 0xc5, 0x83, 0x98, 0xd8, 0x13, 0x69, 0xfa, 0x2b, 0x78, 0x54, 0xd0, 0xe8, 0xf2, 0xfb,
+	0x45, 0x6a, 0xb2, 0x67, 0x93, 0xd8, 0xc4, 0x8b, 0x04, 0x7a, 0x0d, 0x3b, 0x23, 0xcf, 0x0d, 0xad,
+	0xf8, 0x1e, 0xd6, 0xe3, 0x2d, 0x55, 0x74, 0x06, 0xea, 0x39, 0x65, 0xc1, 0xc4, 0xc7, 0x82, 0x58,
+	0x31, 0x2a, 0xf5, 0xbd, 0x9d, 0xd5, 0x06, 0x48, 0x76, 0x26, 0xeb, 0xfa, 0x60, 0x49, 0x4a, 0xc1,
+	0x4d, 0x8d, 0x9e, 0x80, 0x9a, 0x16, 0xbb, 0x7c, 0x23, 0xa5, 0xdc, 0x96, 0x01, 0xd5, 0x4c, 0xdf,
+	0x0b, 0xfe, 0x4a, 0xfe, 0x65, 0x2a, 0x9f, 0xec, 0x54, 0x42, 0xa9, 0x97, 0x1e, 0x5e, 0x5a, 0xef,
+	0x4d, 0xec, 0x17, 0xa0, 0xe6, 0xdb, 0x36, 0xc4, 0x7c, 0xfd, 0x42, 0xad, 0x24, 0x57, 0xdc, 0xcd,
+	0x36, 0xc0, 0x88, 0x84, 0xce, 0x9a, 0x11, 0x29, 0xb8, 0xc5, 0x88, 0xb4, 0xb8, 0x6a, 0x44, 0x46,
+	0x29, 0x1b, 0xd1, 0x06, 0x78, 0x87, 0x03, 0xb2, 0xa6, 0x9f, 0x82, 0x5b, 0xf4, 0xd3, 0xe2, 0xaa,
+	0x7e, 0x46, 0x29, 0xe9, 0xf7, 0xfa, 0xb3, 0x45, 0x43, 0xf9, 0xb5, 0x68, 0x28, 0xbf, 0x17, 0x0d,
+	0xe5, 0xc7, 0x4d, 0x43, 0x99, 0xdd, 0x34, 0x94, 0x4f, 0xa7, 0x77, 0x2f, 0x11, 0x8b, 0x6c, 0xa3,
+	0x60, 0xc8, 0x78, 0x47, 0xfe, 0x49, 0x9f, 0xff, 0x19, 0x00, 0x2b, 0x93, 0x3a, 0x29, 0xce, 0x05,
+	0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -230,6 +357,9 @@ type TransactClient interface {
 	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Find the minimal GasLimit with which a CallTx would succeed by simulating it against the current committed EVM
+	// state, plus the node's configured margin, the CallTx may create a contract
+	EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -320,6 +450,15 @@ func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opt
 	return out, nil
 }
 
+func (c *transactClient) EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error) {
+	out := new(GasEstimate)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, opts...)
@@ -376,6 +515,9 @@ type TransactServer interface {
 	CallTxSim(context.Context, *payload.CallTx) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error)
+	// Find the minimal GasLimit with which a CallTx would succeed by simulating it against the current committed EVM
+	// state, plus the node's configured margin, the CallTx may create a contract
+	EstimateGas(context.Context, *payload.CallTx) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -534,6 +676,24 @@ func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.CallTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).EstimateGas(ctx, req.(*payload.CallTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SendTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.SendTx)
 	if err := dec(in); err != nil {
@@ -642,6 +802,10 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallCodeSim",
 			Handler:    _Transact_CallCodeSim_Handler,
 		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Transact_EstimateGas_Handler,
+		},
 		{
 			MethodName: "SendTxSync",
 			Handler:    _Transact_SendTxSync_Handler,
@@ -704,6 +868,53 @@ func (m *CallCodeParam) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GasEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasEstimate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasUsed))
+	}
+	if m.Exception != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Exception.Size()))
+		n2, err := m.Exception.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.RevertReason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.RevertReason)))
+		i += copy(dAtA[i:], m.RevertReason)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,11 +934,11 @@ func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n2, err := m.Envelope.MarshalTo(dAtA[i:])
+		n3, err := m.Envelope.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -754,21 +965,21 @@ func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n3, err := m.Envelope.MarshalTo(dAtA[i:])
+		n4, err := m.Envelope.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.Payload != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Payload.Size()))
-		n4, err := m.Payload.MarshalTo(dAtA[i:])
+		n5, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -807,6 +1018,32 @@ func (m *CallCodeParam) Size() (n int) {
 	return n
 }
 
+func (m *GasEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasUsed))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TxEnvelope) Size() (n int) {
 	if m == nil {
 		return 0
@@ -871,7 +1108,7 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -899,7 +1136,7 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -908,6 +1145,9 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -929,7 +1169,7 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -938,6 +1178,9 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -960,7 +1203,7 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -969,6 +1212,9 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -986,6 +1232,169 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpctransact
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1014,7 +1423,7 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1042,7 +1451,7 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1051,6 +1460,9 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1070,6 +1482,9 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpctransact
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1098,7 +1513,7 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1126,7 +1541,7 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1135,6 +1550,9 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1159,7 +1577,7 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1168,6 +1586,9 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRpctransact
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1187,6 +1608,9 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthRpctransact
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1254,10 +1678,13 @@ func skipRpctransact(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthRpctransact
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthRpctransact
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -1286,6 +1713,9 @@ func skipRpctransact(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthRpctransact
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -1304,44 +1734,3 @@ var (
 	ErrInvalidLengthRpctransact = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowRpctransact   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("rpctransact.proto", fileDescriptor_rpctransact_0d4753fe41955324) }
-func init() {
-	golang_proto.RegisterFile("rpctransact.proto", fileDescriptor_rpctransact_0d4753fe41955324)
-}
-
-var fileDescriptor_rpctransact_0d4753fe41955324 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0x25, 0xfc, 0x18, 0xdb, 0x97, 0x56, 0x65, 0xbe, 0x50, 0x55, 0x28, 0x45, 0x3d, 0x20, 0x84,
-	0xb6, 0xa4, 0x2a, 0x3b, 0x22, 0x50, 0x53, 0xb6, 0x23, 0x9a, 0xd2, 0x08, 0x09, 0x6e, 0xae, 0x63,
-	0xb2, 0x48, 0x49, 0x1c, 0x39, 0x0e, 0x24, 0x7f, 0x05, 0x97, 0xfd, 0x41, 0x1c, 0x7b, 0xe4, 0xbc,
-	0x43, 0x85, 0xba, 0x7f, 0x04, 0xc5, 0x4e, 0x47, 0xd2, 0xae, 0x2b, 0x97, 0xdd, 0x9e, 0xdf, 0x97,
-	0xf7, 0xfc, 0x3d, 0x7f, 0x76, 0xe0, 0x90, 0x27, 0x44, 0x70, 0x1c, 0xa7, 0x98, 0x08, 0x33, 0xe1,
-	0x4c, 0x30, 0xa4, 0xd7, 0xa8, 0xde, 0xb1, 0x1f, 0x88, 0x8b, 0x6c, 0x66, 0x12, 0x16, 0x59, 0x3e,
-	0xf3, 0x99, 0x25, 0xbf, 0x99, 0x65, 0xdf, 0xe4, 0x4a, 0x2e, 0x24, 0x52, 0xda, 0x1e, 0xd0, 0x9c,
-	0x92, 0x0a, 0xb7, 0x13, 0x5c, 0x84, 0x0c, 0x7b, 0xd5, 0xf2, 0x40, 0xe4, 0xa9, 0x82, 0x83, 0x9f,
-	0x1a, 0xb4, 0x27, 0x38, 0x0c, 0x27, 0xcc, 0xa3, 0xe7, 0x98, 0xe3, 0x08, 0x7d, 0x06, 0xfd, 0x8c,
-	0xb3, 0x68, 0xec, 0x79, 0x9c, 0xa6, 0x69, 0x57, 0x7b, 0xa9, 0xbd, 0x6e, 0xd9, 0x27, 0xf3, 0x45,
-	0xff, 0xc1, 0xd5, 0xa2, 0x7f, 0x54, 0xeb, 0xe1, 0xa2, 0x48, 0x28, 0x0f, 0xa9, 0xe7, 0x53, 0x6e,
-	0xcd, 0x32, 0xce, 0xd9, 0x0f, 0x8b, 0xf0, 0x22, 0x11, 0xcc, 0xac, 0xb4, 0x4e, 0xdd, 0x08, 0x21,
-	0x78, 0x5c, 0x6e, 0xd2, 0x7d, 0x58, 0x1a, 0x3a, 0x12, 0x97, 0xdc, 0x47, 0x2c, 0x70, 0xf7, 0x91,
-	0xe2, 0x4a, 0x3c, 0xf0, 0x01, 0xdc, 0xfc, 0x34, 0xfe, 0x4e, 0x43, 0x96, 0x50, 0xf4, 0x05, 0xf6,
-	0x57, 0x58, 0xb6, 0xa2, 0x8f, 0xda, 0x66, 0xd9, 0xfd, 0x8a, 0xb4, 0xcd, 0xab, 0x45, 0xff, 0xcd,
-	0xdd, 0x5d, 0xd5, 0xbf, 0x77, 0x6e, 0xec, 0x06, 0x97, 0x1a, 0x74, 0xfe, 0xed, 0xa4, 0xc2, 0xdf,
-	0xdf, 0x76, 0xe8, 0x15, 0x3c, 0x3d, 0x57, 0x53, 0x90, 0x47, 0xa0, 0x8f, 0x5a, 0xe6, 0x6a, 0x2a,
-	0xe3, 0xb8, 0x70, 0x56, 0xc5, 0xd1, 0xe5, 0x13, 0xd8, 0x77, 0xab, 0x99, 0x23, 0x1b, 0x3a, 0x36,
-	0x67, 0xd8, 0x23, 0x38, 0x15, 0x6e, 0x3e, 0x2d, 0x62, 0x82, 0x5e, 0x98, 0xf5, 0x7b, 0xb2, 0x16,
-	0xa0, 0x77, 0x68, 0xca, 0xb1, 0xbb, 0xf9, 0x69, 0x4e, 0x49, 0x26, 0x02, 0x16, 0xa3, 0xf7, 0xf0,
-	0xac, 0xe6, 0x31, 0x4e, 0x77, 0x9b, 0xb4, 0x64, 0x66, 0x87, 0x12, 0x1a, 0x24, 0x02, 0x7d, 0x80,
-	0xbd, 0x69, 0xe0, 0xc7, 0x6e, 0xbe, 0x43, 0xf5, 0x7c, 0x4b, 0x15, 0x9d, 0x80, 0x7e, 0xc6, 0x78,
-	0x94, 0x85, 0x58, 0x50, 0x37, 0x47, 0x8d, 0xdc, 0xdb, 0x55, 0x43, 0x80, 0xf2, 0x62, 0x56, 0xa9,
-	0x3b, 0x37, 0x22, 0x45, 0xde, 0x16, 0xf4, 0x08, 0x74, 0x55, 0x54, 0x19, 0x37, 0x24, 0xcd, 0x58,
-	0x16, 0x1c, 0x54, 0xfe, 0x41, 0xf4, 0x5f, 0xf6, 0xef, 0x94, 0x7d, 0x79, 0x71, 0x4b, 0x49, 0xaf,
-	0xd1, 0x78, 0xe3, 0x0d, 0xdd, 0xa6, 0x1e, 0x02, 0x4c, 0x69, 0xec, 0x6d, 0xc4, 0x51, 0xe4, 0x96,
-	0x38, 0xaa, 0xb8, 0x1e, 0xa7, 0x92, 0x34, 0xe3, 0x0c, 0x01, 0x3e, 0xe1, 0x88, 0x6e, 0xf8, 0x2b,
-	0x72, 0x8b, 0xbf, 0x2a, 0xae, 0xfb, 0x57, 0x92, 0x86, 0xbf, 0x3d, 0x99, 0x2f, 0x0d, 0xed, 0xf7,
-	0xd2, 0xd0, 0xfe, 0x2c, 0x0d, 0xed, 0xd7, 0xb5, 0xa1, 0xcd, 0xaf, 0x0d, 0xed, 0xeb, 0xf1, 0xdd,
-	0x4f, 0x81, 0x27, 0xc4, 0xaa, 0x9d, 0xd2, 0x6c, 0x4f, 0xfe, 0x74, 0xde, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x01, 0x16, 0xdf, 0xeb, 0x04, 0x00, 0x00,
-}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -74,11 +76,33 @@ func (ts *transactServer) CallTxSim(ctx context.Context, param *payload.CallTx)
 	if param.Address == nil {
 		return nil, fmt.Errorf("CallSim requires a non-nil address from which to retrieve code")
 	}
-	return ts.transactor.CallSim(param.Input.Address, *param.Address, param.Data)
+	return ts.transactor.CallSim(ctx, param.Input.Address, *param.Address, param.Data)
 }
 
 func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam) (*exec.TxExecution, error) {
-	return ts.transactor.CallCodeSim(param.FromAddress, param.Code, param.Data)
+	return ts.transactor.CallCodeSim(ctx, param.FromAddress, param.Code, param.Data)
+}
+
+func (ts *transactServer) EstimateGas(ctx context.Context, param *payload.CallTx) (*GasEstimate, error) {
+	if param.Input == nil {
+		return nil, fmt.Errorf("EstimateGas requires an Input from which to make the call")
+	}
+	gasLimit, txe, err := ts.transactor.EstimateGas(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	estimate := &GasEstimate{
+		GasLimit:  gasLimit,
+		GasUsed:   txe.Result.GetGasUsed(),
+		Exception: txe.Exception,
+	}
+	if txe.Exception != nil && txe.Exception.ErrorCode() == errors.ErrorCodeExecutionReverted {
+		reason, err := abi.UnpackRevert(txe.Result.GetReturn())
+		if err == nil && reason != nil {
+			estimate.RevertReason = *reason
+		}
+	}
+	return estimate, nil
 }
 
 func (ts *transactServer) SendTxSync(ctx context.Context, param *payload.SendTx) (*exec.TxExecution, error) {
@@ -0,0 +1,57 @@
+package rpctransact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/keys/mock"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactServer_EstimateGasRevertReason(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	caller := acm.GeneratePrivateAccountFromSecret("frogs")
+	spec, err := abi.ReadAbiSpec([]byte(`[{"type":"function","name":"Error","inputs":[{"name":"","type":"string"}]}]`))
+	require.NoError(t, err)
+	reason, _, err := spec.Pack("Error", "not today")
+	require.NoError(t, err)
+	// Copy the revert data appended to the code into memory and revert with it:
+	// PUSH1 length, PUSH1 offset of the data, PUSH1 0, CODECOPY, PUSH1 length, PUSH1 0, REVERT
+	code := []byte{0x60, byte(len(reason)), 0x60, 12, 0x60, 0x00, 0x39, 0x60, byte(len(reason)), 0x60, 0x00, 0xfd}
+	contract := &acm.Account{Address: crypto.Address{1, 2, 3}, Code: append(code, reason...)}
+	st := acmstate.NewMemoryState()
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:     acm.GlobalPermissionsAddress,
+		Permissions: permission.AllAccountPermissions,
+	}))
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: caller.GetAddress(), Balance: 1000}))
+	require.NoError(t, st.UpdateAccount(contract))
+	trans := execution.NewTransactor(&bcm.Blockchain{}, execution.Params{}, event.NewEmitter(logger),
+		execution.NewAccounts(st, mock.NewKeyClient(caller), 100), nil, txs.NewAminoCodec(), logger)
+	server := NewTransactServer(trans, txs.NewAminoCodec())
+
+	estimate, err := server.EstimateGas(context.Background(), &payload.CallTx{
+		Input:   &payload.TxInput{Address: caller.GetAddress()},
+		Address: &contract.Address,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, estimate.Exception)
+	assert.Equal(t, errors.ErrorCodeExecutionReverted, estimate.Exception.ErrorCode())
+	assert.Equal(t, "not today", estimate.RevertReason)
+
+	_, err = server.EstimateGas(context.Background(), &payload.CallTx{Address: &contract.Address})
+	assert.Error(t, err, "an Input is required")
+}
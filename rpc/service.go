@@ -96,6 +96,10 @@ func (s *Service) UnconfirmedTxs(maxTxs int64) (*ResultUnconfirmedTxs, error) {
 	}, nil
 }
 
+func (s *Service) MempoolSize() int {
+	return s.nodeView.MempoolSize()
+}
+
 func (s *Service) Status() (*ResultStatus, error) {
 	return Status(s.BlockchainInfo(), s.validators, s.nodeView, "", "")
 }
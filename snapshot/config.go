@@ -0,0 +1,30 @@
+package snapshot
+
+const (
+	// Target size of a chunk in bytes - kept well below the default gRPC message size limit
+	DefaultChunkSize = 1 << 20
+	DefaultDirectory = "snapshots"
+)
+
+type SnapshotConfig struct {
+	// Whether to take periodic snapshots of state
+	Enabled bool
+	// Take a snapshot every Interval blocks
+	Interval uint64
+	// Number of most recent snapshots to retain on disk (0 retains all snapshots)
+	Keep uint64
+	// Target size of each chunk in bytes
+	ChunkSize uint64
+	// Directory in which to store snapshots, relative paths are relative to the Tendermint root
+	Directory string
+}
+
+func DefaultSnapshotConfig() *SnapshotConfig {
+	return &SnapshotConfig{
+		Enabled:   false,
+		Interval:  1000,
+		Keep:      2,
+		ChunkSize: DefaultChunkSize,
+		Directory: DefaultDirectory,
+	}
+}
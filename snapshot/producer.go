@@ -0,0 +1,124 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/process"
+)
+
+const producerSubscriber = "SnapshotProducer"
+
+// Snapshotter takes a snapshot of the state as at height (see state.State.Snapshot)
+type Snapshotter interface {
+	Snapshot(chainID string, height uint64, chunkSize uint64, writeChunk func(chunk *Chunk) error) (*Manifest, error)
+}
+
+// TendermintSnapshotter provides the Tendermint state a new node needs to resume consensus after height
+type TendermintSnapshotter interface {
+	SnapshotState(height uint64) (*TendermintState, error)
+}
+
+// Producer takes a snapshot every Interval blocks and saves it to a Store
+type Producer struct {
+	chainID     string
+	config      *SnapshotConfig
+	snapshotter Snapshotter
+	tendermint  TendermintSnapshotter
+	store       *Store
+	logger      *logging.Logger
+}
+
+func NewProducer(chainID string, config *SnapshotConfig, snapshotter Snapshotter, tendermint TendermintSnapshotter,
+	store *Store, logger *logging.Logger) *Producer {
+
+	return &Producer{
+		chainID:     chainID,
+		config:      config,
+		snapshotter: snapshotter,
+		tendermint:  tendermint,
+		store:       store,
+		logger:      logger.WithScope("SnapshotProducer"),
+	}
+}
+
+// Start taking snapshots as blocks are committed, the returned process stops the producer when shut down
+func (p *Producer) Start(subscribable event.Subscribable) (process.Process, error) {
+	if p.config.Interval == 0 {
+		return nil, fmt.Errorf("snapshot interval must be greater than zero")
+	}
+	out, err := subscribable.Subscribe(context.Background(), producerSubscriber, exec.QueryForBlockExecution(), 1)
+	if err != nil {
+		return nil, err
+	}
+	// Snapshots can take a while so take them away from the pubsub loop, if we are still busy with the previous
+	// snapshot when the next one is due we skip it
+	heights := make(chan uint64, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for height := range heights {
+			err := p.Take(height)
+			if err != nil {
+				p.logger.InfoMsg("Could not take snapshot", "height", height, structure.ErrorKey, err)
+			}
+		}
+	}()
+	go func() {
+		defer close(heights)
+		for msg := range out {
+			be, ok := msg.(*exec.BlockExecution)
+			if !ok || be.Height%p.config.Interval != 0 {
+				continue
+			}
+			select {
+			case heights <- be.Height:
+			default:
+				p.logger.InfoMsg("Skipping snapshot since previous snapshot is still in progress",
+					"height", be.Height)
+			}
+		}
+	}()
+	return process.ShutdownFunc(func(ctx context.Context) error {
+		// Closes out which in turn closes heights
+		err := subscribable.UnsubscribeAll(ctx, producerSubscriber)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	}), nil
+}
+
+// Take a snapshot at height synchronously, pruning old snapshots afterwards
+func (p *Producer) Take(height uint64) error {
+	p.logger.InfoMsg("Taking snapshot", "height", height)
+	manifest, err := p.store.Save(height, func(writeChunk func(chunk *Chunk) error) (*Manifest, error) {
+		manifest, err := p.snapshotter.Snapshot(p.chainID, height, p.config.ChunkSize, writeChunk)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Tendermint, err = p.tendermint.SnapshotState(height)
+		if err != nil {
+			return nil, fmt.Errorf("could not snapshot Tendermint state: %v", err)
+		}
+		return manifest, nil
+	})
+	if err != nil {
+		return err
+	}
+	p.logger.InfoMsg("Snapshot taken", "height", height, "app_hash", manifest.AppHash,
+		"chunks", len(manifest.ChunkHashes))
+	if p.config.Keep > 0 {
+		return p.store.Prune(p.config.Keep)
+	}
+	return nil
+}
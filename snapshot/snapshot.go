@@ -0,0 +1,116 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+)
+
+// Chunks contain the raw database entries of the IAVL trees making up the state forest (see storage.ForestExporter)
+const FormatIAVLForestEntries = uint64(1)
+
+// A Source from which snapshots may be restored, for example a local Store or a peer's Snapshot gRPC service
+type Source interface {
+	// List the manifests of the snapshots available from this source
+	ListManifests() ([]*Manifest, error)
+	// Get a particular chunk of the snapshot taken at height
+	GetChunk(height, index uint64) (*Chunk, error)
+}
+
+// Get the manifest of the snapshot in source whose app hash matches appHash
+func FindManifest(source Source, appHash []byte) (*Manifest, error) {
+	manifests, err := source.ListManifests()
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range manifests {
+		if bytes.Equal(manifest.AppHash, appHash) {
+			return manifest, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find a snapshot with app hash %X among %d snapshots available",
+		appHash, len(manifests))
+}
+
+// Fetch each chunk described by manifest from source in order, checking each against its hash in the manifest
+func ReadChunks(source Source, manifest *Manifest, fn func(chunk *Chunk) error) error {
+	for i, expectedHash := range manifest.ChunkHashes {
+		index := uint64(i)
+		chunk, err := source.GetChunk(manifest.Height, index)
+		if err != nil {
+			return fmt.Errorf("could not get chunk %d of snapshot at height %d: %v", index, manifest.Height, err)
+		}
+		if chunk.Height != manifest.Height || chunk.Index != index {
+			return fmt.Errorf("requested chunk %d of snapshot at height %d but received chunk %d at height %d",
+				index, manifest.Height, chunk.Index, chunk.Height)
+		}
+		hash, err := ChunkHash(chunk)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, expectedHash) {
+			return fmt.Errorf("chunk %d of snapshot at height %d has hash %X but manifest expects %X",
+				index, manifest.Height, hash, expectedHash)
+		}
+		err = fn(chunk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ChunkHash(chunk *Chunk) (binary.HexBytes, error) {
+	bs, err := chunk.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode chunk in order to hash it: %v", err)
+	}
+	hash := sha256.Sum256(bs)
+	return hash[:], nil
+}
+
+// Chunker accumulates entries into chunks of roughly a target size
+type Chunker struct {
+	chunkSize int
+	chunk     *Chunk
+	// Encoded size of the entries in the current chunk
+	size int
+	emit func(chunk *Chunk) error
+}
+
+func NewChunker(height uint64, chunkSize uint64, emit func(chunk *Chunk) error) *Chunker {
+	return &Chunker{
+		chunkSize: int(chunkSize),
+		chunk:     &Chunk{Height: height},
+		emit:      emit,
+	}
+}
+
+func (ch *Chunker) Add(key, value []byte) error {
+	entry := &Entry{Key: key, Value: value}
+	ch.chunk.Entries = append(ch.chunk.Entries, entry)
+	ch.size += entry.Size()
+	if ch.size >= ch.chunkSize {
+		return ch.Flush()
+	}
+	return nil
+}
+
+// Emit any partially filled chunk - must be called once all entries have been added
+func (ch *Chunker) Flush() error {
+	if len(ch.chunk.Entries) == 0 {
+		return nil
+	}
+	err := ch.emit(ch.chunk)
+	if err != nil {
+		return err
+	}
+	ch.chunk = &Chunk{
+		Height: ch.chunk.Height,
+		Index:  ch.chunk.Index + 1,
+	}
+	ch.size = 0
+	return nil
+}
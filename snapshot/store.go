@@ -0,0 +1,166 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	manifestFileName  = "manifest"
+	chunkFilePrefix   = "chunk-"
+	partialDirSuffix  = ".partial"
+	heightDigitLength = 20
+)
+
+// Store keeps snapshots on disk in the layout <dir>/<height>/manifest and <dir>/<height>/chunk-<index>
+type Store struct {
+	dir string
+}
+
+var _ Source = &Store{}
+
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Save a snapshot to the store. The take function is passed a function with which to write each chunk and should
+// return the manifest for the snapshot once all chunks are written. The snapshot becomes visible atomically once take
+// returns successfully.
+func (st *Store) Save(height uint64, take func(writeChunk func(chunk *Chunk) error) (*Manifest, error)) (*Manifest, error) {
+	dir := st.snapshotDir(height)
+	partialDir := dir + partialDirSuffix
+	err := os.RemoveAll(partialDir)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(partialDir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("could not create snapshot directory: %v", err)
+	}
+	defer os.RemoveAll(partialDir)
+	manifest, err := take(func(chunk *Chunk) error {
+		return writeMessage(filepath.Join(partialDir, chunkFileName(chunk.Index)), chunk)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = writeMessage(filepath.Join(partialDir, manifestFileName), manifest)
+	if err != nil {
+		return nil, err
+	}
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, os.Rename(partialDir, dir)
+}
+
+// List manifests in ascending order of height
+func (st *Store) ListManifests() ([]*Manifest, error) {
+	heights, err := st.heights()
+	if err != nil {
+		return nil, err
+	}
+	manifests := make([]*Manifest, len(heights))
+	for i, height := range heights {
+		manifests[i], err = st.GetManifest(height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return manifests, nil
+}
+
+func (st *Store) GetManifest(height uint64) (*Manifest, error) {
+	manifest := new(Manifest)
+	err := readMessage(filepath.Join(st.snapshotDir(height), manifestFileName), manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (st *Store) GetChunk(height, index uint64) (*Chunk, error) {
+	chunk := new(Chunk)
+	err := readMessage(filepath.Join(st.snapshotDir(height), chunkFileName(index)), chunk)
+	if err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// Delete all but the keep most recent snapshots
+func (st *Store) Prune(keep uint64) error {
+	heights, err := st.heights()
+	if err != nil {
+		return err
+	}
+	if uint64(len(heights)) <= keep {
+		return nil
+	}
+	for _, height := range heights[:uint64(len(heights))-keep] {
+		err = os.RemoveAll(st.snapshotDir(height))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *Store) heights() ([]uint64, error) {
+	infos, err := ioutil.ReadDir(st.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var heights []uint64
+	for _, info := range infos {
+		if !info.IsDir() || strings.HasSuffix(info.Name(), partialDirSuffix) {
+			continue
+		}
+		height, err := strconv.ParseUint(info.Name(), 10, 64)
+		if err != nil {
+			// Not one of ours
+			continue
+		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+func (st *Store) snapshotDir(height uint64) string {
+	return filepath.Join(st.dir, fmt.Sprintf("%0*d", heightDigitLength, height))
+}
+
+func chunkFileName(index uint64) string {
+	return fmt.Sprintf("%s%d", chunkFilePrefix, index)
+}
+
+type message interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+func writeMessage(filename string, msg message) error {
+	bs, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, bs, 0600)
+}
+
+func readMessage(filename string, msg message) error {
+	bs, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return msg.Unmarshal(bs)
+}
@@ -0,0 +1,94 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := NewStore(dir)
+
+	for _, height := range []uint64{10, 2, 30} {
+		_, err := store.Save(height, takeSnapshot(height, 100))
+		require.NoError(t, err)
+	}
+	// Failed snapshots should leave no trace
+	_, err = store.Save(40, func(writeChunk func(chunk *Chunk) error) (*Manifest, error) {
+		return nil, fmt.Errorf("oh no")
+	})
+	require.Error(t, err)
+
+	manifests, err := store.ListManifests()
+	require.NoError(t, err)
+	require.Len(t, manifests, 3)
+	assert.Equal(t, []uint64{2, 10, 30}, []uint64{manifests[0].Height, manifests[1].Height, manifests[2].Height})
+
+	manifest, err := FindManifest(store, manifests[1].AppHash)
+	require.NoError(t, err)
+	var entries int
+	err = ReadChunks(store, manifest, func(chunk *Chunk) error {
+		entries += len(chunk.Entries)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 100, entries)
+
+	require.NoError(t, store.Prune(2))
+	manifests, err = store.ListManifests()
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	assert.Equal(t, uint64(10), manifests[0].Height)
+}
+
+func TestChunker(t *testing.T) {
+	var chunks []*Chunk
+	chunker := NewChunker(1, 64, func(chunk *Chunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	for i := 0; i < 20; i++ {
+		require.NoError(t, chunker.Add([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+	require.NoError(t, chunker.Flush())
+	require.True(t, len(chunks) > 1)
+	var entries int
+	for i, chunk := range chunks {
+		assert.Equal(t, uint64(i), chunk.Index)
+		assert.Equal(t, uint64(1), chunk.Height)
+		entries += len(chunk.Entries)
+	}
+	assert.Equal(t, 20, entries)
+}
+
+func takeSnapshot(height uint64, numEntries int) func(writeChunk func(chunk *Chunk) error) (*Manifest, error) {
+	return func(writeChunk func(chunk *Chunk) error) (*Manifest, error) {
+		manifest := &Manifest{
+			Height:  height,
+			AppHash: []byte(fmt.Sprintf("hash-%d", height)),
+			Format:  FormatIAVLForestEntries,
+		}
+		chunker := NewChunker(height, 128, func(chunk *Chunk) error {
+			hash, err := ChunkHash(chunk)
+			if err != nil {
+				return err
+			}
+			manifest.ChunkHashes = append(manifest.ChunkHashes, hash)
+			return writeChunk(chunk)
+		})
+		for i := 0; i < numEntries; i++ {
+			err := chunker.Add([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return manifest, chunker.Flush()
+	}
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Call fn for each key in the domain [start, end) whose value differs between from and to in ascending key order. The
+// value passed for a key absent from one of the trees is nil. When both are saved IAVL trees only the nodes that are not
+// shared between them are loaded, so the cost is proportional to the number of changes rather than the size of the trees.
+func DiffTrees(from, to KVCallbackIterable, start, end []byte, fn func(key, fromValue, toValue []byte) error) error {
+	fromTree, fromOk := from.(iavlTree)
+	toTree, toOk := to.(iavlTree)
+	if fromOk && toOk && fromTree.immutable().db != nil && toTree.immutable().db != nil {
+		return diffIAVLTrees(fromTree.immutable(), toTree.immutable(), start, end, fn)
+	}
+	return diffIterables(from, to, start, end, fn)
+}
+
+// Compare every key of from and to
+func diffIterables(from, to KVCallbackIterable, start, end []byte, fn func(key, fromValue, toValue []byte) error) error {
+	fromIt := KVCallbackIterator(from, true, start, end)
+	defer fromIt.Close()
+	toIt := KVCallbackIterator(to, true, start, end)
+	defer toIt.Close()
+	for fromIt.Valid() || toIt.Valid() {
+		var cmp int
+		switch {
+		case !fromIt.Valid():
+			cmp = 1
+		case !toIt.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIt.Key(), toIt.Key())
+		}
+		var err error
+		switch {
+		case cmp < 0:
+			err = fn(fromIt.Key(), fromIt.Value(), nil)
+			fromIt.Next()
+		case cmp > 0:
+			err = fn(toIt.Key(), nil, toIt.Value())
+			toIt.Next()
+		default:
+			if !bytes.Equal(fromIt.Value(), toIt.Value()) {
+				err = fn(toIt.Key(), fromIt.Value(), toIt.Value())
+			}
+			fromIt.Next()
+			toIt.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Call fn for each tree with a prefix in [start, end) whose hash differs between the from and to forests, passing the
+// tree as it is in each forest. Trees with identical hashes are skipped without being loaded and DiffTrees only walks the
+// parts of the remaining trees that have changed.
+func DiffForests(from, to *ImmutableForest, start, end []byte,
+	fn func(prefix []byte, fromTree, toTree KVCallbackIterableReader) error) error {
+
+	return DiffTrees(from.commitsTree, to.commitsTree, start, end, func(prefix, fromCommit, toCommit []byte) error {
+		fromHash, err := commitHash(fromCommit)
+		if err != nil {
+			return err
+		}
+		toHash, err := commitHash(toCommit)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(fromHash, toHash) {
+			return nil
+		}
+		fromTree, err := from.tree(prefix)
+		if err != nil {
+			return err
+		}
+		toTree, err := to.tree(prefix)
+		if err != nil {
+			return err
+		}
+		return fn(prefix, fromTree, toTree)
+	})
+}
+
+func commitHash(bs []byte) ([]byte, error) {
+	if bs == nil {
+		return nil, nil
+	}
+	commitID, err := UnmarshalCommitID(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not read tree commit when diffing forests: %v", err)
+	}
+	return commitID.Hash, nil
+}
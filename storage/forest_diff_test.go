@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestDiffForests(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	set := func(prefix, key, value string) {
+		tree, err := forest.Writer(bz(prefix))
+		require.NoError(t, err)
+		if value == "" {
+			tree.Delete(bz(key))
+		} else {
+			tree.Set(bz(key), bz(value))
+		}
+	}
+	set("a", "unchanged", "1")
+	set("b", "changed", "1")
+	set("b", "removed", "1")
+	set("b", "same", "1")
+	_, fromVersion, err := forest.Save()
+	require.NoError(t, err)
+
+	set("b", "changed", "2")
+	set("b", "removed", "")
+	set("b", "added", "1")
+	set("c", "new", "1")
+	_, toVersion, err := forest.Save()
+	require.NoError(t, err)
+
+	from, err := forest.GetImmutable(fromVersion)
+	require.NoError(t, err)
+	to, err := forest.GetImmutable(toVersion)
+	require.NoError(t, err)
+
+	var diffs []string
+	err = DiffForests(from, to, nil, nil, func(prefix []byte, fromTree, toTree KVCallbackIterableReader) error {
+		return DiffTrees(fromTree, toTree, nil, nil, func(key, fromValue, toValue []byte) error {
+			diffs = append(diffs, string(prefix)+"/"+string(key)+":"+string(fromValue)+"->"+string(toValue))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"b/added:->1",
+		"b/changed:1->2",
+		"b/removed:1->",
+		"c/new:->1",
+	}, diffs)
+}
+
+func TestDiffTrees_IAVL(t *testing.T) {
+	db := &countingDB{DB: dbm.NewMemDB()}
+	tree := NewRWTree(db, 0)
+	for i := 0; i < 1000; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%04d", i)), []byte("value"))
+	}
+	_, fromVersion, err := tree.Save()
+	require.NoError(t, err)
+	tree.Set(bz("key0010"), bz("changed"))
+	tree.Set(bz("key0500"), bz("value"))
+	tree.Delete(bz("key0900"))
+	tree.Set(bz("key2000"), bz("added"))
+	_, toVersion, err := tree.Save()
+	require.NoError(t, err)
+
+	from, err := tree.GetImmutable(fromVersion)
+	require.NoError(t, err)
+	to, err := tree.GetImmutable(toVersion)
+	require.NoError(t, err)
+
+	collect := func(diff func(from, to KVCallbackIterable, start, end []byte,
+		fn func(key, fromValue, toValue []byte) error) error) []string {
+		var diffs []string
+		require.NoError(t, diff(from, to, nil, nil, func(key, fromValue, toValue []byte) error {
+			diffs = append(diffs, string(key)+":"+string(fromValue)+"->"+string(toValue))
+			return nil
+		}))
+		return diffs
+	}
+	db.gets = 0
+	diffs := collect(DiffTrees)
+	gets := db.gets
+	assert.Equal(t, []string{
+		"key0010:value->changed",
+		"key0900:value->",
+		"key2000:->added",
+	}, diffs)
+	assert.Equal(t, collect(diffIterables), diffs)
+	// Only the paths to the changed leaves are read rather than the 2000 or so nodes in each tree
+	assert.True(t, gets < 200, "read %d nodes", gets)
+}
+
+type countingDB struct {
+	dbm.DB
+	gets int
+}
+
+func (db *countingDB) Get(key []byte) []byte {
+	db.gets++
+	return db.DB.Get(key)
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// We read IAVL's node database directly in order to export the nodes of a forest exactly as they were written. Replaying
+// the keys and values of a tree into a fresh tree would not reproduce the same hashes because IAVL nodes commit to the
+// version at which they were last modified. These must match the layout used by github.com/tendermint/iavl.
+const (
+	iavlNodePrefix = 'n'
+	iavlRootPrefix = 'r'
+)
+
+// ForestExporter walks the raw database entries of a MutableForest that are reachable from the root of the forest at
+// particular versions. The entries it emits can be written to an empty database to reproduce the forest at those versions
+// and each IAVL node is verified against its hash as it is read. Entries shared between versions are only emitted once.
+type ForestExporter struct {
+	db      dbm.DB
+	visited map[string]struct{}
+}
+
+// Pass the DB that was passed to NewMutableForest
+func NewForestExporter(db dbm.DB) *ForestExporter {
+	return &ForestExporter{
+		db:      db,
+		visited: make(map[string]struct{}),
+	}
+}
+
+// Export the entries reachable from the forest at version calling fn with each one. Returns the hash of the forest at
+// version as computed from the entries.
+func (fe *ForestExporter) Export(version int64, fn func(key, value []byte) error) ([]byte, error) {
+	commitsDB := NewPrefixDB(fe.db, commitsPrefix)
+	treeDB := NewPrefixDB(fe.db, treePrefix)
+	return fe.exportTree(Prefix(commitsPrefix), commitsDB, version, func(key, value []byte) error {
+		commitID, err := UnmarshalCommitID(value)
+		if err != nil {
+			return err
+		}
+		prefix := Prefix(treePrefix).Key(key)
+		hash, err := fe.exportTree(prefix, NewPrefixDB(treeDB, string(key)), commitID.Version, nil, fn)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, commitID.Hash) {
+			return fmt.Errorf("tree with prefix %q has hash %X at version %d but forest commits hash %X",
+				key, hash, commitID.Version, commitID.Hash)
+		}
+		return nil
+	}, fn)
+}
+
+// Verify that the forest stored in db at version is complete, that every node matches its hash, and that the forest
+// has the expected hash
+func VerifyForest(db dbm.DB, version int64, hash []byte) error {
+	actualHash, err := NewForestExporter(db).Export(version, func(key, value []byte) error { return nil })
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, actualHash) {
+		return fmt.Errorf("VerifyForest() expected forest at version %d to have hash %X but it has hash %X",
+			version, hash, actualHash)
+	}
+	return nil
+}
+
+// Walks the tree stored in db (whose keys have prefix relative to the exporter's DB) at version, calling leafFn for each
+// leaf and fn for each raw DB entry
+func (fe *ForestExporter) exportTree(prefix Prefix, db dbm.DB, version int64, leafFn func(key, value []byte) error,
+	fn func(key, value []byte) error) ([]byte, error) {
+
+	rootKey := make([]byte, 9)
+	rootKey[0] = iavlRootPrefix
+	binary.BigEndian.PutUint64(rootKey[1:], uint64(version))
+	rootHash := db.Get(rootKey)
+	if rootHash == nil {
+		return nil, fmt.Errorf("could not find root of tree with prefix %q at version %d", prefix, version)
+	}
+	err := fe.emit(prefix.Key(rootKey), rootHash, fn)
+	if err != nil {
+		return nil, err
+	}
+	if len(rootHash) == 0 {
+		// Empty tree
+		return rootHash, nil
+	}
+	return rootHash, fe.exportNode(prefix, db, rootHash, leafFn, fn)
+}
+
+func (fe *ForestExporter) exportNode(prefix Prefix, db dbm.DB, hash []byte, leafFn func(key, value []byte) error,
+	fn func(key, value []byte) error) error {
+
+	key := append([]byte{iavlNodePrefix}, hash...)
+	if _, ok := fe.visited[string(prefix.Key(key))]; ok {
+		// We have already emitted this node and all of its descendants (and exported any trees referenced from its leaves)
+		return nil
+	}
+	bs := db.Get(key)
+	if bs == nil {
+		return fmt.Errorf("could not find node with hash %X in tree with prefix %q", hash, prefix)
+	}
+	node, err := decodeIAVLNode(bs)
+	if err != nil {
+		return fmt.Errorf("could not decode node with hash %X in tree with prefix %q: %v", hash, prefix, err)
+	}
+	actualHash, err := node.hash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, actualHash) {
+		return fmt.Errorf("node in tree with prefix %q stored under hash %X has hash %X", prefix, hash, actualHash)
+	}
+	err = fe.emit(prefix.Key(key), bs, fn)
+	if err != nil {
+		return err
+	}
+	if node.height == 0 {
+		if leafFn != nil {
+			return leafFn(node.key, node.value)
+		}
+		return nil
+	}
+	err = fe.exportNode(prefix, db, node.leftHash, leafFn, fn)
+	if err != nil {
+		return err
+	}
+	return fe.exportNode(prefix, db, node.rightHash, leafFn, fn)
+}
+
+func (fe *ForestExporter) emit(key, value []byte, fn func(key, value []byte) error) error {
+	if _, ok := fe.visited[string(key)]; ok {
+		return nil
+	}
+	fe.visited[string(key)] = struct{}{}
+	return fn(key, value)
+}
+
+// Mirrors the serialisation of iavl.Node
+type iavlNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func decodeIAVLNode(bs []byte) (*iavlNode, error) {
+	node := new(iavlNode)
+	var n int
+	var err error
+	node.height, n, err = amino.DecodeInt8(bs)
+	if err != nil {
+		return nil, err
+	}
+	bs = bs[n:]
+	node.size, n, err = amino.DecodeVarint(bs)
+	if err != nil {
+		return nil, err
+	}
+	bs = bs[n:]
+	node.version, n, err = amino.DecodeVarint(bs)
+	if err != nil {
+		return nil, err
+	}
+	bs = bs[n:]
+	node.key, n, err = amino.DecodeByteSlice(bs)
+	if err != nil {
+		return nil, err
+	}
+	bs = bs[n:]
+	if node.height == 0 {
+		node.value, _, err = amino.DecodeByteSlice(bs)
+		return node, err
+	}
+	node.leftHash, n, err = amino.DecodeByteSlice(bs)
+	if err != nil {
+		return nil, err
+	}
+	bs = bs[n:]
+	node.rightHash, _, err = amino.DecodeByteSlice(bs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (node *iavlNode) hash() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	err := amino.EncodeInt8(buf, node.height)
+	if err != nil {
+		return nil, err
+	}
+	err = amino.EncodeVarint(buf, node.size)
+	if err != nil {
+		return nil, err
+	}
+	err = amino.EncodeVarint(buf, node.version)
+	if err != nil {
+		return nil, err
+	}
+	if node.height == 0 {
+		err = amino.EncodeByteSlice(buf, node.key)
+		if err != nil {
+			return nil, err
+		}
+		err = amino.EncodeByteSlice(buf, tmhash.Sum(node.value))
+	} else {
+		err = amino.EncodeByteSlice(buf, node.leftHash)
+		if err != nil {
+			return nil, err
+		}
+		err = amino.EncodeByteSlice(buf, node.rightHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tmhash.Sum(buf.Bytes()), nil
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestForestExporter_Export(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+
+	setAndSave := func(prefix string, kvs ...string) ([]byte, int64) {
+		tree, err := forest.Writer(bz(prefix))
+		require.NoError(t, err)
+		for i := 0; i < len(kvs); i += 2 {
+			tree.Set(bz(kvs[i]), bz(kvs[i+1]))
+		}
+		hash, version, err := forest.Save()
+		require.NoError(t, err)
+		return hash, version
+	}
+
+	setAndSave("balances", "Caitlin", "2344", "Cora", "654456")
+	hash2, version2 := setAndSave("names", "Caitlin", "female", "Cora", "female")
+	hash3, version3 := setAndSave("balances", "Edward", "34", "Cora", "1")
+
+	exporter := NewForestExporter(db)
+	importDB := dbm.NewMemDB()
+	for _, version := range []int64{version2, version3} {
+		_, err := exporter.Export(version, func(key, value []byte) error {
+			assert.False(t, importDB.Has(key), "should not export the same entry twice")
+			importDB.Set(key, value)
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, VerifyForest(importDB, version2, hash2))
+	require.NoError(t, VerifyForest(importDB, version3, hash3))
+	require.Error(t, VerifyForest(importDB, version3, hash2))
+
+	imported, err := NewMutableForest(importDB, 100)
+	require.NoError(t, err)
+	require.NoError(t, imported.Load(version3))
+	assert.Equal(t, hash3, imported.Hash())
+
+	reader, err := imported.Reader(bz("balances"))
+	require.NoError(t, err)
+	assert.Equal(t, bz("1"), reader.Get(bz("Cora")))
+	assert.Equal(t, bz("34"), reader.Get(bz("Edward")))
+
+	// We should be able to carry on writing to the imported forest and obtain the same hash as the original
+	hash4, version4 := setAndSave("names", "Edward", "male")
+	tree, err := imported.Writer(bz("names"))
+	require.NoError(t, err)
+	tree.Set(bz("Edward"), bz("male"))
+	importedHash4, importedVersion4, err := imported.Save()
+	require.NoError(t, err)
+	assert.Equal(t, version4, importedVersion4)
+	assert.Equal(t, hash4, importedHash4)
+}
+
+func TestVerifyForest(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	tree, err := forest.Writer(bz("names"))
+	require.NoError(t, err)
+	tree.Set(bz("Lindsay"), bz("unisex"))
+	hash, version, err := forest.Save()
+	require.NoError(t, err)
+	require.NoError(t, VerifyForest(db, version, hash))
+
+	// Tamper with a leaf
+	var leafKey []byte
+	_, err = NewForestExporter(db).Export(version, func(key, value []byte) error {
+		if node, err := decodeIAVLNode(value); err == nil && node.height == 0 && string(node.key) == "Lindsay" {
+			leafKey = key
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, leafKey)
+	node, err := decodeIAVLNode(db.Get(leafKey))
+	require.NoError(t, err)
+	node.value = bz("female")
+	db.Set(leafKey, encodeIAVLNode(t, node))
+	assert.Error(t, VerifyForest(db, version, hash))
+}
+
+func encodeIAVLNode(t *testing.T, node *iavlNode) []byte {
+	buf := new(bytes.Buffer)
+	require.NoError(t, amino.EncodeInt8(buf, node.height))
+	require.NoError(t, amino.EncodeVarint(buf, node.size))
+	require.NoError(t, amino.EncodeVarint(buf, node.version))
+	require.NoError(t, amino.EncodeByteSlice(buf, node.key))
+	require.NoError(t, amino.EncodeByteSlice(buf, node.value))
+	return buf.Bytes()
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Implemented by ImmutableTree and by RWTree through its read tree
+type iavlTree interface {
+	immutable() *ImmutableTree
+}
+
+// The nodes of one tree that have not been matched against the other tree, by height then hash
+type iavlFrontier struct {
+	db     dbm.DB
+	levels map[int8]map[string]*iavlNode
+}
+
+// Diff two saved IAVL trees by expanding both from their roots one height at a time. Identical subtrees have identical
+// hashes and heights, so when we reach the height of a subtree present in both trees it is dropped from both without
+// being loaded. Only the nodes on the paths to changed leaves are ever read.
+func diffIAVLTrees(from, to *ImmutableTree, start, end []byte, fn func(key, fromValue, toValue []byte) error) error {
+	fromFrontier, err := newIAVLFrontier(from)
+	if err != nil {
+		return err
+	}
+	toFrontier, err := newIAVLFrontier(to)
+	if err != nil {
+		return err
+	}
+	height := fromFrontier.maxHeight()
+	if toFrontier.maxHeight() > height {
+		height = toFrontier.maxHeight()
+	}
+	for ; height > 0; height-- {
+		fromFrontier.dropShared(toFrontier, height)
+		err = fromFrontier.expand(height)
+		if err != nil {
+			return err
+		}
+		err = toFrontier.expand(height)
+		if err != nil {
+			return err
+		}
+	}
+	fromFrontier.dropShared(toFrontier, 0)
+	fromValues := fromFrontier.leaves(start, end)
+	toValues := toFrontier.leaves(start, end)
+	keys := make([]string, 0, len(fromValues)+len(toValues))
+	for key := range fromValues {
+		keys = append(keys, key)
+	}
+	for key := range toValues {
+		if _, ok := fromValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fromValue, inFrom := fromValues[key]
+		toValue, inTo := toValues[key]
+		if inFrom && inTo && bytes.Equal(fromValue, toValue) {
+			// Rewritten with the same value at a later version
+			continue
+		}
+		err = fn([]byte(key), fromValue, toValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newIAVLFrontier(tree *ImmutableTree) (*iavlFrontier, error) {
+	frontier := &iavlFrontier{
+		db:     tree.db,
+		levels: make(map[int8]map[string]*iavlNode),
+	}
+	hash := tree.Hash()
+	if hash == nil {
+		return frontier, nil
+	}
+	return frontier, frontier.add(hash)
+}
+
+func (frontier *iavlFrontier) maxHeight() int8 {
+	var height int8
+	for h := range frontier.levels {
+		if h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// Drop the subtrees of the given height present in both frontiers
+func (frontier *iavlFrontier) dropShared(other *iavlFrontier, height int8) {
+	nodes, otherNodes := frontier.levels[height], other.levels[height]
+	for hash := range nodes {
+		if _, ok := otherNodes[hash]; ok {
+			delete(nodes, hash)
+			delete(otherNodes, hash)
+		}
+	}
+}
+
+// Replace the nodes of the given height with their children
+func (frontier *iavlFrontier) expand(height int8) error {
+	for _, node := range frontier.levels[height] {
+		err := frontier.add(node.leftHash)
+		if err != nil {
+			return err
+		}
+		err = frontier.add(node.rightHash)
+		if err != nil {
+			return err
+		}
+	}
+	delete(frontier.levels, height)
+	return nil
+}
+
+func (frontier *iavlFrontier) add(hash []byte) error {
+	node, err := frontier.load(hash)
+	if err != nil {
+		return err
+	}
+	nodes, ok := frontier.levels[node.height]
+	if !ok {
+		nodes = make(map[string]*iavlNode)
+		frontier.levels[node.height] = nodes
+	}
+	nodes[string(hash)] = node
+	return nil
+}
+
+// The remaining leaves with keys in [start, end)
+func (frontier *iavlFrontier) leaves(start, end []byte) map[string][]byte {
+	values := make(map[string][]byte)
+	for _, node := range frontier.levels[0] {
+		if start != nil && bytes.Compare(node.key, start) < 0 || end != nil && bytes.Compare(node.key, end) >= 0 {
+			continue
+		}
+		values[string(node.key)] = node.value
+	}
+	return values
+}
+
+func (frontier *iavlFrontier) load(hash []byte) (*iavlNode, error) {
+	bs := frontier.db.Get(append([]byte{iavlNodePrefix}, hash...))
+	if bs == nil {
+		return nil, fmt.Errorf("could not find IAVL node %X when diffing trees", hash)
+	}
+	node, err := decodeIAVLNode(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode IAVL node %X when diffing trees: %v", hash, err)
+	}
+	return node, nil
+}
@@ -1,12 +1,21 @@
 package storage
 
-import "github.com/tendermint/iavl"
+import (
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
 
 // We wrap IAVL's tree types in order to provide iteration helpers and to harmonise other interface types with what we
 // expect
 
 type ImmutableTree struct {
 	*iavl.ImmutableTree
+	// The database holding the tree's nodes, used to walk saved nodes when diffing
+	db dbm.DB
+}
+
+func (imt *ImmutableTree) immutable() *ImmutableTree {
+	return imt
 }
 
 func (imt *ImmutableTree) Get(key []byte) []byte {
@@ -137,6 +137,20 @@ func (muf *MutableForest) Delete(prefix []byte) (*CommitID, error) {
 	return UnmarshalCommitID(bs)
 }
 
+// Get the number of keys in the last saved version of the tree at prefix
+func (muf *MutableForest) Size(prefix []byte) (int64, error) {
+	tree, err := muf.tree(prefix)
+	if err != nil {
+		return 0, err
+	}
+	return tree.Size(), nil
+}
+
+// Get the number of trees in the last saved version of this forest
+func (muf *MutableForest) Trees() int64 {
+	return muf.commitsTree.Size()
+}
+
 // Get the current global hash for all trees in this forest
 func (muf *MutableForest) Hash() []byte {
 	return muf.commitsTree.Hash()
@@ -105,6 +105,26 @@ func TestMutableForest_Load(t *testing.T) {
 	require.Equal(t, dump, forest.Dump())
 }
 
+func TestMutableForest_Size(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	prefix := bz("fooos")
+	tree, err := forest.Writer(prefix)
+	require.NoError(t, err)
+	tree.Set(bz("bar"), bz("nog"))
+	tree.Set(bz("baz"), bz("nag"))
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+
+	size, err := forest.Size(prefix)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), size)
+	size, err = forest.Size(bz("empty"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+	assert.Equal(t, int64(1), forest.Trees())
+}
+
 func TestSorted(t *testing.T) {
 	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
 	require.NoError(t, err)
@@ -9,12 +9,14 @@ import (
 
 type MutableTree struct {
 	*iavl.MutableTree
+	db dbm.DB
 }
 
 func NewMutableTree(db dbm.DB, cacheSize int) *MutableTree {
 	tree := iavl.NewMutableTree(db, cacheSize)
 	return &MutableTree{
 		MutableTree: tree,
+		db:          db,
 	}
 }
 
@@ -49,12 +51,12 @@ func (mut *MutableTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &ImmutableTree{tree}, nil
+	return &ImmutableTree{ImmutableTree: tree, db: mut.db}, nil
 }
 
 // Get the current working tree as an ImmutableTree (for the methods - not immutable!)
 func (mut *MutableTree) asImmutable() *ImmutableTree {
-	return &ImmutableTree{mut.MutableTree.ImmutableTree}
+	return &ImmutableTree{ImmutableTree: mut.MutableTree.ImmutableTree, db: mut.db}
 }
 
 func (mut *MutableTree) Iterate(start, end []byte, ascending bool, fn func(key []byte, value []byte) error) error {
@@ -24,7 +24,7 @@ func NewRWTree(db dbm.DB, cacheSize int) *RWTree {
 	tree := NewMutableTree(db, cacheSize)
 	return &RWTree{
 		tree:          tree,
-		ImmutableTree: &ImmutableTree{iavl.NewImmutableTree(db, cacheSize)},
+		ImmutableTree: &ImmutableTree{ImmutableTree: iavl.NewImmutableTree(db, cacheSize), db: db},
 	}
 }
 
@@ -72,6 +72,11 @@ func (rwt *RWTree) Delete(key []byte) ([]byte, bool) {
 	return rwt.tree.Remove(key)
 }
 
+// Gets the value of key in the working tree, unlike Get this reflects writes since the last save
+func (rwt *RWTree) GetWriteTree(key []byte) []byte {
+	return rwt.tree.Get(key)
+}
+
 // Returns true if there have been any writes since last save
 func (rwt *RWTree) Updated() bool {
 	return rwt.updated
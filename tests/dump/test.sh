@@ -77,7 +77,11 @@ burrow dump -j --height $height dump-after-restore.json
 
 kill $burrow_pid
 
-if cmp dump.json dump-after-restore.json
+# The header carries the chain ID and the trailer a checksum over it so leave them out of the comparison
+sed '1d;$d' dump.json > dump-rows.json
+sed '1d;$d' dump-after-restore.json > dump-rows-after-restore.json
+
+if cmp dump-rows.json dump-rows-after-restore.json
 then
 	echo "------------------------------------"
 	echo "Done."
@@ -85,6 +89,6 @@ then
 else
 	echo "RESTORE FAILURE"
 	echo "restored dump is different"
-	diff -u dump.json dump-after-restore.json
+	diff -u dump-rows.json dump-rows-after-restore.json
 	exit 1
 fi
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+type TracingConfig struct {
+	Enabled bool
+	// File to append spans to as JSON lines, or "stdout" or "stderr"
+	OutputPath string
+	// OpenTelemetry collector OTLP/HTTP traces endpoint to send spans to instead of writing them to OutputPath, such as
+	// http://localhost:4318/v1/traces
+	OTLPEndpoint string `json:",omitempty" toml:",omitempty"`
+	// Exporter to use instead of the above, set from Go before the kernel is started since it cannot be loaded from
+	// config
+	Exporter Exporter `json:"-" toml:"-"`
+}
+
+// Service name with which spans are sent to an OpenTelemetry collector
+const ServiceName = "burrow"
+
+func DefaultTracingConfig() *TracingConfig {
+	return &TracingConfig{
+		Enabled:    false,
+		OutputPath: "stderr",
+	}
+}
+
+// Tracer returns nil if tracing is not enabled
+func (conf *TracingConfig) Tracer(logger *logging.Logger) (*Tracer, error) {
+	if conf == nil || !conf.Enabled {
+		return nil, nil
+	}
+	if conf.Exporter != nil {
+		return NewTracer(conf.Exporter, logger), nil
+	}
+	if conf.OTLPEndpoint != "" {
+		return NewTracer(NewOTLPExporter(conf.OTLPEndpoint, ServiceName, logger), logger), nil
+	}
+	exporter, err := NewFileExporter(conf.OutputPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open tracing output: %v", err)
+	}
+	return NewTracer(exporter, logger), nil
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterExporter writes each span as a line of JSON, it can be used to collect traces offline
+type WriterExporter struct {
+	mtx     sync.Mutex
+	encoder *json.Encoder
+}
+
+var _ Exporter = &WriterExporter{}
+
+func NewWriterExporter(writer io.Writer) *WriterExporter {
+	return &WriterExporter{encoder: json.NewEncoder(writer)}
+}
+
+// NewFileExporter appends spans to the file at path, or writes them to stdout or stderr if path is "stdout" or
+// "stderr"
+func NewFileExporter(path string) (*WriterExporter, error) {
+	switch path {
+	case "stdout":
+		return NewWriterExporter(os.Stdout), nil
+	case "stderr":
+		return NewWriterExporter(os.Stderr), nil
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterExporter(file), nil
+}
+
+func (we *WriterExporter) ExportSpan(span *SpanData) error {
+	we.mtx.Lock()
+	defer we.mtx.Unlock()
+	return we.encoder.Encode(span)
+}
@@ -0,0 +1,231 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+const (
+	// Spans waiting to be sent, further spans are dropped until there is room
+	otlpQueueSize = 4096
+	// Spans sent in a single request
+	otlpBatchSize = 512
+	// Queued spans are sent at least this often
+	otlpFlushInterval = 5 * time.Second
+	otlpTimeout       = 10 * time.Second
+	// OTLP span kind and status codes
+	otlpSpanKindInternal = 1
+	otlpStatusCodeError  = 2
+)
+
+// OTLPExporter sends spans to an OpenTelemetry collector using OTLP over HTTP with JSON encoding. Spans are queued and
+// sent in batches from a background goroutine so that exporting never blocks the traced operation.
+type OTLPExporter struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+	logger      *logging.Logger
+	spans       chan *SpanData
+	stop        chan struct{}
+	done        chan struct{}
+	stopOnce    sync.Once
+}
+
+var _ Exporter = &OTLPExporter{}
+
+// NewOTLPExporter starts an exporter sending spans to endpoint, an OTLP/HTTP traces endpoint such as
+// http://localhost:4318/v1/traces, on behalf of serviceName. Close must be called to send any remaining spans.
+func NewOTLPExporter(endpoint, serviceName string, logger *logging.Logger) *OTLPExporter {
+	oe := &OTLPExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: otlpTimeout},
+		logger:      logger.With(structure.ComponentKey, "OTLPExporter"),
+		spans:       make(chan *SpanData, otlpQueueSize),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	go oe.run()
+	return oe
+}
+
+func (oe *OTLPExporter) ExportSpan(span *SpanData) error {
+	select {
+	case oe.spans <- span:
+		return nil
+	default:
+		return fmt.Errorf("OTLP export queue is full, dropping span")
+	}
+}
+
+// Close sends any queued spans and stops the exporter
+func (oe *OTLPExporter) Close() error {
+	oe.stopOnce.Do(func() {
+		close(oe.stop)
+	})
+	<-oe.done
+	return nil
+}
+
+func (oe *OTLPExporter) run() {
+	defer close(oe.done)
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+	var batch []*SpanData
+	for {
+		select {
+		case span := <-oe.spans:
+			batch = append(batch, span)
+			if len(batch) < otlpBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-oe.stop:
+			for {
+				select {
+				case span := <-oe.spans:
+					batch = append(batch, span)
+				default:
+					oe.send(batch)
+					return
+				}
+			}
+		}
+		oe.send(batch)
+		batch = nil
+	}
+}
+
+func (oe *OTLPExporter) send(batch []*SpanData) {
+	if len(batch) == 0 {
+		return
+	}
+	body, err := json.Marshal(oe.request(batch))
+	if err != nil {
+		oe.logger.InfoMsg("could not encode spans", structure.ErrorKey, err)
+		return
+	}
+	response, err := oe.client.Post(oe.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		oe.logger.InfoMsg("could not send spans", structure.ErrorKey, err, "spans", len(batch))
+		return
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		oe.logger.InfoMsg("collector rejected spans", "status", response.Status, "spans", len(batch))
+	}
+}
+
+// The OTLP/HTTP JSON encoding of an ExportTraceServiceRequest, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func (oe *OTLPExporter) request(batch []*SpanData) *otlpRequest {
+	spans := make([]otlpSpan, len(batch))
+	for i, data := range batch {
+		spans[i] = otlpSpan{
+			TraceID:           data.TraceID,
+			SpanID:            data.SpanID,
+			ParentSpanID:      data.ParentSpanID,
+			Name:              data.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(data.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(data.End.UnixNano(), 10),
+		}
+		for key, value := range data.Attributes {
+			spans[i].Attributes = append(spans[i].Attributes, otlpAttribute(key, value))
+		}
+		if data.Error != "" {
+			spans[i].Status = &otlpStatus{Code: otlpStatusCodeError, Message: data.Error}
+		}
+	}
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{otlpAttribute("service.name", oe.serviceName)},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/hyperledger/burrow/tracing"},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+// OTLP encodes 64-bit integers as strings in JSON. Its integers are signed so larger unsigned values are sent as
+// strings.
+func otlpAttribute(key string, value interface{}) otlpKeyValue {
+	var v map[string]interface{}
+	switch value := value.(type) {
+	case string:
+		v = map[string]interface{}{"stringValue": value}
+	case bool:
+		v = map[string]interface{}{"boolValue": value}
+	case int:
+		v = map[string]interface{}{"intValue": strconv.FormatInt(int64(value), 10)}
+	case int64:
+		v = map[string]interface{}{"intValue": strconv.FormatInt(value, 10)}
+	case uint64:
+		if value > math.MaxInt64 {
+			v = map[string]interface{}{"stringValue": strconv.FormatUint(value, 10)}
+		} else {
+			v = map[string]interface{}{"intValue": strconv.FormatUint(value, 10)}
+		}
+	case float64:
+		v = map[string]interface{}{"doubleValue": value}
+	default:
+		v = map[string]interface{}{"stringValue": fmt.Sprint(value)}
+	}
+	return otlpKeyValue{Key: key, Value: v}
+}
@@ -0,0 +1,251 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The descriptors of opentelemetry/proto/collector/trace/v1/trace_service.proto and the protos it imports from
+// opentelemetry-proto v1.0.0 (https://github.com/open-telemetry/opentelemetry-proto) as a FileDescriptorSet. They are
+// the raw descriptors embedded in the official generated code of go.opentelemetry.io/proto/otlp v1.0.0.
+const otlpDescriptorSetFile = "testdata/otlp_trace_v1.0.0.pb"
+
+const otlpExportTraceServiceRequest = ".opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest"
+
+// Check our OTLP/HTTP JSON payload against the official protos following the OTLP JSON encoding rules: fields use the
+// lowerCamelCase JSON names, enums are integers, 64-bit integers are decimal strings, and trace and span IDs are hex
+// rather than base64
+func TestOTLPRequestMatchesProto(t *testing.T) {
+	bs, err := ioutil.ReadFile(otlpDescriptorSetFile)
+	require.NoError(t, err)
+	fds := new(descriptor.FileDescriptorSet)
+	require.NoError(t, proto.Unmarshal(bs, fds))
+	schema := newProtoSchema(fds)
+
+	assert.Equal(t, int32(otlpSpanKindInternal),
+		schema.enumValue(".opentelemetry.proto.trace.v1.Span.SpanKind", "SPAN_KIND_INTERNAL"))
+	assert.Equal(t, int32(otlpStatusCodeError),
+		schema.enumValue(".opentelemetry.proto.trace.v1.Status.StatusCode", "STATUS_CODE_ERROR"))
+
+	start := time.Unix(1600000000, 123)
+	sc := SpanContext{TraceID: newTraceID(), SpanID: newSpanID(), Sampled: true}
+	batch := []*SpanData{
+		{
+			TraceID: sc.TraceID.String(),
+			SpanID:  sc.SpanID.String(),
+			Name:    "root",
+			Start:   start,
+			End:     start.Add(time.Second),
+		},
+		{
+			TraceID:      sc.TraceID.String(),
+			SpanID:       newSpanID().String(),
+			ParentSpanID: sc.SpanID.String(),
+			Name:         "child",
+			Start:        start,
+			End:          start.Add(time.Millisecond),
+			Attributes: map[string]interface{}{
+				"string":     "foo",
+				"bool":       true,
+				"int":        -1,
+				"int64":      int64(math.MinInt64),
+				"uint64":     uint64(3),
+				"big_uint64": uint64(math.MaxUint64),
+				"float64":    0.5,
+				"other":      []byte{1, 2},
+			},
+			Error: "boom",
+		},
+	}
+	body, err := json.Marshal((&OTLPExporter{serviceName: ServiceName}).request(batch))
+	require.NoError(t, err)
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload interface{}
+	require.NoError(t, decoder.Decode(&payload))
+	schema.checkMessage(t, otlpExportTraceServiceRequest, payload, "request")
+
+	// The test is only as good as the schema it checks against
+	assert.Contains(t, string(body), `"parentSpanId"`)
+	assert.Contains(t, string(body), `"doubleValue"`)
+}
+
+// Enough of the protos to check a payload against
+type protoSchema struct {
+	messages map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
+}
+
+func newProtoSchema(fds *descriptor.FileDescriptorSet) *protoSchema {
+	schema := &protoSchema{
+		messages: make(map[string]*descriptor.DescriptorProto),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+	}
+	for _, file := range fds.File {
+		prefix := "." + file.GetPackage()
+		schema.addMessages(prefix, file.MessageType)
+		schema.addEnums(prefix, file.EnumType)
+	}
+	return schema
+}
+
+func (ps *protoSchema) addMessages(prefix string, messages []*descriptor.DescriptorProto) {
+	for _, message := range messages {
+		name := prefix + "." + message.GetName()
+		ps.messages[name] = message
+		ps.addMessages(name, message.NestedType)
+		ps.addEnums(name, message.EnumType)
+	}
+}
+
+func (ps *protoSchema) addEnums(prefix string, enums []*descriptor.EnumDescriptorProto) {
+	for _, enum := range enums {
+		ps.enums[prefix+"."+enum.GetName()] = enum
+	}
+}
+
+func (ps *protoSchema) enumValue(enumName, valueName string) int32 {
+	enum, ok := ps.enums[enumName]
+	if !ok {
+		panic("unknown enum " + enumName)
+	}
+	for _, value := range enum.Value {
+		if value.GetName() == valueName {
+			return value.GetNumber()
+		}
+	}
+	panic("unknown enum value " + valueName)
+}
+
+func (ps *protoSchema) checkMessage(t *testing.T, messageName string, value interface{}, path string) {
+	message, ok := ps.messages[messageName]
+	require.True(t, ok, "%s: unknown message %s", path, messageName)
+	object, ok := value.(map[string]interface{})
+	require.True(t, ok, "%s: %s should be an object", path, messageName)
+	fields := make(map[string]*descriptor.FieldDescriptorProto)
+	for _, field := range message.Field {
+		fields[field.GetJsonName()] = field
+	}
+	oneofs := make(map[int32]string)
+	for key, value := range object {
+		fieldPath := path + "." + key
+		field, ok := fields[key]
+		if !assert.True(t, ok, "%s: %s has no field with JSON name %s", fieldPath, messageName, key) {
+			continue
+		}
+		if field.OneofIndex != nil {
+			other, set := oneofs[field.GetOneofIndex()]
+			assert.False(t, set, "%s: %s of the same oneof is also set", fieldPath, other)
+			oneofs[field.GetOneofIndex()] = key
+		}
+		if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			elements, ok := value.([]interface{})
+			if assert.True(t, ok, "%s: repeated field should be an array", fieldPath) {
+				for i, element := range elements {
+					ps.checkValue(t, field, element, fieldPath+"["+strconv.Itoa(i)+"]")
+				}
+			}
+			continue
+		}
+		ps.checkValue(t, field, value, fieldPath)
+	}
+}
+
+func (ps *protoSchema) checkValue(t *testing.T, field *descriptor.FieldDescriptorProto, value interface{}, path string) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		ps.checkMessage(t, field.GetTypeName(), value, path)
+
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		number, ok := value.(json.Number)
+		if assert.True(t, ok, "%s: enum should be a number", path) {
+			n, err := number.Int64()
+			require.NoError(t, err, path)
+			enum := ps.enums[field.GetTypeName()]
+			require.NotNil(t, enum, "%s: unknown enum %s", path, field.GetTypeName())
+			var valid bool
+			for _, v := range enum.Value {
+				valid = valid || int64(v.GetNumber()) == n
+			}
+			assert.True(t, valid, "%s: %d is not a value of %s", path, n, field.GetTypeName())
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		assert.IsType(t, "", value, path)
+
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		s, ok := value.(string)
+		if !assert.True(t, ok, "%s: bytes should be a string", path) {
+			return
+		}
+		switch field.GetName() {
+		case "trace_id", "span_id", "parent_span_id":
+			length := 8
+			if field.GetName() == "trace_id" {
+				length = 16
+			}
+			bs, err := hex.DecodeString(s)
+			if assert.NoError(t, err, "%s: IDs should be hex", path) {
+				assert.Len(t, bs, length, path)
+			}
+		default:
+			_, err := base64.StdEncoding.DecodeString(s)
+			assert.NoError(t, err, path)
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		assert.IsType(t, true, value, path)
+
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		s, ok := value.(string)
+		if assert.True(t, ok, "%s: 64-bit integer should be a string", path) {
+			_, err := strconv.ParseInt(s, 10, 64)
+			assert.NoError(t, err, path)
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		s, ok := value.(string)
+		if assert.True(t, ok, "%s: 64-bit integer should be a string", path) {
+			_, err := strconv.ParseUint(s, 10, 64)
+			assert.NoError(t, err, path)
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		number, ok := value.(json.Number)
+		if assert.True(t, ok, "%s: 32-bit integer should be a number", path) {
+			_, err := strconv.ParseInt(number.String(), 10, 32)
+			assert.NoError(t, err, path)
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		number, ok := value.(json.Number)
+		if assert.True(t, ok, "%s: 32-bit integer should be a number", path) {
+			_, err := strconv.ParseUint(number.String(), 10, 32)
+			assert.NoError(t, err, path)
+		}
+
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		number, ok := value.(json.Number)
+		if assert.True(t, ok, "%s: floating point value should be a number", path) {
+			_, err := number.Float64()
+			assert.NoError(t, err, path)
+		}
+
+	default:
+		t.Errorf("%s: unexpected field type %v", path, field.GetType())
+	}
+}
@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceparentKey is the gRPC metadata key (and HTTP header) carrying a W3C Trace Context span context
+const TraceparentKey = "traceparent"
+
+const (
+	traceparentVersion = "00"
+	flagSampled        = 0x01
+)
+
+// Traceparent formats sc as a W3C Trace Context traceparent value
+func (sc SpanContext) Traceparent() string {
+	flags := 0
+	if sc.Sampled {
+		flags = flagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceparentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceparent parses a W3C Trace Context traceparent value
+func ParseTraceparent(traceparent string) (SpanContext, error) {
+	sc := SpanContext{}
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, fmt.Errorf("traceparent '%s' is not of the form version-traceid-spanid-flags", traceparent)
+	}
+	version, err := hex.DecodeString(parts[0])
+	if err != nil || version[0] == 0xff || (version[0] == 0 && len(parts) != 4) {
+		return sc, fmt.Errorf("traceparent '%s' has invalid version", traceparent)
+	}
+	_, err = hex.Decode(sc.TraceID[:], []byte(parts[1]))
+	if err != nil {
+		return sc, fmt.Errorf("traceparent '%s' has invalid trace ID: %v", traceparent, err)
+	}
+	_, err = hex.Decode(sc.SpanID[:], []byte(parts[2]))
+	if err != nil {
+		return sc, fmt.Errorf("traceparent '%s' has invalid span ID: %v", traceparent, err)
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return sc, fmt.Errorf("traceparent '%s' has invalid flags: %v", traceparent, err)
+	}
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("traceparent '%s' has all-zero trace ID or span ID", traceparent)
+	}
+	sc.Sampled = flags[0]&flagSampled != 0
+	return sc, nil
+}
+
+// NewOutgoingContext adds the span context of the span in ctx to its outgoing gRPC metadata so that a server can
+// continue the trace
+func NewOutgoingContext(ctx context.Context) context.Context {
+	span := SpanFromContext(ctx)
+	if span == nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, TraceparentKey, span.context.Traceparent())
+}
+
+// The valid span context propagated by a client in ctx's incoming gRPC metadata if there is one
+func remoteFromContext(ctx context.Context) (SpanContext, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return SpanContext{}, false
+	}
+	values := md.Get(TraceparentKey)
+	if len(values) == 0 {
+		return SpanContext{}, false
+	}
+	sc, err := ParseTraceparent(values[0])
+	if err != nil {
+		return SpanContext{}, false
+	}
+	return sc, true
+}
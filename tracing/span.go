@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// TraceID identifies all the spans belonging to a single trace
+type TraceID [16]byte
+
+// SpanID identifies a span within a trace
+type SpanID [8]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// SpanContext is the part of a span that is propagated to its children, including across process boundaries
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// Whether the span is recorded and exported
+	Sampled bool
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// Span records a timed operation, a nil Span (as returned when tracing is disabled) ignores all calls
+type Span struct {
+	tracer  *Tracer
+	name    string
+	context SpanContext
+	parent  SpanID
+	start   time.Time
+	// The hash of the transaction this span belongs to, if any
+	txHash string
+	// The span that was active for txHash when this span was started
+	previous   *Span
+	mtx        sync.Mutex
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+// SpanData is the record of an ended span passed to an Exporter
+type SpanData struct {
+	TraceID      string                 `json:"trace_id"`
+	SpanID       string                 `json:"span_id"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	Name         string                 `json:"name"`
+	Start        time.Time              `json:"start"`
+	End          time.Time              `json:"end"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// SetAttribute annotates the span with a key-value pair
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// SetError records that the operation failed with err, a nil err is ignored
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.err = err
+}
+
+// End marks the end of the operation and exports the span if it is sampled, calls after the first are ignored
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	if s.ended {
+		s.mtx.Unlock()
+		return
+	}
+	s.ended = true
+	end := s.tracer.now()
+	data := &SpanData{
+		TraceID:    s.context.TraceID.String(),
+		SpanID:     s.context.SpanID.String(),
+		Name:       s.name,
+		Start:      s.start,
+		End:        end,
+		Attributes: s.attributes,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	if s.err != nil {
+		data.Error = s.err.Error()
+	}
+	s.mtx.Unlock()
+	s.tracer.end(s, data)
+}
+
+func (s *Span) isEnded() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.ended
+}
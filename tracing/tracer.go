@@ -0,0 +1,230 @@
+// Package tracing records spans for distributed tracing following the OpenTelemetry data model. It is a small
+// implementation of that model rather than the OpenTelemetry Go SDK because the SDK cannot be vendored with dep: its
+// OTLP exporters need google.golang.org/grpc v1.41 and the google.golang.org/protobuf runtime whereas we, along with
+// Tendermint, are pinned to grpc v1.19 and github.com/golang/protobuf v1.1, and it is released as several Go modules
+// in one repository tagged per module (such as sdk/v1.0.1), which dep cannot resolve. Spans can still be sent to any
+// OpenTelemetry collector with the OTLPExporter, whose payload is tested against the official OTLP protos, and spans
+// are handed to a pluggable Exporter when they end so an embedder able to depend on the SDK can set an Exporter that
+// forwards to it (see TracingConfig.Exporter). Span contexts are propagated from clients using W3C Trace Context
+// 'traceparent' gRPC metadata.
+//
+// Transactions are not passed down the stack with a context so spans for them are keyed by transaction hash: the span
+// most recently started for a transaction hash and not yet ended is the parent of the next span started for that hash.
+// This nests CheckTx and DeliverTx spans under the BroadcastTxSync that submitted the transaction and the execution and
+// EVM spans under those.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// Transactions for which no span has started or ended for this long are forgotten, including those with a span that
+// was never ended
+const txTraceTTL = 10 * time.Minute
+
+// Exporter receives spans as they end, it must be safe for concurrent use
+type Exporter interface {
+	ExportSpan(span *SpanData) error
+}
+
+// Tracer starts spans and exports them, a nil Tracer is disabled and starts nil spans
+type Tracer struct {
+	exporter  Exporter
+	logger    *logging.Logger
+	now       func() time.Time
+	mtx       sync.Mutex
+	txs       map[string]*txTrace
+	lastSweep time.Time
+}
+
+type txTrace struct {
+	// The first span started for the transaction
+	root SpanContext
+	// The innermost span started for the transaction that has not ended
+	active  *Span
+	updated time.Time
+}
+
+type spanKey struct{}
+
+var (
+	globalMtx    sync.RWMutex
+	globalTracer *Tracer
+)
+
+// NewTracer returns a Tracer exporting spans to exporter
+func NewTracer(exporter Exporter, logger *logging.Logger) *Tracer {
+	return &Tracer{
+		exporter: exporter,
+		logger:   logger.With(structure.ComponentKey, "Tracer"),
+		now:      time.Now,
+		txs:      make(map[string]*txTrace),
+	}
+}
+
+// SetTracer sets the Tracer used by the package-level functions, passing nil disables tracing
+func SetTracer(tracer *Tracer) {
+	globalMtx.Lock()
+	defer globalMtx.Unlock()
+	globalTracer = tracer
+}
+
+// GetTracer returns the Tracer used by the package-level functions
+func GetTracer() *Tracer {
+	globalMtx.RLock()
+	defer globalMtx.RUnlock()
+	return globalTracer
+}
+
+// Enabled returns whether a Tracer is set, it can be used to avoid the cost of preparing span names and attributes
+func Enabled() bool {
+	return GetTracer() != nil
+}
+
+// Start a span with the global Tracer
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return GetTracer().Start(ctx, name)
+}
+
+// StartTx starts a span for a transaction with the global Tracer
+func StartTx(ctx context.Context, txHash []byte, name string) (context.Context, *Span) {
+	return GetTracer().StartTx(ctx, txHash, name)
+}
+
+// SpanFromContext returns the span carried by ctx or nil if there is none
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// Start a span that is a child of the span in ctx, or of the remote span in ctx's incoming gRPC metadata, or else the
+// root of a new trace. The returned context carries the new span.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	parent, ok := parentFromContext(ctx)
+	if !ok {
+		parent = SpanContext{TraceID: newTraceID(), Sampled: true}
+	}
+	span := t.newSpan(name, parent)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// StartTx starts a span for the transaction with txHash. Its parent is the span in ctx if there is one, otherwise the
+// active span for the transaction, otherwise the first span for the transaction. If there are no spans for the
+// transaction then the trace ID is derived from txHash so spans for the same transaction on different nodes share a
+// trace.
+func (t *Tracer) StartTx(ctx context.Context, txHash []byte, name string) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	if len(txHash) == 0 {
+		return t.Start(ctx, name)
+	}
+	key := hex.EncodeToString(txHash)
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	now := t.now()
+	t.sweep(now)
+	tt, ok := t.txs[key]
+	parent, fromContext := parentFromContext(ctx)
+	switch {
+	case fromContext:
+	case ok && tt.active != nil:
+		parent = tt.active.context
+	case ok:
+		parent = tt.root
+	default:
+		parent = SpanContext{Sampled: true}
+		copy(parent.TraceID[:], txHash)
+	}
+	span := t.newSpan(name, parent)
+	span.txHash = key
+	span.SetAttribute("tx_hash", key)
+	if !ok {
+		tt = &txTrace{root: span.context}
+		t.txs[key] = tt
+	}
+	span.previous = tt.active
+	tt.active = span
+	tt.updated = now
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (t *Tracer) newSpan(name string, parent SpanContext) *Span {
+	span := &Span{
+		tracer: t,
+		name:   name,
+		context: SpanContext{
+			TraceID: parent.TraceID,
+			SpanID:  newSpanID(),
+			Sampled: parent.Sampled,
+		},
+		parent: parent.SpanID,
+		start:  t.now(),
+	}
+	return span
+}
+
+func (t *Tracer) end(span *Span, data *SpanData) {
+	if span.txHash != "" {
+		t.mtx.Lock()
+		if tt, ok := t.txs[span.txHash]; ok {
+			if tt.active == span {
+				// Spans may end out of order so skip any started before this one that have already ended
+				active := span.previous
+				for active != nil && active.isEnded() {
+					active = active.previous
+				}
+				tt.active = active
+			}
+			tt.updated = data.End
+		}
+		t.mtx.Unlock()
+	}
+	if !span.context.Sampled {
+		return
+	}
+	err := t.exporter.ExportSpan(data)
+	if err != nil {
+		t.logger.InfoMsg("could not export span", structure.ErrorKey, err, "span", data.Name)
+	}
+}
+
+// Forget transactions that have not been touched for txTraceTTL, must be called with lock held
+func (t *Tracer) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < txTraceTTL {
+		return
+	}
+	t.lastSweep = now
+	for key, tt := range t.txs {
+		if now.Sub(tt.updated) >= txTraceTTL {
+			delete(t.txs, key)
+		}
+	}
+}
+
+func parentFromContext(ctx context.Context) (SpanContext, bool) {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.context, true
+	}
+	return remoteFromContext(ctx)
+}
+
+func newTraceID() (id TraceID) {
+	rand.Read(id[:])
+	return
+}
+
+func newSpanID() (id SpanID) {
+	rand.Read(id[:])
+	return
+}
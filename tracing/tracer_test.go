@@ -0,0 +1,223 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracerStartTx(t *testing.T) {
+	exporter := new(recordingExporter)
+	tracer := NewTracer(exporter, logging.NewNoopLogger())
+	txHash := bytes.Repeat([]byte{0xab}, 32)
+
+	ctx, rpc := tracer.Start(context.Background(), "rpc")
+	_, broadcast := tracer.StartTx(ctx, txHash, "broadcast")
+	_, checkTx := tracer.StartTx(context.Background(), txHash, "checkTx")
+	_, execute := tracer.StartTx(context.Background(), txHash, "execute")
+	execute.SetError(fmt.Errorf("boom"))
+	execute.End()
+	checkTx.End()
+	_, deliverTx := tracer.StartTx(context.Background(), txHash, "deliverTx")
+	deliverTx.End()
+	broadcast.End()
+	rpc.End()
+	// Ignored
+	rpc.End()
+
+	spans := exporter.byName()
+	require.Len(t, spans, 5)
+	traceID := rpc.Context().TraceID.String()
+	for _, span := range spans {
+		assert.Equal(t, traceID, span.TraceID)
+	}
+	assert.Equal(t, "", spans["rpc"].ParentSpanID)
+	assert.Equal(t, spans["rpc"].SpanID, spans["broadcast"].ParentSpanID)
+	assert.Equal(t, spans["broadcast"].SpanID, spans["checkTx"].ParentSpanID)
+	assert.Equal(t, spans["checkTx"].SpanID, spans["execute"].ParentSpanID)
+	assert.Equal(t, spans["broadcast"].SpanID, spans["deliverTx"].ParentSpanID)
+	assert.Equal(t, "boom", spans["execute"].Error)
+	assert.Equal(t, fmt.Sprintf("%x", txHash), spans["checkTx"].Attributes["tx_hash"])
+
+	// Once all spans have ended the next is parented by the first span for the tx
+	_, recheckTx := tracer.StartTx(context.Background(), txHash, "recheckTx")
+	recheckTx.End()
+	assert.Equal(t, spans["broadcast"].SpanID, exporter.byName()["recheckTx"].ParentSpanID)
+
+	// And forgotten after a while
+	now := time.Now().Add(txTraceTTL)
+	tracer.now = func() time.Time { return now }
+	_, other := tracer.StartTx(context.Background(), []byte{1, 2, 3}, "other")
+	assert.Len(t, tracer.txs, 1)
+	other.End()
+}
+
+func TestTracerSpansEndOutOfOrder(t *testing.T) {
+	exporter := new(recordingExporter)
+	tracer := NewTracer(exporter, logging.NewNoopLogger())
+	txHash := bytes.Repeat([]byte{0xef}, 32)
+
+	_, checkTx := tracer.StartTx(context.Background(), txHash, "checkTx")
+	_, execute := tracer.StartTx(context.Background(), txHash, "execute")
+	checkTx.End()
+	execute.End()
+	// No ended span is left active
+	assert.Nil(t, tracer.txs[fmt.Sprintf("%x", txHash)].active)
+	_, deliverTx := tracer.StartTx(context.Background(), txHash, "deliverTx")
+	deliverTx.End()
+	assert.Equal(t, checkTx.Context().SpanID.String(), exporter.byName()["deliverTx"].ParentSpanID)
+
+	// A span that never ends does not keep the transaction from being forgotten
+	tracer.StartTx(context.Background(), txHash, "leaked")
+	now := time.Now().Add(txTraceTTL)
+	tracer.now = func() time.Time { return now }
+	_, other := tracer.StartTx(context.Background(), []byte{1, 2, 3}, "other")
+	assert.Len(t, tracer.txs, 1)
+	other.End()
+}
+
+func TestTracerStartTxWithoutParent(t *testing.T) {
+	exporter := new(recordingExporter)
+	tracer := NewTracer(exporter, logging.NewNoopLogger())
+	txHash := bytes.Repeat([]byte{0xcd}, 32)
+
+	_, checkTx := tracer.StartTx(context.Background(), txHash, "checkTx")
+	checkTx.End()
+	span := exporter.byName()["checkTx"]
+	assert.Equal(t, fmt.Sprintf("%x", txHash[:16]), span.TraceID)
+	assert.Equal(t, "", span.ParentSpanID)
+}
+
+func TestTracerRemoteParent(t *testing.T) {
+	exporter := new(recordingExporter)
+	tracer := NewTracer(exporter, logging.NewNoopLogger())
+	remote := SpanContext{TraceID: newTraceID(), SpanID: newSpanID(), Sampled: true}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceparentKey, remote.Traceparent()))
+	_, span := tracer.Start(ctx, "rpc")
+	span.End()
+	assert.Equal(t, remote.TraceID.String(), exporter.byName()["rpc"].TraceID)
+	assert.Equal(t, remote.SpanID.String(), exporter.byName()["rpc"].ParentSpanID)
+
+	// Not sampled by client
+	remote.Sampled = false
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceparentKey, remote.Traceparent()))
+	_, span = tracer.Start(ctx, "unsampled")
+	span.End()
+	assert.NotContains(t, exporter.byName(), "unsampled")
+}
+
+func TestNilTracer(t *testing.T) {
+	var tracer *Tracer
+	ctx, span := tracer.StartTx(context.Background(), []byte{1}, "nothing")
+	assert.Nil(t, span)
+	assert.Nil(t, SpanFromContext(ctx))
+	span.SetAttribute("foo", "bar")
+	span.SetError(fmt.Errorf("ignored"))
+	span.End()
+}
+
+func TestParseTraceparent(t *testing.T) {
+	sc, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.Traceparent())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01",
+	} {
+		_, err = ParseTraceparent(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestWriterExporter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	tracer := NewTracer(NewWriterExporter(buf), logging.NewNoopLogger())
+	_, span := tracer.Start(context.Background(), "foo")
+	span.SetAttribute("height", 3)
+	span.End()
+
+	data := new(SpanData)
+	require.NoError(t, json.Unmarshal(buf.Bytes(), data))
+	assert.Equal(t, "foo", data.Name)
+	assert.Equal(t, float64(3), data.Attributes["height"])
+	assert.Equal(t, span.Context().SpanID.String(), data.SpanID)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	requests := make(chan *otlpRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		request := new(otlpRequest)
+		require.NoError(t, json.Unmarshal(body, request))
+		requests <- request
+	}))
+	defer srv.Close()
+
+	exporter := NewOTLPExporter(srv.URL, ServiceName, logging.NewNoopLogger())
+	tracer := NewTracer(exporter, logging.NewNoopLogger())
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttribute("height", uint64(3))
+	child.SetError(fmt.Errorf("boom"))
+	child.End()
+	parent.End()
+	require.NoError(t, exporter.Close())
+
+	request := <-requests
+	require.Len(t, request.ResourceSpans, 1)
+	assert.Equal(t, "burrow", request.ResourceSpans[0].Resource.Attributes[0].Value["stringValue"])
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, parent.Context().TraceID.String(), spans[0].TraceID)
+	assert.Equal(t, parent.Context().SpanID.String(), spans[0].ParentSpanID)
+	assert.Equal(t, "height", spans[0].Attributes[0].Key)
+	assert.Equal(t, "3", spans[0].Attributes[0].Value["intValue"])
+	assert.Equal(t, &otlpStatus{Code: otlpStatusCodeError, Message: "boom"}, spans[0].Status)
+	assert.Nil(t, spans[1].Status)
+}
+
+type recordingExporter struct {
+	sync.Mutex
+	spans []*SpanData
+}
+
+func (re *recordingExporter) ExportSpan(span *SpanData) error {
+	re.Lock()
+	defer re.Unlock()
+	re.spans = append(re.spans, span)
+	return nil
+}
+
+func (re *recordingExporter) byName() map[string]*SpanData {
+	re.Lock()
+	defer re.Unlock()
+	spans := make(map[string]*SpanData)
+	for _, span := range re.spans {
+		spans[span.Name] = span
+	}
+	return spans
+}
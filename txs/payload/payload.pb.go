@@ -1,20 +1,20 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: payload.proto
 
-package payload // import "github.com/hyperledger/burrow/txs/payload"
-
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import spec "github.com/hyperledger/burrow/genesis/spec"
-import permission "github.com/hyperledger/burrow/permission"
-
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
-
-import io "io"
+package payload
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	spec "github.com/hyperledger/burrow/genesis/spec"
+	permission "github.com/hyperledger/burrow/permission"
+	io "io"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -42,6 +42,7 @@ var Ballot_ProposalState_name = map[int32]string{
 	1: "EXECUTED",
 	2: "FAILED",
 }
+
 var Ballot_ProposalState_value = map[string]int32{
 	"PROPOSED": 0,
 	"EXECUTED": 1,
@@ -51,20 +52,21 @@ var Ballot_ProposalState_value = map[string]int32{
 func (x Ballot_ProposalState) String() string {
 	return proto.EnumName(Ballot_ProposalState_name, int32(x))
 }
+
 func (Ballot_ProposalState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{14, 0}
+	return fileDescriptor_678c914f1bee6d56, []int{14, 0}
 }
 
 type Any struct {
-	CallTx               *CallTx     `protobuf:"bytes,1,opt,name=CallTx" json:"CallTx,omitempty"`
-	SendTx               *SendTx     `protobuf:"bytes,2,opt,name=SendTx" json:"SendTx,omitempty"`
-	NameTx               *NameTx     `protobuf:"bytes,3,opt,name=NameTx" json:"NameTx,omitempty"`
-	PermsTx              *PermsTx    `protobuf:"bytes,4,opt,name=PermsTx" json:"PermsTx,omitempty"`
-	GovTx                *GovTx      `protobuf:"bytes,5,opt,name=GovTx" json:"GovTx,omitempty"`
-	BondTx               *BondTx     `protobuf:"bytes,6,opt,name=BondTx" json:"BondTx,omitempty"`
-	UnbondTx             *UnbondTx   `protobuf:"bytes,7,opt,name=UnbondTx" json:"UnbondTx,omitempty"`
-	BatchTx              *BatchTx    `protobuf:"bytes,8,opt,name=BatchTx" json:"BatchTx,omitempty"`
-	ProposalTx           *ProposalTx `protobuf:"bytes,9,opt,name=ProposalTx" json:"ProposalTx,omitempty"`
+	CallTx               *CallTx     `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	SendTx               *SendTx     `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
+	NameTx               *NameTx     `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
+	PermsTx              *PermsTx    `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
+	GovTx                *GovTx      `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
+	BondTx               *BondTx     `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
+	UnbondTx             *UnbondTx   `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
+	BatchTx              *BatchTx    `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	ProposalTx           *ProposalTx `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -74,7 +76,7 @@ func (m *Any) Reset()         { *m = Any{} }
 func (m *Any) String() string { return proto.CompactTextString(m) }
 func (*Any) ProtoMessage()    {}
 func (*Any) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{0}
+	return fileDescriptor_678c914f1bee6d56, []int{0}
 }
 func (m *Any) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -91,8 +93,8 @@ func (m *Any) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Any) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Any.Merge(dst, src)
+func (m *Any) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Any.Merge(m, src)
 }
 func (m *Any) XXX_Size() int {
 	return m.Size()
@@ -178,7 +180,10 @@ type TxInput struct {
 	// The amount of native token to transfer from the input address
 	Amount uint64 `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
 	// The sequence number that this transaction will induce (i.e. one greater than the input account's current sequence)
-	Sequence             uint64   `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// When non-zero the transaction is protected from replay by its hash until this block height rather than by the
+	// input's sequence number, which is then not checked and may be used as a nonce to make the hash unique
+	ExpiryHeight         uint64   `protobuf:"varint,4,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -187,7 +192,7 @@ type TxInput struct {
 func (m *TxInput) Reset()      { *m = TxInput{} }
 func (*TxInput) ProtoMessage() {}
 func (*TxInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{1}
+	return fileDescriptor_678c914f1bee6d56, []int{1}
 }
 func (m *TxInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,8 +209,8 @@ func (m *TxInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *TxInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxInput.Merge(dst, src)
+func (m *TxInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInput.Merge(m, src)
 }
 func (m *TxInput) XXX_Size() int {
 	return m.Size()
@@ -230,6 +235,13 @@ func (m *TxInput) GetSequence() uint64 {
 	return 0
 }
 
+func (m *TxInput) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 func (*TxInput) XXX_MessageName() string {
 	return "payload.TxInput"
 }
@@ -248,7 +260,7 @@ type TxOutput struct {
 func (m *TxOutput) Reset()      { *m = TxOutput{} }
 func (*TxOutput) ProtoMessage() {}
 func (*TxOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{2}
+	return fileDescriptor_678c914f1bee6d56, []int{2}
 }
 func (m *TxOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,8 +277,8 @@ func (m *TxOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *TxOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxOutput.Merge(dst, src)
+func (m *TxOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutput.Merge(m, src)
 }
 func (m *TxOutput) XXX_Size() int {
 	return m.Size()
@@ -291,7 +303,7 @@ func (*TxOutput) XXX_MessageName() string {
 // A instruction to run smart contract code in the EVM
 type CallTx struct {
 	// The caller's input
-	Input *TxInput `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	// The contract address to call or nil if we are creating a contract
 	Address *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
 	// The upper bound on the amount of gas (and therefore EVM execution steps) this CallTx may generate
@@ -308,7 +320,7 @@ type CallTx struct {
 func (m *CallTx) Reset()      { *m = CallTx{} }
 func (*CallTx) ProtoMessage() {}
 func (*CallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{3}
+	return fileDescriptor_678c914f1bee6d56, []int{3}
 }
 func (m *CallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,8 +337,8 @@ func (m *CallTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *CallTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallTx.Merge(dst, src)
+func (m *CallTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTx.Merge(m, src)
 }
 func (m *CallTx) XXX_Size() int {
 	return m.Size()
@@ -365,9 +377,9 @@ func (*CallTx) XXX_MessageName() string {
 // A payment between two sets of parties
 type SendTx struct {
 	// The payers
-	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
+	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
 	// The payees
-	Outputs              []*TxOutput `protobuf:"bytes,2,rep,name=Outputs" json:"Outputs,omitempty"`
+	Outputs              []*TxOutput `protobuf:"bytes,2,rep,name=Outputs,proto3" json:"Outputs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -376,7 +388,7 @@ type SendTx struct {
 func (m *SendTx) Reset()      { *m = SendTx{} }
 func (*SendTx) ProtoMessage() {}
 func (*SendTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{4}
+	return fileDescriptor_678c914f1bee6d56, []int{4}
 }
 func (m *SendTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,8 +405,8 @@ func (m *SendTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *SendTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendTx.Merge(dst, src)
+func (m *SendTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTx.Merge(m, src)
 }
 func (m *SendTx) XXX_Size() int {
 	return m.Size()
@@ -412,9 +424,9 @@ func (*SendTx) XXX_MessageName() string {
 // An update to the on-chain permissions
 type PermsTx struct {
 	// The permission moderator
-	Input *TxInput `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	// The modified permissions
-	PermArgs             permission.PermArgs `protobuf:"bytes,2,opt,name=PermArgs" json:"PermArgs"`
+	PermArgs             permission.PermArgs `protobuf:"bytes,2,opt,name=PermArgs,proto3" json:"PermArgs"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -423,7 +435,7 @@ type PermsTx struct {
 func (m *PermsTx) Reset()      { *m = PermsTx{} }
 func (*PermsTx) ProtoMessage() {}
 func (*PermsTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{5}
+	return fileDescriptor_678c914f1bee6d56, []int{5}
 }
 func (m *PermsTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,8 +452,8 @@ func (m *PermsTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *PermsTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermsTx.Merge(dst, src)
+func (m *PermsTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermsTx.Merge(m, src)
 }
 func (m *PermsTx) XXX_Size() int {
 	return m.Size()
@@ -473,22 +485,24 @@ func (*PermsTx) XXX_MessageName() string {
 // A request to claim a globally unique name across the entire chain with some optional data storage leased for a fee
 type NameTx struct {
 	// The name updater
-	Input *TxInput `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	// The name to update or create
 	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	// The data to store against the name
 	Data string `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	// The fee to provide that will determine the length of the name lease
-	Fee                  uint64   `protobuf:"varint,4,opt,name=Fee,proto3" json:"Fee,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Fee uint64 `protobuf:"varint,4,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	// If set transfer ownership of the name to this address, or when registering the name make this address its owner
+	Owner                *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,5,opt,name=Owner,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *NameTx) Reset()      { *m = NameTx{} }
 func (*NameTx) ProtoMessage() {}
 func (*NameTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{6}
+	return fileDescriptor_678c914f1bee6d56, []int{6}
 }
 func (m *NameTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,8 +519,8 @@ func (m *NameTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *NameTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NameTx.Merge(dst, src)
+func (m *NameTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameTx.Merge(m, src)
 }
 func (m *NameTx) XXX_Size() int {
 	return m.Size()
@@ -550,8 +564,8 @@ func (*NameTx) XXX_MessageName() string {
 }
 
 type BondTx struct {
-	Inputs               []*TxInput  `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
-	UnbondTo             []*TxOutput `protobuf:"bytes,2,rep,name=UnbondTo" json:"UnbondTo,omitempty"`
+	Inputs               []*TxInput  `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	UnbondTo             []*TxOutput `protobuf:"bytes,2,rep,name=UnbondTo,proto3" json:"UnbondTo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -560,7 +574,7 @@ type BondTx struct {
 func (m *BondTx) Reset()      { *m = BondTx{} }
 func (*BondTx) ProtoMessage() {}
 func (*BondTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{7}
+	return fileDescriptor_678c914f1bee6d56, []int{7}
 }
 func (m *BondTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,8 +591,8 @@ func (m *BondTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *BondTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BondTx.Merge(dst, src)
+func (m *BondTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondTx.Merge(m, src)
 }
 func (m *BondTx) XXX_Size() int {
 	return m.Size()
@@ -594,7 +608,7 @@ func (*BondTx) XXX_MessageName() string {
 }
 
 type UnbondTx struct {
-	Input                *TxInput                                     `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	Input                *TxInput                                     `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	Height               uint64                                       `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
@@ -605,7 +619,7 @@ type UnbondTx struct {
 func (m *UnbondTx) Reset()      { *m = UnbondTx{} }
 func (*UnbondTx) ProtoMessage() {}
 func (*UnbondTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{8}
+	return fileDescriptor_678c914f1bee6d56, []int{8}
 }
 func (m *UnbondTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,8 +636,8 @@ func (m *UnbondTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *UnbondTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnbondTx.Merge(dst, src)
+func (m *UnbondTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondTx.Merge(m, src)
 }
 func (m *UnbondTx) XXX_Size() int {
 	return m.Size()
@@ -639,8 +653,8 @@ func (*UnbondTx) XXX_MessageName() string {
 }
 
 type GovTx struct {
-	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
-	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates" json:"AccountUpdates,omitempty"`
+	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -649,7 +663,7 @@ type GovTx struct {
 func (m *GovTx) Reset()      { *m = GovTx{} }
 func (*GovTx) ProtoMessage() {}
 func (*GovTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{9}
+	return fileDescriptor_678c914f1bee6d56, []int{9}
 }
 func (m *GovTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,8 +680,8 @@ func (m *GovTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *GovTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovTx.Merge(dst, src)
+func (m *GovTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovTx.Merge(m, src)
 }
 func (m *GovTx) XXX_Size() int {
 	return m.Size()
@@ -683,10 +697,10 @@ func (*GovTx) XXX_MessageName() string {
 }
 
 type ProposalTx struct {
-	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	VotingWeight         int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
 	ProposalHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
-	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal" json:"Proposal,omitempty"`
+	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
 	XXX_unrecognized     []byte                                         `json:"-"`
 	XXX_sizecache        int32                                          `json:"-"`
@@ -695,7 +709,7 @@ type ProposalTx struct {
 func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
 func (*ProposalTx) ProtoMessage() {}
 func (*ProposalTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{10}
+	return fileDescriptor_678c914f1bee6d56, []int{10}
 }
 func (m *ProposalTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,8 +726,8 @@ func (m *ProposalTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *ProposalTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposalTx.Merge(dst, src)
+func (m *ProposalTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTx.Merge(m, src)
 }
 func (m *ProposalTx) XXX_Size() int {
 	return m.Size()
@@ -729,8 +743,8 @@ func (*ProposalTx) XXX_MessageName() string {
 }
 
 type BatchTx struct {
-	Inputs               []*TxInput `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
-	Txs                  []*Any     `protobuf:"bytes,2,rep,name=Txs" json:"Txs,omitempty"`
+	Inputs               []*TxInput `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	Txs                  []*Any     `protobuf:"bytes,2,rep,name=Txs,proto3" json:"Txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -739,7 +753,7 @@ type BatchTx struct {
 func (m *BatchTx) Reset()      { *m = BatchTx{} }
 func (*BatchTx) ProtoMessage() {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{11}
+	return fileDescriptor_678c914f1bee6d56, []int{11}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,8 +770,8 @@ func (m *BatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *BatchTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchTx.Merge(dst, src)
+func (m *BatchTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTx.Merge(m, src)
 }
 func (m *BatchTx) XXX_Size() int {
 	return m.Size()
@@ -783,7 +797,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{12}
+	return fileDescriptor_678c914f1bee6d56, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,8 +814,8 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(dst, src)
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
 }
 func (m *Vote) XXX_Size() int {
 	return m.Size()
@@ -819,7 +833,7 @@ func (*Vote) XXX_MessageName() string {
 type Proposal struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx" json:"BatchTx,omitempty"`
+	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -828,7 +842,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{13}
+	return fileDescriptor_678c914f1bee6d56, []int{13}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,8 +859,8 @@ func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proposal.Merge(dst, src)
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
 }
 func (m *Proposal) XXX_Size() int {
 	return m.Size()
@@ -862,10 +876,10 @@ func (*Proposal) XXX_MessageName() string {
 }
 
 type Ballot struct {
-	Proposal             *Proposal                                      `protobuf:"bytes,1,opt,name=Proposal" json:"Proposal,omitempty"`
+	Proposal             *Proposal                                      `protobuf:"bytes,1,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
 	FinalizingTx         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=FinalizingTx,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"FinalizingTx,omitempty"`
 	ProposalState        Ballot_ProposalState                           `protobuf:"varint,4,opt,name=proposalState,proto3,enum=payload.Ballot_ProposalState" json:"proposalState,omitempty"`
-	Votes                []*Vote                                        `protobuf:"bytes,5,rep,name=Votes" json:"Votes,omitempty"`
+	Votes                []*Vote                                        `protobuf:"bytes,5,rep,name=Votes,proto3" json:"Votes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
 	XXX_unrecognized     []byte                                         `json:"-"`
 	XXX_sizecache        int32                                          `json:"-"`
@@ -875,7 +889,7 @@ func (m *Ballot) Reset()         { *m = Ballot{} }
 func (m *Ballot) String() string { return proto.CompactTextString(m) }
 func (*Ballot) ProtoMessage()    {}
 func (*Ballot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_payload_935b893e761fc798, []int{14}
+	return fileDescriptor_678c914f1bee6d56, []int{14}
 }
 func (m *Ballot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,8 +906,8 @@ func (m *Ballot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Ballot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Ballot.Merge(dst, src)
+func (m *Ballot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ballot.Merge(m, src)
 }
 func (m *Ballot) XXX_Size() int {
 	return m.Size()
@@ -929,6 +943,8 @@ func (*Ballot) XXX_MessageName() string {
 	return "payload.Ballot"
 }
 func init() {
+	proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
+	golang_proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
 	proto.RegisterType((*Any)(nil), "payload.Any")
 	golang_proto.RegisterType((*Any)(nil), "payload.Any")
 	proto.RegisterType((*TxInput)(nil), "payload.TxInput")
@@ -959,9 +975,77 @@ func init() {
 	golang_proto.RegisterType((*Proposal)(nil), "payload.Proposal")
 	proto.RegisterType((*Ballot)(nil), "payload.Ballot")
 	golang_proto.RegisterType((*Ballot)(nil), "payload.Ballot")
-	proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
-	golang_proto.RegisterEnum("payload.Ballot_ProposalState", Ballot_ProposalState_name, Ballot_ProposalState_value)
 }
+
+func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
+func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
+
+var fileDescriptor_678c914f1bee6d56 = []byte{
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbd, 0x6f, 0x23, 0x45,
+	0x14, 0xcf, 0x64, 0xd7, 0x1f, 0xf7, 0xce, 0x09, 0xbe, 0xe1, 0x43, 0x56, 0x24, 0xec, 0x93, 0x41,
+	0x70, 0x7c, 0xc4, 0x86, 0x3b, 0x3e, 0xa4, 0x34, 0xc8, 0x1b, 0x3b, 0x97, 0xa0, 0x53, 0x62, 0x4d,
+	0x36, 0x07, 0x42, 0xa2, 0x58, 0xdb, 0x83, 0xbd, 0xc2, 0xde, 0x59, 0x76, 0xc7, 0x77, 0x6b, 0x2a,
+	0x0a, 0x0a, 0x5a, 0x3a, 0xca, 0x14, 0xfc, 0x03, 0x54, 0x50, 0x52, 0xa6, 0xa4, 0xa6, 0x38, 0xa1,
+	0x5c, 0xc3, 0xff, 0x40, 0x83, 0x66, 0x76, 0x66, 0x3d, 0x36, 0xc7, 0xc5, 0x09, 0xe8, 0xba, 0x7d,
+	0xef, 0xfd, 0x66, 0xde, 0x7b, 0xbf, 0xf7, 0x31, 0x0b, 0x1b, 0xa1, 0x37, 0x1b, 0x33, 0x6f, 0xd0,
+	0x08, 0x23, 0xc6, 0x19, 0x2e, 0x28, 0x71, 0x6b, 0x7b, 0xe8, 0xf3, 0xd1, 0xb4, 0xd7, 0xe8, 0xb3,
+	0x49, 0x73, 0xc8, 0x86, 0xac, 0x29, 0xed, 0xbd, 0xe9, 0x17, 0x52, 0x92, 0x82, 0xfc, 0x4a, 0xcf,
+	0x6d, 0x95, 0x43, 0x1a, 0x4d, 0xfc, 0x38, 0xf6, 0x59, 0xa0, 0x34, 0x10, 0x87, 0xb4, 0x9f, 0x7e,
+	0xd7, 0xbf, 0xb7, 0xc0, 0x6a, 0x05, 0x33, 0xfc, 0x3a, 0xe4, 0x77, 0xbd, 0xf1, 0xd8, 0x4d, 0x2a,
+	0xe8, 0x26, 0xba, 0x75, 0xfd, 0xf6, 0x73, 0x0d, 0xed, 0x3d, 0x55, 0x13, 0x65, 0x16, 0xc0, 0x63,
+	0x1a, 0x0c, 0xdc, 0xa4, 0xb2, 0xbe, 0x04, 0x4c, 0xd5, 0x44, 0x99, 0x05, 0xf0, 0xd0, 0x9b, 0x50,
+	0x37, 0xa9, 0x58, 0x4b, 0xc0, 0x54, 0x4d, 0x94, 0x19, 0xbf, 0x09, 0x85, 0x2e, 0x8d, 0x26, 0xb1,
+	0x9b, 0x54, 0x6c, 0x89, 0x2c, 0x67, 0x48, 0xa5, 0x27, 0x1a, 0x80, 0x5f, 0x85, 0xdc, 0x5d, 0xf6,
+	0xc0, 0x4d, 0x2a, 0x39, 0x89, 0xdc, 0xcc, 0x90, 0x52, 0x4b, 0x52, 0xa3, 0x70, 0xed, 0x30, 0x19,
+	0x63, 0x7e, 0xc9, 0x75, 0xaa, 0x26, 0xca, 0x8c, 0xb7, 0xa1, 0x78, 0x12, 0xf4, 0x52, 0x68, 0x41,
+	0x42, 0x6f, 0x64, 0x50, 0x6d, 0x20, 0x19, 0x44, 0x44, 0xea, 0x78, 0xbc, 0x3f, 0x72, 0x93, 0x4a,
+	0x71, 0x29, 0x52, 0xa5, 0x27, 0x1a, 0x80, 0xef, 0x00, 0x74, 0x23, 0x16, 0xb2, 0xd8, 0x13, 0xa4,
+	0x5e, 0x93, 0xf0, 0xe7, 0xe7, 0x89, 0x65, 0x26, 0x62, 0xc0, 0x76, 0xec, 0xb3, 0xd3, 0x1a, 0xaa,
+	0xff, 0x8c, 0xa0, 0xe0, 0x26, 0x07, 0x41, 0x38, 0xe5, 0xf8, 0x10, 0x0a, 0xad, 0xc1, 0x20, 0xa2,
+	0x71, 0x2c, 0x0b, 0x53, 0x72, 0xde, 0x3b, 0x7b, 0x54, 0x5b, 0xfb, 0xfd, 0x51, 0xed, 0x6d, 0xa3,
+	0x0b, 0x46, 0xb3, 0x90, 0x46, 0x63, 0x3a, 0x18, 0xd2, 0xa8, 0xd9, 0x9b, 0x46, 0x11, 0x7b, 0xd8,
+	0xec, 0x47, 0xb3, 0x90, 0xb3, 0x86, 0x3a, 0x4b, 0xf4, 0x25, 0xf8, 0x25, 0xc8, 0xb7, 0x26, 0x6c,
+	0x1a, 0x70, 0x59, 0x3e, 0x9b, 0x28, 0x09, 0x6f, 0x41, 0xf1, 0x98, 0x7e, 0x35, 0xa5, 0x41, 0x9f,
+	0xca, 0x7a, 0xd9, 0x24, 0x93, 0x71, 0x1d, 0x4a, 0x9d, 0x24, 0xf4, 0xa3, 0xd9, 0x3e, 0xf5, 0x87,
+	0x23, 0x2e, 0xab, 0x64, 0x93, 0x05, 0xdd, 0x8e, 0xfd, 0xc3, 0x69, 0x6d, 0xad, 0x9e, 0x40, 0xd1,
+	0x4d, 0x8e, 0xa6, 0xfc, 0x19, 0x46, 0xae, 0x3c, 0xff, 0x85, 0x74, 0x03, 0xe3, 0xd7, 0x20, 0x27,
+	0xb9, 0xab, 0xa0, 0xa5, 0x1a, 0x29, 0x4e, 0x49, 0x6a, 0xc6, 0x1f, 0xcf, 0x03, 0x5c, 0x97, 0x01,
+	0xbe, 0x73, 0xf5, 0xe0, 0xb6, 0xa0, 0x78, 0xd7, 0x8b, 0xef, 0xf9, 0x13, 0x9f, 0x6b, 0xfa, 0xb4,
+	0x8c, 0xcb, 0x60, 0xed, 0x51, 0xaa, 0x58, 0x13, 0x9f, 0xf8, 0x00, 0xec, 0xb6, 0xc7, 0x3d, 0xd9,
+	0xc4, 0x25, 0xe7, 0x7d, 0xc5, 0xcb, 0xf6, 0xd3, 0x5d, 0xf7, 0xfc, 0xc0, 0x8b, 0x66, 0x8d, 0x7d,
+	0x9a, 0x38, 0x33, 0x4e, 0x63, 0x22, 0xaf, 0x50, 0xd9, 0xfb, 0x7a, 0x28, 0xf1, 0x2d, 0xc8, 0xcb,
+	0xec, 0x04, 0xe9, 0xd6, 0x13, 0xb3, 0x57, 0x76, 0xfc, 0x16, 0x14, 0xd2, 0x4a, 0x89, 0xf4, 0xad,
+	0x85, 0xd6, 0xd7, 0x35, 0x24, 0x1a, 0xb1, 0x53, 0xfc, 0xee, 0xb4, 0xb6, 0x26, 0x5d, 0xb1, 0x6c,
+	0x5a, 0x57, 0x26, 0xfa, 0x03, 0x28, 0x8a, 0x23, 0xad, 0x68, 0x18, 0xab, 0xa5, 0xf1, 0x42, 0xc3,
+	0x58, 0x4a, 0xda, 0xe6, 0xd8, 0x82, 0x08, 0x92, 0x61, 0x55, 0x6e, 0xbf, 0x20, 0xbd, 0x48, 0x56,
+	0x76, 0x88, 0xc1, 0x16, 0x27, 0xa4, 0xb3, 0x6b, 0x44, 0x7e, 0x0b, 0x9d, 0xe4, 0xdc, 0x4a, 0x75,
+	0xe2, 0xfb, 0x09, 0x95, 0xd9, 0x83, 0xdc, 0xd1, 0xc3, 0x80, 0x46, 0x95, 0xdc, 0x15, 0x3b, 0x22,
+	0x3d, 0xae, 0x42, 0xff, 0x52, 0xef, 0xa1, 0x4b, 0x94, 0x65, 0xbe, 0x92, 0xd8, 0xbf, 0xd7, 0x25,
+	0x83, 0x18, 0x85, 0xf9, 0x11, 0xc1, 0x7c, 0x53, 0xad, 0xca, 0xd4, 0xe1, 0xf2, 0x0c, 0xfc, 0xf7,
+	0x21, 0x55, 0x4b, 0x22, 0x9d, 0x02, 0x25, 0x19, 0x61, 0x7e, 0x83, 0xd4, 0x0a, 0xbf, 0x04, 0x27,
+	0xbb, 0xb0, 0xd9, 0xea, 0xf7, 0xc5, 0xb4, 0x9f, 0x84, 0x03, 0x8f, 0x53, 0xdd, 0xb1, 0x2f, 0x36,
+	0xe4, 0x4b, 0xe6, 0xd2, 0x49, 0x38, 0xf6, 0x38, 0x55, 0x18, 0xd9, 0x47, 0x88, 0x2c, 0x1d, 0x31,
+	0x42, 0xf8, 0x13, 0x99, 0xbb, 0x79, 0x65, 0xae, 0xea, 0x50, 0xba, 0xcf, 0xb8, 0x1f, 0x0c, 0x3f,
+	0x49, 0x33, 0x14, 0x84, 0x59, 0x64, 0x41, 0x87, 0x4f, 0xa0, 0xa4, 0x6f, 0xde, 0xf7, 0xe2, 0x91,
+	0x64, 0xa1, 0xe4, 0xbc, 0x7b, 0xf9, 0xe9, 0x5e, 0xb8, 0x46, 0x34, 0x85, 0x96, 0xd5, 0x1b, 0x79,
+	0xe3, 0x1f, 0x4f, 0x09, 0xc9, 0x20, 0x46, 0xaa, 0x9f, 0x67, 0x2f, 0xd6, 0x25, 0xe8, 0xae, 0x82,
+	0xe5, 0x26, 0x9a, 0xe3, 0x52, 0x06, 0x6b, 0x05, 0x33, 0x22, 0x0c, 0xc6, 0xf5, 0xdf, 0x22, 0xb0,
+	0xef, 0x33, 0x4e, 0xff, 0xf7, 0x65, 0xbf, 0x02, 0xd7, 0x46, 0x18, 0x0f, 0xe6, 0xf4, 0x64, 0xb3,
+	0x8f, 0x8c, 0xd9, 0xbf, 0x09, 0xd7, 0xdb, 0x34, 0xee, 0x47, 0x7e, 0xc8, 0x7d, 0x16, 0xa8, 0xb5,
+	0x60, 0xaa, 0xcc, 0x97, 0xdd, 0xba, 0xe0, 0x65, 0x37, 0xfc, 0xfe, 0xb4, 0x0e, 0x79, 0xc7, 0x1b,
+	0x8f, 0x19, 0x5f, 0xa8, 0x10, 0xba, 0xb0, 0x42, 0xa2, 0x4f, 0xf6, 0xfc, 0xc0, 0x1b, 0xfb, 0x5f,
+	0xfb, 0xc1, 0x50, 0xfd, 0x4b, 0x5d, 0xad, 0x4f, 0xcc, 0x6b, 0xf0, 0x2e, 0x6c, 0x84, 0xca, 0xc5,
+	0x31, 0xf7, 0x78, 0xba, 0xda, 0x36, 0x6f, 0xbf, 0x6c, 0x24, 0x23, 0xa2, 0x6d, 0x74, 0x4d, 0x10,
+	0x59, 0x3c, 0x83, 0x5f, 0x81, 0x9c, 0xa8, 0x69, 0x5c, 0xc9, 0xc9, 0x06, 0xd8, 0xc8, 0x0e, 0x0b,
+	0x2d, 0x49, 0x6d, 0xf5, 0x0f, 0x61, 0x63, 0xe1, 0x12, 0x5c, 0x82, 0x62, 0x97, 0x1c, 0x75, 0x8f,
+	0x8e, 0x3b, 0xed, 0xf2, 0x9a, 0x90, 0x3a, 0x9f, 0x76, 0x76, 0x4f, 0xdc, 0x4e, 0xbb, 0x8c, 0x30,
+	0x40, 0x7e, 0xaf, 0x75, 0x70, 0xaf, 0xd3, 0x2e, 0xaf, 0x3b, 0x1f, 0x9d, 0x9d, 0x57, 0xd1, 0x6f,
+	0xe7, 0x55, 0xf4, 0xc7, 0x79, 0x15, 0xfd, 0xfa, 0xb8, 0x8a, 0xce, 0x1e, 0x57, 0xd1, 0x67, 0x6f,
+	0x3c, 0x3d, 0x6b, 0x9e, 0xc4, 0x4d, 0x15, 0x45, 0x2f, 0x2f, 0x7f, 0x5c, 0xef, 0xfc, 0x3d, 0x00,
+	0xdc, 0x54, 0xce, 0xfb, 0x1f, 0x0b, 0x00, 0x00,
+}
+
 func (m *Any) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1106,6 +1190,11 @@ func (m *TxInput) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Sequence))
 	}
+	if m.ExpiryHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.ExpiryHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1331,6 +1420,16 @@ func (m *NameTx) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Fee))
 	}
+	if m.Owner != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Owner.Size()))
+		n18, err := m.Owner.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1401,20 +1500,20 @@ func (m *UnbondTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n18, err := m.Input.MarshalTo(dAtA[i:])
+		n19, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n19, err := m.Address.MarshalTo(dAtA[i:])
+	n20, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	if m.Height != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -1490,11 +1589,11 @@ func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n20, err := m.Input.MarshalTo(dAtA[i:])
+		n21, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if m.VotingWeight != 0 {
 		dAtA[i] = 0x10
@@ -1505,21 +1604,21 @@ func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.ProposalHash.Size()))
-		n21, err := m.ProposalHash.MarshalTo(dAtA[i:])
+		n22, err := m.ProposalHash.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if m.Proposal != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Proposal.Size()))
-		n22, err := m.Proposal.MarshalTo(dAtA[i:])
+		n23, err := m.Proposal.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1590,11 +1689,11 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n23, err := m.Address.MarshalTo(dAtA[i:])
+	n24, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n24
 	if m.VotingWeight != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1637,11 +1736,11 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.BatchTx.Size()))
-		n24, err := m.BatchTx.MarshalTo(dAtA[i:])
+		n25, err := m.BatchTx.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1668,21 +1767,21 @@ func (m *Ballot) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Proposal.Size()))
-		n25, err := m.Proposal.MarshalTo(dAtA[i:])
+		n26, err := m.Proposal.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if m.FinalizingTx != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.FinalizingTx.Size()))
-		n26, err := m.FinalizingTx.MarshalTo(dAtA[i:])
+		n27, err := m.FinalizingTx.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	if m.ProposalState != 0 {
 		dAtA[i] = 0x20
@@ -1778,6 +1877,9 @@ func (m *TxInput) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovPayload(uint64(m.Sequence))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovPayload(uint64(m.ExpiryHeight))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1892,6 +1994,10 @@ func (m *NameTx) Size() (n int) {
 	if m.Fee != 0 {
 		n += 1 + sovPayload(uint64(m.Fee))
 	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2172,7 +2278,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2200,7 +2306,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2209,6 +2315,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2233,7 +2342,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2242,6 +2351,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2266,7 +2378,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2275,6 +2387,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2299,7 +2414,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2308,6 +2423,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2332,7 +2450,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2341,6 +2459,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2365,7 +2486,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2374,6 +2495,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2398,7 +2522,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2407,6 +2531,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2431,7 +2558,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2440,6 +2567,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2464,7 +2594,7 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2473,6 +2603,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2492,6 +2625,9 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2520,7 +2656,7 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2548,7 +2684,7 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2557,6 +2693,9 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2578,7 +2717,7 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= (uint64(b) & 0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2597,7 +2736,26 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= (uint64(b) & 0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2611,6 +2769,9 @@ func (m *TxInput) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2639,7 +2800,7 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2667,7 +2828,7 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2676,6 +2837,9 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2697,7 +2861,7 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= (uint64(b) & 0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2711,6 +2875,9 @@ func (m *TxOutput) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2739,7 +2906,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2767,7 +2934,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2776,6 +2943,9 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2800,7 +2970,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2809,6 +2979,9 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2832,7 +3005,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= (uint64(b) & 0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2851,7 +3024,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Fee |= (uint64(b) & 0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2870,7 +3043,7 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2879,6 +3052,9 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2895,6 +3071,9 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2923,7 +3102,7 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2951,7 +3130,7 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2960,6 +3139,9 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2982,7 +3164,7 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2991,6 +3173,9 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3008,6 +3193,9 @@ func (m *SendTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3036,7 +3224,7 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3064,7 +3252,7 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3073,6 +3261,9 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3097,7 +3288,7 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3106,6 +3297,9 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3122,6 +3316,9 @@ func (m *PermsTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3150,7 +3347,7 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3178,7 +3375,7 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3187,6 +3384,9 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3211,7 +3411,7 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3221,6 +3421,9 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3240,7 +3443,7 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3250,6 +3453,9 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3269,11 +3475,46 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Fee |= (uint64(b) & 0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Owner = &v
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -3283,6 +3524,9 @@ func (m *NameTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3311,7 +3555,7 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3339,7 +3583,7 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3348,6 +3592,9 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3370,7 +3617,7 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3379,6 +3626,9 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3396,6 +3646,9 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3424,7 +3677,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3452,7 +3705,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3461,6 +3714,9 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3485,7 +3741,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3494,6 +3750,9 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3515,7 +3774,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3529,6 +3788,9 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3557,7 +3819,7 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3585,7 +3847,7 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3594,6 +3856,9 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3616,7 +3881,7 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3625,6 +3890,9 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3642,6 +3910,9 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3670,7 +3941,7 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3698,7 +3969,7 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3707,6 +3978,9 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3731,7 +4005,7 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingWeight |= (int64(b) & 0x7F) << shift
+				m.VotingWeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3750,7 +4024,7 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3759,6 +4033,9 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3782,7 +4059,7 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3791,6 +4068,9 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3810,6 +4090,9 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3838,7 +4121,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3866,7 +4149,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3875,6 +4158,9 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3897,7 +4183,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3906,6 +4192,9 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3923,6 +4212,9 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3951,7 +4243,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3979,7 +4271,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3988,6 +4280,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4009,7 +4304,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingWeight |= (int64(b) & 0x7F) << shift
+				m.VotingWeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4023,6 +4318,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4051,7 +4349,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4079,7 +4377,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4089,6 +4387,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4108,7 +4409,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4118,6 +4419,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4137,7 +4441,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4146,6 +4450,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4165,6 +4472,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4193,7 +4503,7 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -4221,7 +4531,7 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4230,6 +4540,9 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4254,7 +4567,7 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4263,6 +4576,9 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4286,7 +4602,7 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalState |= (Ballot_ProposalState(b) & 0x7F) << shift
+				m.ProposalState |= Ballot_ProposalState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4305,7 +4621,7 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4314,6 +4630,9 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthPayload
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4331,6 +4650,9 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4398,10 +4720,13 @@ func skipPayload(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthPayload
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthPayload
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -4430,6 +4755,9 @@ func skipPayload(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthPayload
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -4448,70 +4776,3 @@ var (
 	ErrInvalidLengthPayload = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowPayload   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("payload.proto", fileDescriptor_payload_935b893e761fc798) }
-func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_payload_935b893e761fc798) }
-
-var fileDescriptor_payload_935b893e761fc798 = []byte{
-	// 958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0x23, 0x45,
-	0x10, 0x4e, 0x67, 0xc6, 0x8f, 0xad, 0x75, 0x82, 0xb7, 0x79, 0xc8, 0x8a, 0x84, 0xbd, 0x32, 0x08,
-	0x96, 0x47, 0x6c, 0xd8, 0xe5, 0x21, 0xe5, 0x82, 0x3c, 0xb1, 0xb3, 0x09, 0x5a, 0x25, 0x51, 0x67,
-	0xb2, 0x20, 0x24, 0x0e, 0x63, 0xbb, 0xb1, 0x47, 0x8c, 0xa7, 0x87, 0x99, 0xf6, 0x32, 0xe6, 0xc4,
-	0x81, 0x03, 0x57, 0xc4, 0x85, 0x63, 0x0e, 0xfc, 0x01, 0xfe, 0x01, 0xc7, 0x1c, 0x39, 0x73, 0x58,
-	0xa1, 0xec, 0x85, 0xff, 0xc0, 0x05, 0x75, 0x4f, 0xf7, 0xb8, 0xed, 0x85, 0x5d, 0x27, 0xa0, 0xbd,
-	0x4d, 0x55, 0x7d, 0xdd, 0x55, 0xf5, 0xd5, 0xa3, 0x07, 0x36, 0x22, 0x6f, 0x16, 0x30, 0x6f, 0xd8,
-	0x8a, 0x62, 0xc6, 0x19, 0x2e, 0x29, 0x71, 0x6b, 0x7b, 0xe4, 0xf3, 0xf1, 0xb4, 0xdf, 0x1a, 0xb0,
-	0x49, 0x7b, 0xc4, 0x46, 0xac, 0x2d, 0xed, 0xfd, 0xe9, 0x17, 0x52, 0x92, 0x82, 0xfc, 0xca, 0xce,
-	0x6d, 0x55, 0x23, 0x1a, 0x4f, 0xfc, 0x24, 0xf1, 0x59, 0xa8, 0x34, 0x90, 0x44, 0x74, 0x90, 0x7d,
-	0x37, 0x7f, 0xb0, 0xc0, 0xea, 0x84, 0x33, 0xfc, 0x3a, 0x14, 0x77, 0xbd, 0x20, 0x70, 0xd3, 0x1a,
-	0xba, 0x89, 0x6e, 0x5d, 0xbf, 0xfd, 0x5c, 0x4b, 0x7b, 0xcf, 0xd4, 0x44, 0x99, 0x05, 0xf0, 0x84,
-	0x86, 0x43, 0x37, 0xad, 0xad, 0x2f, 0x01, 0x33, 0x35, 0x51, 0x66, 0x01, 0x3c, 0xf4, 0x26, 0xd4,
-	0x4d, 0x6b, 0xd6, 0x12, 0x30, 0x53, 0x13, 0x65, 0xc6, 0x6f, 0x42, 0xe9, 0x98, 0xc6, 0x93, 0xc4,
-	0x4d, 0x6b, 0xb6, 0x44, 0x56, 0x73, 0xa4, 0xd2, 0x13, 0x0d, 0xc0, 0xaf, 0x42, 0xe1, 0x2e, 0x7b,
-	0xe0, 0xa6, 0xb5, 0x82, 0x44, 0x6e, 0xe6, 0x48, 0xa9, 0x25, 0x99, 0x51, 0xb8, 0x76, 0x98, 0x8c,
-	0xb1, 0xb8, 0xe4, 0x3a, 0x53, 0x13, 0x65, 0xc6, 0xdb, 0x50, 0x3e, 0x0d, 0xfb, 0x19, 0xb4, 0x24,
-	0xa1, 0x37, 0x72, 0xa8, 0x36, 0x90, 0x1c, 0x22, 0x22, 0x75, 0x3c, 0x3e, 0x18, 0xbb, 0x69, 0xad,
-	0xbc, 0x14, 0xa9, 0xd2, 0x13, 0x0d, 0xc0, 0x77, 0x00, 0x8e, 0x63, 0x16, 0xb1, 0xc4, 0x13, 0xa4,
-	0x5e, 0x93, 0xf0, 0xe7, 0xe7, 0x89, 0xe5, 0x26, 0x62, 0xc0, 0x76, 0xec, 0xf3, 0xb3, 0x06, 0x6a,
-	0xfe, 0x88, 0xa0, 0xe4, 0xa6, 0x07, 0x61, 0x34, 0xe5, 0xf8, 0x10, 0x4a, 0x9d, 0xe1, 0x30, 0xa6,
-	0x49, 0x22, 0x0b, 0x53, 0x71, 0xde, 0x3b, 0x7f, 0xd8, 0x58, 0xfb, 0xfd, 0x61, 0xe3, 0x6d, 0xa3,
-	0x0b, 0xc6, 0xb3, 0x88, 0xc6, 0x01, 0x1d, 0x8e, 0x68, 0xdc, 0xee, 0x4f, 0xe3, 0x98, 0x7d, 0xdd,
-	0x1e, 0xc4, 0xb3, 0x88, 0xb3, 0x96, 0x3a, 0x4b, 0xf4, 0x25, 0xf8, 0x25, 0x28, 0x76, 0x26, 0x6c,
-	0x1a, 0x72, 0x59, 0x3e, 0x9b, 0x28, 0x09, 0x6f, 0x41, 0xf9, 0x84, 0x7e, 0x35, 0xa5, 0xe1, 0x80,
-	0xca, 0x7a, 0xd9, 0x24, 0x97, 0x77, 0xec, 0x9f, 0xce, 0x1a, 0x6b, 0xcd, 0x14, 0xca, 0x6e, 0x7a,
-	0x34, 0xe5, 0xcf, 0x30, 0x2a, 0xe5, 0xf9, 0x2f, 0xa4, 0x9b, 0x13, 0xbf, 0x06, 0x05, 0xc9, 0x8b,
-	0xea, 0xd2, 0x39, 0xff, 0x8a, 0x2f, 0x92, 0x99, 0xf1, 0xc7, 0xf3, 0x00, 0xd7, 0x65, 0x80, 0xef,
-	0x5c, 0x3d, 0xb8, 0x2d, 0x28, 0xdf, 0xf5, 0x92, 0x7b, 0xfe, 0xc4, 0xe7, 0x9a, 0x1a, 0x2d, 0xe3,
-	0x2a, 0x58, 0x7b, 0x94, 0xca, 0xbe, 0xb5, 0x89, 0xf8, 0xc4, 0x07, 0x60, 0x77, 0x3d, 0xee, 0xc9,
-	0x06, 0xad, 0x38, 0xef, 0x2b, 0x5e, 0xb6, 0x9f, 0xec, 0xba, 0xef, 0x87, 0x5e, 0x3c, 0x6b, 0xed,
-	0xd3, 0xd4, 0x99, 0x71, 0x9a, 0x10, 0x79, 0x85, 0xca, 0xde, 0xd7, 0x03, 0x87, 0x6f, 0x41, 0x51,
-	0x66, 0x27, 0x48, 0xb7, 0xfe, 0x31, 0x7b, 0x65, 0xc7, 0x6f, 0x41, 0x29, 0xab, 0x94, 0x48, 0xdf,
-	0x5a, 0x68, 0x6b, 0x5d, 0x43, 0xa2, 0x11, 0x3b, 0xe5, 0xef, 0xcf, 0x1a, 0x6b, 0xd2, 0x15, 0xcb,
-	0x27, 0x71, 0x65, 0xa2, 0x3f, 0x80, 0xb2, 0x38, 0xd2, 0x89, 0x47, 0x89, 0x5a, 0x08, 0x2f, 0xb4,
-	0x8c, 0x85, 0xa3, 0x6d, 0x8e, 0x2d, 0x88, 0x20, 0x39, 0x56, 0xe5, 0x16, 0xe9, 0x1d, 0xb1, 0xb2,
-	0x3f, 0x0c, 0xb6, 0x38, 0x21, 0x7d, 0x5d, 0x23, 0xf2, 0x5b, 0xe8, 0x24, 0xe5, 0x56, 0xa6, 0x13,
-	0xdf, 0x8f, 0x17, 0x46, 0x79, 0xfc, 0x52, 0xaf, 0x86, 0x4b, 0xb0, 0x39, 0xdf, 0x12, 0xec, 0xdf,
-	0xe9, 0xcc, 0x21, 0x06, 0x9f, 0x3f, 0xa3, 0xf9, 0x7e, 0x59, 0x39, 0xc3, 0xc3, 0xe5, 0xd6, 0xfd,
-	0xef, 0xb3, 0xb5, 0x4f, 0xfd, 0xd1, 0x58, 0x37, 0xaf, 0x92, 0x8c, 0x30, 0xbf, 0x45, 0x6a, 0xab,
-	0x5e, 0x82, 0x93, 0x5d, 0xd8, 0xec, 0x0c, 0x06, 0x62, 0x48, 0x4f, 0xa3, 0xa1, 0xc7, 0xa9, 0x6e,
-	0xb4, 0x17, 0x5b, 0xf2, 0x71, 0x71, 0xe9, 0x24, 0x0a, 0x3c, 0x4e, 0x15, 0x46, 0x96, 0x1f, 0x91,
-	0xa5, 0x23, 0x46, 0x08, 0x7f, 0x22, 0x73, 0x5d, 0xae, 0xcc, 0x55, 0x13, 0x2a, 0xf7, 0x19, 0xf7,
-	0xc3, 0xd1, 0x27, 0x59, 0x86, 0x82, 0x30, 0x8b, 0x2c, 0xe8, 0xf0, 0x29, 0x54, 0xf4, 0xcd, 0xfb,
-	0x5e, 0x32, 0x96, 0x2c, 0x54, 0x9c, 0x77, 0x2f, 0x3f, 0x94, 0x0b, 0xd7, 0x88, 0xa6, 0xd0, 0xb2,
-	0x7a, 0xb6, 0x6e, 0x3c, 0xb6, 0xdd, 0x49, 0x0e, 0x31, 0x52, 0xfd, 0x3c, 0x7f, 0x44, 0x2e, 0x41,
-	0x77, 0x1d, 0x2c, 0x37, 0xd5, 0x1c, 0x57, 0x72, 0x58, 0x27, 0x9c, 0x11, 0x61, 0x30, 0xae, 0xff,
-	0x0e, 0x81, 0x7d, 0x9f, 0x71, 0xfa, 0xbf, 0xef, 0xe8, 0x15, 0xb8, 0x36, 0xc2, 0x78, 0x30, 0xa7,
-	0x27, 0x9f, 0x59, 0x64, 0xcc, 0xec, 0x4d, 0xb8, 0xde, 0xa5, 0xc9, 0x20, 0xf6, 0x23, 0xee, 0xb3,
-	0x50, 0x8d, 0xb3, 0xa9, 0x32, 0x1f, 0x5b, 0xeb, 0x29, 0x8f, 0xad, 0xe1, 0xf7, 0x97, 0x75, 0x28,
-	0x3a, 0x5e, 0x10, 0x30, 0xbe, 0x50, 0x21, 0xf4, 0xd4, 0x0a, 0x89, 0x3e, 0xd9, 0xf3, 0x43, 0x2f,
-	0xf0, 0xbf, 0xf1, 0xc3, 0x91, 0xfa, 0xbd, 0xb9, 0x5a, 0x9f, 0x98, 0xd7, 0xe0, 0x5d, 0xd8, 0x88,
-	0x94, 0x8b, 0x13, 0xee, 0xf1, 0x6c, 0x25, 0x6d, 0xde, 0x7e, 0xd9, 0x48, 0x46, 0x44, 0x9b, 0x47,
-	0x24, 0x41, 0x64, 0xf1, 0x0c, 0x7e, 0x05, 0x0a, 0xa2, 0xa6, 0x49, 0xad, 0x20, 0x1b, 0x60, 0x23,
-	0x3f, 0x2c, 0xb4, 0x24, 0xb3, 0x35, 0x3f, 0x84, 0x8d, 0x85, 0x4b, 0x70, 0x05, 0xca, 0xc7, 0xe4,
-	0xe8, 0xf8, 0xe8, 0xa4, 0xd7, 0xad, 0xae, 0x09, 0xa9, 0xf7, 0x69, 0x6f, 0xf7, 0xd4, 0xed, 0x75,
-	0xab, 0x08, 0x03, 0x14, 0xf7, 0x3a, 0x07, 0xf7, 0x7a, 0xdd, 0xea, 0xba, 0xf3, 0xd1, 0xf9, 0x45,
-	0x1d, 0xfd, 0x76, 0x51, 0x47, 0x7f, 0x5c, 0xd4, 0xd1, 0xaf, 0x8f, 0xea, 0xe8, 0xfc, 0x51, 0x1d,
-	0x7d, 0xf6, 0xc6, 0x93, 0xb3, 0xe6, 0x69, 0xd2, 0x56, 0x51, 0xf4, 0x8b, 0xf2, 0x5f, 0xf2, 0xce,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x4c, 0xec, 0xa1, 0xb2, 0x0a, 0x00, 0x00,
-}
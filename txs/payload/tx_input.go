@@ -5,5 +5,15 @@ import (
 )
 
 func (input *TxInput) String() string {
+	if input.Expires() {
+		return fmt.Sprintf("TxInput{%s, Amount: %v, Nonce:%v, ExpiryHeight: %v}", input.Address, input.Amount,
+			input.Sequence, input.ExpiryHeight)
+	}
 	return fmt.Sprintf("TxInput{%s, Amount: %v, Sequence:%v}", input.Address, input.Amount, input.Sequence)
 }
+
+// Whether the input is protected from replay by its transaction's hash until ExpiryHeight rather than by its sequence
+// number, in which case the input's account sequence number is neither checked nor incremented
+func (input *TxInput) Expires() bool {
+	return input.ExpiryHeight != 0
+}
@@ -1,20 +1,20 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: txs.proto
 
-package txs // import "github.com/hyperledger/burrow/txs"
+package txs
 
-import proto "github.com/gogo/protobuf/proto"
-import golang_proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-import crypto "github.com/hyperledger/burrow/crypto"
-
-import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
-import github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
-import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
-
-import io "io"
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	crypto "github.com/hyperledger/burrow/crypto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
+	io "io"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -30,7 +30,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // An envelope contains both the signable Tx and the signatures for each input (in signatories)
 type Envelope struct {
-	Signatories []Signatory `protobuf:"bytes,1,rep,name=Signatories" json:"Signatories"`
+	Signatories []Signatory `protobuf:"bytes,1,rep,name=Signatories,proto3" json:"Signatories"`
 	// Canonical bytes of the Tx ready to be signed
 	Tx                   *Tx      `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -41,7 +41,7 @@ type Envelope struct {
 func (m *Envelope) Reset()      { *m = Envelope{} }
 func (*Envelope) ProtoMessage() {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_txs_e56788fdeba40e42, []int{0}
+	return fileDescriptor_372ebcf753025bdc, []int{0}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -58,8 +58,8 @@ func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Envelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Envelope.Merge(dst, src)
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
 }
 func (m *Envelope) XXX_Size() int {
 	return m.Size()
@@ -84,8 +84,8 @@ func (*Envelope) XXX_MessageName() string {
 // Signatory contains signature and one or both of Address and PublicKey to identify the signer
 type Signatory struct {
 	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
-	PublicKey            *crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey" json:"PublicKey,omitempty"`
-	Signature            *crypto.Signature                             `protobuf:"bytes,4,opt,name=Signature" json:"Signature,omitempty"`
+	PublicKey            *crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Signature            *crypto.Signature                             `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
 	XXX_unrecognized     []byte                                        `json:"-"`
 	XXX_sizecache        int32                                         `json:"-"`
@@ -95,7 +95,7 @@ func (m *Signatory) Reset()         { *m = Signatory{} }
 func (m *Signatory) String() string { return proto.CompactTextString(m) }
 func (*Signatory) ProtoMessage()    {}
 func (*Signatory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_txs_e56788fdeba40e42, []int{1}
+	return fileDescriptor_372ebcf753025bdc, []int{1}
 }
 func (m *Signatory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,8 +112,8 @@ func (m *Signatory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Signatory) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Signatory.Merge(dst, src)
+func (m *Signatory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signatory.Merge(m, src)
 }
 func (m *Signatory) XXX_Size() int {
 	return m.Size()
@@ -151,17 +151,20 @@ type Receipt struct {
 	// Whether the transaction creates a contract
 	CreatesContract bool `protobuf:"varint,3,opt,name=CreatesContract,proto3" json:"CreatesContract,omitempty"`
 	// The address of the contract being called
-	ContractAddress      github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=ContractAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"ContractAddress"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	ContractAddress github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=ContractAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"ContractAddress"`
+	// The priority CheckTx assigned the transaction from its fee, type and inputs, which decides whether it is admitted
+	// to a loaded mempool but not its order in blocks (the mempool is FIFO)
+	Priority             uint64   `protobuf:"varint,5,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_txs_e56788fdeba40e42, []int{2}
+	return fileDescriptor_372ebcf753025bdc, []int{2}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,8 +181,8 @@ func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Receipt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Receipt.Merge(dst, src)
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
 }
 func (m *Receipt) XXX_Size() int {
 	return m.Size()
@@ -204,6 +207,13 @@ func (m *Receipt) GetCreatesContract() bool {
 	return false
 }
 
+func (m *Receipt) GetPriority() uint64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 func (*Receipt) XXX_MessageName() string {
 	return "txs.Receipt"
 }
@@ -215,6 +225,42 @@ func init() {
 	proto.RegisterType((*Receipt)(nil), "txs.Receipt")
 	golang_proto.RegisterType((*Receipt)(nil), "txs.Receipt")
 }
+
+func init() { proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
+func init() { golang_proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
+
+var fileDescriptor_372ebcf753025bdc = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xee, 0x39, 0x26, 0x4d, 0x2e, 0x85, 0x8a, 0x1b, 0x90, 0x95, 0xc1, 0x0e, 0x99, 0x3c, 0x50,
+	0x1b, 0x85, 0x5f, 0x12, 0x1b, 0xae, 0x90, 0xaa, 0x22, 0xa4, 0xea, 0xf0, 0xc4, 0x80, 0xb0, 0x9d,
+	0x87, 0x63, 0x29, 0xe4, 0xac, 0xf3, 0x19, 0xee, 0xfe, 0x13, 0x46, 0xfe, 0x0d, 0x36, 0xc6, 0x88,
+	0x89, 0x39, 0x83, 0x85, 0xd2, 0xff, 0x82, 0x09, 0xf9, 0x38, 0xa7, 0xa5, 0x43, 0x51, 0xb7, 0xfb,
+	0xde, 0xfb, 0xbe, 0xef, 0x7d, 0x7e, 0xcf, 0x78, 0x28, 0x64, 0x15, 0x94, 0x9c, 0x09, 0x46, 0x7a,
+	0x42, 0x56, 0xe3, 0xa3, 0xbc, 0x10, 0x8b, 0x3a, 0x0d, 0x32, 0xf6, 0x31, 0xcc, 0x59, 0xce, 0x42,
+	0xdd, 0x4b, 0xeb, 0x0f, 0x1a, 0x69, 0xa0, 0x5f, 0x7f, 0x35, 0xe3, 0x83, 0x8c, 0xab, 0x52, 0x18,
+	0x34, 0x7d, 0x8f, 0x07, 0x2f, 0x57, 0x9f, 0x60, 0xc9, 0x4a, 0x20, 0x4f, 0xf1, 0xe8, 0x4d, 0x91,
+	0xaf, 0x12, 0xc1, 0x78, 0x01, 0x95, 0x83, 0x26, 0x3d, 0x7f, 0x34, 0xbb, 0x13, 0xb4, 0xe3, 0xba,
+	0xba, 0x8a, 0xec, 0x75, 0xe3, 0xed, 0xd1, 0xcb, 0x44, 0x72, 0x0f, 0x5b, 0xb1, 0x74, 0xac, 0x09,
+	0xf2, 0x0f, 0xa2, 0xfe, 0xa6, 0xf1, 0xac, 0x58, 0x52, 0x2b, 0x96, 0xcf, 0xed, 0x2f, 0x5f, 0xbd,
+	0xbd, 0xe9, 0x37, 0x84, 0x87, 0x3b, 0x39, 0x39, 0xc5, 0xfb, 0x2f, 0xe6, 0x73, 0x0e, 0x55, 0xeb,
+	0xdf, 0x0a, 0x1e, 0x6e, 0x1a, 0xef, 0xc1, 0xa5, 0x2f, 0x58, 0xa8, 0x12, 0xf8, 0x12, 0xe6, 0x39,
+	0xf0, 0x30, 0xad, 0x39, 0x67, 0x9f, 0x43, 0x13, 0xd8, 0xe8, 0x68, 0x67, 0x40, 0x42, 0x3c, 0x3c,
+	0xab, 0xd3, 0x65, 0x91, 0xbd, 0x02, 0xa5, 0xc7, 0x8f, 0x66, 0x77, 0x03, 0x43, 0xde, 0x35, 0xe8,
+	0x05, 0x87, 0x84, 0x5d, 0x92, 0x9a, 0x83, 0x63, 0xff, 0x2b, 0xd8, 0x35, 0xe8, 0x05, 0x67, 0xfa,
+	0xc3, 0xc2, 0xfb, 0x14, 0x32, 0x28, 0x4a, 0x41, 0x4e, 0x71, 0x3f, 0x96, 0xb1, 0x2a, 0x41, 0x07,
+	0xbf, 0x1d, 0xcd, 0x7e, 0x37, 0x5e, 0x70, 0x7d, 0x70, 0x21, 0xab, 0xb0, 0x4c, 0xd4, 0x92, 0x25,
+	0xf3, 0xa0, 0x55, 0x52, 0xe3, 0x40, 0x5e, 0xb7, 0x5e, 0x27, 0x49, 0xb5, 0x30, 0x5b, 0x7b, 0xd2,
+	0x2e, 0x75, 0xd3, 0x78, 0x47, 0xd7, 0xfb, 0xa5, 0xc5, 0x2a, 0xe1, 0x2a, 0x38, 0x01, 0x19, 0x29,
+	0x01, 0x15, 0x35, 0x26, 0xc4, 0xc7, 0x87, 0xc7, 0x1c, 0x12, 0x01, 0xd5, 0x31, 0x5b, 0x09, 0x9e,
+	0x64, 0xc2, 0xe9, 0x4d, 0x90, 0x3f, 0xa0, 0x57, 0xcb, 0xe4, 0x1d, 0x3e, 0xec, 0xde, 0xdd, 0x19,
+	0x6c, 0x9d, 0xe0, 0xb1, 0x49, 0x70, 0xb3, 0x53, 0x5c, 0x35, 0x23, 0x63, 0x3c, 0x38, 0xe3, 0x05,
+	0xe3, 0x85, 0x50, 0xce, 0xad, 0x09, 0xf2, 0x6d, 0xba, 0xc3, 0xd1, 0xb3, 0xf5, 0xd6, 0x45, 0x3f,
+	0xb7, 0x2e, 0xfa, 0xb5, 0x75, 0xd1, 0xf7, 0x73, 0x17, 0xad, 0xcf, 0x5d, 0xf4, 0xf6, 0xfe, 0x7f,
+	0x57, 0x98, 0xf6, 0xf5, 0xaf, 0xfa, 0xe8, 0xcf, 0x00, 0x9f, 0x7b, 0xbd, 0x30, 0xf9, 0x02, 0x00,
+	0x00,
+}
+
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -355,6 +401,11 @@ func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n6
+	if m.Priority != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTxs(dAtA, i, uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -432,6 +483,9 @@ func (m *Receipt) Size() (n int) {
 	}
 	l = m.ContractAddress.Size()
 	n += 1 + l + sovTxs(uint64(l))
+	if m.Priority != 0 {
+		n += 1 + sovTxs(uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -466,7 +520,7 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -494,7 +548,7 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -503,6 +557,9 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -525,7 +582,7 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -534,6 +591,9 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -552,6 +612,9 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthTxs
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -580,7 +643,7 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -608,7 +671,7 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -617,6 +680,9 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -640,7 +706,7 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -649,6 +715,9 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -673,7 +742,7 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -682,6 +751,9 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -701,6 +773,9 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthTxs
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -729,7 +804,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -757,7 +832,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -776,7 +851,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -785,6 +860,9 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -806,7 +884,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -826,7 +904,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -835,6 +913,9 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTxs
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -842,6 +923,25 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])
@@ -851,6 +951,9 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthTxs
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxs
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -918,10 +1021,13 @@ func skipTxs(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthTxs
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthTxs
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -950,6 +1056,9 @@ func skipTxs(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthTxs
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -968,37 +1077,3 @@ var (
 	ErrInvalidLengthTxs = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowTxs   = fmt.Errorf("proto: integer overflow")
 )
-
-func init() { proto.RegisterFile("txs.proto", fileDescriptor_txs_e56788fdeba40e42) }
-func init() { golang_proto.RegisterFile("txs.proto", fileDescriptor_txs_e56788fdeba40e42) }
-
-var fileDescriptor_txs_e56788fdeba40e42 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xee, 0x39, 0x51, 0xda, 0x5c, 0x0a, 0x15, 0x37, 0xa0, 0xa8, 0x83, 0x1d, 0x32, 0x65, 0xa0,
-	0x36, 0x0a, 0xbf, 0x24, 0x36, 0x5c, 0x21, 0x55, 0x45, 0x48, 0xe8, 0xf0, 0xc4, 0x80, 0xb0, 0x9d,
-	0x87, 0x63, 0xc9, 0xf8, 0xac, 0xbb, 0x33, 0xdc, 0xfd, 0x27, 0x8c, 0xfc, 0x05, 0xec, 0x6c, 0x8c,
-	0x19, 0x99, 0x33, 0x58, 0x28, 0xfd, 0x2f, 0x98, 0x90, 0xaf, 0xe7, 0xb4, 0x74, 0x08, 0x62, 0x7b,
-	0x3f, 0xbe, 0xef, 0x7b, 0xdf, 0xbd, 0x77, 0x78, 0x28, 0x95, 0xf0, 0x2b, 0xce, 0x24, 0x23, 0x3d,
-	0xa9, 0xc4, 0xf1, 0x49, 0x96, 0xcb, 0x65, 0x9d, 0xf8, 0x29, 0xfb, 0x18, 0x64, 0x2c, 0x63, 0x81,
-	0xe9, 0x25, 0xf5, 0x07, 0x93, 0x99, 0xc4, 0x44, 0x97, 0x9c, 0xe3, 0xc3, 0x94, 0xeb, 0x4a, 0xda,
-	0x6c, 0xfa, 0x1e, 0x1f, 0xbc, 0x28, 0x3f, 0x41, 0xc1, 0x2a, 0x20, 0x4f, 0xf0, 0xe8, 0x4d, 0x9e,
-	0x95, 0xb1, 0x64, 0x3c, 0x07, 0x31, 0x46, 0x93, 0xde, 0x6c, 0x34, 0xbf, 0xed, 0xb7, 0xe3, 0xba,
-	0xba, 0x0e, 0xfb, 0xab, 0xc6, 0xdb, 0xa3, 0xd7, 0x81, 0xe4, 0x2e, 0x76, 0x22, 0x35, 0x76, 0x26,
-	0x68, 0x76, 0x18, 0x0e, 0xd6, 0x8d, 0xe7, 0x44, 0x8a, 0x3a, 0x91, 0x7a, 0xd6, 0xff, 0xf2, 0xd5,
-	0xdb, 0x9b, 0x7e, 0x47, 0x78, 0xb8, 0xa5, 0x93, 0x73, 0xbc, 0xff, 0x7c, 0xb1, 0xe0, 0x20, 0x5a,
-	0xfd, 0x96, 0xf0, 0x60, 0xdd, 0x78, 0xf7, 0xaf, 0xbd, 0x60, 0xa9, 0x2b, 0xe0, 0x05, 0x2c, 0x32,
-	0xe0, 0x41, 0x52, 0x73, 0xce, 0x3e, 0x07, 0xd6, 0xb0, 0xe5, 0xd1, 0x4e, 0x80, 0x04, 0x78, 0xf8,
-	0xba, 0x4e, 0x8a, 0x3c, 0x7d, 0x09, 0xda, 0x8c, 0x1f, 0xcd, 0xef, 0xf8, 0x16, 0xbc, 0x6d, 0xd0,
-	0x2b, 0x4c, 0x4b, 0xb8, 0x74, 0x52, 0x73, 0x18, 0xf7, 0xff, 0x26, 0x6c, 0x1b, 0xf4, 0x0a, 0x33,
-	0xfd, 0xe6, 0xe0, 0x7d, 0x0a, 0x29, 0xe4, 0x95, 0x24, 0xe7, 0x78, 0x10, 0xa9, 0x48, 0x57, 0x60,
-	0x8c, 0xdf, 0x0a, 0xe7, 0xbf, 0x1b, 0xcf, 0xdf, 0x6d, 0x5c, 0x2a, 0x11, 0x54, 0xb1, 0x2e, 0x58,
-	0xbc, 0xf0, 0x5b, 0x26, 0xb5, 0x0a, 0xe4, 0x55, 0xab, 0x75, 0x16, 0x8b, 0xa5, 0xdd, 0xda, 0xe3,
-	0x76, 0xa9, 0xeb, 0xc6, 0x3b, 0xd9, 0xad, 0x97, 0xe4, 0x65, 0xcc, 0xb5, 0x7f, 0x06, 0x2a, 0xd4,
-	0x12, 0x04, 0xb5, 0x22, 0x64, 0x86, 0x8f, 0x4e, 0x39, 0xc4, 0x12, 0xc4, 0x29, 0x2b, 0x25, 0x8f,
-	0x53, 0x39, 0xee, 0x4d, 0xd0, 0xec, 0x80, 0xde, 0x2c, 0x93, 0x77, 0xf8, 0xa8, 0x8b, 0xbb, 0x33,
-	0xf4, 0x8d, 0x83, 0x47, 0xd6, 0xc1, 0xff, 0x9d, 0xe2, 0xa6, 0x58, 0xf8, 0x74, 0xb5, 0x71, 0xd1,
-	0xcf, 0x8d, 0x8b, 0x7e, 0x6d, 0x5c, 0xf4, 0xe3, 0xc2, 0x45, 0xab, 0x0b, 0x17, 0xbd, 0xbd, 0xf7,
-	0xcf, 0x35, 0x25, 0x03, 0xf3, 0x1d, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xe2, 0x91,
-	0x22, 0xdd, 0x02, 0x00, 0x00,
-}
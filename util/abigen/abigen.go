@@ -0,0 +1,529 @@
+// Package abigen generates typed Go bindings for a contract from its ABI. The generated code calls the contract
+// through the rpctransact service and watches for its events through the rpcevents service.
+package abigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/iancoleman/strcase"
+)
+
+const bindingTemplateText = `// Code generated by burrow abi gen. DO NOT EDIT.
+
+package [[.Package]]
+
+import (
+	"context"
+[[- if .Bytecode]]
+	"encoding/hex"
+[[- end]]
+	"fmt"
+	"io"
+[[- if .UsesBig]]
+	"math/big"
+[[- end]]
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// [[.Name]]Abi is the ABI the [[.Name]] bindings were generated from
+const [[.Name]]Abi = [[printf "%q" .Abi]]
+[[- if .Bytecode]]
+
+// [[.Name]]Bytecode is the EVM bytecode deployed by Deploy[[.Name]]
+const [[.Name]]Bytecode = "[[.Bytecode]]"
+[[- end]]
+
+var [[.Spec]] *abi.AbiSpec
+
+func init() {
+	var err error
+	[[.Spec]], err = abi.ReadAbiSpec([]byte([[.Name]]Abi))
+	if err != nil {
+		panic(fmt.Sprintf("could not read [[.Name]] ABI: %v", err))
+	}
+}
+[[- range .Structs]]
+
+// [[.Name]] is the Solidity struct [[.Signature]]
+type [[.Name]] struct {
+[[- range .Fields]]
+	[[.Name]] [[.Type]]
+[[- end]]
+}
+[[- end]]
+
+// [[.Name]] calls the functions of, and watches for the events emitted by, a deployed [[.Name]] contract
+type [[.Name]] struct {
+	Address crypto.Address
+	// The account that signs and pays for transactions sent to the contract
+	Input    crypto.Address
+	Amount   uint64
+	Fee      uint64
+	GasLimit uint64
+	transact rpctransact.TransactClient
+	events   rpcevents.ExecutionEventsClient
+}
+
+func New[[.Name]](address, input crypto.Address, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) *[[.Name]] {
+	return &[[.Name]]{
+		Address:  address,
+		Input:    input,
+		GasLimit: contexts.GasLimit,
+		transact: transact,
+		events:   events,
+	}
+}
+[[- if .Bytecode]]
+
+// Deploy[[.Name]] creates a new [[.Name]] contract from [[.Name]]Bytecode
+func Deploy[[.Name]](ctx context.Context, transact rpctransact.TransactClient, events rpcevents.ExecutionEventsClient,
+	input crypto.Address[[range .Constructor.Inputs]], [[.Name]] [[.Type]][[end]]) (*[[.Name]], *exec.TxExecution, error) {
+	code, err := hex.DecodeString([[.Name]]Bytecode)
+	if err != nil {
+		return nil, nil, err
+	}
+[[- if .Constructor.Inputs]]
+	args, _, err := [[.Spec]].Pack(""[[range .Constructor.Inputs]], [[.Name]][[end]])
+	if err != nil {
+		return nil, nil, err
+	}
+	code = append(code, args...)
+[[- end]]
+	txe, err := transact.CallTxSync(ctx, &payload.CallTx{
+		Input:    &payload.TxInput{Address: input},
+		Data:     code,
+		GasLimit: contexts.GasLimit,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	err = txe.Exception.AsError()
+	if err != nil {
+		return nil, txe, err
+	}
+	return New[[.Name]](txe.Receipt.ContractAddress, input, transact, events), txe, nil
+}
+[[- end]]
+[[- range .Methods]]
+
+// [[.GoName]] [[if .Constant]]simulates a call to[[else]]sends a transaction calling[[end]] [[.Signature]]
+func (c *[[$.Name]]) [[.GoName]](ctx context.Context[[range .Inputs]], [[.Name]] [[.Type]][[end]]) ([[range .Outputs]][[.Name]] [[.Type]], [[end]][[if not .Constant]]txe *exec.TxExecution, [[end]]err error) {
+	data, _, err := [[$.Spec]].Pack("[[.Name]]"[[range .Inputs]], [[.Name]][[end]])
+	if err != nil {
+		return
+	}
+[[- if .Constant]]
+	txe, err := c.transact.CallTxSim(ctx, c.callTx(data))
+[[- else]]
+	txe, err = c.transact.CallTxSync(ctx, c.callTx(data))
+[[- end]]
+	if err != nil {
+		return
+	}
+	err = txe.Exception.AsError()
+[[- if .Outputs]]
+	if err != nil {
+		return
+	}
+	err = [[$.Spec]].Unpack(txe.GetResult().GetReturn(), "[[.Name]]"[[range .Outputs]], &[[.Name]][[end]])
+[[- end]]
+	return
+}
+[[- end]]
+[[- range .Events]]
+
+// [[.GoName]]Event is the event [[.Signature]]
+type [[.GoName]]Event struct {
+[[- range .Fields]]
+	[[.Name]] [[.Type]]
+[[- end]]
+	Header *exec.Header
+}
+
+// [[.GoName]]Query matches [[.Name]] events emitted by the contract
+func (c *[[$.Name]]) [[.GoName]]Query() *query.Builder {
+	return c.logQuery([[$.Spec]].Events["[[.Name]]"].EventID)
+}
+
+// Watch[[.GoName]] calls consumer with each [[.Name]] event emitted by the contract within blockRange (which may
+// have a streaming end bound) until the range is exhausted or consumer returns an error
+func (c *[[$.Name]]) Watch[[.GoName]](ctx context.Context, blockRange *rpcevents.BlockRange,
+	consumer func(*[[.GoName]]Event) error) error {
+	spec := [[$.Spec]].Events["[[.Name]]"]
+	return c.watch(ctx, blockRange, c.[[.GoName]]Query(), func(ev *exec.Event) error {
+		e := new([[.GoName]]Event)
+		err := abi.UnpackEvent(&spec, ev.Log.Topics, ev.Log.Data[[range .Fields]], &e.[[.Name]][[end]])
+		if err != nil {
+			return err
+		}
+		e.Header = ev.Header
+		return consumer(e)
+	})
+}
+[[- end]]
+
+func (c *[[.Name]]) callTx(data []byte) *payload.CallTx {
+	address := c.Address
+	return &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: c.Input,
+			Amount:  c.Amount,
+		},
+		Address:  &address,
+		Data:     data,
+		Fee:      c.Fee,
+		GasLimit: c.GasLimit,
+	}
+}
+
+func (c *[[.Name]]) logQuery(eventID abi.EventID) *query.Builder {
+	return query.NewBuilder().
+		AndEquals(event.EventTypeKey, exec.TypeLog.String()).
+		AndEquals(event.AddressKey, c.Address.String()).
+		AndEquals(exec.LogNKey(0), binary.HexBytes(eventID.Bytes()).String())
+}
+
+func (c *[[.Name]]) watch(ctx context.Context, blockRange *rpcevents.BlockRange, queryBuilder *query.Builder,
+	consumer func(*exec.Event) error) error {
+	qry, err := queryBuilder.Query()
+	if err != nil {
+		return err
+	}
+	stream, err := c.events.Stream(ctx, &rpcevents.BlocksRequest{BlockRange: blockRange})
+	if err != nil {
+		return err
+	}
+	err = rpcevents.ConsumeBlockExecutions(stream, func(be *exec.BlockExecution) error {
+		for _, txe := range be.TxExecutions {
+			// Events from reverted transactions were never emitted
+			if txe.Exception != nil {
+				continue
+			}
+			for _, ev := range txe.Events {
+				if ev.Log != nil && qry.Matches(ev.Tagged()) {
+					err := consumer(ev)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+`
+
+var bindingTemplate *template.Template
+
+func init() {
+	var err error
+	bindingTemplate, err = template.New("GoBindingTemplate").
+		Delims("[[", "]]").
+		Parse(bindingTemplateText)
+	if err != nil {
+		panic(fmt.Errorf("couldn't parse Go binding template: %s", err))
+	}
+}
+
+type binding struct {
+	Package     string
+	Name        string
+	Spec        string
+	Abi         string
+	Bytecode    string
+	UsesBig     bool
+	Constructor method
+	Methods     []method
+	Events      []eventType
+	Structs     []structType
+	// Struct type names by tuple signature
+	structNames map[string]string
+}
+
+type variable struct {
+	Name string
+	Type string
+}
+
+type method struct {
+	Name      string
+	GoName    string
+	Signature string
+	Constant  bool
+	Inputs    []variable
+	Outputs   []variable
+}
+
+type eventType struct {
+	Name      string
+	GoName    string
+	Signature string
+	Fields    []variable
+}
+
+type structType struct {
+	Name      string
+	Signature string
+	Fields    []variable
+}
+
+// Generate returns the source of a Go package named pkg containing bindings for the contract name described by
+// abiOrBin, which may be either a JSON ABI or the bin file output by burrow deploy (in which case a Deploy function
+// is also generated from its bytecode)
+func Generate(pkg, name string, abiOrBin []byte) ([]byte, error) {
+	abiJSON := abiOrBin
+	var bytecode string
+	contract := new(compile.SolidityContract)
+	if json.Unmarshal(abiOrBin, contract) == nil && len(contract.Abi) > 0 {
+		abiJSON = contract.Abi
+		bytecode = contract.Evm.Bytecode.Object
+		if strings.Contains(bytecode, "_") {
+			return nil, fmt.Errorf("bytecode for %s has unlinked library references", name)
+		}
+	}
+	spec, err := abi.ReadAbiSpec(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	constant, err := constantFunctions(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	// Compact the ABI since we embed it in the generated source
+	buf := new(bytes.Buffer)
+	err = json.Compact(buf, abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &binding{
+		Package:     pkg,
+		Name:        strcase.ToCamel(name),
+		Spec:        strcase.ToLowerCamel(name) + "Spec",
+		Abi:         buf.String(),
+		Bytecode:    bytecode,
+		structNames: make(map[string]string),
+	}
+
+	b.Constructor = method{
+		Inputs: b.variables("", spec.Constructor.Inputs, "arg", reservedNames("input", "transact", "events")),
+	}
+
+	for _, fname := range sortedKeys(spec.Functions) {
+		fs := spec.Functions[fname]
+		used := reservedNames()
+		m := method{
+			Name:      fname,
+			GoName:    strcase.ToCamel(fname),
+			Signature: abi.Signature(fname, fs.Inputs),
+			Constant:  constant[fname],
+		}
+		m.Inputs = b.variables(fname, fs.Inputs, "arg", used)
+		m.Outputs = b.variables(fname, fs.Outputs, "ret", used)
+		b.Methods = append(b.Methods, m)
+	}
+
+	eventNames := make([]string, 0, len(spec.Events))
+	for ename := range spec.Events {
+		eventNames = append(eventNames, ename)
+	}
+	sort.Strings(eventNames)
+	for _, ename := range eventNames {
+		es := spec.Events[ename]
+		e := eventType{
+			Name:      ename,
+			GoName:    strcase.ToCamel(ename),
+			Signature: abi.Signature(ename, es.Inputs),
+		}
+		used := map[string]bool{"Header": true}
+		for i, arg := range es.Inputs {
+			name := strcase.ToCamel(argName(arg.Name, "Arg", i))
+			e.Fields = append(e.Fields, variable{
+				Name: uniqueName(name, used),
+				Type: b.goType(e.GoName+name, arg),
+			})
+		}
+		b.Events = append(b.Events, e)
+	}
+
+	buf.Reset()
+	err = bindingTemplate.Execute(buf, b)
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not format generated bindings: %v", err)
+	}
+	return src, nil
+}
+
+// Older compilers mark the functions that do not modify state as constant whereas newer ones give them a view or pure
+// stateMutability, AbiSpec only records the former
+func constantFunctions(abiJSON []byte) (map[string]bool, error) {
+	var specs []abi.AbiSpecJSON
+	err := json.Unmarshal(abiJSON, &specs)
+	if err != nil {
+		return nil, err
+	}
+	constant := make(map[string]bool)
+	for _, s := range specs {
+		if s.Type == "function" && (s.Constant || s.StateMutability == "view" || s.StateMutability == "pure") {
+			constant[s.Name] = true
+		}
+	}
+	return constant, nil
+}
+
+func (b *binding) variables(fname string, args []abi.Argument, prefix string, used map[string]bool) []variable {
+	vars := make([]variable, len(args))
+	for i, arg := range args {
+		name := argName(arg.Name, prefix, i)
+		vars[i] = variable{
+			Name: uniqueName(strcase.ToLowerCamel(name), used),
+			Type: b.goType(strcase.ToCamel(fname)+strcase.ToCamel(name), arg),
+		}
+	}
+	return vars
+}
+
+func (b *binding) goType(context string, arg abi.Argument) string {
+	if arg.IsArray {
+		return arrayType(arg.ArrayLength) + b.evmGoType(context, arg.EVM)
+	}
+	return b.evmGoType(context, arg.EVM)
+}
+
+func (b *binding) evmGoType(context string, evm abi.EVMType) string {
+	switch t := evm.(type) {
+	case abi.EVMBool:
+		return "bool"
+	case abi.EVMUint:
+		if t.M == 8 || t.M == 16 || t.M == 32 || t.M == 64 {
+			return fmt.Sprintf("uint%d", t.M)
+		}
+		b.UsesBig = true
+		return "*big.Int"
+	case abi.EVMInt:
+		if t.M == 8 || t.M == 16 || t.M == 32 || t.M == 64 {
+			return fmt.Sprintf("int%d", t.M)
+		}
+		b.UsesBig = true
+		return "*big.Int"
+	case abi.EVMAddress:
+		return "crypto.Address"
+	case abi.EVMBytes:
+		if t.M > 0 {
+			return fmt.Sprintf("[%d]byte", t.M)
+		}
+		return "[]byte"
+	case abi.EVMString:
+		return "string"
+	case abi.EVMArray:
+		return arrayType(t.Length) + b.evmGoType(context, t.Elem)
+	case abi.EVMTuple:
+		return b.structType(context, t)
+	default:
+		b.UsesBig = true
+		return "*big.Float"
+	}
+}
+
+// structType returns the name of the Go struct for a tuple, generating the struct if it has not yet been seen.
+// Structs are named after where they first occur.
+func (b *binding) structType(context string, tuple abi.EVMTuple) string {
+	sig := tuple.GetSignature()
+	if name, ok := b.structNames[sig]; ok {
+		return name
+	}
+	used := make(map[string]bool)
+	for _, st := range b.Structs {
+		used[st.Name] = true
+	}
+	used[b.Name] = true
+	st := structType{
+		Name:      uniqueName(context, used),
+		Signature: sig,
+	}
+	b.structNames[sig] = st.Name
+	fieldsUsed := make(map[string]bool)
+	for i, c := range tuple.Components {
+		name := strcase.ToCamel(argName(c.Name, "Field", i))
+		st.Fields = append(st.Fields, variable{
+			Name: uniqueName(name, fieldsUsed),
+			Type: b.goType(st.Name+name, c),
+		})
+	}
+	b.Structs = append(b.Structs, st)
+	return st.Name
+}
+
+func arrayType(length uint64) string {
+	if length > 0 {
+		return fmt.Sprintf("[%d]", length)
+	}
+	return "[]"
+}
+
+func argName(name, prefix string, i int) string {
+	if name == "" {
+		return prefix + strconv.Itoa(i)
+	}
+	return name
+}
+
+// reservedNames are identifiers used by generated methods that arguments must not shadow
+func reservedNames(names ...string) map[string]bool {
+	used := map[string]bool{"c": true, "ctx": true, "data": true, "txe": true, "err": true, "args": true,
+		"code": true}
+	for _, name := range names {
+		used[name] = true
+	}
+	return used
+}
+
+func uniqueName(name string, used map[string]bool) string {
+	if token.Lookup(name).IsKeyword() {
+		name += "_"
+	}
+	unique := name
+	for i := 1; used[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	used[unique] = true
+	return unique
+}
+
+func sortedKeys(functions map[string]abi.FunctionSpec) []string {
+	keys := make([]string, 0, len(functions))
+	for k := range functions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
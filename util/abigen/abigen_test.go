@@ -0,0 +1,135 @@
+package abigen
+
+import (
+	"context"
+	"flag"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/hyperledger/burrow/util/abigen/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// The bindings for testABI are checked in at internal/store so that they are compiled and can be called
+const storeBindings = "internal/store/store.go"
+
+var update = flag.Bool("update", false, "regenerate "+storeBindings)
+
+const testABI = `[
+  {"type":"constructor","inputs":[{"name":"owner","type":"address"}]},
+  {"type":"function","name":"get","stateMutability":"view","inputs":[{"name":"key","type":"bytes32"}],
+   "outputs":[{"name":"","type":"uint256"},{"name":"ok","type":"bool"}]},
+  {"type":"function","name":"set","stateMutability":"nonpayable","inputs":[{"name":"key","type":"bytes32"},{"name":"values","type":"int64[]"}],
+   "outputs":[]},
+  {"type":"function","name":"setOrder","constant":false,"inputs":[{"name":"order","type":"tuple",
+   "components":[{"name":"id","type":"uint32"},{"name":"buyer","type":"address"},{"name":"items","type":"string[2]"}]}],
+   "outputs":[]},
+  {"type":"function","name":"type","constant":true,"inputs":[{"name":"func","type":"uint8"}],"outputs":[{"name":"","type":"string"}]},
+  {"type":"event","name":"Set","inputs":[{"name":"key","type":"bytes32","indexed":true},{"name":"value","type":"int256","indexed":false}]}
+]`
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("store", "Store", []byte(testABI))
+	require.NoError(t, err, "%s", src)
+	_, err = parser.ParseFile(token.NewFileSet(), "store.go", src, parser.AllErrors)
+	require.NoError(t, err, "%s", src)
+	code := string(src)
+	for _, decl := range []string{
+		"package store",
+		"type Store struct",
+		"func NewStore(",
+		// view and pure functions are simulated
+		"func (c *Store) Get(ctx context.Context, key [32]byte) (ret0 *big.Int, ok bool, err error)",
+		"func (c *Store) Set(ctx context.Context, key [32]byte, values []int64) (txe *exec.TxExecution, err error)",
+		"type SetOrderOrder struct",
+		"func (c *Store) Type(ctx context.Context, func_ uint8)",
+		"type SetEvent struct",
+		"func (c *Store) WatchSet(",
+	} {
+		assert.Contains(t, code, decl)
+	}
+	// No bytecode so no deploy
+	assert.NotContains(t, code, "func DeployStore(")
+}
+
+func TestGenerateFromBin(t *testing.T) {
+	bin := `{"Abi":` + testABI + `,"Evm":{"Bytecode":{"Object":"6080604052"}}}`
+	src, err := Generate("store", "Store", []byte(bin))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func DeployStore(")
+
+	bin = `{"Abi":` + testABI + `,"Evm":{"Bytecode":{"Object":"6080__Library__6052"}}}`
+	_, err = Generate("store", "Store", []byte(bin))
+	assert.Error(t, err)
+}
+
+func TestGeneratedBindings(t *testing.T) {
+	src, err := Generate("store", "Store", []byte(testABI))
+	require.NoError(t, err)
+	if *update {
+		require.NoError(t, ioutil.WriteFile(storeBindings, src, 0644))
+	}
+	checkedIn, err := ioutil.ReadFile(storeBindings)
+	require.NoError(t, err)
+	require.Equal(t, string(src), string(checkedIn), "%s is stale, regenerate it with go test -update", storeBindings)
+
+	spec, err := abi.ReadAbiSpec([]byte(testABI))
+	require.NoError(t, err)
+	client := new(transactClient)
+	client.result, err = abi.Pack(spec.Functions["get"].Outputs, big.NewInt(42), true)
+	require.NoError(t, err)
+	contract := store.NewStore(crypto.Address{1}, crypto.Address{2}, client, nil)
+	key := [32]byte{3}
+
+	value, ok, err := contract.Get(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), value)
+	assert.True(t, ok)
+	require.Len(t, client.simulated, 1)
+	assert.Empty(t, client.sent)
+	data, _, err := spec.Pack("get", key)
+	require.NoError(t, err)
+	assert.Equal(t, data, []byte(client.simulated[0].Data))
+	assert.Equal(t, crypto.Address{1}, *client.simulated[0].Address)
+
+	txe, err := contract.Set(context.Background(), key, []int64{1, 2})
+	require.NoError(t, err)
+	assert.NotNil(t, txe)
+	require.Len(t, client.sent, 1)
+	data, _, err = spec.Pack("set", key, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, data, []byte(client.sent[0].Data))
+	assert.Equal(t, crypto.Address{2}, client.sent[0].Input.Address)
+}
+
+// Records the transactions it is asked to simulate or send, the rest of the client is left unimplemented
+type transactClient struct {
+	rpctransact.TransactClient
+	simulated []*payload.CallTx
+	sent      []*payload.CallTx
+	result    []byte
+}
+
+func (tc *transactClient) CallTxSim(ctx context.Context, in *payload.CallTx,
+	opts ...grpc.CallOption) (*exec.TxExecution, error) {
+
+	tc.simulated = append(tc.simulated, in)
+	return &exec.TxExecution{Result: &exec.Result{Return: tc.result}}, nil
+}
+
+func (tc *transactClient) CallTxSync(ctx context.Context, in *payload.CallTx,
+	opts ...grpc.CallOption) (*exec.TxExecution, error) {
+
+	tc.sent = append(tc.sent, in)
+	return &exec.TxExecution{Result: new(exec.Result)}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"text/template"
 
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/iancoleman/strcase"
 )
 
@@ -138,10 +139,10 @@ func (function *solidityFunction) ArgList() string {
 	argList := make([]string, len(function.Abi.Inputs))
 	for i, arg := range function.Abi.Inputs {
 		storage := ""
-		if arg.EVM.Dynamic() {
+		if arg.IsArray || arg.EVM.Dynamic() {
 			storage = " memory"
 		}
-		argList[i] = fmt.Sprintf("%s%s %s", arg.EVM.GetSignature(), storage, param(arg.Name))
+		argList[i] = fmt.Sprintf("%s%s %s", solidityType(arg), storage, param(arg.Name))
 	}
 	return strings.Join(argList, ", ")
 }
@@ -149,11 +150,19 @@ func (function *solidityFunction) ArgList() string {
 func (function *solidityFunction) RetList() string {
 	argList := make([]string, len(function.Abi.Outputs))
 	for i, arg := range function.Abi.Outputs {
-		argList[i] = fmt.Sprintf("%s %s", arg.EVM.GetSignature(), param(arg.Name))
+		argList[i] = fmt.Sprintf("%s %s", solidityType(arg), param(arg.Name))
 	}
 	return strings.Join(argList, ", ")
 }
 
+// The Solidity type of an argument including its array dimension, if any
+func solidityType(arg abi.Argument) string {
+	if arg.IsArray {
+		return abi.EVMArray{Elem: arg.EVM, Length: arg.ArrayLength}.GetSignature()
+	}
+	return arg.EVM.GetSignature()
+}
+
 func (function *solidityFunction) Comment() string {
 	return comment(function.SNativeFunctionDescription.Comment)
 }
@@ -40,17 +40,26 @@ func decodeEvent(header *exec.Header, log *exec.LogEvent, abiSpec *abi.AbiSpec)
 
 	// for each decoded item value, stores it in given item name
 	for i, input := range evAbi.Inputs {
-		switch v := unpackedData[i].(type) {
-		case *crypto.Address:
-			data[input.Name] = v.String()
-		case *big.Int:
-			data[input.Name] = v.String()
-		case *string:
-			data[input.Name] = *v
-		default:
-			data[input.Name] = v
-		}
+		decodeValue(data, input.Name, unpackedData[i])
 	}
 
 	return data, nil
 }
+
+// decodeValue stores v under name, flattening structs (tuples) into one item per field named like 'order.amount'
+func decodeValue(data map[string]interface{}, name string, v interface{}) {
+	switch v := v.(type) {
+	case *crypto.Address:
+		data[name] = v.String()
+	case *big.Int:
+		data[name] = v.String()
+	case *string:
+		data[name] = *v
+	case *map[string]interface{}:
+		for field, value := range *v {
+			decodeValue(data, name+"."+field, value)
+		}
+	default:
+		data[name] = v
+	}
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStructEvent(t *testing.T) {
+	abiSpec, err := abi.ReadAbiSpec([]byte(`[{"name":"OrderPlaced","type":"event","anonymous":false,"inputs":[
+  {"name":"order","type":"tuple","indexed":false,
+   "components":[{"name":"amount","type":"uint256"},{"name":"note","type":"string"}]}]}]`))
+	require.NoError(t, err)
+	evAbi := abiSpec.Events["OrderPlaced"]
+
+	data, err := abi.Pack(evAbi.Inputs, []interface{}{"42", "hi"})
+	require.NoError(t, err)
+
+	log := &exec.LogEvent{
+		Topics: []binary.Word256{binary.LeftPadWord256(evAbi.EventID.Bytes())},
+		Data:   data,
+	}
+	decoded, err := decodeEvent(&exec.Header{}, log, abiSpec)
+	require.NoError(t, err)
+	assert.Equal(t, "OrderPlaced", decoded[types.EventNameLabel])
+	assert.Equal(t, "42", decoded["order.amount"])
+	assert.Equal(t, "hi", decoded["order.note"])
+}